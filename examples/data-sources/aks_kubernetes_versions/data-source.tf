@@ -0,0 +1,10 @@
+# Read the Kubernetes versions available for AKS clusters in a location
+data "tanzu-mission-control_aks_kubernetes_versions" "read_aks_versions" {
+  credential_name = "azure-aks-credential"                 # Required
+  subscription_id = "00000000-0000-0000-0000-000000000000" # Required
+  location        = "eastus"                               # Required
+}
+
+output "latest_aks_version" {
+  value = data.tanzu-mission-control_aks_kubernetes_versions.read_aks_versions.latest_version
+}
@@ -0,0 +1,10 @@
+# Read Tanzu Mission Control cluster groups : list the production cluster groups
+data "tanzu-mission-control_cluster_groups" "read_cluster_groups" {
+  labels = {
+    "env" : "prod"
+  }
+}
+
+output "cluster_group_names" {
+  value = data.tanzu-mission-control_cluster_groups.read_cluster_groups.cluster_groups[*].name
+}
@@ -0,0 +1,21 @@
+# Read Tanzu Mission Control clusters : list the healthy attached clusters of a cluster group
+data "tanzu-mission-control_clusters" "read_clusters" {
+  management_cluster_name = "attached"
+  provisioner_name        = "attached"
+  cluster_group           = "default"
+  health                  = "HEALTHY"
+
+  labels = {
+    "env" : "prod"
+  }
+}
+
+# Attach a namespace to each of the listed clusters
+resource "tanzu-mission-control_namespace" "namespace" {
+  for_each = { for cluster in data.tanzu-mission-control_clusters.read_clusters.clusters : cluster.name => cluster }
+
+  name                    = "tf-namespace"
+  cluster_name            = each.value.name
+  management_cluster_name = each.value.management_cluster_name
+  provisioner_name        = each.value.provisioner_name
+}
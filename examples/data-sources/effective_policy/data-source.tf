@@ -0,0 +1,17 @@
+# Read the security policies effective on an attached cluster
+data "tanzu-mission-control_effective_policy" "security" {
+  cluster_name = "tf-attach-test" # Required
+  policy_type  = "security-policy"
+}
+
+# Read all the policies effective on a namespace of a provisioned cluster
+data "tanzu-mission-control_effective_policy" "namespace" {
+  management_cluster_name = "tkgm-mgmt"
+  provisioner_name        = "default"
+  cluster_name            = "tkgm-workload" # Required
+  namespace_name          = "tf-ns-test"
+}
+
+output "inherited_security_policies" {
+  value = [for policy in data.tanzu-mission-control_effective_policy.security.policies : policy.name if policy.inherited]
+}
@@ -0,0 +1,19 @@
+# Read the Kubernetes versions available for EKS clusters in a region
+data "tanzu-mission-control_eks_kubernetes_versions" "read_eks_versions" {
+  credential_name = "aws-eks-credential" # Required
+  region          = "us-west-2"          # Required
+}
+
+resource "tanzu-mission-control_ekscluster" "eks_cluster" {
+  credential_name = "aws-eks-credential"
+  region          = "us-west-2"
+  name            = "tf-test-cluster"
+
+  spec {
+    config {
+      kubernetes_version = data.tanzu-mission-control_eks_kubernetes_versions.read_eks_versions.latest_version
+      # ...
+    }
+    # ...
+  }
+}
@@ -0,0 +1,19 @@
+# Read the results of a CIS benchmark inspection
+data "tanzu-mission-control_inspection_results" "cis" {
+  name         = tanzu-mission-control_inspection.cis.name         # Required
+  cluster_name = tanzu-mission-control_inspection.cis.cluster_name # Required
+}
+
+# Fail the run when any of the checks failed
+resource "terraform_data" "cis_gate" {
+  lifecycle {
+    precondition {
+      condition     = data.tanzu-mission-control_inspection_results.cis.succeeded
+      error_message = "CIS inspection failed: ${join(", ", [for result in data.tanzu-mission-control_inspection_results.cis.results : result.id if result.status == "FAIL"])}"
+    }
+  }
+}
+
+output "cis_summary" {
+  value = data.tanzu-mission-control_inspection_results.cis.summary
+}
@@ -0,0 +1,10 @@
+# Read Tanzu Mission Control management clusters : list the production management clusters
+data "tanzu-mission-control_management_clusters" "read_management_clusters" {
+  labels = {
+    "env" : "prod"
+  }
+}
+
+output "management_cluster_names" {
+  value = data.tanzu-mission-control_management_clusters.read_management_clusters.management_clusters[*].name
+}
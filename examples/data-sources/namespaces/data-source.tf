@@ -0,0 +1,10 @@
+# Read Tanzu Mission Control namespaces : list the namespaces of a workspace on the attached clusters
+data "tanzu-mission-control_namespaces" "read_namespaces" {
+  management_cluster_name = "attached"
+  provisioner_name        = "attached"
+  workspace_name          = "tf-workspace"
+}
+
+output "namespaces" {
+  value = [for namespace in data.tanzu-mission-control_namespaces.read_namespaces.namespaces : "${namespace.cluster_name}/${namespace.name}"]
+}
@@ -0,0 +1,11 @@
+# Read the versions of a package available on a cluster
+data "tanzu-mission-control_package_versions" "read_package_versions" {
+  cluster_name            = "testcluster"                   # Required
+  management_cluster_name = "attached"                      # Default: attached
+  provisioner_name        = "attached"                      # Default: attached
+  package_metadata_name   = "cert-manager.tanzu.vmware.com" # Required
+}
+
+output "cert_manager_versions" {
+  value = data.tanzu-mission-control_package_versions.read_package_versions.versions
+}
@@ -0,0 +1,8 @@
+# Read Tanzu Mission Control provisioners : list the provisioners of a management cluster
+data "tanzu-mission-control_provisioners" "read_provisioners" {
+  management_cluster_name = "tkgm-management-cluster"
+}
+
+output "provisioner_names" {
+  value = data.tanzu-mission-control_provisioners.read_provisioners.provisioners[*].name
+}
@@ -0,0 +1,14 @@
+# Read the EC2 instance types available for TKG AWS cluster nodes in a region
+data "tanzu-mission-control_tkg_aws_instance_types" "read_instance_types" {
+  credential_name = "aws-tkg-credential" # Required
+  region          = "us-west-2"          # Required
+}
+
+variable "worker_instance_type" {
+  type    = string
+  default = "m5.large"
+}
+
+output "worker_instance_type_available" {
+  value = contains(data.tanzu-mission-control_tkg_aws_instance_types.read_instance_types.instance_types, var.worker_instance_type)
+}
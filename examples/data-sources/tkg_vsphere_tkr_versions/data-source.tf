@@ -0,0 +1,10 @@
+# Read the Tanzu Kubernetes releases available on a vSphere management cluster
+data "tanzu-mission-control_tkg_vsphere_tkr_versions" "read_tkr_versions" {
+  management_cluster_name = "tkgm-vsphere-mgmt" # Required
+  provisioner_name        = "default"           # Required
+  include_incompatible    = false               # Default: false
+}
+
+output "latest_tkr_version" {
+  value = data.tanzu-mission-control_tkg_vsphere_tkr_versions.read_tkr_versions.latest_version
+}
@@ -0,0 +1,12 @@
+# Read Tanzu Mission Control workspaces : list the workspaces of a team
+data "tanzu-mission-control_workspaces" "read_workspaces" {
+  name = "tf-*"
+
+  labels = {
+    "team" : "platform"
+  }
+}
+
+output "workspace_names" {
+  value = data.tanzu-mission-control_workspaces.read_workspaces.workspaces[*].name
+}
@@ -6,6 +6,13 @@ provider "tanzu-mission-control" {
   # if you are using dev or different csp endpoint, change the default value below
   # for production environments the vmw_cloud_endpoint is console.cloud.vmware.com
   # vmw_cloud_endpoint = "console.cloud.vmware.com" or optionally use VMW_CLOUD_ENDPOINT env var
+
+  # optionally tune how requests are retried on connection errors, rate limiting (429) and server errors (5xx)
+  # retry {
+  #   max_retries = 5
+  #   min_backoff = "1s"
+  #   max_backoff = "30s"
+  # }
 }
 
 # Provider configuration for TMC Self-Managed
@@ -0,0 +1,40 @@
+// Tanzu Mission Control AKS Cluster Nodepool
+resource "tanzu-mission-control_akscluster_nodepool" "tf_aks_spot_nodepool" {
+  credential_name = "azure-test"                           // Required
+  subscription_id = "00000000-0000-0000-0000-000000000000" // Required
+  resource_group  = "my-resource-group"                    // Required
+  cluster_name    = "my-aks-cluster"                       // Required
+  name            = "spotnp"                               // Required
+
+  ready_wait_timeout = "30m" // Default: waits until 30 min for the nodepool to become ready
+
+  meta {
+    description = "spot nodepool owned by team a"
+    labels      = { "team" : "a" }
+  }
+
+  spec {
+    mode    = "USER"            // Required, only USER nodepools are supported
+    count   = 1                 // Required
+    vm_size = "Standard_D4s_v3" // Required // Force Recreate
+
+    type                      = "VIRTUAL_MACHINE_SCALE_SETS"
+    os_type                   = "LINUX"
+    scale_set_priority        = "SPOT"   // Force Recreate
+    scale_set_eviction_policy = "DELETE" // Force Recreate
+    spot_max_price            = -1       // Force Recreate
+    node_labels               = { "team" : "a" }
+
+    taints {
+      effect = "NO_SCHEDULE"
+      key    = "kubernetes.azure.com/scalesetpriority"
+      value  = "spot"
+    }
+
+    auto_scaling_config {
+      enable    = true
+      min_count = 1
+      max_count = 5
+    }
+  }
+}
@@ -0,0 +1,25 @@
+# Create a daily backup schedule of all the namespaces of a cluster
+resource "tanzu-mission-control_backup_schedule" "daily" {
+  name                    = "tf-daily-backup"     # Required
+  cluster_name            = "tf-attached-cluster" # Required
+  management_cluster_name = "attached"            # Default: attached
+  provisioner_name        = "attached"            # Default: attached
+
+  spec {
+    paused = false
+
+    schedule {
+      rate = "0 2 * * *" # Required
+    }
+
+    template {
+      storage_location          = "tf-tmc-provisioned-location" # Required
+      ttl                       = "720h0m0s"                    # Default: 720h0m0s
+      excluded_namespaces       = ["kube-system", "vmware-system-tmc"]
+      include_cluster_resources = true # Default: true
+      snapshot_volumes          = true # Default: true
+    }
+  }
+
+  depends_on = [tanzu-mission-control_enable_data_protection.demo]
+}
@@ -0,0 +1,25 @@
+# Create an Azure AKS credential from a service principal
+resource "tanzu-mission-control_credential" "azure_aks_cred" {
+  name = "tf-azure-aks-test"
+
+  meta {
+    description = "credential"
+    labels = {
+      "key1" : "value1",
+    }
+  }
+
+  spec {
+    capability = "MANAGED_K8S_PROVIDER"
+    provider   = "AZURE_AKS"
+
+    data {
+      azure_service_principal {
+        subscription_id = "00000000-0000-0000-0000-000000000000"
+        tenant_id       = "00000000-0000-0000-0000-000000000000"
+        client_id       = "00000000-0000-0000-0000-000000000000"
+        client_secret   = var.azure_client_secret
+      }
+    }
+  }
+}
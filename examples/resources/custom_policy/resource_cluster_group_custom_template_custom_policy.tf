@@ -0,0 +1,37 @@
+/*
+Cluster group scoped Tanzu Mission Control custom policy with custom input recipe.
+This policy is applied to a cluster group using the k8srequiredreplicas custom policy template with its typed parameters.
+The defined scope and input blocks can be updated to change the policy's scope and recipe, respectively.
+*/
+resource "tanzu-mission-control_custom_policy" "cluster_group_scoped_custom_template_custom_policy" {
+  name = "tf-custom-test"
+
+  scope {
+    cluster_group {
+      cluster_group = "tf-create-test"
+    }
+  }
+
+  spec {
+    input {
+      custom {
+        template_name = tanzu-mission-control_custom_policy_template.required_replicas.name
+        audit         = false
+
+        parameters = jsonencode({
+          min        = 2
+          namespaces = ["default", "production"]
+        })
+
+        target_kubernetes_resources {
+          api_groups = [
+            "apps",
+          ]
+          kinds = [
+            "Deployment",
+          ]
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,37 @@
+/*
+Tanzu Mission Control custom policy template wrapping a raw ConstraintTemplate manifest.
+Namespaces are synced into OPA through the data inventory so that the Rego can reference them.
+*/
+resource "tanzu-mission-control_custom_policy_template" "block_unlabelled_namespace_resources" {
+  name = "k8sblockunlabellednamespaceresources"
+
+  spec {
+    data_inventory {
+      group   = ""
+      version = "v1"
+      kind    = "Namespace"
+    }
+
+    template_manifest = <<YAML
+apiVersion: templates.gatekeeper.sh/v1beta1
+kind: ConstraintTemplate
+metadata:
+  name: k8sblockunlabellednamespaceresources
+spec:
+  crd:
+    spec:
+      names:
+        kind: K8sBlockUnlabelledNamespaceResources
+  targets:
+    - target: admission.k8s.gatekeeper.sh
+      rego: |
+        package k8sblockunlabellednamespaceresources
+
+        violation[{"msg": msg}] {
+          ns := data.inventory.cluster["v1"]["Namespace"][input.review.object.metadata.namespace]
+          not ns.metadata.labels["team"]
+          msg := "resources are not allowed in namespaces without a team label"
+        }
+YAML
+  }
+}
@@ -0,0 +1,36 @@
+/*
+Tanzu Mission Control custom policy template defined from its Rego source and parameters schema.
+The ConstraintTemplate manifest is built by the provider. The template name must be the lowercase form of the kind.
+*/
+resource "tanzu-mission-control_custom_policy_template" "required_replicas" {
+  name = "k8srequiredreplicas"
+
+  spec {
+    constraint_template {
+      kind = "K8sRequiredReplicas"
+      rego = <<EOT
+package k8srequiredreplicas
+
+violation[{"msg": msg}] {
+  input.review.object.metadata.namespace == input.parameters.namespaces[_]
+  input.review.object.spec.replicas < input.parameters.min
+  msg := sprintf("deployments must have at least %v replicas", [input.parameters.min])
+}
+EOT
+
+      parameters = jsonencode({
+        properties = {
+          min = {
+            type = "integer"
+          }
+          namespaces = {
+            type = "array"
+            items = {
+              type = "string"
+            }
+          }
+        }
+      })
+    }
+  }
+}
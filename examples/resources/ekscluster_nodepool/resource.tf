@@ -0,0 +1,42 @@
+// Tanzu Mission Control EKS Cluster Nodepool
+resource "tanzu-mission-control_ekscluster_nodepool" "tf_eks_nodepool" {
+  credential_name = "eks-test"          // Required
+  region          = "us-west-2"         // Required
+  cluster_name    = "tf2-eks-cluster-2" // Required
+  name            = "team-a-np"         // Required
+
+  ready_wait_timeout = "30m" // Default: waits until 30 min for the nodepool to become ready
+
+  meta {
+    description = "nodepool owned by team a"
+    labels      = { "team" : "a" }
+  }
+
+  spec {
+    role_arn = "arn:aws:iam::000000000000:role/worker.1234567890123467890.eks.tmc.cloud.vmware.com" // Required
+
+    capacity_type  = "ON_DEMAND"
+    root_disk_size = 40 // Default: 20GiB
+    tags           = { "nptag" : "nptagvalue" }
+    node_labels    = { "team" : "a" }
+
+    subnet_ids = [ // Required
+      "subnet-0a184f9301ae39a86",
+      "subnet-0b495d7c212fc92a1",
+    ]
+
+    instance_types = [
+      "t3.medium",
+    ]
+
+    scaling_config {
+      desired_size = 2
+      max_size     = 4
+      min_size     = 1
+    }
+
+    update_config {
+      max_unavailable_percentage = "25"
+    }
+  }
+}
@@ -0,0 +1,14 @@
+# Enable data protection on an attached cluster
+resource "tanzu-mission-control_enable_data_protection" "demo" {
+  cluster_name            = "tf-attached-cluster" # Required
+  management_cluster_name = "attached"            # Default: attached
+  provisioner_name        = "attached"            # Default: attached
+
+  spec {
+    enable_csi_snapshots                 = false
+    disable_restic                       = false
+    enable_all_api_group_versions_backup = false
+  }
+
+  delete_backups = false # Default: false
+}
@@ -0,0 +1,19 @@
+# Enable the Helm feature on a cluster group
+resource "tanzu-mission-control_helm_feature" "enable_cluster_group_helm" {
+  scope {
+    cluster_group {
+      name = "default" # Required
+    }
+  }
+}
+
+# Enable the Helm feature on a cluster
+resource "tanzu-mission-control_helm_feature" "enable_cluster_helm" {
+  scope {
+    cluster {
+      name                    = "tf-cluster" # Required
+      management_cluster_name = "attached"   # Default: attached
+      provisioner_name        = "attached"   # Default: attached
+    }
+  }
+}
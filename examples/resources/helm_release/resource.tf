@@ -0,0 +1,65 @@
+# Create Tanzu Mission Control Helm release of a chart from a Helm repository on a cluster group
+resource "tanzu-mission-control_helm_release" "create_cluster_group_helm_release" {
+  name = "tf-helm-release-name" # Required
+
+  namespace_name = "tf-namespace" # Required
+
+  scope {
+    cluster_group {
+      name = "default" # Required
+    }
+  }
+
+  meta {
+    description = "Helm release managed through terraform"
+    labels      = { "key" : "value" }
+  }
+
+  spec {
+    chart_ref {
+      chart                = "podinfo"              # Required
+      repository_name      = "podinfo"              # Required
+      repository_namespace = "tanzu-helm-resources" # Required
+      repository_type      = "HELM"                 # Required
+      version              = "6.5.0"
+    }
+
+    values = yamlencode({
+      replicaCount = 2
+      ui           = { message = "Deployed through terraform" }
+    })
+
+    interval         = "10m" # Default: 10m
+    target_namespace = "podinfo"
+  }
+}
+
+# Create Tanzu Mission Control Helm release of a chart from a Git repository on a cluster
+resource "tanzu-mission-control_helm_release" "create_cluster_helm_release" {
+  name = "tf-helm-release-name" # Required
+
+  namespace_name = "tf-namespace" # Required
+
+  scope {
+    cluster {
+      name                    = "tf-cluster" # Required
+      management_cluster_name = "attached"   # Default: attached
+      provisioner_name        = "attached"   # Default: attached
+    }
+  }
+
+  spec {
+    chart_ref {
+      chart                = "charts/podinfo"    # Required
+      repository_name      = "tf-git-repository" # Required
+      repository_namespace = "tf-namespace"      # Required
+      repository_type      = "GIT"               # Required
+    }
+
+    values = <<-EOT
+      replicaCount: 2
+      ui:
+        message: Deployed through terraform
+    EOT
+  }
+}
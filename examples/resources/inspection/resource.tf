@@ -0,0 +1,25 @@
+# Run a CIS benchmark inspection on an attached cluster
+resource "tanzu-mission-control_inspection" "cis" {
+  name                    = "tf-cis-inspection"   # Required
+  cluster_name            = "tf-attached-cluster" # Required
+  management_cluster_name = "attached"            # Default: attached
+  provisioner_name        = "attached"            # Default: attached
+
+  spec {
+    type = "CIS" # Required: CIS, LITE or CONFORMANCE
+  }
+}
+
+# Run a full Kubernetes conformance inspection, which takes longer than the default create timeout
+resource "tanzu-mission-control_inspection" "conformance" {
+  name         = "tf-conformance-inspection" # Required
+  cluster_name = "tf-attached-cluster"       # Required
+
+  spec {
+    type = "CONFORMANCE" # Required: CIS, LITE or CONFORMANCE
+  }
+
+  timeouts {
+    create = "3h"
+  }
+}
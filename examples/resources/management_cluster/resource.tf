@@ -0,0 +1,33 @@
+# Register a Tanzu Kubernetes Grid management cluster and apply the registration manifest through its kubeconfig
+resource "tanzu-mission-control_management_cluster" "register_tkgm_management_cluster" {
+  name = "tf-tkgm-management-cluster"
+
+  meta {
+    description = "Management cluster registered through terraform"
+    labels = {
+      "key1" : "value1",
+    }
+  }
+
+  spec {
+    kubernetes_provider_type = "VMWARE_TANZU_KUBERNETES_GRID"
+    cluster_group            = "default"
+  }
+
+  register_management_cluster {
+    kubeconfig_file = "<kube-config-path>"
+  }
+}
+
+# Register a vSphere with Tanzu supervisor cluster, the registration link is applied out of band
+resource "tanzu-mission-control_management_cluster" "register_tkgs_management_cluster" {
+  name = "tf-tkgs-management-cluster"
+
+  spec {
+    kubernetes_provider_type = "VMWARE_TANZU_KUBERNETES_GRID_SERVICE"
+  }
+}
+
+output "tkgs_registration_link" {
+  value = tanzu-mission-control_management_cluster.register_tkgs_management_cluster.registration_link
+}
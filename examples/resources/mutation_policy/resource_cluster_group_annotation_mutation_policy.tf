@@ -0,0 +1,29 @@
+/*
+Cluster group scoped Tanzu Mission Control mutation policy with annotation input recipe.
+This policy is applied to a cluster group and adds the given annotation to the targeted Kubernetes resources.
+The defined scope and input blocks can be updated to change the policy's scope and recipe, respectively.
+*/
+resource "tanzu-mission-control_mutation_policy" "cluster_group_scoped_annotation_mutation_policy" {
+  name = "tf-mp-test"
+
+  scope {
+    cluster_group {
+      cluster_group = "tf-create-test-cg"
+    }
+  }
+
+  spec {
+    input {
+      annotation {
+        target_kubernetes_resources {
+          api_groups = [""]
+          kinds      = ["Pod"]
+        }
+        annotation {
+          key   = "owner"
+          value = "platform-team"
+        }
+      }
+    }
+  }
+}
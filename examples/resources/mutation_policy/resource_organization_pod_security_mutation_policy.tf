@@ -0,0 +1,45 @@
+/*
+Organization scoped Tanzu Mission Control mutation policy with pod_security input recipe.
+This policy is applied to an organization, mutates the security context of pods and is inherited by the cluster groups, workspaces and clusters.
+The defined scope and input blocks can be updated to change the policy's scope and recipe, respectively.
+*/
+resource "tanzu-mission-control_mutation_policy" "organization_scoped_pod_security_mutation_policy" {
+  name = "tf-mp-test"
+
+  scope {
+    organization {
+      organization = "dummy-id"
+    }
+  }
+
+  spec {
+    input {
+      pod_security {
+        allow_privilege_escalation {
+          condition = "Always"
+          value     = false
+        }
+        capabilities_drop {
+          operation = "merge"
+          values    = ["NET_RAW"]
+        }
+        run_as_non_root {
+          condition = "IfFieldDoesNotExist"
+          value     = true
+        }
+        run_as_user {
+          condition = "IfFieldDoesNotExist"
+          value     = 1000
+        }
+        se_linux_options {
+          condition = "IfFieldDoesNotExist"
+          level     = "s0:c123,c456"
+        }
+        supplemental_groups {
+          condition = "IfFieldDoesNotExist"
+          values    = [1000, 2000]
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,41 @@
+/*
+Workspace scoped Tanzu Mission Control mutation policy with label input recipe.
+This policy is applied to a workspace and adds the given label to the targeted Kubernetes resources.
+The defined scope and input blocks can be updated to change the policy's scope and recipe, respectively.
+*/
+resource "tanzu-mission-control_mutation_policy" "workspace_scoped_label_mutation_policy" {
+  name = "tf-mp-test"
+
+  scope {
+    workspace {
+      workspace = "tf-workspace"
+    }
+  }
+
+  spec {
+    input {
+      label {
+        target_kubernetes_resources {
+          api_groups = ["apps"]
+          kinds      = ["Deployment", "StatefulSet"]
+        }
+        scope = "Namespaced"
+        label {
+          key   = "team"
+          value = "platform"
+        }
+      }
+    }
+
+    namespace_selector {
+      match_expressions {
+        key      = "component"
+        operator = "NotIn"
+        values = [
+          "api-server",
+          "agent-gateway"
+        ]
+      }
+    }
+  }
+}
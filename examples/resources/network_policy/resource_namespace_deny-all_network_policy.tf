@@ -0,0 +1,24 @@
+/*
+Namespace scoped Tanzu Mission Control network policy with deny-all input recipe.
+This policy is applied to a single namespace of a cluster with the deny-all configuration option,
+without the need for a workspace or a namespace selector.
+The defined scope and input blocks can be updated to change the policy's scope and recipe, respectively.
+*/
+resource "tanzu-mission-control_network_policy" "namespace_scoped_deny-all_network_policy" {
+  name = "tf-network-test"
+
+  scope {
+    namespace {
+      management_cluster_name = "attached"
+      provisioner_name        = "attached"
+      cluster_name            = "tf-create-test"
+      name                    = "tf-team-namespace"
+    }
+  }
+
+  spec {
+    input {
+      deny_all {}
+    }
+  }
+}
@@ -0,0 +1,34 @@
+# Create Tanzu Mission Control package install on a cluster
+resource "tanzu-mission-control_package_install" "create_cluster_package_install" {
+  name           = "cert-manager" # Required
+  namespace_name = "tanzu-packages" # Required
+
+  scope {
+    cluster {
+      name                    = "testcluster" # Required
+      provisioner_name        = "attached"    # Default: attached
+      management_cluster_name = "attached"    # Default: attached
+    }
+  }
+
+  meta {
+    description = "Package install managed through terraform"
+    labels      = { "key" : "value" }
+  }
+
+  spec {
+    package_ref {
+      package_metadata_name = "cert-manager.tanzu.vmware.com" # Required
+
+      version_selection {
+        constraints = "1.10.2+vmware.1-tkg.1" # Required
+      }
+    }
+
+    role_binding_scope = "CLUSTER" # Default: CLUSTER
+
+    inline_values = yamlencode({
+      namespace = "cert-manager"
+    })
+  }
+}
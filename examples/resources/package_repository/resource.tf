@@ -0,0 +1,21 @@
+# Create Tanzu Mission Control package repository on a cluster group
+resource "tanzu-mission-control_package_repository" "create_cluster_group_package_repository" {
+  name = "tanzu-standard" # Required
+
+  scope {
+    cluster_group {
+      name = "default" # Required
+    }
+  }
+
+  meta {
+    description = "Tanzu standard package repository managed through terraform"
+    labels      = { "key" : "value" }
+  }
+
+  spec {
+    imgpkg_bundle {
+      image = "projects.registry.vmware.com/tkg/packages/standard/repo:v2.2.0_update.2" # Required
+    }
+  }
+}
@@ -0,0 +1,12 @@
+# Create Tanzu Mission Control provisioner
+resource "tanzu-mission-control_provisioner" "create_provisioner" {
+  management_cluster_name = tanzu-mission-control_management_cluster.register_tkgm_management_cluster.name
+  name                    = "tf-provisioner"
+
+  meta {
+    description = "Create provisioner through terraform"
+    labels = {
+      "key1" : "value1",
+    }
+  }
+}
@@ -0,0 +1,33 @@
+/*
+Namespace scoped Tanzu Mission Control namespace quota policy with custom input recipe.
+This policy is applied to a single namespace of a cluster with the custom configuration option,
+without the need for a workspace or a namespace selector.
+The defined scope and input blocks can be updated to change the policy's scope and recipe, respectively.
+*/
+
+resource "tanzu-mission-control_namespace_quota_policy" "namespace_scoped_custom_quota_policy" {
+  name = "tf-qt-test"
+
+  scope {
+    namespace {
+      management_cluster_name = "attached"
+      provisioner_name        = "attached"
+      cluster_name            = "tf-create-test"
+      name                    = "tf-team-namespace"
+    }
+  }
+
+  spec {
+    input {
+      custom {
+        limits_cpu      = "4"
+        limits_memory   = "8Mi"
+        requests_cpu    = "2"
+        requests_memory = "4Mi"
+        resource_counts = {
+          pods = 10
+        }
+      }
+    }
+  }
+}
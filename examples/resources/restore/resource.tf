@@ -0,0 +1,17 @@
+# Restore a namespace of a backup into a new namespace
+resource "tanzu-mission-control_restore" "demo" {
+  name                    = "tf-restore"          # Required
+  cluster_name            = "tf-attached-cluster" # Required
+  management_cluster_name = "attached"            # Default: attached
+  provisioner_name        = "attached"            # Default: attached
+
+  spec {
+    backup_name         = "tf-daily-backup-20230101020000" # Required
+    included_namespaces = ["demo"]
+    restore_pvs         = true
+
+    namespace_mapping = {
+      "demo" : "demo-restored"
+    }
+  }
+}
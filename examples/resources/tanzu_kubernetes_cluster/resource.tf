@@ -0,0 +1,61 @@
+# Create a ClusterClass based Tanzu Kubernetes cluster on a vSphere with Tanzu supervisor cluster
+resource "tanzu-mission-control_tanzu_kubernetes_cluster" "create_tanzu_kubernetes_cluster" {
+  management_cluster_name = "<management-cluster>" # Required
+  provisioner_name        = "<provisioner>"        # Required
+  name                    = "tf-tkc"               # Required
+
+  meta {
+    description = "ClusterClass based cluster created through terraform"
+    labels = {
+      "key1" : "value1",
+    }
+  }
+
+  spec {
+    cluster_group = "default"
+
+    topology {
+      cluster_class = "tanzukubernetescluster"
+      version       = "v1.26.5+vmware.2-fips.1"
+
+      cluster_variables = jsonencode({
+        vmClass             = "best-effort-large"
+        storageClass        = "vsan-default-storage-policy"
+        defaultStorageClass = "vsan-default-storage-policy"
+      })
+
+      control_plane {
+        replicas = 3
+
+        os_image {
+          name = "ubuntu"
+        }
+      }
+
+      nodepool {
+        name         = "md-0"
+        description  = "general purpose node pool"
+        worker_class = "node-pool"
+        replicas     = 2
+      }
+
+      nodepool {
+        name         = "md-gpu"
+        worker_class = "node-pool"
+        replicas     = 1
+
+        override_variables = jsonencode({
+          vmClass = "guaranteed-xlarge-gpu"
+        })
+      }
+
+      network {
+        pod_cidr_blocks     = ["192.168.0.0/16"]
+        service_cidr_blocks = ["10.96.0.0/12"]
+        service_domain      = "cluster.local"
+      }
+    }
+  }
+
+  ready_wait_timeout = "30m"
+}
@@ -0,0 +1,67 @@
+# Create a target location backed by a Tanzu Mission Control provisioned AWS S3 storage
+resource "tanzu-mission-control_target_location" "tmc_provisioned" {
+  name          = "tf-tmc-provisioned-location" # Required
+  provider_name = "tmc"                         # Default: tmc
+
+  spec {
+    target_provider = "AWS"                # Required
+    credential_name = "tf-aws-s3-cred"     # Required
+    region          = "us-west-2"
+
+    assigned_groups {
+      cluster {
+        name                    = "tf-attached-cluster"
+        management_cluster_name = "attached"
+        provisioner_name        = "attached"
+      }
+
+      cluster_groups = ["default"]
+    }
+  }
+}
+
+# Create a target location backed by a self-hosted S3 compatible storage
+resource "tanzu-mission-control_target_location" "self_hosted" {
+  name = "tf-self-hosted-location" # Required
+
+  spec {
+    target_provider = "AWS"                # Required
+    credential_name = "tf-minio-cred"      # Required
+    bucket          = "tf-backups"
+    region          = "us-east-1"
+
+    config {
+      aws {
+        url                 = "https://minio.example.com"
+        s3_force_path_style = true
+      }
+    }
+
+    assigned_groups {
+      cluster_groups = ["default"]
+    }
+  }
+}
+
+# Create a target location backed by an Azure blob storage
+resource "tanzu-mission-control_target_location" "azure" {
+  name = "tf-azure-location" # Required
+
+  spec {
+    target_provider = "AZURE"              # Required
+    credential_name = "tf-azure-blob-cred" # Required
+    bucket          = "tf-backups"
+
+    config {
+      azure {
+        resource_group  = "tf-resource-group"
+        storage_account = "tfstorageaccount"
+        subscription_id = "00000000-0000-0000-0000-000000000000"
+      }
+    }
+
+    assigned_groups {
+      cluster_groups = ["default"]
+    }
+  }
+}
@@ -6,10 +6,13 @@ SPDX-License-Identifier: MPL-2.0
 package authctx
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/proxy"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
 )
 
 const (
@@ -42,6 +45,8 @@ type TanzuContext struct {
 	VMWCloudEndPoint string // selfmanaged odic issuer is stored here
 	TMCConnection    *client.TanzuMissionControl
 	TLSConfig        *proxy.TLSConfig
+	RetryPolicy      *transport.RetryPolicy
+	StopContext      context.Context // cancelled when terraform stops the provider
 }
 
 func (cfg *TanzuContext) Setup() (err error) {
@@ -72,6 +77,12 @@ func setup(cfg *TanzuContext) (err error) {
 		return errors.Wrap(err, "unable to get user context")
 	}
 
+	cfg.TMCConnection.WithRetryPolicy(cfg.RetryPolicy)
+
+	if cfg.StopContext != nil {
+		cfg.TMCConnection.WithContext(cfg.StopContext)
+	}
+
 	cfg.TMCConnection.WithHost(cfg.ServerEndpoint)
 	cfg.TMCConnection.Headers.Set("Host", cfg.ServerEndpoint)
 
@@ -23,4 +23,10 @@ const (
 	clientAuthCert             = "client_auth_cert"
 	clientAuthKey              = "client_auth_key"
 	caCert                     = "ca_cert"
+
+	// retry configs.
+	retry      = "retry"
+	maxRetries = "max_retries"
+	minBackoff = "min_backoff"
+	maxBackoff = "max_backoff"
 )
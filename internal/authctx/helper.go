@@ -8,13 +8,17 @@ package authctx
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/proxy"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 )
 
@@ -40,6 +44,8 @@ func ProviderAuthSchema() map[string]*schema.Schema {
 
 		selfManaged: selfManagedAuthSchema,
 
+		retry: retrySchema,
+
 		insecureAllowUnverifiedSSL: {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -111,10 +117,93 @@ var selfManagedAuthSchema = &schema.Schema{
 	},
 }
 
-func ProviderConfigureContext(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+var retrySchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Retry policy of the requests made to Tanzu Mission Control. Connection errors, rate limited (429) and server side (5xx) responses are retried with exponential backoff and jitter, honouring the Retry-After header when present, up to max_backoff.",
+	Optional:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			maxRetries: {
+				Type:         schema.TypeInt,
+				Description:  "Maximum number of retries of a failed request, 0 disables the retries.",
+				Optional:     true,
+				Default:      transport.DefaultMaxRetries,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			minBackoff: {
+				Type:             schema.TypeString,
+				Description:      "Wait before the first retry, doubled on every subsequent retry. Accepted duration values like 500ms, 5s or 1m.",
+				Optional:         true,
+				Default:          transport.DefaultMinBackoff.String(),
+				ValidateDiagFunc: validateBackoff,
+			},
+			maxBackoff: {
+				Type:             schema.TypeString,
+				Description:      "Upper bound of the wait between two attempts. Accepted duration values like 500ms, 5s or 1m.",
+				Optional:         true,
+				Default:          transport.DefaultMaxBackoff.String(),
+				ValidateDiagFunc: validateBackoff,
+			},
+		},
+	},
+}
+
+func validateBackoff(value interface{}, path cty.Path) diag.Diagnostics {
+	duration, err := time.ParseDuration(value.(string))
+	if err != nil || duration <= 0 {
+		return diag.Diagnostics{
+			{
+				Severity:      diag.Error,
+				Summary:       "Invalid backoff duration",
+				Detail:        fmt.Sprintf("%q is not a duration higher than zero, accepted duration values are like 500ms, 5s or 1m", value),
+				AttributePath: path,
+			},
+		}
+	}
+
+	return nil
+}
+
+func getRetryPolicy(d *schema.ResourceData) *transport.RetryPolicy {
+	policy := transport.DefaultRetryPolicy()
+
+	if _, ok := d.GetOk(retry); !ok {
+		return policy
+	}
+
+	policy.MaxRetries, _ = d.Get(helper.GetFirstElementOf(retry, maxRetries)).(int)
+
+	if value, ok := d.Get(helper.GetFirstElementOf(retry, minBackoff)).(string); ok {
+		if duration, err := time.ParseDuration(value); err == nil {
+			policy.MinBackoff = duration
+		}
+	}
+
+	if value, ok := d.Get(helper.GetFirstElementOf(retry, maxBackoff)).(string); ok {
+		if duration, err := time.ParseDuration(value); err == nil {
+			policy.MaxBackoff = duration
+		}
+	}
+
+	return policy
+}
+
+// stopContext returns the context cancelled when Terraform stops the provider, e.g. on Ctrl-C.
+func stopContext(ctx context.Context) context.Context {
+	if stopCtx, ok := schema.StopContext(ctx); ok {
+		return stopCtx
+	}
+
+	return context.Background()
+}
+
+func ProviderConfigureContext(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	config := TanzuContext{
 		TLSConfig:   &proxy.TLSConfig{},
 		SelfManaged: false,
+		RetryPolicy: getRetryPolicy(d),
+		StopContext: stopContext(ctx),
 	}
 
 	_, saasAuth := d.GetOk(vmwCloudAPIToken)
@@ -159,9 +248,11 @@ func ProviderConfigureContext(_ context.Context, d *schema.ResourceData) (interf
 
 // The default transport is needed for mocking. The http mocking library used in testing
 // can only intercept calls if they're made with the default transport.
-func ProviderConfigureContextWithDefaultTransportForTesting(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+func ProviderConfigureContextWithDefaultTransportForTesting(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	config := TanzuContext{
-		TLSConfig: &proxy.TLSConfig{},
+		TLSConfig:   &proxy.TLSConfig{},
+		RetryPolicy: getRetryPolicy(d),
+		StopContext: stopContext(ctx),
 	}
 
 	config.ServerEndpoint, _ = d.Get(endpoint).(string)
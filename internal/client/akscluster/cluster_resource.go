@@ -23,6 +23,7 @@ const (
 	queryParamKeyCredentialName    = "fullName.credentialName" //nolint:gosec
 	queryParamKeySubscriptionID    = "fullName.subscriptionId"
 	queryParamKeyResourceGroupName = "fullName.resourceGroupName"
+	upgradeVersionsPath            = "upgradeversions"
 )
 
 // New creates a new aks cluster resource service API client.
@@ -45,6 +46,8 @@ type ClientService interface {
 
 	AksClusterResourceServiceGetByID(id string) (*aksmodel.VmwareTanzuManageV1alpha1AksclusterGetAksClusterResponse, error)
 
+	AksClusterResourceServiceGetUpgradeVersions(fn *aksmodel.VmwareTanzuManageV1alpha1AksclusterFullName) (*aksmodel.VmwareTanzuManageV1alpha1AksclusterGetUpgradeVersionsResponse, error)
+
 	AksClusterResourceServiceUpdate(request *aksmodel.VmwareTanzuManageV1alpha1AksclusterUpdateAksClusterRequest) (*aksmodel.VmwareTanzuManageV1alpha1AksclusterUpdateAksClusterResponse, error)
 
 	AksClusterResourceServiceDelete(fn *aksmodel.VmwareTanzuManageV1alpha1AksclusterFullName, force string) error
@@ -113,6 +116,29 @@ func (c *Client) AksClusterResourceServiceGetByID(id string) (*aksmodel.VmwareTa
 	return clusterResponse, nil
 }
 
+// AksClusterResourceServiceGetUpgradeVersions gets the Kubernetes versions an aks cluster can be upgraded to.
+func (c *Client) AksClusterResourceServiceGetUpgradeVersions(fn *aksmodel.VmwareTanzuManageV1alpha1AksclusterFullName) (*aksmodel.VmwareTanzuManageV1alpha1AksclusterGetUpgradeVersionsResponse, error) {
+	queryParams := url.Values{}
+	if fn.CredentialName != "" {
+		queryParams.Add(queryParamKeyCredentialName, fn.CredentialName)
+	}
+
+	if fn.SubscriptionID != "" {
+		queryParams.Add(queryParamKeySubscriptionID, fn.SubscriptionID)
+	}
+
+	if fn.ResourceGroupName != "" {
+		queryParams.Add(queryParamKeyResourceGroupName, fn.ResourceGroupName)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.Name, upgradeVersionsPath).AppendQueryParams(queryParams).String()
+	versionsResponse := &aksmodel.VmwareTanzuManageV1alpha1AksclusterGetUpgradeVersionsResponse{}
+
+	err := c.Get(requestURL, versionsResponse)
+
+	return versionsResponse, err
+}
+
 /*
 AksClusterResourceServiceUpdate updates overwrite an aks cluster.
 */
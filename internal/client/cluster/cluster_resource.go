@@ -6,8 +6,10 @@ SPDX-License-Identifier: MPL-2.0
 package clusterclient
 
 import (
+	"context"
 	"net/url"
 
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/pagination"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
@@ -18,6 +20,12 @@ const (
 	queryParamKeyForce                 = "force"
 	queryParamKeyManagementClusterName = "fullName.managementClusterName"
 	queryParamKeyProvisionerName       = "fullName.provisionerName"
+
+	queryParamKeySearchScopeName                  = "searchScope.name"
+	queryParamKeySearchScopeManagementClusterName = "searchScope.managementClusterName"
+	queryParamKeySearchScopeProvisionerName       = "searchScope.provisionerName"
+	queryParamKeyQuery                            = "query"
+	queryParamKeySortBy                           = "sortBy"
 )
 
 // New creates a new cluster resource service API client.
@@ -40,7 +48,11 @@ type ClientService interface {
 
 	ManageV1alpha1ClusterResourceServiceGet(fn *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName) (*clustermodel.VmwareTanzuManageV1alpha1ClusterGetClusterResponse, error)
 
+	ManageV1alpha1ClusterResourceServiceGetWithContext(ctx context.Context, fn *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName) (*clustermodel.VmwareTanzuManageV1alpha1ClusterGetClusterResponse, error)
+
 	ManageV1alpha1ClusterResourceServiceUpdate(request *clustermodel.VmwareTanzuManageV1alpha1ClusterRequest) (*clustermodel.VmwareTanzuManageV1alpha1ClusterResponse, error)
+
+	ManageV1alpha1ClusterResourceServiceList(request *clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersRequestParameters) (*clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersResponse, error)
 }
 
 /*
@@ -96,6 +108,16 @@ ManageV1alpha1ClusterResourceServiceGet gets a cluster.
 */
 func (c *Client) ManageV1alpha1ClusterResourceServiceGet(
 	fn *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName,
+) (*clustermodel.VmwareTanzuManageV1alpha1ClusterGetClusterResponse, error) {
+	return c.ManageV1alpha1ClusterResourceServiceGetWithContext(c.Context(), fn)
+}
+
+/*
+ManageV1alpha1ClusterResourceServiceGetWithContext is ManageV1alpha1ClusterResourceServiceGet bound to the given context, cancelling the context aborts the request and its retries.
+*/
+func (c *Client) ManageV1alpha1ClusterResourceServiceGetWithContext(
+	ctx context.Context,
+	fn *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName,
 ) (*clustermodel.VmwareTanzuManageV1alpha1ClusterGetClusterResponse, error) {
 	queryParams := url.Values{}
 
@@ -109,7 +131,46 @@ func (c *Client) ManageV1alpha1ClusterResourceServiceGet(
 
 	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.Name).AppendQueryParams(queryParams).String()
 	clusterResponse := &clustermodel.VmwareTanzuManageV1alpha1ClusterGetClusterResponse{}
-	err := c.Get(requestURL, clusterResponse)
+	err := c.GetWithContext(ctx, requestURL, clusterResponse)
 
 	return clusterResponse, err
 }
+
+/*
+ManageV1alpha1ClusterResourceServiceList lists clusters.
+*/
+func (c *Client) ManageV1alpha1ClusterResourceServiceList(
+	request *clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersRequestParameters,
+) (*clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersResponse, error) {
+	queryParams := url.Values{}
+
+	if request.SearchScope != nil {
+		if request.SearchScope.Name != "" {
+			queryParams.Add(queryParamKeySearchScopeName, request.SearchScope.Name)
+		}
+
+		if request.SearchScope.ManagementClusterName != "" {
+			queryParams.Add(queryParamKeySearchScopeManagementClusterName, request.SearchScope.ManagementClusterName)
+		}
+
+		if request.SearchScope.ProvisionerName != "" {
+			queryParams.Add(queryParamKeySearchScopeProvisionerName, request.SearchScope.ProvisionerName)
+		}
+	}
+
+	if request.Query != "" {
+		queryParams.Add(queryParamKeyQuery, request.Query)
+	}
+
+	if request.SortBy != "" {
+		queryParams.Add(queryParamKeySortBy, request.SortBy)
+	}
+
+	pagination.AddQueryParams(queryParams, request.Pagination, request.IncludeTotalCount)
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup).AppendQueryParams(queryParams).String()
+	clusterListResponse := &clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersResponse{}
+	err := c.Get(requestURL, clusterListResponse)
+
+	return clusterListResponse, err
+}
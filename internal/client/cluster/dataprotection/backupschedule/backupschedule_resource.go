@@ -0,0 +1,115 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package backupscheduleclient
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	backupschedulemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/dataprotection/cluster/backupschedule"
+)
+
+const (
+	apiVersionAndGroup                 = "v1alpha1/clusters"
+	apiKind                            = "dataprotection/schedules"
+	queryParamKeyManagementClusterName = "fullName.managementClusterName"
+	queryParamKeyProvisionerName       = "fullName.provisionerName"
+	queryParamKeyOrgID                 = "fullName.orgID"
+)
+
+// New creates a new backup schedule resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for backup schedule resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	ManageV1alpha1ClusterDataProtectionScheduleResourceServiceCreate(request *backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRequest) (*backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse, error)
+
+	ManageV1alpha1ClusterDataProtectionScheduleResourceServiceDelete(fn *backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName) error
+
+	ManageV1alpha1ClusterDataProtectionScheduleResourceServiceGet(fn *backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName) (*backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse, error)
+
+	ManageV1alpha1ClusterDataProtectionScheduleResourceServiceGetWithContext(ctx context.Context, fn *backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName) (*backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse, error)
+
+	ManageV1alpha1ClusterDataProtectionScheduleResourceServiceUpdate(request *backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRequest) (*backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse, error)
+}
+
+/*
+ManageV1alpha1ClusterDataProtectionScheduleResourceServiceCreate creates a backup schedule.
+*/
+func (c *Client) ManageV1alpha1ClusterDataProtectionScheduleResourceServiceCreate(request *backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRequest) (*backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Schedule.FullName.ClusterName, apiKind).String()
+	scheduleResponse := &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse{}
+	err := c.Create(requestURL, request, scheduleResponse)
+
+	return scheduleResponse, err
+}
+
+/*
+ManageV1alpha1ClusterDataProtectionScheduleResourceServiceDelete deletes a backup schedule.
+*/
+func (c *Client) ManageV1alpha1ClusterDataProtectionScheduleResourceServiceDelete(fn *backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName) error {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiKind, fn.Name).AppendQueryParams(fullNameQueryParams(fn)).String()
+
+	return c.Delete(requestURL)
+}
+
+/*
+ManageV1alpha1ClusterDataProtectionScheduleResourceServiceGet gets a backup schedule.
+*/
+func (c *Client) ManageV1alpha1ClusterDataProtectionScheduleResourceServiceGet(fn *backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName) (*backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse, error) {
+	return c.ManageV1alpha1ClusterDataProtectionScheduleResourceServiceGetWithContext(c.Context(), fn)
+}
+
+/*
+ManageV1alpha1ClusterDataProtectionScheduleResourceServiceGetWithContext is ManageV1alpha1ClusterDataProtectionScheduleResourceServiceGet bound to the given context, cancelling the context aborts the request and its retries.
+*/
+func (c *Client) ManageV1alpha1ClusterDataProtectionScheduleResourceServiceGetWithContext(ctx context.Context, fn *backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName) (*backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiKind, fn.Name).AppendQueryParams(fullNameQueryParams(fn)).String()
+	scheduleResponse := &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse{}
+	err := c.GetWithContext(ctx, requestURL, scheduleResponse)
+
+	return scheduleResponse, err
+}
+
+/*
+ManageV1alpha1ClusterDataProtectionScheduleResourceServiceUpdate updates overwrite a backup schedule.
+*/
+func (c *Client) ManageV1alpha1ClusterDataProtectionScheduleResourceServiceUpdate(request *backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRequest) (*backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Schedule.FullName.ClusterName, apiKind, request.Schedule.FullName.Name).String()
+	scheduleResponse := &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse{}
+	err := c.Update(requestURL, request, scheduleResponse)
+
+	return scheduleResponse, err
+}
+
+func fullNameQueryParams(fn *backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName) url.Values {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeyManagementClusterName, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeyProvisionerName, fn.ProvisionerName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	return queryParams
+}
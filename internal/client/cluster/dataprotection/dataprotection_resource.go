@@ -0,0 +1,132 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package dataprotectionclusterclient
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	dataprotectionmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/dataprotection/cluster/dataprotection"
+)
+
+const (
+	apiVersionAndGroup                    = "v1alpha1/clusters"
+	apiKind                               = "dataprotection"
+	queryParamKeyManagementClusterName    = "fullName.managementClusterName"
+	queryParamKeyProvisionerName          = "fullName.provisionerName"
+	queryParamKeyOrgID                    = "fullName.orgID"
+	queryParamKeyDeleteBackups            = "deleteBackups"
+	queryParamKeySearchManagementCluster  = "searchScope.managementClusterName"
+	queryParamKeySearchProvisionerName    = "searchScope.provisionerName"
+	queryParamKeySearchDataProtectionName = "searchScope.name"
+)
+
+// New creates a new data protection resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for data protection resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	ManageV1alpha1ClusterDataProtectionResourceServiceCreate(request *dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionRequest) (*dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionResponse, error)
+
+	ManageV1alpha1ClusterDataProtectionResourceServiceDelete(fn *dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionFullName, deleteBackups bool) error
+
+	ManageV1alpha1ClusterDataProtectionResourceServiceList(fn *dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionFullName) (*dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionListDataProtectionsResponse, error)
+
+	ManageV1alpha1ClusterDataProtectionResourceServiceListWithContext(ctx context.Context, fn *dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionFullName) (*dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionListDataProtectionsResponse, error)
+
+	ManageV1alpha1ClusterDataProtectionResourceServiceUpdate(request *dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionRequest) (*dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionResponse, error)
+}
+
+/*
+ManageV1alpha1ClusterDataProtectionResourceServiceCreate enables data protection on a cluster.
+*/
+func (c *Client) ManageV1alpha1ClusterDataProtectionResourceServiceCreate(request *dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionRequest) (*dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.DataProtection.FullName.ClusterName, apiKind).String()
+	dataProtectionResponse := &dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionResponse{}
+	err := c.Create(requestURL, request, dataProtectionResponse)
+
+	return dataProtectionResponse, err
+}
+
+/*
+ManageV1alpha1ClusterDataProtectionResourceServiceDelete disables data protection on a cluster, deleting the backups of the cluster when deleteBackups is set.
+*/
+func (c *Client) ManageV1alpha1ClusterDataProtectionResourceServiceDelete(fn *dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionFullName, deleteBackups bool) error {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeyManagementClusterName, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeyProvisionerName, fn.ProvisionerName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	queryParams.Add(queryParamKeyDeleteBackups, strconv.FormatBool(deleteBackups))
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiKind).AppendQueryParams(queryParams).String()
+
+	return c.Delete(requestURL)
+}
+
+/*
+ManageV1alpha1ClusterDataProtectionResourceServiceList lists the data protection of a cluster.
+*/
+func (c *Client) ManageV1alpha1ClusterDataProtectionResourceServiceList(fn *dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionFullName) (*dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionListDataProtectionsResponse, error) {
+	return c.ManageV1alpha1ClusterDataProtectionResourceServiceListWithContext(c.Context(), fn)
+}
+
+/*
+ManageV1alpha1ClusterDataProtectionResourceServiceListWithContext is ManageV1alpha1ClusterDataProtectionResourceServiceList bound to the given context, cancelling the context aborts the request and its retries.
+*/
+func (c *Client) ManageV1alpha1ClusterDataProtectionResourceServiceListWithContext(ctx context.Context, fn *dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionFullName) (*dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionListDataProtectionsResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeySearchManagementCluster, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeySearchProvisionerName, fn.ProvisionerName)
+	}
+
+	if fn.Name != "" {
+		queryParams.Add(queryParamKeySearchDataProtectionName, fn.Name)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiKind).AppendQueryParams(queryParams).String()
+	dataProtectionResponse := &dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionListDataProtectionsResponse{}
+	err := c.GetWithContext(ctx, requestURL, dataProtectionResponse)
+
+	return dataProtectionResponse, err
+}
+
+/*
+ManageV1alpha1ClusterDataProtectionResourceServiceUpdate updates overwrite the data protection of a cluster.
+*/
+func (c *Client) ManageV1alpha1ClusterDataProtectionResourceServiceUpdate(request *dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionRequest) (*dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.DataProtection.FullName.ClusterName, apiKind).String()
+	dataProtectionResponse := &dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionResponse{}
+	err := c.Update(requestURL, request, dataProtectionResponse)
+
+	return dataProtectionResponse, err
+}
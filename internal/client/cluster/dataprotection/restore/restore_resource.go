@@ -0,0 +1,102 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package restoreclient
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	restoremodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/dataprotection/cluster/restore"
+)
+
+const (
+	apiVersionAndGroup                 = "v1alpha1/clusters"
+	apiKind                            = "dataprotection/restores"
+	queryParamKeyManagementClusterName = "fullName.managementClusterName"
+	queryParamKeyProvisionerName       = "fullName.provisionerName"
+	queryParamKeyOrgID                 = "fullName.orgID"
+)
+
+// New creates a new restore resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for restore resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	ManageV1alpha1ClusterDataProtectionRestoreResourceServiceCreate(request *restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRequest) (*restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreResponse, error)
+
+	ManageV1alpha1ClusterDataProtectionRestoreResourceServiceDelete(fn *restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName) error
+
+	ManageV1alpha1ClusterDataProtectionRestoreResourceServiceGet(fn *restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName) (*restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreResponse, error)
+
+	ManageV1alpha1ClusterDataProtectionRestoreResourceServiceGetWithContext(ctx context.Context, fn *restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName) (*restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreResponse, error)
+}
+
+/*
+ManageV1alpha1ClusterDataProtectionRestoreResourceServiceCreate creates a restore.
+*/
+func (c *Client) ManageV1alpha1ClusterDataProtectionRestoreResourceServiceCreate(request *restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRequest) (*restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Restore.FullName.ClusterName, apiKind).String()
+	restoreResponse := &restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreResponse{}
+	err := c.Create(requestURL, request, restoreResponse)
+
+	return restoreResponse, err
+}
+
+/*
+ManageV1alpha1ClusterDataProtectionRestoreResourceServiceDelete deletes a restore, the restored resources are kept on the cluster.
+*/
+func (c *Client) ManageV1alpha1ClusterDataProtectionRestoreResourceServiceDelete(fn *restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName) error {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiKind, fn.Name).AppendQueryParams(fullNameQueryParams(fn)).String()
+
+	return c.Delete(requestURL)
+}
+
+/*
+ManageV1alpha1ClusterDataProtectionRestoreResourceServiceGet gets a restore.
+*/
+func (c *Client) ManageV1alpha1ClusterDataProtectionRestoreResourceServiceGet(fn *restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName) (*restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreResponse, error) {
+	return c.ManageV1alpha1ClusterDataProtectionRestoreResourceServiceGetWithContext(c.Context(), fn)
+}
+
+/*
+ManageV1alpha1ClusterDataProtectionRestoreResourceServiceGetWithContext is ManageV1alpha1ClusterDataProtectionRestoreResourceServiceGet bound to the given context, cancelling the context aborts the request and its retries.
+*/
+func (c *Client) ManageV1alpha1ClusterDataProtectionRestoreResourceServiceGetWithContext(ctx context.Context, fn *restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName) (*restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiKind, fn.Name).AppendQueryParams(fullNameQueryParams(fn)).String()
+	restoreResponse := &restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreResponse{}
+	err := c.GetWithContext(ctx, requestURL, restoreResponse)
+
+	return restoreResponse, err
+}
+
+func fullNameQueryParams(fn *restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName) url.Values {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeyManagementClusterName, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeyProvisionerName, fn.ProvisionerName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	return queryParams
+}
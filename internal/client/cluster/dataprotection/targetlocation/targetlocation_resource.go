@@ -0,0 +1,105 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package targetlocationclient
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	targetlocationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/dataprotection/targetlocation"
+)
+
+const (
+	apiVersionAndGroup = "v1alpha1/dataprotection/providers"
+	apiKind            = "backuplocations"
+	queryParamKeyOrgID = "fullName.orgID"
+)
+
+// New creates a new target location resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for target location resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	ManageV1alpha1DataProtectionBackupLocationResourceServiceCreate(request *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationRequest) (*targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse, error)
+
+	ManageV1alpha1DataProtectionBackupLocationResourceServiceDelete(fn *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationFullName) error
+
+	ManageV1alpha1DataProtectionBackupLocationResourceServiceGet(fn *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationFullName) (*targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse, error)
+
+	ManageV1alpha1DataProtectionBackupLocationResourceServiceGetWithContext(ctx context.Context, fn *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationFullName) (*targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse, error)
+
+	ManageV1alpha1DataProtectionBackupLocationResourceServiceUpdate(request *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationRequest) (*targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse, error)
+}
+
+/*
+ManageV1alpha1DataProtectionBackupLocationResourceServiceCreate creates a target location.
+*/
+func (c *Client) ManageV1alpha1DataProtectionBackupLocationResourceServiceCreate(request *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationRequest) (*targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.BackupLocation.FullName.ProviderName, apiKind).String()
+	backupLocationResponse := &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse{}
+	err := c.Create(requestURL, request, backupLocationResponse)
+
+	return backupLocationResponse, err
+}
+
+/*
+ManageV1alpha1DataProtectionBackupLocationResourceServiceDelete deletes a target location.
+*/
+func (c *Client) ManageV1alpha1DataProtectionBackupLocationResourceServiceDelete(fn *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationFullName) error {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ProviderName, apiKind, fn.Name).AppendQueryParams(fullNameQueryParams(fn)).String()
+
+	return c.Delete(requestURL)
+}
+
+/*
+ManageV1alpha1DataProtectionBackupLocationResourceServiceGet gets a target location.
+*/
+func (c *Client) ManageV1alpha1DataProtectionBackupLocationResourceServiceGet(fn *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationFullName) (*targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse, error) {
+	return c.ManageV1alpha1DataProtectionBackupLocationResourceServiceGetWithContext(c.Context(), fn)
+}
+
+/*
+ManageV1alpha1DataProtectionBackupLocationResourceServiceGetWithContext is ManageV1alpha1DataProtectionBackupLocationResourceServiceGet bound to the given context, cancelling the context aborts the request and its retries.
+*/
+func (c *Client) ManageV1alpha1DataProtectionBackupLocationResourceServiceGetWithContext(ctx context.Context, fn *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationFullName) (*targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ProviderName, apiKind, fn.Name).AppendQueryParams(fullNameQueryParams(fn)).String()
+	backupLocationResponse := &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse{}
+	err := c.GetWithContext(ctx, requestURL, backupLocationResponse)
+
+	return backupLocationResponse, err
+}
+
+/*
+ManageV1alpha1DataProtectionBackupLocationResourceServiceUpdate updates overwrite a target location.
+*/
+func (c *Client) ManageV1alpha1DataProtectionBackupLocationResourceServiceUpdate(request *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationRequest) (*targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.BackupLocation.FullName.ProviderName, apiKind, request.BackupLocation.FullName.Name).String()
+	backupLocationResponse := &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse{}
+	err := c.Update(requestURL, request, backupLocationResponse)
+
+	return backupLocationResponse, err
+}
+
+func fullNameQueryParams(fn *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationFullName) url.Values {
+	queryParams := url.Values{}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	return queryParams
+}
@@ -0,0 +1,100 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmfeatureclusterclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	helmfeatureclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmfeature/cluster"
+)
+
+const (
+	apiVersionAndGroup                            = "v1alpha1/clusters"
+	apiKind                                       = "fluxcd/helm"
+	queryParamKeyFullNameManagementClusterName    = "fullName.managementClusterName"
+	queryParamKeyFullNameProvisionerName          = "fullName.provisionerName"
+	queryParamKeySearchScopeManagementClusterName = "searchScope.managementClusterName"
+	queryParamKeySearchScopeProvisionerName       = "searchScope.provisionerName"
+	queryParamKeyOrgID                            = "fullName.orgID"
+)
+
+// New creates a new cluster Flux CD Helm feature resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for cluster Flux CD Helm feature resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceService Client methods.
+type ClientService interface {
+	VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceServiceCreate(request *helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmRequest) (*helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmResponse, error)
+
+	VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceServiceDelete(fn *helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmFullName) error
+
+	VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceServiceList(rp *helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmListHelmsRequestParameters) (*helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmListHelmsResponse, error)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceServiceCreate creates a Flux CD Helm feature scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceServiceCreate(request *helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmRequest) (*helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Helm.FullName.ClusterName, apiKind).String()
+	fluxCDHelmClusterResponse := &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmResponse{}
+	err := p.Create(requestURL, request, fluxCDHelmClusterResponse)
+
+	return fluxCDHelmClusterResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceServiceDelete deletes a Flux CD Helm feature scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceServiceDelete(fn *helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmFullName) error {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeyFullNameManagementClusterName, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeyFullNameProvisionerName, fn.ProvisionerName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiKind).AppendQueryParams(queryParams).String()
+
+	return p.Delete(requestURL)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceServiceList lists Flux CD Helm features scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceServiceList(rp *helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmListHelmsRequestParameters) (*helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmListHelmsResponse, error) {
+	queryParams := url.Values{}
+
+	if rp.SearchScope.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeySearchScopeManagementClusterName, rp.SearchScope.ManagementClusterName)
+	}
+
+	if rp.SearchScope.ProvisionerName != "" {
+		queryParams.Add(queryParamKeySearchScopeProvisionerName, rp.SearchScope.ProvisionerName)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, rp.SearchScope.ClusterName, apiKind).AppendQueryParams(queryParams).String()
+	fluxCDHelmClusterResponse := &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmListHelmsResponse{}
+	err := p.Get(requestURL, fluxCDHelmClusterResponse)
+
+	return fluxCDHelmClusterResponse, err
+}
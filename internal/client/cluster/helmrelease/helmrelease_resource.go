@@ -0,0 +1,116 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmreleaseclusterclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	helmreleaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
+)
+
+const (
+	apiVersionAndGroup                 = "v1alpha1/clusters"
+	apiSubGroup                        = "namespaces"
+	apiKind                            = "fluxcd/helmreleases"
+	queryParamKeyManagementClusterName = "fullName.managementClusterName"
+	queryParamKeyProvisionerName       = "fullName.provisionerName"
+	queryParamKeyOrgID                 = "fullName.orgID"
+)
+
+// New creates a new cluster Flux CD Helm release resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for cluster Flux CD Helm release resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceService Client methods.
+type ClientService interface {
+	VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceCreate(request *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseRequest) (*helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseResponse, error)
+
+	VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceDelete(fn *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseFullName) error
+
+	VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceGet(fn *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseFullName) (*helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseGetReleaseResponse, error)
+
+	VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceUpdate(request *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseRequest) (*helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseResponse, error)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceCreate creates a Flux CD Helm release scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceCreate(request *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseRequest) (*helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Release.FullName.ClusterName, apiSubGroup, request.Release.FullName.NamespaceName, apiKind).String()
+	fluxCDHelmReleaseClusterResponse := &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseResponse{}
+	err := p.Create(requestURL, request, fluxCDHelmReleaseClusterResponse)
+
+	return fluxCDHelmReleaseClusterResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceDelete deletes a Flux CD Helm release scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceDelete(fn *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseFullName) error {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeyManagementClusterName, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeyProvisionerName, fn.ProvisionerName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiSubGroup, fn.NamespaceName, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+
+	return p.Delete(requestURL)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceGet gets a Flux CD Helm release scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceGet(fn *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseFullName) (*helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseGetReleaseResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeyManagementClusterName, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeyProvisionerName, fn.ProvisionerName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiSubGroup, fn.NamespaceName, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+	fluxCDHelmReleaseClusterResponse := &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseGetReleaseResponse{}
+	err := p.Get(requestURL, fluxCDHelmReleaseClusterResponse)
+
+	return fluxCDHelmReleaseClusterResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceUpdate updates overwrite a Flux CD Helm release scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceUpdate(request *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseRequest) (*helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Release.FullName.ClusterName, apiSubGroup, request.Release.FullName.NamespaceName, apiKind, request.Release.FullName.Name).String()
+	fluxCDHelmReleaseClusterResponse := &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseResponse{}
+	err := p.Update(requestURL, request, fluxCDHelmReleaseClusterResponse)
+
+	return fluxCDHelmReleaseClusterResponse, err
+}
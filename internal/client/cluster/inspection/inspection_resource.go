@@ -0,0 +1,102 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package inspectionclient
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	inspectionmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/inspection"
+)
+
+const (
+	apiVersionAndGroup                 = "v1alpha1/clusters"
+	apiKind                            = "inspection/scans"
+	queryParamKeyManagementClusterName = "fullName.managementClusterName"
+	queryParamKeyProvisionerName       = "fullName.provisionerName"
+	queryParamKeyOrgID                 = "fullName.orgID"
+)
+
+// New creates a new inspection scan resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for inspection scan resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	ManageV1alpha1ClusterInspectionScanResourceServiceCreate(request *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanRequest) (*inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanResponse, error)
+
+	ManageV1alpha1ClusterInspectionScanResourceServiceDelete(fn *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanFullName) error
+
+	ManageV1alpha1ClusterInspectionScanResourceServiceGet(fn *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanFullName) (*inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanResponse, error)
+
+	ManageV1alpha1ClusterInspectionScanResourceServiceGetWithContext(ctx context.Context, fn *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanFullName) (*inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanResponse, error)
+}
+
+/*
+ManageV1alpha1ClusterInspectionScanResourceServiceCreate creates an inspection, which triggers its scan on the cluster.
+*/
+func (c *Client) ManageV1alpha1ClusterInspectionScanResourceServiceCreate(request *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanRequest) (*inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Scan.FullName.ClusterName, apiKind).String()
+	inspectionResponse := &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanResponse{}
+	err := c.Create(requestURL, request, inspectionResponse)
+
+	return inspectionResponse, err
+}
+
+/*
+ManageV1alpha1ClusterInspectionScanResourceServiceDelete deletes an inspection.
+*/
+func (c *Client) ManageV1alpha1ClusterInspectionScanResourceServiceDelete(fn *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanFullName) error {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiKind, fn.Name).AppendQueryParams(fullNameQueryParams(fn)).String()
+
+	return c.Delete(requestURL)
+}
+
+/*
+ManageV1alpha1ClusterInspectionScanResourceServiceGet gets an inspection.
+*/
+func (c *Client) ManageV1alpha1ClusterInspectionScanResourceServiceGet(fn *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanFullName) (*inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanResponse, error) {
+	return c.ManageV1alpha1ClusterInspectionScanResourceServiceGetWithContext(c.Context(), fn)
+}
+
+/*
+ManageV1alpha1ClusterInspectionScanResourceServiceGetWithContext is ManageV1alpha1ClusterInspectionScanResourceServiceGet bound to the given context, cancelling the context aborts the request and its retries.
+*/
+func (c *Client) ManageV1alpha1ClusterInspectionScanResourceServiceGetWithContext(ctx context.Context, fn *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanFullName) (*inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiKind, fn.Name).AppendQueryParams(fullNameQueryParams(fn)).String()
+	inspectionResponse := &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanResponse{}
+	err := c.GetWithContext(ctx, requestURL, inspectionResponse)
+
+	return inspectionResponse, err
+}
+
+func fullNameQueryParams(fn *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanFullName) url.Values {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeyManagementClusterName, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeyProvisionerName, fn.ProvisionerName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	return queryParams
+}
@@ -0,0 +1,126 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packageinstallclusterclient
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	packageinstallclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packageinstall/cluster"
+)
+
+const (
+	apiVersionAndGroup                 = "v1alpha1/clusters"
+	apiSubGroup                        = "namespaces"
+	apiKind                            = "tanzupackage/installedpackages"
+	queryParamKeyManagementClusterName = "fullName.managementClusterName"
+	queryParamKeyProvisionerName       = "fullName.provisionerName"
+	queryParamKeyOrgID                 = "fullName.orgID"
+)
+
+// New creates a new cluster tanzu package install resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for cluster tanzu package install resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceService Client methods.
+type ClientService interface {
+	VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceCreate(request *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest) (*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallResponse, error)
+
+	VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceDelete(fn *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallFullName) error
+
+	VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceGet(fn *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallFullName) (*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallGetInstallResponse, error)
+
+	VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceGetWithContext(ctx context.Context, fn *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallFullName) (*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallGetInstallResponse, error)
+
+	VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceUpdate(request *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest) (*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallResponse, error)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceCreate creates a tanzu package install scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceCreate(request *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest) (*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Install.FullName.ClusterName, apiSubGroup, request.Install.FullName.NamespaceName, apiKind).String()
+	packageInstallClusterResponse := &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallResponse{}
+	err := p.Create(requestURL, request, packageInstallClusterResponse)
+
+	return packageInstallClusterResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceDelete deletes a tanzu package install scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceDelete(fn *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallFullName) error {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeyManagementClusterName, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeyProvisionerName, fn.ProvisionerName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiSubGroup, fn.NamespaceName, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+
+	return p.Delete(requestURL)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceGet gets a tanzu package install scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceGet(fn *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallFullName) (*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallGetInstallResponse, error) {
+	return p.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceGetWithContext(p.Context(), fn)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceGetWithContext is VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceGet bound to the given context, cancelling the context aborts the request and its retries.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceGetWithContext(ctx context.Context, fn *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallFullName) (*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallGetInstallResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeyManagementClusterName, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeyProvisionerName, fn.ProvisionerName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiSubGroup, fn.NamespaceName, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+	packageInstallClusterResponse := &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallGetInstallResponse{}
+	err := p.GetWithContext(ctx, requestURL, packageInstallClusterResponse)
+
+	return packageInstallClusterResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceUpdate updates overwrite a tanzu package install scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceUpdate(request *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest) (*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Install.FullName.ClusterName, apiSubGroup, request.Install.FullName.NamespaceName, apiKind, request.Install.FullName.Name).String()
+	packageInstallClusterResponse := &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallResponse{}
+	err := p.Update(requestURL, request, packageInstallClusterResponse)
+
+	return packageInstallClusterResponse, err
+}
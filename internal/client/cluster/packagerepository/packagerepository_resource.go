@@ -0,0 +1,125 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packagerepositoryclusterclient
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	packagerepositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packagerepository/cluster"
+)
+
+const (
+	apiVersionAndGroup                 = "v1alpha1/clusters"
+	apiKind                            = "tanzupackage/repositories"
+	queryParamKeyManagementClusterName = "fullName.managementClusterName"
+	queryParamKeyProvisionerName       = "fullName.provisionerName"
+	queryParamKeyOrgID                 = "fullName.orgID"
+)
+
+// New creates a new cluster tanzu package repository resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for cluster tanzu package repository resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceService Client methods.
+type ClientService interface {
+	VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceCreate(request *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepositoryRequest) (*packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepositoryResponse, error)
+
+	VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceDelete(fn *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryFullName) error
+
+	VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceGet(fn *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryFullName) (*packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryGetRepositoryResponse, error)
+
+	VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceGetWithContext(ctx context.Context, fn *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryFullName) (*packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryGetRepositoryResponse, error)
+
+	VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceUpdate(request *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepositoryRequest) (*packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepositoryResponse, error)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceCreate creates a tanzu package repository scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceCreate(request *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepositoryRequest) (*packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepositoryResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Repository.FullName.ClusterName, apiKind).String()
+	packageRepositoryClusterResponse := &packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepositoryResponse{}
+	err := p.Create(requestURL, request, packageRepositoryClusterResponse)
+
+	return packageRepositoryClusterResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceDelete deletes a tanzu package repository scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceDelete(fn *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryFullName) error {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeyManagementClusterName, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeyProvisionerName, fn.ProvisionerName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+
+	return p.Delete(requestURL)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceGet gets a tanzu package repository scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceGet(fn *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryFullName) (*packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryGetRepositoryResponse, error) {
+	return p.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceGetWithContext(p.Context(), fn)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceGetWithContext is VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceGet bound to the given context, cancelling the context aborts the request and its retries.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceGetWithContext(ctx context.Context, fn *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryFullName) (*packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryGetRepositoryResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeyManagementClusterName, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeyProvisionerName, fn.ProvisionerName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+	packageRepositoryClusterResponse := &packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryGetRepositoryResponse{}
+	err := p.GetWithContext(ctx, requestURL, packageRepositoryClusterResponse)
+
+	return packageRepositoryClusterResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceUpdate updates overwrite a tanzu package repository scoped to a cluster resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceUpdate(request *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepositoryRequest) (*packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepositoryResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Repository.FullName.ClusterName, apiKind, request.Repository.FullName.Name).String()
+	packageRepositoryClusterResponse := &packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepositoryResponse{}
+	err := p.Update(requestURL, request, packageRepositoryClusterResponse)
+
+	return packageRepositoryClusterResponse, err
+}
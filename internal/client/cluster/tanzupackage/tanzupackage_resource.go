@@ -0,0 +1,66 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzupackageclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	tanzupackagemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackage"
+)
+
+const (
+	apiVersionAndGroup                 = "v1alpha1/clusters"
+	apiSubGroup                        = "namespaces"
+	apiMetadataKind                    = "tanzupackage/metadatas"
+	apiKind                            = "packages"
+	queryParamKeyManagementClusterName = "fullName.managementClusterName"
+	queryParamKeyProvisionerName       = "fullName.provisionerName"
+	queryParamKeyOrgID                 = "fullName.orgID"
+)
+
+// New creates a new cluster tanzu package resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for cluster tanzu package resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageResourceService Client methods.
+type ClientService interface {
+	VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageResourceServiceList(fn *tanzupackagemodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageFullName) (*tanzupackagemodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageListPackagesResponse, error)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageResourceServiceList lists the versions of a package available on a cluster.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageResourceServiceList(fn *tanzupackagemodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageFullName) (*tanzupackagemodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageListPackagesResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeyManagementClusterName, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeyProvisionerName, fn.ProvisionerName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiSubGroup, fn.NamespaceName, apiMetadataKind, fn.MetadataName, apiKind).AppendQueryParams(queryParams).String()
+	packagesResponse := &tanzupackagemodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageListPackagesResponse{}
+	err := p.Get(requestURL, packagesResponse)
+
+	return packagesResponse, err
+}
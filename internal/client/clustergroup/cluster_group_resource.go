@@ -7,8 +7,11 @@ package clustergroupclient
 
 import (
 	"fmt"
+	"net/url"
 
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/pagination"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	clustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/clustergroup"
 )
 
@@ -31,6 +34,8 @@ type ClientService interface {
 	ManageV1alpha1ClusterGroupResourceServiceGet(fn *clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFullName) (*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupGetClusterGroupResponse, error)
 
 	ManageV1alpha1ClusterGroupResourceServiceUpdate(request *clustergroupmodel.VmwareTanzuManageV1alpha1ClusterGroupRequest) (*clustergroupmodel.VmwareTanzuManageV1alpha1ClusterGroupResponse, error)
+
+	ManageV1alpha1ClusterGroupResourceServiceList(request *clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsRequestParameters) (*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsResponse, error)
 }
 
 // ManageV1alpha1ClusterGroupResourceServiceGet gets a cluster group.
@@ -74,3 +79,30 @@ func (c *Client) ManageV1alpha1ClusterGroupResourceServiceUpdate(
 
 	return clusterGroupResponse, err
 }
+
+// ManageV1alpha1ClusterGroupResourceServiceList lists cluster groups.
+func (c *Client) ManageV1alpha1ClusterGroupResourceServiceList(
+	request *clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsRequestParameters,
+) (*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsResponse, error) {
+	queryParams := url.Values{}
+
+	if request.SearchScope != nil && request.SearchScope.Name != "" {
+		queryParams.Add("searchScope.name", request.SearchScope.Name)
+	}
+
+	if request.Query != "" {
+		queryParams.Add("query", request.Query)
+	}
+
+	if request.SortBy != "" {
+		queryParams.Add("sortBy", request.SortBy)
+	}
+
+	pagination.AddQueryParams(queryParams, request.Pagination, request.IncludeTotalCount)
+
+	requestURL := helper.ConstructRequestURL("v1alpha1/clustergroups").AppendQueryParams(queryParams).String()
+	clusterGroupListResponse := &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsResponse{}
+	err := c.Get(requestURL, clusterGroupListResponse)
+
+	return clusterGroupListResponse, err
+}
@@ -0,0 +1,78 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmfeatureclustergroupclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	helmfeatureclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmfeature/clustergroup"
+)
+
+const (
+	apiVersionAndGroup = "v1alpha1/clustergroups"
+	apiKind            = "fluxcd/helm"
+	queryParamKeyOrgID = "fullName.orgID"
+)
+
+// New creates a new cluster group Flux CD Helm feature resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for cluster group Flux CD Helm feature resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceService Client methods.
+type ClientService interface {
+	VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceServiceCreate(request *helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmRequest) (*helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmResponse, error)
+
+	VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceServiceDelete(fn *helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmFullName) error
+
+	VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceServiceList(rp *helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmListHelmsRequestParameters) (*helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmListHelmsResponse, error)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceServiceCreate creates a Flux CD Helm feature scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceServiceCreate(request *helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmRequest) (*helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Helm.FullName.ClusterGroupName, apiKind).String()
+	fluxCDHelmClusterGroupResponse := &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmResponse{}
+	err := p.Create(requestURL, request, fluxCDHelmClusterGroupResponse)
+
+	return fluxCDHelmClusterGroupResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceServiceDelete deletes a Flux CD Helm feature scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceServiceDelete(fn *helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmFullName) error {
+	queryParams := url.Values{}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterGroupName, apiKind).AppendQueryParams(queryParams).String()
+
+	return p.Delete(requestURL)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceServiceList lists Flux CD Helm features scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceServiceList(rp *helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmListHelmsRequestParameters) (*helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmListHelmsResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, rp.SearchScope.ClusterGroupName, apiKind).String()
+	fluxCDHelmClusterGroupResponse := &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmListHelmsResponse{}
+	err := p.Get(requestURL, fluxCDHelmClusterGroupResponse)
+
+	return fluxCDHelmClusterGroupResponse, err
+}
@@ -0,0 +1,107 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmreleaseclustergroupclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	helmreleaseclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/clustergroup"
+)
+
+const (
+	apiVersionAndGroup         = "v1alpha1/clustergroups"
+	apiSubGroup                = "namespace"
+	apiKind                    = "fluxcd/helmreleases"
+	queryParamKeyNamespaceName = "fullName.namespaceName"
+	queryParamKeyOrgID         = "fullName.orgID"
+)
+
+// New creates a new cluster Flux CD Helm release resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for cluster group Flux CD Helm release resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceService Client methods.
+type ClientService interface {
+	VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceCreate(request *helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseRequest) (*helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseResponse, error)
+
+	VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceDelete(fn *helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseFullName) error
+
+	VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceGet(fn *helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseFullName) (*helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseGetReleaseResponse, error)
+
+	VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceUpdate(request *helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseRequest) (*helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseResponse, error)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceCreate creates a Flux CD Helm release scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceCreate(request *helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseRequest) (*helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Release.FullName.ClusterGroupName, apiSubGroup, apiKind).String()
+	fluxCDHelmReleaseClusterGroupResponse := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseResponse{}
+	err := p.Create(requestURL, request, fluxCDHelmReleaseClusterGroupResponse)
+
+	return fluxCDHelmReleaseClusterGroupResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceDelete deletes a Flux CD Helm release scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceDelete(fn *helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseFullName) error {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterGroupName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+
+	return p.Delete(requestURL)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceGet gets a Flux CD Helm release scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceGet(fn *helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseFullName) (*helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseGetReleaseResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterGroupName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+	fluxCDHelmReleaseClusterGroupResponse := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseGetReleaseResponse{}
+	err := p.Get(requestURL, fluxCDHelmReleaseClusterGroupResponse)
+
+	return fluxCDHelmReleaseClusterGroupResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceUpdate updates overwrite a Flux CD Helm release scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceUpdate(request *helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseRequest) (*helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Release.FullName.ClusterGroupName, apiSubGroup, apiKind, request.Release.FullName.Name).String()
+	fluxCDHelmReleaseClusterGroupResponse := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseResponse{}
+	err := p.Update(requestURL, request, fluxCDHelmReleaseClusterGroupResponse)
+
+	return fluxCDHelmReleaseClusterGroupResponse, err
+}
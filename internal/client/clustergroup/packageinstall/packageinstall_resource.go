@@ -0,0 +1,117 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packageinstallclustergroupclient
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	packageinstallclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packageinstall/clustergroup"
+)
+
+const (
+	apiVersionAndGroup         = "v1alpha1/clustergroups"
+	apiSubGroup                = "namespace"
+	apiKind                    = "tanzupackage/installedpackages"
+	queryParamKeyNamespaceName = "fullName.namespaceName"
+	queryParamKeyOrgID         = "fullName.orgID"
+)
+
+// New creates a new cluster group tanzu package install resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for cluster group tanzu package install resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceService Client methods.
+type ClientService interface {
+	VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceCreate(request *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest) (*packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse, error)
+
+	VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceDelete(fn *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName) error
+
+	VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceGet(fn *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName) (*packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallGetInstallResponse, error)
+
+	VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceGetWithContext(ctx context.Context, fn *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName) (*packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallGetInstallResponse, error)
+
+	VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceUpdate(request *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest) (*packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse, error)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceCreate creates a tanzu package install scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceCreate(request *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest) (*packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Install.FullName.ClusterGroupName, apiSubGroup, apiKind).String()
+	packageInstallClusterGroupResponse := &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse{}
+	err := p.Create(requestURL, request, packageInstallClusterGroupResponse)
+
+	return packageInstallClusterGroupResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceDelete deletes a tanzu package install scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceDelete(fn *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName) error {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterGroupName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+
+	return p.Delete(requestURL)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceGet gets a tanzu package install scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceGet(fn *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName) (*packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallGetInstallResponse, error) {
+	return p.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceGetWithContext(p.Context(), fn)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceGetWithContext is VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceGet bound to the given context, cancelling the context aborts the request and its retries.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceGetWithContext(ctx context.Context, fn *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName) (*packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallGetInstallResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.NamespaceName != "" {
+		queryParams.Add(queryParamKeyNamespaceName, fn.NamespaceName)
+	}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterGroupName, apiSubGroup, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+	packageInstallClusterGroupResponse := &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallGetInstallResponse{}
+	err := p.GetWithContext(ctx, requestURL, packageInstallClusterGroupResponse)
+
+	return packageInstallClusterGroupResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceUpdate updates overwrite a tanzu package install scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceUpdate(request *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest) (*packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Install.FullName.ClusterGroupName, apiSubGroup, apiKind, request.Install.FullName.Name).String()
+	packageInstallClusterGroupResponse := &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallResponse{}
+	err := p.Update(requestURL, request, packageInstallClusterGroupResponse)
+
+	return packageInstallClusterGroupResponse, err
+}
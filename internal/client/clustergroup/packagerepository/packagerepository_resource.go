@@ -0,0 +1,107 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packagerepositoryclustergroupclient
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	packagerepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packagerepository/clustergroup"
+)
+
+const (
+	apiVersionAndGroup = "v1alpha1/clustergroups"
+	apiKind            = "tanzupackage/repositories"
+	queryParamKeyOrgID = "fullName.orgID"
+)
+
+// New creates a new cluster group tanzu package repository resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for cluster group tanzu package repository resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceService Client methods.
+type ClientService interface {
+	VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceCreate(request *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryRequest) (*packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryResponse, error)
+
+	VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceDelete(fn *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryFullName) error
+
+	VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceGet(fn *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryFullName) (*packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryGetRepositoryResponse, error)
+
+	VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceGetWithContext(ctx context.Context, fn *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryFullName) (*packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryGetRepositoryResponse, error)
+
+	VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceUpdate(request *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryRequest) (*packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryResponse, error)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceCreate creates a tanzu package repository scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceCreate(request *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryRequest) (*packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Repository.FullName.ClusterGroupName, apiKind).String()
+	packageRepositoryClusterGroupResponse := &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryResponse{}
+	err := p.Create(requestURL, request, packageRepositoryClusterGroupResponse)
+
+	return packageRepositoryClusterGroupResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceDelete deletes a tanzu package repository scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceDelete(fn *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryFullName) error {
+	queryParams := url.Values{}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterGroupName, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+
+	return p.Delete(requestURL)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceGet gets a tanzu package repository scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceGet(fn *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryFullName) (*packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryGetRepositoryResponse, error) {
+	return p.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceGetWithContext(p.Context(), fn)
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceGetWithContext is VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceGet bound to the given context, cancelling the context aborts the request and its retries.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceGetWithContext(ctx context.Context, fn *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryFullName) (*packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryGetRepositoryResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.OrgID != "" {
+		queryParams.Add(queryParamKeyOrgID, fn.OrgID)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterGroupName, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+	packageRepositoryClusterGroupResponse := &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryGetRepositoryResponse{}
+	err := p.GetWithContext(ctx, requestURL, packageRepositoryClusterGroupResponse)
+
+	return packageRepositoryClusterGroupResponse, err
+}
+
+/*
+VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceUpdate updates overwrite a tanzu package repository scoped to a cluster group resource.
+*/
+func (p *Client) VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceUpdate(request *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryRequest) (*packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Repository.FullName.ClusterGroupName, apiKind, request.Repository.FullName.Name).String()
+	packageRepositoryClusterGroupResponse := &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryResponse{}
+	err := p.Update(requestURL, request, packageRepositoryClusterGroupResponse)
+
+	return packageRepositoryClusterGroupResponse, err
+}
@@ -6,6 +6,8 @@ SPDX-License-Identifier: MPL-2.0
 package credentialclient
 
 import (
+	"context"
+
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	credentialsmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/credential"
@@ -34,6 +36,8 @@ type ClientService interface {
 	CredentialResourceServiceDelete(fn *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialFullName) error
 
 	CredentialResourceServiceGet(fn *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialFullName) (*credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialGetCredentialResponse, error)
+
+	CredentialResourceServiceGetWithContext(ctx context.Context, fn *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialFullName) (*credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialGetCredentialResponse, error)
 }
 
 /*
@@ -63,10 +67,20 @@ CredentialResourceServiceGet gets a credential.
 */
 func (c *Client) CredentialResourceServiceGet(
 	fn *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialFullName,
+) (*credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialGetCredentialResponse, error) {
+	return c.CredentialResourceServiceGetWithContext(c.Context(), fn)
+}
+
+/*
+CredentialResourceServiceGetWithContext is CredentialResourceServiceGet bound to the given context, cancelling the context aborts the request and its retries.
+*/
+func (c *Client) CredentialResourceServiceGetWithContext(
+	ctx context.Context,
+	fn *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialFullName,
 ) (*credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialGetCredentialResponse, error) {
 	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.Name).String()
 	resp := &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialGetCredentialResponse{}
-	err := c.Get(requestURL, resp)
+	err := c.GetWithContext(ctx, requestURL, resp)
 
 	return resp, err
 }
@@ -0,0 +1,79 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package custompolicytemplateclient
+
+import (
+	"fmt"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	custompolicytemplatemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/custompolicytemplate"
+)
+
+const (
+	apiVersionAndGroup = "v1alpha1/policy/templates"
+)
+
+// New creates a new custom policy template resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+// Client for custom policy template resource service API.
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	CustomPolicyTemplateResourceServiceCreate(request *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData) (*custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData, error)
+
+	CustomPolicyTemplateResourceServiceDelete(fn *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName) error
+
+	CustomPolicyTemplateResourceServiceGet(fn *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName) (*custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData, error)
+
+	CustomPolicyTemplateResourceServiceUpdate(request *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData) (*custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData, error)
+}
+
+// CustomPolicyTemplateResourceServiceCreate creates a custom policy template.
+func (c *Client) CustomPolicyTemplateResourceServiceCreate(
+	request *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData,
+) (*custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData, error) {
+	response := &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData{}
+	err := c.Create(apiVersionAndGroup, request, response)
+
+	return response, err
+}
+
+// CustomPolicyTemplateResourceServiceUpdate updates a custom policy template.
+func (c *Client) CustomPolicyTemplateResourceServiceUpdate(
+	request *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData,
+) (*custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData, error) {
+	requestURL := fmt.Sprintf("%s/%s", apiVersionAndGroup, request.Template.FullName.Name)
+	response := &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData{}
+	err := c.Update(requestURL, request, response)
+
+	return response, err
+}
+
+// CustomPolicyTemplateResourceServiceDelete deletes a custom policy template.
+func (c *Client) CustomPolicyTemplateResourceServiceDelete(
+	fn *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName,
+) error {
+	requestURL := fmt.Sprintf("%s/%s", apiVersionAndGroup, fn.Name)
+
+	return c.Delete(requestURL)
+}
+
+// CustomPolicyTemplateResourceServiceGet gets a custom policy template.
+func (c *Client) CustomPolicyTemplateResourceServiceGet(
+	fn *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName,
+) (*custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData, error) {
+	requestURL := fmt.Sprintf("%s/%s", apiVersionAndGroup, fn.Name)
+	response := &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData{}
+	err := c.Get(requestURL, response)
+
+	return response, err
+}
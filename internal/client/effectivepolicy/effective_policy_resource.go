@@ -0,0 +1,65 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package effectivepolicyclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policyeffectivemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/effective"
+)
+
+const (
+	apiVersionAndGroup                 = "v1alpha1/policy/effective"
+	queryParamKeyClusterName           = "searchScope.clusterName"
+	queryParamKeyManagementClusterName = "searchScope.managementClusterName"
+	queryParamKeyProvisionerName       = "searchScope.provisionerName"
+	queryParamKeyNamespaceName         = "searchScope.namespaceName"
+	queryParamKeyPolicyType            = "searchScope.policyType"
+)
+
+// New creates a new effective policy service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+// Client for effective policy service API.
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	ManageV1alpha1EffectivePolicyServiceList(request *policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicyListRequestParameters) (*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicyListResponse, error)
+}
+
+// ManageV1alpha1EffectivePolicyServiceList lists the policies effective on a cluster or a namespace.
+func (c *Client) ManageV1alpha1EffectivePolicyServiceList(
+	request *policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicyListRequestParameters,
+) (*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicyListResponse, error) {
+	queryParams := url.Values{}
+
+	if request.SearchScope != nil {
+		for key, value := range map[string]string{
+			queryParamKeyClusterName:           request.SearchScope.ClusterName,
+			queryParamKeyManagementClusterName: request.SearchScope.ManagementClusterName,
+			queryParamKeyProvisionerName:       request.SearchScope.ProvisionerName,
+			queryParamKeyNamespaceName:         request.SearchScope.NamespaceName,
+			queryParamKeyPolicyType:            request.SearchScope.PolicyType,
+		} {
+			if value != "" {
+				queryParams.Add(key, value)
+			}
+		}
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup).AppendQueryParams(queryParams).String()
+	response := &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicyListResponse{}
+	err := c.Get(requestURL, response)
+
+	return response, err
+}
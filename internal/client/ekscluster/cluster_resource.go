@@ -6,6 +6,7 @@ SPDX-License-Identifier: MPL-2.0
 package ekscluster
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -23,6 +24,7 @@ const (
 	queryParamKeyForce          = "force"
 	queryParamKeyCredentialName = "fullName.credentialName" //nolint:gosec
 	queryParamKeyRegion         = "fullName.region"
+	upgradeVersionsPath         = "upgradeversions"
 )
 
 // New creates a new eks cluster resource service API client.
@@ -45,8 +47,12 @@ type ClientService interface {
 
 	EksClusterResourceServiceGet(fn *eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterGetEksClusterResponse, error)
 
+	EksClusterResourceServiceGetWithContext(ctx context.Context, fn *eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterGetEksClusterResponse, error)
+
 	EksClusterResourceServiceGetByID(id string) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterGetEksClusterResponse, error)
 
+	EksClusterResourceServiceGetUpgradeVersions(fn *eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterGetUpgradeVersionsResponse, error)
+
 	EksClusterResourceServiceUpdate(request *eksmodel.VmwareTanzuManageV1alpha1EksclusterCreateUpdateEksClusterRequest) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterCreateUpdateEksClusterResponse, error)
 }
 
@@ -84,6 +90,13 @@ func (c *Client) EksClusterResourceServiceDelete(fn *eksmodel.VmwareTanzuManageV
 EksClusterResourceServiceGet gets an eks cluster.
 */
 func (c *Client) EksClusterResourceServiceGet(fn *eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterGetEksClusterResponse, error) {
+	return c.EksClusterResourceServiceGetWithContext(c.Context(), fn)
+}
+
+/*
+EksClusterResourceServiceGetWithContext is EksClusterResourceServiceGet bound to the given context, cancelling the context aborts the request and its retries.
+*/
+func (c *Client) EksClusterResourceServiceGetWithContext(ctx context.Context, fn *eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterGetEksClusterResponse, error) {
 	queryParams := url.Values{}
 	if fn.CredentialName != "" {
 		queryParams.Add(queryParamKeyCredentialName, fn.CredentialName)
@@ -96,7 +109,7 @@ func (c *Client) EksClusterResourceServiceGet(fn *eksmodel.VmwareTanzuManageV1al
 	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.Name).AppendQueryParams(queryParams).String()
 	clusterResponse := &eksmodel.VmwareTanzuManageV1alpha1EksclusterGetEksClusterResponse{}
 
-	err := c.Get(requestURL, clusterResponse)
+	err := c.GetWithContext(ctx, requestURL, clusterResponse)
 
 	return clusterResponse, err
 }
@@ -131,6 +144,27 @@ func (c *Client) EksClusterResourceServiceGetByID(id string) (*eksmodel.VmwareTa
 	return clusterResponse, nil
 }
 
+/*
+EksClusterResourceServiceGetUpgradeVersions gets the Kubernetes versions an eks cluster can be upgraded to.
+*/
+func (c *Client) EksClusterResourceServiceGetUpgradeVersions(fn *eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterGetUpgradeVersionsResponse, error) {
+	queryParams := url.Values{}
+	if fn.CredentialName != "" {
+		queryParams.Add(queryParamKeyCredentialName, fn.CredentialName)
+	}
+
+	if fn.Region != "" {
+		queryParams.Add(queryParamKeyRegion, fn.Region)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.Name, upgradeVersionsPath).AppendQueryParams(queryParams).String()
+	versionsResponse := &eksmodel.VmwareTanzuManageV1alpha1EksclusterGetUpgradeVersionsResponse{}
+
+	err := c.Get(requestURL, versionsResponse)
+
+	return versionsResponse, err
+}
+
 /*
 EksClusterResourceServiceUpdate updates overwrite an eks cluster.
 */
@@ -6,6 +6,7 @@ SPDX-License-Identifier: MPL-2.0
 package eksnodepool
 
 import (
+	"context"
 	"net/url"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
@@ -39,6 +40,8 @@ type Client struct {
 type ClientService interface {
 	EksNodePoolResourceServiceGet(fn *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolFullName) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIResponse, error)
 
+	EksNodePoolResourceServiceGetWithContext(ctx context.Context, fn *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolFullName) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIResponse, error)
+
 	EksNodePoolResourceServiceCreate(request *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIRequest) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIResponse, error)
 
 	EksNodePoolResourceServiceList(cluster *eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolListNodepoolsResponse, error)
@@ -50,6 +53,11 @@ type ClientService interface {
 
 // EksNodePoolResourceServiceGet implements ClientService.
 func (c *Client) EksNodePoolResourceServiceGet(fn *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolFullName) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIResponse, error) {
+	return c.EksNodePoolResourceServiceGetWithContext(c.Context(), fn)
+}
+
+// EksNodePoolResourceServiceGetWithContext is EksNodePoolResourceServiceGet bound to the given context, cancelling the context aborts the request and its retries.
+func (c *Client) EksNodePoolResourceServiceGetWithContext(ctx context.Context, fn *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolFullName) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIResponse, error) {
 	queryParams := url.Values{}
 
 	if fn.CredentialName != "" {
@@ -63,7 +71,7 @@ func (c *Client) EksNodePoolResourceServiceGet(fn *eksmodel.VmwareTanzuManageV1a
 	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.EksClusterName, apiNodepoolsPath, fn.Name).AppendQueryParams(queryParams).String()
 	response := &eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIResponse{}
 
-	err := c.Get(requestURL, response)
+	err := c.GetWithContext(ctx, requestURL, response)
 
 	return response, err
 }
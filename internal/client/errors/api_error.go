@@ -0,0 +1,169 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package clienterrors
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	requestIDHeader = "X-Request-Id"
+
+	badRequestType  = "type.googleapis.com/google.rpc.BadRequest"
+	requestInfoType = "type.googleapis.com/google.rpc.RequestInfo"
+)
+
+// FieldViolation describes a field of the request rejected by Tanzu Mission Control.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// APIError is an error response of the Tanzu Mission Control API, decoded from the grpc-gateway runtime error body.
+type APIError struct {
+	HTTPCode        int
+	Code            codes.Code
+	Message         string
+	RequestID       string
+	FieldViolations []FieldViolation
+	Method          string
+	URL             string
+}
+
+// runtimeError mirrors the grpc.gateway.runtime.Error model, keeping the details undecoded since
+// grpc-gateway renders them as JSON objects tagged with their @type.
+type runtimeError struct {
+	Code    *int32            `json:"code"`
+	Error   string            `json:"error"`
+	Message string            `json:"message"`
+	Details []json.RawMessage `json:"details"`
+}
+
+type errorDetail struct {
+	Type            string `json:"@type"`
+	RequestID       string `json:"requestId"`
+	FieldViolations []struct {
+		Field       string `json:"field"`
+		Description string `json:"description"`
+	} `json:"fieldViolations"`
+}
+
+// NewAPIError builds the error of a failed request from its response and body. Bodies which are not grpc-gateway
+// errors are kept as the message of the error, with the gRPC code derived from the HTTP status.
+func NewAPIError(method string, url string, response *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		HTTPCode:  response.StatusCode,
+		Code:      codeFromHTTPStatus(response.StatusCode),
+		Method:    method,
+		URL:       url,
+		RequestID: response.Header.Get(requestIDHeader),
+	}
+
+	var runtimeErr runtimeError
+	if err := json.Unmarshal(body, &runtimeErr); err != nil || (runtimeErr.Code == nil && runtimeErr.Message == "" && runtimeErr.Error == "") {
+		apiErr.Message = strings.TrimSpace(string(body))
+		return apiErr
+	}
+
+	if runtimeErr.Code != nil {
+		apiErr.Code = codes.Code(*runtimeErr.Code)
+	}
+
+	apiErr.Message = runtimeErr.Message
+	if apiErr.Message == "" {
+		apiErr.Message = runtimeErr.Error
+	}
+
+	for _, raw := range runtimeErr.Details {
+		var detail errorDetail
+		if err := json.Unmarshal(raw, &detail); err != nil {
+			continue
+		}
+
+		switch detail.Type {
+		case requestInfoType:
+			if apiErr.RequestID == "" {
+				apiErr.RequestID = detail.RequestID
+			}
+		case badRequestType:
+			for _, violation := range detail.FieldViolations {
+				apiErr.FieldViolations = append(apiErr.FieldViolations, FieldViolation{
+					Field:       violation.Field,
+					Description: violation.Description,
+				})
+			}
+		}
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s request(%s) failed with status : %d %s, code: %s, message: %s",
+		e.Method, e.URL, e.HTTPCode, http.StatusText(e.HTTPCode), e.Code, e.Message)
+}
+
+// Detail renders the error over multiple lines, including the request ID and the rejected fields when known.
+func (e *APIError) Detail() string {
+	lines := []string{
+		fmt.Sprintf("Request: %s %s", e.Method, e.URL),
+		fmt.Sprintf("HTTP status: %d %s", e.HTTPCode, http.StatusText(e.HTTPCode)),
+		fmt.Sprintf("Code: %s", e.Code),
+	}
+
+	if e.Message != "" {
+		lines = append(lines, fmt.Sprintf("Message: %s", e.Message))
+	}
+
+	if e.RequestID != "" {
+		lines = append(lines, fmt.Sprintf("Request ID: %s", e.RequestID))
+	}
+
+	for _, violation := range e.FieldViolations {
+		lines = append(lines, fmt.Sprintf("Field %s: %s", violation.Field, violation.Description))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// codeFromHTTPStatus maps an HTTP status to the gRPC code grpc-gateway would have translated into that status.
+func codeFromHTTPStatus(status int) codes.Code {
+	switch status {
+	case http.StatusOK:
+		return codes.OK
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	default:
+		if status >= http.StatusInternalServerError {
+			return codes.Internal
+		}
+
+		return codes.Unknown
+	}
+}
@@ -6,9 +6,11 @@ SPDX-License-Identifier: MPL-2.0
 package clienterrors
 
 import (
-	"fmt"
 	"net/http"
-	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
 )
 
 type ClientErrors struct {
@@ -24,54 +26,91 @@ func ErrorWithHTTPCode(httpCode int, err error) ClientErrors {
 }
 
 func IsNotFoundError(err error) bool {
-	if err == nil {
-		return false
-	}
+	return hasStatus(err, http.StatusNotFound, codes.NotFound)
+}
 
-	convertedError, ok := err.(ClientErrors)
-	if !ok {
-		return strings.Contains(err.Error(), fmt.Sprintf("%d", http.StatusNotFound)) &&
-			strings.Contains(err.Error(), http.StatusText(http.StatusNotFound))
-	}
+func IsUnauthorizedError(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized, codes.Unauthenticated)
+}
+
+func IsAlreadyExistsError(err error) bool {
+	return hasStatus(err, http.StatusConflict, codes.AlreadyExists)
+}
+
+// IsConflict reports whether the request conflicted with the current state of the resource,
+// either because it already exists or because a concurrent change aborted it.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict, codes.AlreadyExists, codes.Aborted)
+}
+
+// IsPermissionDenied reports whether the caller is not allowed to perform the request.
+func IsPermissionDenied(err error) bool {
+	return hasStatus(err, http.StatusForbidden, codes.PermissionDenied)
+}
 
-	if convertedError.httpCode == http.StatusNotFound {
-		return true
+// IsFailedPrecondition reports whether the request was rejected because the resource is not in a state allowing it.
+func IsFailedPrecondition(err error) bool {
+	return hasStatus(err, http.StatusPreconditionFailed, codes.FailedPrecondition)
+}
+
+// IsRateLimited reports whether the request was rejected by the rate limits of Tanzu Mission Control.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests, codes.ResourceExhausted)
+}
+
+// AsAPIError returns the Tanzu Mission Control API error wrapped by err, if any.
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
 	}
 
-	return false
+	return nil, false
 }
 
-func IsUnauthorizedError(err error) bool {
+// ToDiagnostics converts err into Terraform diagnostics, detailing the Tanzu Mission Control API error it wraps
+// over multiple lines when there is one.
+func ToDiagnostics(err error) diag.Diagnostics {
 	if err == nil {
-		return false
+		return nil
 	}
 
-	convertedError, ok := err.(ClientErrors)
+	apiErr, ok := AsAPIError(err)
 	if !ok {
-		return strings.Contains(err.Error(), fmt.Sprintf("%d", http.StatusUnauthorized)) &&
-			strings.Contains(err.Error(), http.StatusText(http.StatusUnauthorized))
+		return diag.FromErr(err)
 	}
 
-	if convertedError.httpCode == http.StatusUnauthorized {
-		return true
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  err.Error(),
+			Detail:   apiErr.Detail(),
+		},
 	}
-
-	return false
 }
 
-func IsAlreadyExistsError(err error) bool {
+func hasStatus(err error, httpCode int, grpcCodes ...codes.Code) bool {
 	if err == nil {
 		return false
 	}
 
-	convertedError, ok := err.(ClientErrors)
-	if !ok {
-		return strings.Contains(err.Error(), fmt.Sprintf("%d", http.StatusConflict)) &&
-			strings.Contains(err.Error(), http.StatusText(http.StatusConflict))
+	if apiErr, ok := AsAPIError(err); ok {
+		if apiErr.HTTPCode == httpCode {
+			return true
+		}
+
+		for _, code := range grpcCodes {
+			if apiErr.Code == code {
+				return true
+			}
+		}
+
+		return false
 	}
 
-	if convertedError.httpCode == http.StatusConflict {
-		return true
+	var clientErr ClientErrors
+	if errors.As(err, &clientErr) {
+		return clientErr.httpCode == httpCode
 	}
 
 	return false
@@ -84,3 +123,7 @@ func (e ClientErrors) Error() string {
 
 	return e.err.Error()
 }
+
+func (e ClientErrors) Unwrap() error {
+	return e.err
+}
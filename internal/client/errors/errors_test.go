@@ -0,0 +1,193 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package clienterrors
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestNewAPIError(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		statusCode int
+		header     http.Header
+		body       string
+		expected   *APIError
+	}{
+		{
+			name:       "case for a grpc-gateway error with details",
+			statusCode: http.StatusBadRequest,
+			header:     http.Header{},
+			body: `{"code":9,"error":"cluster is not ready","message":"cluster is not ready","details":[` +
+				`{"@type":"type.googleapis.com/google.rpc.RequestInfo","requestId":"req-1"},` +
+				`{"@type":"type.googleapis.com/google.rpc.BadRequest","fieldViolations":[{"field":"spec.version","description":"unsupported version"}]}]}`,
+			expected: &APIError{
+				HTTPCode:  http.StatusBadRequest,
+				Code:      codes.FailedPrecondition,
+				Message:   "cluster is not ready",
+				RequestID: "req-1",
+				FieldViolations: []FieldViolation{
+					{Field: "spec.version", Description: "unsupported version"},
+				},
+				Method: http.MethodPost,
+				URL:    "v1alpha1/clusters",
+			},
+		},
+		{
+			name:       "case for a request ID sent as a header",
+			statusCode: http.StatusConflict,
+			header:     http.Header{requestIDHeader: {"req-2"}},
+			body:       `{"code":6,"message":"workspace already exists"}`,
+			expected: &APIError{
+				HTTPCode:  http.StatusConflict,
+				Code:      codes.AlreadyExists,
+				Message:   "workspace already exists",
+				RequestID: "req-2",
+				Method:    http.MethodPost,
+				URL:       "v1alpha1/clusters",
+			},
+		},
+		{
+			name:       "case for a body which is not a grpc-gateway error",
+			statusCode: http.StatusTooManyRequests,
+			header:     http.Header{},
+			body:       "rate limit exceeded\n",
+			expected: &APIError{
+				HTTPCode: http.StatusTooManyRequests,
+				Code:     codes.ResourceExhausted,
+				Message:  "rate limit exceeded",
+				Method:   http.MethodPost,
+				URL:      "v1alpha1/clusters",
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			response := &http.Response{StatusCode: test.statusCode, Header: test.header}
+
+			actual := NewAPIError(http.MethodPost, "v1alpha1/clusters", response, []byte(test.body))
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	t.Parallel()
+
+	apiError := func(statusCode int, code codes.Code) error {
+		return errors.Wrap(&APIError{HTTPCode: statusCode, Code: code}, "unable to get cluster")
+	}
+
+	cases := []struct {
+		name      string
+		err       error
+		predicate func(error) bool
+		expected  bool
+	}{
+		{
+			name:      "case for a wrapped not found error",
+			err:       apiError(http.StatusNotFound, codes.NotFound),
+			predicate: IsNotFoundError,
+			expected:  true,
+		},
+		{
+			name:      "case for a wrapped client error with HTTP code",
+			err:       errors.Wrap(ErrorWithHTTPCode(http.StatusNotFound, errors.New("cluster list by ID was empty")), "get cluster"),
+			predicate: IsNotFoundError,
+			expected:  true,
+		},
+		{
+			name:      "case for an untyped error mentioning 404 Not Found",
+			err:       errors.New("404 Not Found"),
+			predicate: IsNotFoundError,
+			expected:  false,
+		},
+		{
+			name:      "case for a conflict aborted by a concurrent change",
+			err:       apiError(http.StatusConflict, codes.Aborted),
+			predicate: IsConflict,
+			expected:  true,
+		},
+		{
+			name:      "case for a permission denied error",
+			err:       apiError(http.StatusForbidden, codes.PermissionDenied),
+			predicate: IsPermissionDenied,
+			expected:  true,
+		},
+		{
+			name:      "case for a failed precondition sent as bad request",
+			err:       apiError(http.StatusBadRequest, codes.FailedPrecondition),
+			predicate: IsFailedPrecondition,
+			expected:  true,
+		},
+		{
+			name:      "case for an invalid argument",
+			err:       apiError(http.StatusBadRequest, codes.InvalidArgument),
+			predicate: IsFailedPrecondition,
+			expected:  false,
+		},
+		{
+			name:      "case for a rate limited error",
+			err:       apiError(http.StatusTooManyRequests, codes.ResourceExhausted),
+			predicate: IsRateLimited,
+			expected:  true,
+		},
+		{
+			name:      "case for a nil error",
+			err:       nil,
+			predicate: IsConflict,
+			expected:  false,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, test.predicate(test.err))
+		})
+	}
+}
+
+func TestToDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	apiErr := &APIError{
+		HTTPCode:  http.StatusBadRequest,
+		Code:      codes.InvalidArgument,
+		Message:   "invalid cluster spec",
+		RequestID: "req-1",
+		FieldViolations: []FieldViolation{
+			{Field: "spec.version", Description: "unsupported version"},
+		},
+		Method: http.MethodPost,
+		URL:    "v1alpha1/clusters",
+	}
+
+	diags := ToDiagnostics(errors.Wrap(apiErr, "Unable to create Tanzu Mission Control cluster entry"))
+	require.Len(t, diags, 1)
+	require.Contains(t, diags[0].Summary, "Unable to create Tanzu Mission Control cluster entry")
+	require.Equal(t, "Request: POST v1alpha1/clusters\n"+
+		"HTTP status: 400 Bad Request\n"+
+		"Code: InvalidArgument\n"+
+		"Message: invalid cluster spec\n"+
+		"Request ID: req-1\n"+
+		"Field spec.version: unsupported version", diags[0].Detail)
+
+	diags = ToDiagnostics(errors.New("unable to set meta"))
+	require.Len(t, diags, 1)
+	require.Empty(t, diags[0].Detail)
+
+	require.Nil(t, ToDiagnostics(nil))
+}
@@ -13,29 +13,50 @@ import (
 	aksnodepoolclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/akscluster/nodepool"
 	clusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster"
 	continuousdeliveryclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/continuousdelivery"
+	dataprotectionclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/dataprotection"
+	backupscheduleclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/dataprotection/backupschedule"
+	restoreclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/dataprotection/restore"
+	targetlocationclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/dataprotection/targetlocation"
 	gitrepositoryclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/gitrepository"
+	helmfeatureclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/helmfeature"
+	helmreleaseclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/helmrelease"
 	iamclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/iam_policy"
+	inspectionclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/inspection"
 	kustomizationclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/kustomization"
 	manifestclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/manifest"
+	packageinstallclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/packageinstall"
+	packagerepositoryclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/packagerepository"
 	policyclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/policy"
 	sourcesecretclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/sourcesecret"
+	tanzupackageclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/cluster/tanzupackage"
 	clustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup"
 	continuousdeliveryclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/continuousdelivery"
 	gitrepositoryclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/gitrepository"
+	helmfeatureclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/helmfeature"
+	helmreleaseclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/helmrelease"
 	iamclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/iam_policy"
 	kustomizationclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/kustomization"
+	packageinstallclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/packageinstall"
+	packagerepositoryclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/packagerepository"
 	policyclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/policy"
 	sourcesecretclustergroupclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/clustergroup/sourcesecret"
 	credentialclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/credential"
+	custompolicytemplateclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/custompolicytemplate"
+	effectivepolicyclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/effectivepolicy"
 	eksclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/ekscluster"
 	eksnodepoolclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/ekscluster/nodepool"
 	integrationclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/integration"
+	managementclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/managementcluster"
+	provisionerclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/managementcluster/provisioner"
 	namespaceclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/namespace"
 	iamnamespaceclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/namespace/iam_policy"
+	policynamespaceclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/namespace/policy"
 	nodepoolclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/nodepool"
 	iamorganizationclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/organization/iam_policy"
 	policyorganizationclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/organization/policy"
+	providermetadataclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/providermetadata"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/proxy"
+	tanzukubernetesclusterclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/tanzukubernetescluster"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
 	workspaceclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/workspace"
 	iamworkspaceclient "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/workspace/iam_policy"
@@ -86,6 +107,7 @@ func newHTTPClient(httpClient *transport.Client) *TanzuMissionControl {
 		ClusterPolicyResourceService:                  policyclusterclient.New(httpClient),
 		ClusterGroupPolicyResourceService:             policyclustergroupclient.New(httpClient),
 		WorkspacePolicyResourceService:                policyworkspaceclient.New(httpClient),
+		NamespacePolicyResourceService:                policynamespaceclient.New(httpClient),
 		OrganizationPolicyResourceService:             policyorganizationclient.New(httpClient),
 		CredentialResourceService:                     credentialclient.New(httpClient),
 		IntegrationResourceService:                    integrationclient.New(httpClient),
@@ -98,6 +120,26 @@ func newHTTPClient(httpClient *transport.Client) *TanzuMissionControl {
 		ClusterSourcesecretResourceService:            sourcesecretclusterclient.New(httpClient),
 		ClusterGroupSourcesecretResourceService:       sourcesecretclustergroupclient.New(httpClient),
 		ManifestResourceService:                       manifestclient.New(httpClient),
+		DataProtectionResourceService:                 dataprotectionclusterclient.New(httpClient),
+		BackupScheduleResourceService:                 backupscheduleclient.New(httpClient),
+		RestoreResourceService:                        restoreclient.New(httpClient),
+		TargetLocationResourceService:                 targetlocationclient.New(httpClient),
+		InspectionScanResourceService:                 inspectionclient.New(httpClient),
+		ClusterPackageRepositoryResourceService:       packagerepositoryclusterclient.New(httpClient),
+		ClusterGroupPackageRepositoryResourceService:  packagerepositoryclustergroupclient.New(httpClient),
+		ClusterPackageInstallResourceService:          packageinstallclusterclient.New(httpClient),
+		ClusterGroupPackageInstallResourceService:     packageinstallclustergroupclient.New(httpClient),
+		TanzupackageResourceService:                   tanzupackageclient.New(httpClient),
+		ClusterHelmResourceService:                    helmfeatureclusterclient.New(httpClient),
+		ClusterGroupHelmResourceService:               helmfeatureclustergroupclient.New(httpClient),
+		ClusterHelmReleaseResourceService:             helmreleaseclusterclient.New(httpClient),
+		ClusterGroupHelmReleaseResourceService:        helmreleaseclustergroupclient.New(httpClient),
+		CustomPolicyTemplateResourceService:           custompolicytemplateclient.New(httpClient),
+		EffectivePolicyService:                        effectivepolicyclient.New(httpClient),
+		ManagementClusterResourceService:              managementclusterclient.New(httpClient),
+		ProvisionerResourceService:                    provisionerclient.New(httpClient),
+		TanzuKubernetesClusterResourceService:         tanzukubernetesclusterclient.New(httpClient),
+		ProviderMetadataService:                       providermetadataclient.New(httpClient),
 	}
 }
 
@@ -121,6 +163,7 @@ type TanzuMissionControl struct {
 	ClusterPolicyResourceService                  policyclusterclient.ClientService
 	ClusterGroupPolicyResourceService             policyclustergroupclient.ClientService
 	WorkspacePolicyResourceService                policyworkspaceclient.ClientService
+	NamespacePolicyResourceService                policynamespaceclient.ClientService
 	OrganizationPolicyResourceService             policyorganizationclient.ClientService
 	CredentialResourceService                     credentialclient.ClientService
 	IntegrationResourceService                    integrationclient.ClientService
@@ -133,4 +176,24 @@ type TanzuMissionControl struct {
 	ClusterSourcesecretResourceService            sourcesecretclusterclient.ClientService
 	ClusterGroupSourcesecretResourceService       sourcesecretclustergroupclient.ClientService
 	ManifestResourceService                       manifestclient.ClientService
+	DataProtectionResourceService                 dataprotectionclusterclient.ClientService
+	BackupScheduleResourceService                 backupscheduleclient.ClientService
+	RestoreResourceService                        restoreclient.ClientService
+	TargetLocationResourceService                 targetlocationclient.ClientService
+	InspectionScanResourceService                 inspectionclient.ClientService
+	ClusterPackageRepositoryResourceService       packagerepositoryclusterclient.ClientService
+	ClusterGroupPackageRepositoryResourceService  packagerepositoryclustergroupclient.ClientService
+	ClusterPackageInstallResourceService          packageinstallclusterclient.ClientService
+	ClusterGroupPackageInstallResourceService     packageinstallclustergroupclient.ClientService
+	TanzupackageResourceService                   tanzupackageclient.ClientService
+	ClusterHelmResourceService                    helmfeatureclusterclient.ClientService
+	ClusterGroupHelmResourceService               helmfeatureclustergroupclient.ClientService
+	ClusterHelmReleaseResourceService             helmreleaseclusterclient.ClientService
+	ClusterGroupHelmReleaseResourceService        helmreleaseclustergroupclient.ClientService
+	CustomPolicyTemplateResourceService           custompolicytemplateclient.ClientService
+	EffectivePolicyService                        effectivepolicyclient.ClientService
+	ManagementClusterResourceService              managementclusterclient.ClientService
+	ProvisionerResourceService                    provisionerclient.ClientService
+	TanzuKubernetesClusterResourceService         tanzukubernetesclusterclient.ClientService
+	ProviderMetadataService                       providermetadataclient.ClientService
 }
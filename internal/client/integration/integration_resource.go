@@ -6,6 +6,7 @@ SPDX-License-Identifier: MPL-2.0
 package integrationclient
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 
@@ -31,6 +32,15 @@ type ClientService interface {
 		*integration.VmwareTanzuManageV1alpha1ClusterIntegrationFullName,
 	) (*integration.VmwareTanzuManageV1alpha1ClusterIntegrationGetIntegrationResponse, error)
 
+	ManageV1alpha1ClusterIntegrationResourceServiceReadWithContext(
+		context.Context,
+		*integration.VmwareTanzuManageV1alpha1ClusterIntegrationFullName,
+	) (*integration.VmwareTanzuManageV1alpha1ClusterIntegrationGetIntegrationResponse, error)
+
+	ManageV1alpha1ClusterIntegrationResourceServiceUpdate(
+		*integration.VmwareTanzuManageV1alpha1ClusterIntegrationUpdateIntegrationRequest,
+	) (*integration.VmwareTanzuManageV1alpha1ClusterIntegrationUpdateIntegrationResponse, error)
+
 	ManageV1alpha1ClusterIntegrationResourceServiceDelete(
 		*integration.VmwareTanzuManageV1alpha1ClusterIntegrationFullName,
 	) error
@@ -53,13 +63,40 @@ func (c *client) ManageV1alpha1ClusterIntegrationResourceServiceCreate(
 
 func (c *client) ManageV1alpha1ClusterIntegrationResourceServiceRead(
 	fn *integration.VmwareTanzuManageV1alpha1ClusterIntegrationFullName,
+) (*integration.VmwareTanzuManageV1alpha1ClusterIntegrationGetIntegrationResponse, error) {
+	return c.ManageV1alpha1ClusterIntegrationResourceServiceReadWithContext(c.Context(), fn)
+}
+
+// ManageV1alpha1ClusterIntegrationResourceServiceReadWithContext is ManageV1alpha1ClusterIntegrationResourceServiceRead bound to the given context, cancelling the context aborts the request and its retries.
+func (c *client) ManageV1alpha1ClusterIntegrationResourceServiceReadWithContext(
+	ctx context.Context,
+	fn *integration.VmwareTanzuManageV1alpha1ClusterIntegrationFullName,
 ) (*integration.VmwareTanzuManageV1alpha1ClusterIntegrationGetIntegrationResponse, error) {
 	if errors := validateFullName(fn); len(errors) > 0 {
 		return nil, fmt.Errorf("incomplete full name: %v (%v)", errors, fn)
 	}
 
 	response := &integration.VmwareTanzuManageV1alpha1ClusterIntegrationGetIntegrationResponse{}
-	err := c.Get(resourceEndpoint(fn), response)
+	err := c.GetWithContext(ctx, resourceEndpoint(fn), response)
+
+	return response, err
+}
+
+func (c *client) ManageV1alpha1ClusterIntegrationResourceServiceUpdate(
+	request *integration.VmwareTanzuManageV1alpha1ClusterIntegrationUpdateIntegrationRequest,
+) (*integration.VmwareTanzuManageV1alpha1ClusterIntegrationUpdateIntegrationResponse, error) {
+	response := &integration.VmwareTanzuManageV1alpha1ClusterIntegrationUpdateIntegrationResponse{}
+
+	if request.Integration == nil {
+		return nil, fmt.Errorf("missing integration to update")
+	}
+
+	fn := request.Integration.FullName
+	if errors := validateFullName(fn); len(errors) > 0 {
+		return nil, fmt.Errorf("incomplete full name: %v (%v)", errors, fn)
+	}
+
+	err := c.Update(resourceEndpoint(fn), request, response)
 
 	return response, err
 }
@@ -0,0 +1,122 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package managementclusterclient
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/pagination"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	managementclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/managementcluster"
+)
+
+const (
+	apiVersionAndGroup = "v1alpha1/managementclusters"
+)
+
+// New creates a new management cluster resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for management cluster resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	ManageV1alpha1ManagementClusterResourceServiceCreate(request *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterRequest) (*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterResponse, error)
+
+	ManageV1alpha1ManagementClusterResourceServiceDelete(fn *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterFullName) error
+
+	ManageV1alpha1ManagementClusterResourceServiceGet(fn *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterFullName) (*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterGetManagementClusterResponse, error)
+
+	ManageV1alpha1ManagementClusterResourceServiceGetWithContext(ctx context.Context, fn *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterFullName) (*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterGetManagementClusterResponse, error)
+
+	ManageV1alpha1ManagementClusterResourceServiceUpdate(request *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterRequest) (*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterResponse, error)
+
+	ManageV1alpha1ManagementClusterResourceServiceList(request *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersRequestParameters) (*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersResponse, error)
+}
+
+/*
+ManageV1alpha1ManagementClusterResourceServiceCreate registers a management cluster.
+*/
+func (c *Client) ManageV1alpha1ManagementClusterResourceServiceCreate(request *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterRequest) (*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterResponse, error) {
+	response := &managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterResponse{}
+	err := c.Create(apiVersionAndGroup, request, response)
+
+	return response, err
+}
+
+/*
+ManageV1alpha1ManagementClusterResourceServiceDelete deregisters a management cluster.
+*/
+func (c *Client) ManageV1alpha1ManagementClusterResourceServiceDelete(fn *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterFullName) error {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.Name).String()
+
+	return c.Delete(requestURL)
+}
+
+/*
+ManageV1alpha1ManagementClusterResourceServiceGet gets a management cluster.
+*/
+func (c *Client) ManageV1alpha1ManagementClusterResourceServiceGet(fn *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterFullName) (*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterGetManagementClusterResponse, error) {
+	return c.ManageV1alpha1ManagementClusterResourceServiceGetWithContext(c.Context(), fn)
+}
+
+/*
+ManageV1alpha1ManagementClusterResourceServiceGetWithContext is ManageV1alpha1ManagementClusterResourceServiceGet bound to the given context, cancelling the context aborts the request and its retries.
+*/
+func (c *Client) ManageV1alpha1ManagementClusterResourceServiceGetWithContext(ctx context.Context, fn *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterFullName) (*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterGetManagementClusterResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.Name).String()
+	response := &managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterGetManagementClusterResponse{}
+	err := c.GetWithContext(ctx, requestURL, response)
+
+	return response, err
+}
+
+/*
+ManageV1alpha1ManagementClusterResourceServiceUpdate updates a management cluster.
+*/
+func (c *Client) ManageV1alpha1ManagementClusterResourceServiceUpdate(request *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterRequest) (*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.ManagementCluster.FullName.Name).String()
+	response := &managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterResponse{}
+	err := c.Update(requestURL, request, response)
+
+	return response, err
+}
+
+/*
+ManageV1alpha1ManagementClusterResourceServiceList lists management clusters.
+*/
+func (c *Client) ManageV1alpha1ManagementClusterResourceServiceList(request *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersRequestParameters) (*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersResponse, error) {
+	queryParams := url.Values{}
+
+	if request.SearchScope != nil && request.SearchScope.Name != "" {
+		queryParams.Add("searchScope.name", request.SearchScope.Name)
+	}
+
+	if request.Query != "" {
+		queryParams.Add("query", request.Query)
+	}
+
+	if request.SortBy != "" {
+		queryParams.Add("sortBy", request.SortBy)
+	}
+
+	pagination.AddQueryParams(queryParams, request.Pagination, request.IncludeTotalCount)
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup).AppendQueryParams(queryParams).String()
+	response := &managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersResponse{}
+	err := c.Get(requestURL, response)
+
+	return response, err
+}
@@ -0,0 +1,120 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package provisionerclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/pagination"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	provisionermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/provisioner"
+)
+
+const (
+	apiVersionAndGroup = "v1alpha1/managementclusters"
+	apiProvisionerPath = "provisioners"
+)
+
+// New creates a new provisioner resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for provisioner resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	ManageV1alpha1ProvisionerResourceServiceCreate(request *provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerRequest) (*provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerResponse, error)
+
+	ManageV1alpha1ProvisionerResourceServiceDelete(fn *provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerFullName) error
+
+	ManageV1alpha1ProvisionerResourceServiceGet(fn *provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerFullName) (*provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerGetProvisionerResponse, error)
+
+	ManageV1alpha1ProvisionerResourceServiceUpdate(request *provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerRequest) (*provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerResponse, error)
+
+	ManageV1alpha1ProvisionerResourceServiceList(request *provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerListProvisionersRequestParameters) (*provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerListProvisionersResponse, error)
+}
+
+/*
+ManageV1alpha1ProvisionerResourceServiceCreate creates a provisioner.
+*/
+func (c *Client) ManageV1alpha1ProvisionerResourceServiceCreate(request *provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerRequest) (*provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Provisioner.FullName.ManagementClusterName, apiProvisionerPath).String()
+	response := &provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerResponse{}
+	err := c.Create(requestURL, request, response)
+
+	return response, err
+}
+
+/*
+ManageV1alpha1ProvisionerResourceServiceDelete deletes a provisioner.
+*/
+func (c *Client) ManageV1alpha1ProvisionerResourceServiceDelete(fn *provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerFullName) error {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ManagementClusterName, apiProvisionerPath, fn.Name).String()
+
+	return c.Delete(requestURL)
+}
+
+/*
+ManageV1alpha1ProvisionerResourceServiceGet gets a provisioner.
+*/
+func (c *Client) ManageV1alpha1ProvisionerResourceServiceGet(fn *provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerFullName) (*provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerGetProvisionerResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ManagementClusterName, apiProvisionerPath, fn.Name).String()
+	response := &provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerGetProvisionerResponse{}
+	err := c.Get(requestURL, response)
+
+	return response, err
+}
+
+/*
+ManageV1alpha1ProvisionerResourceServiceUpdate updates a provisioner.
+*/
+func (c *Client) ManageV1alpha1ProvisionerResourceServiceUpdate(request *provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerRequest) (*provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerResponse, error) {
+	fn := request.Provisioner.FullName
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ManagementClusterName, apiProvisionerPath, fn.Name).String()
+	response := &provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerResponse{}
+	err := c.Update(requestURL, request, response)
+
+	return response, err
+}
+
+/*
+ManageV1alpha1ProvisionerResourceServiceList lists the provisioners of a management cluster.
+*/
+func (c *Client) ManageV1alpha1ProvisionerResourceServiceList(request *provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerListProvisionersRequestParameters) (*provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerListProvisionersResponse, error) {
+	queryParams := url.Values{}
+
+	if request.SearchScope != nil && request.SearchScope.Name != "" {
+		queryParams.Add("searchScope.name", request.SearchScope.Name)
+	}
+
+	if request.Query != "" {
+		queryParams.Add("query", request.Query)
+	}
+
+	if request.SortBy != "" {
+		queryParams.Add("sortBy", request.SortBy)
+	}
+
+	pagination.AddQueryParams(queryParams, request.Pagination, request.IncludeTotalCount)
+
+	managementClusterName := ""
+	if request.SearchScope != nil {
+		managementClusterName = request.SearchScope.ManagementClusterName
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, managementClusterName, apiProvisionerPath).AppendQueryParams(queryParams).String()
+	response := &provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerListProvisionersResponse{}
+	err := c.Get(requestURL, response)
+
+	return response, err
+}
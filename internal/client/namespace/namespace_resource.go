@@ -9,7 +9,9 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/pagination"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	namespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/namespace"
 )
 
@@ -34,6 +36,8 @@ type ClientService interface {
 	ManageV1alpha1NamespaceResourceServiceGet(fn *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName) (*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceGetNamespaceResponse, error)
 
 	ManageV1alpha1NamespaceResourceServiceUpdate(request *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceRequest) (*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceResponse, error)
+
+	ManageV1alpha1NamespaceResourceServiceList(request *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesRequestParameters) (*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesResponse, error)
 }
 
 /*
@@ -105,3 +109,47 @@ func (c *Client) ManageV1alpha1NamespaceResourceServiceGet(
 
 	return namespaceResponse, err
 }
+
+/*
+ManageV1alpha1NamespaceResourceServiceList lists Namespaces, across all the clusters when the cluster name of the search scope is empty.
+*/
+func (c *Client) ManageV1alpha1NamespaceResourceServiceList(
+	request *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesRequestParameters,
+) (*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesResponse, error) {
+	queryParams := url.Values{}
+	clusterName := "*"
+
+	if request.SearchScope != nil {
+		if request.SearchScope.ClusterName != "" {
+			clusterName = request.SearchScope.ClusterName
+		}
+
+		if request.SearchScope.ManagementClusterName != "" {
+			queryParams.Add("searchScope.managementClusterName", request.SearchScope.ManagementClusterName)
+		}
+
+		if request.SearchScope.ProvisionerName != "" {
+			queryParams.Add("searchScope.provisionerName", request.SearchScope.ProvisionerName)
+		}
+
+		if request.SearchScope.Name != "" {
+			queryParams.Add("searchScope.name", request.SearchScope.Name)
+		}
+	}
+
+	if request.Query != "" {
+		queryParams.Add("query", request.Query)
+	}
+
+	if request.SortBy != "" {
+		queryParams.Add("sortBy", request.SortBy)
+	}
+
+	pagination.AddQueryParams(queryParams, request.Pagination, request.IncludeTotalCount)
+
+	requestURL := helper.ConstructRequestURL("v1alpha1/clusters", clusterName, "namespaces").AppendQueryParams(queryParams).String()
+	namespaceListResponse := &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesResponse{}
+	err := c.Get(requestURL, namespaceListResponse)
+
+	return namespaceListResponse, err
+}
@@ -0,0 +1,107 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policynamespaceclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policynamespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/namespace"
+)
+
+const (
+	apiVersionAndGroup                 = "v1alpha1/clusters"
+	apiNamespacesPath                  = "namespaces"
+	apiKind                            = "policies"
+	queryParamKeyManagementClusterName = "fullName.managementClusterName"
+	queryParamKeyProvisionerName       = "fullName.provisionerName"
+)
+
+// New creates a new namespace policy resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for namespace policy resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for ManageV1alpha1ClusterNamespacePolicyResourceService Client methods.
+type ClientService interface {
+	ManageV1alpha1ClusterNamespacePolicyResourceServiceCreate(request *policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyRequest) (*policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyResponse, error)
+
+	ManageV1alpha1ClusterNamespacePolicyResourceServiceDelete(fn *policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName) error
+
+	ManageV1alpha1ClusterNamespacePolicyResourceServiceGet(fn *policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName) (*policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyGetPolicyResponse, error)
+
+	ManageV1alpha1ClusterNamespacePolicyResourceServiceUpdate(request *policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyRequest) (*policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyResponse, error)
+}
+
+/*
+ManageV1alpha1ClusterNamespacePolicyResourceServiceCreate creates a policy scoped to a namespace resource.
+*/
+func (p *Client) ManageV1alpha1ClusterNamespacePolicyResourceServiceCreate(request *policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyRequest) (*policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Policy.FullName.ClusterName, apiNamespacesPath, request.Policy.FullName.NamespaceName, apiKind).String()
+	policyNamespaceResponse := &policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyResponse{}
+	err := p.Create(requestURL, request, policyNamespaceResponse)
+
+	return policyNamespaceResponse, err
+}
+
+/*
+ManageV1alpha1ClusterNamespacePolicyResourceServiceDelete deletes a policy scoped to a namespace resource.
+*/
+func (p *Client) ManageV1alpha1ClusterNamespacePolicyResourceServiceDelete(fn *policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName) error {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeyManagementClusterName, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeyProvisionerName, fn.ProvisionerName)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiNamespacesPath, fn.NamespaceName, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+
+	return p.Delete(requestURL)
+}
+
+/*
+ManageV1alpha1ClusterNamespacePolicyResourceServiceGet gets a policy scoped to a namespace resource.
+*/
+func (p *Client) ManageV1alpha1ClusterNamespacePolicyResourceServiceGet(fn *policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName) (*policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyGetPolicyResponse, error) {
+	queryParams := url.Values{}
+
+	if fn.ManagementClusterName != "" {
+		queryParams.Add(queryParamKeyManagementClusterName, fn.ManagementClusterName)
+	}
+
+	if fn.ProvisionerName != "" {
+		queryParams.Add(queryParamKeyProvisionerName, fn.ProvisionerName)
+	}
+
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ClusterName, apiNamespacesPath, fn.NamespaceName, apiKind, fn.Name).AppendQueryParams(queryParams).String()
+	policyNamespaceResponse := &policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyGetPolicyResponse{}
+	err := p.Get(requestURL, policyNamespaceResponse)
+
+	return policyNamespaceResponse, err
+}
+
+/*
+ManageV1alpha1ClusterNamespacePolicyResourceServiceUpdate updates overwrite a policy scoped to a namespace resource.
+*/
+func (p *Client) ManageV1alpha1ClusterNamespacePolicyResourceServiceUpdate(request *policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyRequest) (*policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, request.Policy.FullName.ClusterName, apiNamespacesPath, request.Policy.FullName.NamespaceName, apiKind, request.Policy.FullName.Name).String()
+	policyNamespaceResponse := &policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyResponse{}
+	err := p.Update(requestURL, request, policyNamespaceResponse)
+
+	return policyNamespaceResponse, err
+}
@@ -6,6 +6,7 @@ SPDX-License-Identifier: MPL-2.0
 package nodepools
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 
@@ -31,8 +32,12 @@ type ClientService interface {
 
 	ManageV1alpha1ClusterNodePoolResourceServiceGet(fn *nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName) (*nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolCreateNodepoolResponse, error)
 
+	ManageV1alpha1ClusterNodePoolResourceServiceGetWithContext(ctx context.Context, fn *nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName) (*nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolCreateNodepoolResponse, error)
+
 	ManageV1alpha1ClusterNodePoolResourceServiceDelete(fn *nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName) error
 
+	ManageV1alpha1ClusterNodePoolResourceServiceDeleteWithContext(ctx context.Context, fn *nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName) error
+
 	ManageV1alpha1ClusterNodePoolResourceServiceUpdate(request *nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolCreateNodepoolRequest) (*nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolCreateNodepoolResponse, error)
 }
 
@@ -52,6 +57,13 @@ func (c *Client) ManageV1alpha1ClusterNodePoolResourceServiceCreate(request *nod
 ManageV1alpha1ClusterNodePoolResourceServiceGet gets a node cluster.
 */
 func (c *Client) ManageV1alpha1ClusterNodePoolResourceServiceGet(fn *nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName) (*nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolCreateNodepoolResponse, error) {
+	return c.ManageV1alpha1ClusterNodePoolResourceServiceGetWithContext(c.Context(), fn)
+}
+
+/*
+ManageV1alpha1ClusterNodePoolResourceServiceGetWithContext is ManageV1alpha1ClusterNodePoolResourceServiceGet bound to the given context, cancelling the context aborts the request and its retries.
+*/
+func (c *Client) ManageV1alpha1ClusterNodePoolResourceServiceGetWithContext(ctx context.Context, fn *nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName) (*nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolCreateNodepoolResponse, error) {
 	queryParams := url.Values{}
 
 	if fn.ManagementClusterName != "" {
@@ -64,12 +76,17 @@ func (c *Client) ManageV1alpha1ClusterNodePoolResourceServiceGet(fn *nodepoolsmo
 
 	requestURL := fmt.Sprintf("%s/%s/%s/%s?%s", "v1alpha1/clusters", fn.ClusterName, "nodepools", fn.Name, queryParams.Encode())
 	clusterNodePoolResponse := &nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolCreateNodepoolResponse{}
-	err := c.Get(requestURL, clusterNodePoolResponse)
+	err := c.GetWithContext(ctx, requestURL, clusterNodePoolResponse)
 
 	return clusterNodePoolResponse, err
 }
 
 func (c *Client) ManageV1alpha1ClusterNodePoolResourceServiceDelete(fn *nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName) error {
+	return c.ManageV1alpha1ClusterNodePoolResourceServiceDeleteWithContext(c.Context(), fn)
+}
+
+// ManageV1alpha1ClusterNodePoolResourceServiceDeleteWithContext is ManageV1alpha1ClusterNodePoolResourceServiceDelete bound to the given context, cancelling the context aborts the request and its retries.
+func (c *Client) ManageV1alpha1ClusterNodePoolResourceServiceDeleteWithContext(ctx context.Context, fn *nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName) error {
 	queryParams := url.Values{}
 
 	if fn.ManagementClusterName != "" {
@@ -82,7 +99,7 @@ func (c *Client) ManageV1alpha1ClusterNodePoolResourceServiceDelete(fn *nodepool
 
 	requestURL := fmt.Sprintf("%s/%s/%s/%s?%s", "v1alpha1/clusters", fn.ClusterName, "nodepools", fn.Name, queryParams.Encode())
 
-	return c.Delete(requestURL)
+	return c.DeleteWithContext(ctx, requestURL)
 }
 
 func (c *Client) ManageV1alpha1ClusterNodePoolResourceServiceUpdate(request *nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolCreateNodepoolRequest) (*nodepoolsmodel.VmwareTanzuManageV1alpha1ClusterNodepoolCreateNodepoolResponse, error) {
@@ -0,0 +1,89 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package pagination
+
+import (
+	"net/url"
+	"strconv"
+
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+// DefaultPageSize is the number of items requested per page while listing.
+const DefaultPageSize = 100
+
+const (
+	queryParamKeyOffset            = "pagination.offset"
+	queryParamKeySize              = "pagination.size"
+	queryParamKeyIncludeTotalCount = "includeTotalCount"
+)
+
+// Page is a page of items returned by a list API, along with the total count of items when it was requested.
+type Page[T any] struct {
+	Items      []T
+	TotalCount string
+}
+
+// ListFunc fetches the page of items described by the given offset pagination options.
+type ListFunc[T any] func(options *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions) (*Page[T], error)
+
+// ListAll pages through a list API until a page is empty, the total count of items is reached or, without total
+// count, a page is short, and returns all the items.
+// A page size lower than one defaults to DefaultPageSize.
+func ListAll[T any](pageSize int, list ListFunc[T]) ([]T, error) {
+	if pageSize < 1 {
+		pageSize = DefaultPageSize
+	}
+
+	var items []T
+
+	for {
+		page, err := list(&optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions{
+			Offset: strconv.Itoa(len(items)),
+			Size:   strconv.Itoa(pageSize),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page.Items...)
+
+		// A page adding no items ends the listing, even when the total count promises more.
+		if len(page.Items) == 0 {
+			return items, nil
+		}
+
+		// The total count, when known, tells the end of the listing even if the server returns shorter pages.
+		if total, err := strconv.Atoi(page.TotalCount); err == nil {
+			if len(items) >= total {
+				return items, nil
+			}
+
+			continue
+		}
+
+		if len(page.Items) < pageSize {
+			return items, nil
+		}
+	}
+}
+
+// AddQueryParams adds the offset pagination options of a list request to its query parameters.
+func AddQueryParams(queryParams url.Values, options *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions, includeTotalCount bool) {
+	if options != nil {
+		if options.Offset != "" {
+			queryParams.Add(queryParamKeyOffset, options.Offset)
+		}
+
+		if options.Size != "" {
+			queryParams.Add(queryParamKeySize, options.Size)
+		}
+	}
+
+	if includeTotalCount {
+		queryParams.Add(queryParamKeyIncludeTotalCount, "true")
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package pagination
+
+import (
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+func TestListAll(t *testing.T) {
+	t.Parallel()
+
+	const pageSize = 10
+
+	cases := []struct {
+		name            string
+		total           int
+		reportTotal     bool
+		reportedTotal   int
+		serverPageSize  int
+		expectedOffsets []string
+	}{
+		{
+			name:            "case for no items",
+			total:           0,
+			reportTotal:     true,
+			expectedOffsets: []string{"0"},
+		},
+		{
+			name:            "case for a single partial page",
+			total:           4,
+			reportTotal:     true,
+			expectedOffsets: []string{"0"},
+		},
+		{
+			name:            "case for full pages matching the total count",
+			total:           2 * pageSize,
+			reportTotal:     true,
+			expectedOffsets: []string{"0", "10"},
+		},
+		{
+			name:            "case for full pages without total count",
+			total:           2 * pageSize,
+			reportTotal:     false,
+			expectedOffsets: []string{"0", "10", "20"},
+		},
+		{
+			name:            "case for a last partial page",
+			total:           2*pageSize + 1,
+			reportTotal:     true,
+			expectedOffsets: []string{"0", "10", "20"},
+		},
+		{
+			name:            "case for pages capped by the server below the page size",
+			total:           pageSize,
+			reportTotal:     true,
+			serverPageSize:  4,
+			expectedOffsets: []string{"0", "4", "8"},
+		},
+		{
+			name:            "case for a total count beyond the listed items",
+			total:           pageSize + 2,
+			reportTotal:     true,
+			reportedTotal:   3 * pageSize,
+			expectedOffsets: []string{"0", "10", "12"},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			var offsets []string
+
+			items, err := ListAll(pageSize, func(options *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions) (*Page[int], error) {
+				offsets = append(offsets, options.Offset)
+				require.Equal(t, strconv.Itoa(pageSize), options.Size)
+
+				offset, err := strconv.Atoi(options.Offset)
+				require.NoError(t, err)
+
+				page := &Page[int]{}
+				if test.reportTotal {
+					page.TotalCount = strconv.Itoa(test.total)
+				}
+
+				if test.reportedTotal != 0 {
+					page.TotalCount = strconv.Itoa(test.reportedTotal)
+				}
+
+				size := pageSize
+				if test.serverPageSize != 0 {
+					size = test.serverPageSize
+				}
+
+				for i := offset; i < test.total && i < offset+size; i++ {
+					page.Items = append(page.Items, i)
+				}
+
+				return page, nil
+			})
+
+			require.NoError(t, err)
+			require.Len(t, items, test.total)
+			require.Equal(t, test.expectedOffsets, offsets)
+		})
+	}
+
+	t.Run("case for a failing page", func(t *testing.T) {
+		_, err := ListAll(pageSize, func(options *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions) (*Page[int], error) {
+			return nil, errors.New("list failed")
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestAddQueryParams(t *testing.T) {
+	t.Parallel()
+
+	queryParams := url.Values{}
+	AddQueryParams(queryParams, &optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions{Offset: "100", Size: "50"}, true)
+	require.Equal(t, "includeTotalCount=true&pagination.offset=100&pagination.size=50", queryParams.Encode())
+
+	queryParams = url.Values{}
+	AddQueryParams(queryParams, nil, false)
+	require.Empty(t, queryParams)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package providermetadataclient
+
+import (
+	"net/url"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	providermetadatamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/providermetadata"
+)
+
+const (
+	eksKubernetesVersionsPath      = "v1alpha1/eksclusters:kubernetesversions"
+	aksKubernetesVersionsPath      = "v1alpha1/aksclusters:kubernetesversions"
+	credentialsPath                = "v1alpha1/account/credentials"
+	instanceTypesPath              = "instancetypes"
+	managementClustersPath         = "v1alpha1/managementclusters"
+	provisionersPath               = "provisioners"
+	tanzuKubernetesReleasesPath    = "tanzukubernetesreleases"
+	queryParamKeyCredentialName    = "credentialName" //nolint:gosec
+	queryParamKeyRegion            = "region"
+	queryParamKeySubscriptionID    = "subscriptionId"
+	queryParamKeyLocation          = "location"
+	queryParamKeyIncludeIncompat   = "includeIncompatible"
+	queryParamValueIncludeIncompat = "true"
+)
+
+// New creates a new provider metadata service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for provider metadata service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	ProviderMetadataServiceGetEksKubernetesVersions(credentialName, region string) (*providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataKubernetesVersionsResponse, error)
+
+	ProviderMetadataServiceGetAksKubernetesVersions(credentialName, subscriptionID, location string) (*providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataKubernetesVersionsResponse, error)
+
+	ProviderMetadataServiceGetAwsInstanceTypes(credentialName, region string) (*providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataInstanceTypesResponse, error)
+
+	ProviderMetadataServiceListTanzuKubernetesReleases(managementClusterName, provisionerName string) (*providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataListTanzuKubernetesReleasesResponse, error)
+}
+
+/*
+ProviderMetadataServiceGetEksKubernetesVersions gets the Kubernetes versions EKS offers in a region.
+*/
+func (c *Client) ProviderMetadataServiceGetEksKubernetesVersions(credentialName, region string) (*providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataKubernetesVersionsResponse, error) {
+	queryParams := url.Values{
+		queryParamKeyCredentialName: []string{credentialName},
+		queryParamKeyRegion:         []string{region},
+	}
+
+	requestURL := helper.ConstructRequestURL(eksKubernetesVersionsPath).AppendQueryParams(queryParams).String()
+	response := &providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataKubernetesVersionsResponse{}
+	err := c.Get(requestURL, response)
+
+	return response, err
+}
+
+/*
+ProviderMetadataServiceGetAksKubernetesVersions gets the Kubernetes versions AKS offers in a location.
+*/
+func (c *Client) ProviderMetadataServiceGetAksKubernetesVersions(credentialName, subscriptionID, location string) (*providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataKubernetesVersionsResponse, error) {
+	queryParams := url.Values{
+		queryParamKeyCredentialName: []string{credentialName},
+		queryParamKeySubscriptionID: []string{subscriptionID},
+		queryParamKeyLocation:       []string{location},
+	}
+
+	requestURL := helper.ConstructRequestURL(aksKubernetesVersionsPath).AppendQueryParams(queryParams).String()
+	response := &providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataKubernetesVersionsResponse{}
+	err := c.Get(requestURL, response)
+
+	return response, err
+}
+
+/*
+ProviderMetadataServiceGetAwsInstanceTypes gets the EC2 instance types available in a region for the AWS credential.
+*/
+func (c *Client) ProviderMetadataServiceGetAwsInstanceTypes(credentialName, region string) (*providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataInstanceTypesResponse, error) {
+	queryParams := url.Values{
+		queryParamKeyRegion: []string{region},
+	}
+
+	requestURL := helper.ConstructRequestURL(credentialsPath, credentialName, instanceTypesPath).AppendQueryParams(queryParams).String()
+	response := &providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataInstanceTypesResponse{}
+	err := c.Get(requestURL, response)
+
+	return response, err
+}
+
+/*
+ProviderMetadataServiceListTanzuKubernetesReleases lists the Tanzu Kubernetes releases of a provisioner, including the
+ones which are not compatible with the management cluster.
+*/
+func (c *Client) ProviderMetadataServiceListTanzuKubernetesReleases(managementClusterName, provisionerName string) (*providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataListTanzuKubernetesReleasesResponse, error) {
+	queryParams := url.Values{
+		queryParamKeyIncludeIncompat: []string{queryParamValueIncludeIncompat},
+	}
+
+	requestURL := helper.ConstructRequestURL(managementClustersPath, managementClusterName, provisionersPath, provisionerName, tanzuKubernetesReleasesPath).AppendQueryParams(queryParams).String()
+	response := &providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataListTanzuKubernetesReleasesResponse{}
+	err := c.Get(requestURL, response)
+
+	return response, err
+}
@@ -0,0 +1,96 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzukubernetesclusterclient
+
+import (
+	"context"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	tkcmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster"
+)
+
+const (
+	apiVersionAndGroup = "v1alpha1/managementclusters"
+	apiProvisionerPath = "provisioners"
+	apiClusterPath     = "tanzukubernetesclusters"
+)
+
+// New creates a new tanzu kubernetes cluster resource service API client.
+func New(transport *transport.Client) ClientService {
+	return &Client{Client: transport}
+}
+
+/*
+Client for tanzu kubernetes cluster resource service API.
+*/
+type Client struct {
+	*transport.Client
+}
+
+// ClientService is the interface for Client methods.
+type ClientService interface {
+	TanzuKubernetesClusterResourceServiceCreate(request *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterRequest) (*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterResponse, error)
+
+	TanzuKubernetesClusterResourceServiceDelete(fn *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterFullName) error
+
+	TanzuKubernetesClusterResourceServiceGet(fn *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterFullName) (*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterGetTanzuKubernetesClusterResponse, error)
+
+	TanzuKubernetesClusterResourceServiceGetWithContext(ctx context.Context, fn *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterFullName) (*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterGetTanzuKubernetesClusterResponse, error)
+
+	TanzuKubernetesClusterResourceServiceUpdate(request *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterRequest) (*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterResponse, error)
+}
+
+/*
+TanzuKubernetesClusterResourceServiceCreate creates a tanzu kubernetes cluster.
+*/
+func (c *Client) TanzuKubernetesClusterResourceServiceCreate(request *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterRequest) (*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterResponse, error) {
+	fn := request.TanzuKubernetesCluster.FullName
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ManagementClusterName, apiProvisionerPath, fn.ProvisionerName, apiClusterPath).String()
+	response := &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterResponse{}
+	err := c.Create(requestURL, request, response)
+
+	return response, err
+}
+
+/*
+TanzuKubernetesClusterResourceServiceDelete deletes a tanzu kubernetes cluster.
+*/
+func (c *Client) TanzuKubernetesClusterResourceServiceDelete(fn *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterFullName) error {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ManagementClusterName, apiProvisionerPath, fn.ProvisionerName, apiClusterPath, fn.Name).String()
+
+	return c.Delete(requestURL)
+}
+
+/*
+TanzuKubernetesClusterResourceServiceGet gets a tanzu kubernetes cluster.
+*/
+func (c *Client) TanzuKubernetesClusterResourceServiceGet(fn *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterFullName) (*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterGetTanzuKubernetesClusterResponse, error) {
+	return c.TanzuKubernetesClusterResourceServiceGetWithContext(c.Context(), fn)
+}
+
+/*
+TanzuKubernetesClusterResourceServiceGetWithContext is TanzuKubernetesClusterResourceServiceGet bound to the given context, cancelling the context aborts the request and its retries.
+*/
+func (c *Client) TanzuKubernetesClusterResourceServiceGetWithContext(ctx context.Context, fn *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterFullName) (*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterGetTanzuKubernetesClusterResponse, error) {
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ManagementClusterName, apiProvisionerPath, fn.ProvisionerName, apiClusterPath, fn.Name).String()
+	response := &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterGetTanzuKubernetesClusterResponse{}
+	err := c.GetWithContext(ctx, requestURL, response)
+
+	return response, err
+}
+
+/*
+TanzuKubernetesClusterResourceServiceUpdate updates a tanzu kubernetes cluster.
+*/
+func (c *Client) TanzuKubernetesClusterResourceServiceUpdate(request *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterRequest) (*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterResponse, error) {
+	fn := request.TanzuKubernetesCluster.FullName
+	requestURL := helper.ConstructRequestURL(apiVersionAndGroup, fn.ManagementClusterName, apiProvisionerPath, fn.ProvisionerName, apiClusterPath, fn.Name).String()
+	response := &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterResponse{}
+	err := c.Update(requestURL, request, response)
+
+	return response, err
+}
@@ -7,6 +7,7 @@ package transport
 
 import (
 	"bytes"
+	"context"
 	"crypto/x509"
 	"io"
 	"net"
@@ -21,16 +22,14 @@ import (
 // Client is the http client implementation.
 type Client struct {
 	*Config
-	client     *http.Client
-	timeout    time.Duration
-	interval   time.Duration
-	retryCount int
+	client      *http.Client
+	timeout     time.Duration
+	retryPolicy *RetryPolicy
+	ctx         context.Context
 }
 
 const (
-	defaultRetryCount       = 3
-	defaultHTTPTimeout      = 30 * time.Second
-	defaultIntervalDuration = 1 * time.Second
+	defaultHTTPTimeout = 30 * time.Second
 )
 
 // NewClient returns a new instance of http Client.
@@ -71,10 +70,10 @@ func NewClientWithDefaultTransport() *Client {
 
 func newHTTPClient(transport *http.Transport) *Client {
 	client := Client{
-		Config:     DefaultTransportConfig(),
-		timeout:    defaultHTTPTimeout,
-		retryCount: defaultRetryCount,
-		interval:   defaultIntervalDuration,
+		Config:      DefaultTransportConfig(),
+		timeout:     defaultHTTPTimeout,
+		retryPolicy: DefaultRetryPolicy(),
+		ctx:         context.Background(),
 		client: &http.Client{
 			Timeout: defaultHTTPTimeout,
 		},
@@ -87,11 +86,63 @@ func newHTTPClient(transport *http.Transport) *Client {
 	return &client
 }
 
+// WithRetryPolicy overrides the default retry policy. Zero backoff durations and nil functions of the given policy
+// keep their default values, a negative MaxRetries disables the retries.
+func (c *Client) WithRetryPolicy(policy *RetryPolicy) *Client {
+	retryPolicy := DefaultRetryPolicy()
+
+	if policy != nil {
+		retryPolicy.MaxRetries = policy.MaxRetries
+		if retryPolicy.MaxRetries < 0 {
+			retryPolicy.MaxRetries = 0
+		}
+
+		if policy.MinBackoff > 0 {
+			retryPolicy.MinBackoff = policy.MinBackoff
+		}
+
+		if policy.MaxBackoff > 0 {
+			retryPolicy.MaxBackoff = policy.MaxBackoff
+		}
+
+		if policy.CheckRetry != nil {
+			retryPolicy.CheckRetry = policy.CheckRetry
+		}
+
+		if policy.Backoff != nil {
+			retryPolicy.Backoff = policy.Backoff
+		}
+	}
+
+	if retryPolicy.MaxBackoff < retryPolicy.MinBackoff {
+		retryPolicy.MaxBackoff = retryPolicy.MinBackoff
+	}
+
+	c.retryPolicy = retryPolicy
+
+	return c
+}
+
+// WithContext sets the context used for requests which are not given one explicitly,
+// cancelling it aborts all the in-flight requests of the client.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	if ctx != nil {
+		c.ctx = ctx
+	}
+
+	return c
+}
+
+// Context returns the context used for requests which are not given one explicitly.
+func (c *Client) Context() context.Context {
+	return c.ctx
+}
+
 // Get makes a HTTP GET request to provided URL.
-func (c *Client) get(url string, headers http.Header) (*http.Response, error) {
+func (c *Client) get(ctx context.Context, url string, headers http.Header) (*http.Response, error) {
 	var response *http.Response
 
-	request, err := http.NewRequest(http.MethodGet, url, nil)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return response, errors.Wrap(err, "GET - request creation failed")
 	}
@@ -102,10 +153,10 @@ func (c *Client) get(url string, headers http.Header) (*http.Response, error) {
 }
 
 // Post makes a HTTP POST request to provided URL and requestBody.
-func (c *Client) post(url string, body io.Reader, headers http.Header) (*http.Response, error) {
+func (c *Client) post(ctx context.Context, url string, body io.Reader, headers http.Header) (*http.Response, error) {
 	var response *http.Response
 
-	request, err := http.NewRequest(http.MethodPost, url, body)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
 	if err != nil {
 		return response, errors.Wrap(err, "POST - request creation failed")
 	}
@@ -116,10 +167,10 @@ func (c *Client) post(url string, body io.Reader, headers http.Header) (*http.Re
 }
 
 // Put makes a HTTP PUT request to provided URL and requestBody.
-func (c *Client) put(url string, body io.Reader, headers http.Header) (*http.Response, error) {
+func (c *Client) put(ctx context.Context, url string, body io.Reader, headers http.Header) (*http.Response, error) {
 	var response *http.Response
 
-	request, err := http.NewRequest(http.MethodPut, url, body)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, url, body)
 	if err != nil {
 		return response, errors.Wrap(err, "PUT - request creation failed")
 	}
@@ -131,10 +182,10 @@ func (c *Client) put(url string, body io.Reader, headers http.Header) (*http.Res
 
 // Patch makes a HTTP PATCH request to provided URL and requestBody.
 // nolint: unused
-func (c *Client) patch(url string, body io.Reader, headers http.Header) (*http.Response, error) {
+func (c *Client) patch(ctx context.Context, url string, body io.Reader, headers http.Header) (*http.Response, error) {
 	var response *http.Response
 
-	request, err := http.NewRequest(http.MethodPatch, url, body)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPatch, url, body)
 	if err != nil {
 		return response, errors.Wrap(err, "PATCH - request creation failed")
 	}
@@ -145,10 +196,10 @@ func (c *Client) patch(url string, body io.Reader, headers http.Header) (*http.R
 }
 
 // Delete makes a HTTP DELETE request with provided URL.
-func (c *Client) delete(url string, headers http.Header) (*http.Response, error) {
+func (c *Client) delete(ctx context.Context, url string, headers http.Header) (*http.Response, error) {
 	var response *http.Response
 
-	request, err := http.NewRequest(http.MethodDelete, url, nil)
+	request, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return response, errors.Wrap(err, "DELETE - request creation failed")
 	}
@@ -158,7 +209,8 @@ func (c *Client) delete(url string, headers http.Header) (*http.Response, error)
 	return c.Do(request)
 }
 
-// Do makes an HTTP request with the native `http.Do` interface.
+// Do makes an HTTP request with the native `http.Do` interface, retrying it according to the retry policy of the client.
+// Retries stop as soon as the context of the request is done.
 func (c *Client) Do(request *http.Request) (*http.Response, error) {
 	request.Close = true
 
@@ -177,13 +229,11 @@ func (c *Client) Do(request *http.Request) (*http.Response, error) {
 	var (
 		err      error
 		response *http.Response
+		ctx      = request.Context()
+		policy   = c.retryPolicy
 	)
 
-	for i := 0; i <= c.retryCount; i++ {
-		if response != nil {
-			response.Body.Close()
-		}
-
+	for attempt := 0; ; attempt++ {
 		response, err = c.client.Do(request)
 
 		if bodyReader != nil {
@@ -192,20 +242,42 @@ func (c *Client) Do(request *http.Request) (*http.Response, error) {
 			_, _ = bodyReader.Seek(0, 0)
 		}
 
-		if err != nil {
-			time.Sleep(c.interval)
-			continue
+		retry, checkErr := policy.CheckRetry(ctx, response, err)
+		if checkErr != nil {
+			err = checkErr
+		}
+
+		if !retry || attempt >= policy.MaxRetries {
+			break
+		}
+
+		backoff := policy.Backoff(policy.MinBackoff, policy.MaxBackoff, attempt, response)
+		if backoff > policy.MaxBackoff {
+			backoff = policy.MaxBackoff
 		}
 
-		if response.StatusCode >= http.StatusInternalServerError {
-			time.Sleep(c.interval)
-			continue
+		if response != nil {
+			drainBody(response)
 		}
 
-		err = nil // Clear errors if any iteration succeeds
+		if waitErr := sleepWithContext(ctx, backoff); waitErr != nil {
+			return nil, errors.Wrap(waitErr, "request cancelled while waiting to retry")
+		}
+	}
+
+	if err != nil {
+		if response != nil {
+			drainBody(response)
+		}
 
-		break
+		return nil, err
 	}
 
-	return response, err
+	return response, nil
+}
+
+// drainBody reads and closes the response body so that the underlying connection can be reused.
+func drainBody(response *http.Response) {
+	_, _ = io.Copy(io.Discard, response.Body)
+	_ = response.Body.Close()
 }
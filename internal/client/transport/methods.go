@@ -7,6 +7,7 @@ package transport
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -26,18 +27,33 @@ type Response interface {
 }
 
 func (c *Client) Create(url string, request Request, response Response) error {
-	return c.invokeAction(http.MethodPost, url, request, response)
+	return c.invokeAction(c.ctx, http.MethodPost, url, request, response)
 }
 
 func (c *Client) Update(url string, request Request, response Response) error {
-	return c.invokeAction(http.MethodPut, url, request, response)
+	return c.invokeAction(c.ctx, http.MethodPut, url, request, response)
 }
 
 func (c *Client) Patch(url string, request Request, response Response) error {
-	return c.invokeAction(http.MethodPatch, url, request, response)
+	return c.invokeAction(c.ctx, http.MethodPatch, url, request, response)
 }
 
-func (c *Client) invokeAction(httpMethodType string, url string, request Request, response Response) error {
+// CreateWithContext is Create bound to the given context, cancelling the context aborts the request and its retries.
+func (c *Client) CreateWithContext(ctx context.Context, url string, request Request, response Response) error {
+	return c.invokeAction(ctx, http.MethodPost, url, request, response)
+}
+
+// UpdateWithContext is Update bound to the given context, cancelling the context aborts the request and its retries.
+func (c *Client) UpdateWithContext(ctx context.Context, url string, request Request, response Response) error {
+	return c.invokeAction(ctx, http.MethodPut, url, request, response)
+}
+
+// PatchWithContext is Patch bound to the given context, cancelling the context aborts the request and its retries.
+func (c *Client) PatchWithContext(ctx context.Context, url string, request Request, response Response) error {
+	return c.invokeAction(ctx, http.MethodPatch, url, request, response)
+}
+
+func (c *Client) invokeAction(ctx context.Context, httpMethodType string, url string, request Request, response Response) error {
 	requestURL := fmt.Sprintf("%s/%s", c.Host, strings.TrimPrefix(url, "/"))
 	body, err := request.MarshalBinary()
 
@@ -63,17 +79,17 @@ func (c *Client) invokeAction(httpMethodType string, url string, request Request
 	// nolint:bodyclose // response is being closed outside the switch block
 	switch httpMethodType {
 	case http.MethodPost:
-		resp, err = c.post(requestURL, bytes.NewReader(body), headers)
+		resp, err = c.post(ctx, requestURL, bytes.NewReader(body), headers)
 		if err != nil {
 			return errors.Wrap(err, "create")
 		}
 	case http.MethodPut:
-		resp, err = c.put(requestURL, bytes.NewReader(body), headers)
+		resp, err = c.put(ctx, requestURL, bytes.NewReader(body), headers)
 		if err != nil {
 			return errors.Wrap(err, "update")
 		}
 	case http.MethodPatch:
-		resp, err = c.patch(requestURL, bytes.NewReader(body), headers)
+		resp, err = c.patch(ctx, requestURL, bytes.NewReader(body), headers)
 		if err != nil {
 			return errors.Wrap(err, "patch")
 		}
@@ -91,7 +107,7 @@ func (c *Client) invokeAction(httpMethodType string, url string, request Request
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return errors.Errorf("%s request failed with status : %v, response: %v", httpMethodType, resp.Status, string(respBody))
+		return clienterrors.NewAPIError(httpMethodType, url, resp, respBody)
 	}
 
 	err = response.UnmarshalBinary(respBody)
@@ -103,9 +119,14 @@ func (c *Client) invokeAction(httpMethodType string, url string, request Request
 }
 
 func (c *Client) Delete(url string) error {
+	return c.DeleteWithContext(c.ctx, url)
+}
+
+// DeleteWithContext is Delete bound to the given context, cancelling the context aborts the request and its retries.
+func (c *Client) DeleteWithContext(ctx context.Context, url string) error {
 	requestURL := fmt.Sprintf("%s/%s", c.Host, strings.TrimPrefix(url, "/"))
 
-	resp, err := c.delete(requestURL, c.Headers)
+	resp, err := c.delete(ctx, requestURL, c.Headers)
 	if err != nil {
 		return errors.Wrap(err, "delete")
 	}
@@ -117,16 +138,21 @@ func (c *Client) Delete(url string) error {
 	respBody, _ := io.ReadAll(resp.Body)
 
 	if resp.StatusCode != http.StatusOK {
-		return clienterrors.ErrorWithHTTPCode(resp.StatusCode, errors.Errorf("delete request(%s) failed with status : %v, response: %v", url, resp.Status, string(respBody)))
+		return clienterrors.NewAPIError(http.MethodDelete, url, resp, respBody)
 	}
 
 	return nil
 }
 
 func (c *Client) Get(url string, response Response) error {
+	return c.GetWithContext(c.ctx, url, response)
+}
+
+// GetWithContext is Get bound to the given context, cancelling the context aborts the request and its retries.
+func (c *Client) GetWithContext(ctx context.Context, url string, response Response) error {
 	requestURL := fmt.Sprintf("%s/%s", c.Host, strings.TrimPrefix(url, "/"))
 
-	resp, err := c.get(requestURL, c.Headers)
+	resp, err := c.get(ctx, requestURL, c.Headers)
 	if err != nil {
 		return errors.Wrap(err, "get request")
 	}
@@ -141,7 +167,7 @@ func (c *Client) Get(url string, response Response) error {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return clienterrors.ErrorWithHTTPCode(resp.StatusCode, errors.Errorf("get request(%s) failed with status : %v, response: %v", url, resp.Status, string(respBody)))
+		return clienterrors.NewAPIError(http.MethodGet, url, resp, respBody)
 	}
 
 	err = response.UnmarshalBinary(respBody)
@@ -6,6 +6,7 @@ SPDX-License-Identifier: MPL-2.0
 package transport
 
 import (
+	"context"
 	"sync"
 	"testing"
 
@@ -58,7 +59,7 @@ func TestConcurrentAccessOfInvokeAction(t *testing.T) {
 		go func() {
 			defer waitGroup.Done()
 
-			actual := c.invokeAction(input.HTTPMethodType, input.URL, input.Request, input.Response)
+			actual := c.invokeAction(context.Background(), input.HTTPMethodType, input.URL, input.Request, input.Response)
 			require.Error(t, actual)
 		}()
 	}
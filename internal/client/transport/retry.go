@@ -0,0 +1,154 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package transport
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the default number of times a request is retried after the initial attempt.
+	DefaultMaxRetries = 3
+	// DefaultMinBackoff is the default wait before the first retry.
+	DefaultMinBackoff = 1 * time.Second
+	// DefaultMaxBackoff is the default upper bound of the wait between two attempts.
+	DefaultMaxBackoff = 30 * time.Second
+
+	retryAfterHeader = "Retry-After"
+)
+
+// CheckRetry decides whether a request is retried given the response or the error of the last attempt.
+// A returned error aborts the retries and is returned to the caller.
+type CheckRetry func(ctx context.Context, response *http.Response, err error) (bool, error)
+
+// Backoff returns the wait before the next attempt, attempt being zero for the first retry.
+type Backoff func(minBackoff, maxBackoff time.Duration, attempt int, response *http.Response) time.Duration
+
+// RetryPolicy configures how failed requests are retried by the client.
+type RetryPolicy struct {
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	CheckRetry CheckRetry
+	Backoff    Backoff
+}
+
+// DefaultRetryPolicy returns a policy retrying connection errors, rate limited and server side failures
+// with exponential backoff and jitter.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries: DefaultMaxRetries,
+		MinBackoff: DefaultMinBackoff,
+		MaxBackoff: DefaultMaxBackoff,
+		CheckRetry: DefaultCheckRetry,
+		Backoff:    ExponentialJitterBackoff,
+	}
+}
+
+// DefaultCheckRetry retries transport errors, 429 Too Many Requests and 5xx responses other than 501 Not Implemented.
+// Requests are never retried once their context is done.
+func DefaultCheckRetry(ctx context.Context, response *http.Response, err error) (bool, error) {
+	if err == nil && !isRetryableStatus(response.StatusCode) {
+		return false, nil
+	}
+
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	return true, nil
+}
+
+func isRetryableStatus(statusCode int) bool {
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		return true
+	case statusCode == http.StatusNotImplemented:
+		return false
+	default:
+		return statusCode >= http.StatusInternalServerError
+	}
+}
+
+// ExponentialJitterBackoff doubles the wait on every attempt, starting from minBackoff and capped at maxBackoff,
+// and picks a random wait in the upper half of that interval so that parallel requests do not retry in lockstep.
+// A Retry-After header sent with a 429 or 503 response takes precedence over the computed wait, up to maxBackoff.
+func ExponentialJitterBackoff(minBackoff, maxBackoff time.Duration, attempt int, response *http.Response) time.Duration {
+	if wait, ok := retryAfter(response); ok {
+		if wait > maxBackoff {
+			return maxBackoff
+		}
+
+		return wait
+	}
+
+	wait := float64(minBackoff) * math.Pow(2, float64(attempt))
+	if wait <= 0 || wait > float64(maxBackoff) {
+		wait = float64(maxBackoff)
+	}
+
+	half := int64(wait / 2)
+	if half <= 0 {
+		return time.Duration(wait)
+	}
+
+	// nolint: gosec // jitter does not need a cryptographically secure source.
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// retryAfter parses the Retry-After header of a rate limited or unavailable response,
+// given either as a number of seconds or as an HTTP date.
+func retryAfter(response *http.Response) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+
+	if response.StatusCode != http.StatusTooManyRequests && response.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	value := response.Header.Get(retryAfterHeader)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// sleepWithContext blocks for the given duration or until the context is done, whichever comes first.
+func sleepWithContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
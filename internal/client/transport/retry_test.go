@@ -0,0 +1,215 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	workspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/workspace"
+)
+
+func TestExponentialJitterBackoff(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		attempt  int
+		response *http.Response
+		min      time.Duration
+		max      time.Duration
+	}{
+		{
+			name:    "case for the first retry",
+			attempt: 0,
+			min:     500 * time.Millisecond,
+			max:     time.Second,
+		},
+		{
+			name:    "case for the third retry",
+			attempt: 2,
+			min:     2 * time.Second,
+			max:     4 * time.Second,
+		},
+		{
+			name:    "case for a retry beyond the maximum backoff",
+			attempt: 10,
+			min:     5 * time.Second,
+			max:     10 * time.Second,
+		},
+		{
+			name:     "case for a rate limited response with Retry-After in seconds",
+			attempt:  0,
+			response: &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{retryAfterHeader: {"7"}}},
+			min:      7 * time.Second,
+			max:      7 * time.Second,
+		},
+		{
+			name:     "case for an unavailable response with Retry-After as an HTTP date",
+			attempt:  0,
+			response: &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{retryAfterHeader: {time.Now().Add(8 * time.Second).UTC().Format(http.TimeFormat)}}},
+			min:      6 * time.Second,
+			max:      8 * time.Second,
+		},
+		{
+			name:     "case for Retry-After beyond the maximum backoff",
+			attempt:  0,
+			response: &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{retryAfterHeader: {"120"}}},
+			min:      10 * time.Second,
+			max:      10 * time.Second,
+		},
+		{
+			name:     "case for Retry-After sent with a server error",
+			attempt:  0,
+			response: &http.Response{StatusCode: http.StatusInternalServerError, Header: http.Header{retryAfterHeader: {"7"}}},
+			min:      500 * time.Millisecond,
+			max:      time.Second,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			actual := ExponentialJitterBackoff(time.Second, 10*time.Second, test.attempt, test.response)
+			require.GreaterOrEqual(t, actual, test.min)
+			require.LessOrEqual(t, actual, test.max)
+		})
+	}
+}
+
+func TestDoRetries(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name             string
+		statusCodes      []int
+		maxRetries       int
+		expectedStatus   int
+		expectedAttempts int32
+	}{
+		{
+			name:             "case for a successful request",
+			statusCodes:      []int{http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 1,
+		},
+		{
+			name:             "case for rate limited and unavailable responses",
+			statusCodes:      []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "case for retries exhausted",
+			statusCodes:      []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			maxRetries:       2,
+			expectedStatus:   http.StatusBadGateway,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "case for a non retryable response",
+			statusCodes:      []int{http.StatusConflict, http.StatusOK},
+			maxRetries:       3,
+			expectedStatus:   http.StatusConflict,
+			expectedAttempts: 1,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := atomic.AddInt32(&attempts, 1)
+				w.WriteHeader(test.statusCodes[attempt-1])
+			}))
+			defer server.Close()
+
+			c := NewClientWithDefaultTransport().WithRetryPolicy(&RetryPolicy{
+				MaxRetries: test.maxRetries,
+				MinBackoff: time.Millisecond,
+				MaxBackoff: 5 * time.Millisecond,
+			})
+
+			request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+			require.NoError(t, err)
+
+			response, err := c.Do(request)
+			require.NoError(t, err)
+
+			defer response.Body.Close()
+
+			require.Equal(t, test.expectedStatus, response.StatusCode)
+			require.Equal(t, test.expectedAttempts, atomic.LoadInt32(&attempts))
+		})
+	}
+}
+
+func TestDoStopsWhenContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set(retryAfterHeader, "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	c := NewClientWithDefaultTransport()
+
+	start := time.Now()
+
+	// nolint: bodyclose // no response is returned once the context is done.
+	_, err := c.get(ctx, server.URL, http.Header{})
+	require.Error(t, err)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 10*time.Second)
+	require.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+}
+
+func TestGetWithContextStopsWhenContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	var attempts int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.Header().Set(retryAfterHeader, "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// The context of the client is never done, only the context of the request is.
+	c := NewClientWithDefaultTransport().WithContext(context.Background())
+	c.Host = server.URL
+
+	start := time.Now()
+
+	err := c.GetWithContext(ctx, "v1alpha1/workspaces/test", &workspacemodel.VmwareTanzuManageV1alphaWorkspaceResponse{})
+	require.Error(t, err)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), 10*time.Second)
+	require.Equal(t, int32(1), atomic.LoadInt32(&attempts))
+}
@@ -7,8 +7,11 @@ package workspaceclient
 
 import (
 	"fmt"
+	"net/url"
 
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/pagination"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/transport"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	workspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/workspace"
 )
 
@@ -33,6 +36,8 @@ type ClientService interface {
 	ManageV1alpha1WorkspaceResourceServiceGet(fn *workspacemodel.VmwareTanzuManageV1alpha1WorkspaceFullName) (*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceGetWorkspaceResponse, error)
 
 	ManageV1alpha1WorkspaceResourceServiceUpdate(fn *workspacemodel.VmwareTanzuManageV1alpha1WorkspaceRequest) (*workspacemodel.VmwareTanzuManageV1alphaWorkspaceResponse, error)
+
+	ManageV1alpha1WorkspaceResourceServiceList(request *workspacemodel.VmwareTanzuManageV1alpha1WorkspaceListWorkspacesRequestParameters) (*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceListWorkspacesResponse, error)
 }
 
 // ManageV1alpha1WorkspaceResourceServiceUpdate updates a workspace.
@@ -76,3 +81,30 @@ func (c *Client) ManageV1alpha1WorkspaceResourceServiceDelete(
 
 	return c.Delete(requestURL)
 }
+
+// ManageV1alpha1WorkspaceResourceServiceList lists workspaces.
+func (c *Client) ManageV1alpha1WorkspaceResourceServiceList(
+	request *workspacemodel.VmwareTanzuManageV1alpha1WorkspaceListWorkspacesRequestParameters,
+) (*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceListWorkspacesResponse, error) {
+	queryParams := url.Values{}
+
+	if request.SearchScope != nil && request.SearchScope.Name != "" {
+		queryParams.Add("searchScope.name", request.SearchScope.Name)
+	}
+
+	if request.Query != "" {
+		queryParams.Add("query", request.Query)
+	}
+
+	if request.SortBy != "" {
+		queryParams.Add("sortBy", request.SortBy)
+	}
+
+	pagination.AddQueryParams(queryParams, request.Pagination, request.IncludeTotalCount)
+
+	requestURL := helper.ConstructRequestURL("v1alpha1/workspaces").AppendQueryParams(queryParams).String()
+	workspaceListResponse := &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceListWorkspacesResponse{}
+	err := c.Get(requestURL, workspaceListResponse)
+
+	return workspaceListResponse, err
+}
@@ -9,6 +9,9 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -166,3 +169,48 @@ func UpdateDataSourceSchema(d *schema.Schema) *schema.Schema {
 
 	return dv
 }
+
+// GetStringMap converts a map attribute read from the resource data into a map of strings.
+func GetStringMap(data interface{}) map[string]string {
+	out := map[string]string{}
+
+	m, ok := data.(map[string]interface{})
+	if !ok {
+		return out
+	}
+
+	for key, value := range m {
+		out[key], _ = value.(string)
+	}
+
+	return out
+}
+
+// MatchLabels reports whether labels contain all the key-value pairs of the selector, an empty selector matching everything.
+func MatchLabels(labels map[string]string, selector map[string]string) bool {
+	for key, value := range selector {
+		if actual, ok := labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// LabelSelectorString renders a label selector as sorted key=value pairs separated by commas.
+func LabelSelectorString(selector map[string]string) string {
+	pairs := make([]string, 0, len(selector))
+
+	for key, value := range selector {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, value))
+	}
+
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+// ConstructListDataSourceID derives a stable ID for a list data source from the values of its filters.
+func ConstructListDataSourceID(filters ...string) string {
+	return strconv.Itoa(schema.HashString(strings.Join(filters, ":")))
+}
@@ -6,6 +6,7 @@ SPDX-License-Identifier: MPL-2.0
 package helper
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -85,6 +86,17 @@ func TestSetPrimitiveValueFloat64toFloat32(t *testing.T) {
 	require.Equal(t, f32, float32(0))
 }
 
+func TestMatchLabels(t *testing.T) {
+	labels := map[string]string{"team": "payments", "env": "prod"}
+
+	require.True(t, MatchLabels(labels, nil))
+	require.True(t, MatchLabels(labels, map[string]string{"team": "payments"}))
+	require.True(t, MatchLabels(labels, map[string]string{"team": "payments", "env": "prod"}))
+	require.False(t, MatchLabels(labels, map[string]string{"team": "billing"}))
+	require.False(t, MatchLabels(labels, map[string]string{"owner": ""}))
+	require.False(t, MatchLabels(nil, map[string]string{"team": "payments"}))
+}
+
 func TestRetryUntilTimeout(t *testing.T) {
 	testFun := func() (bool, error) {
 		return true, nil
@@ -95,3 +107,45 @@ func TestRetryUntilTimeout(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, 2, retries)
 }
+
+func TestRetryUntilTimeoutWithContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("stops when the function succeeds", func(t *testing.T) {
+		attempts := 0
+		testFun := func() (bool, error) {
+			attempts++
+			return attempts < 3, nil
+		}
+
+		retries, err := RetryUntilTimeoutWithContext(context.Background(), testFun, time.Millisecond, time.Minute)
+
+		require.NoError(t, err)
+		require.Equal(t, 3, retries)
+	})
+
+	t.Run("runs at least once for a zero timeout", func(t *testing.T) {
+		testFun := func() (bool, error) {
+			return true, nil
+		}
+
+		retries, err := RetryUntilTimeoutWithContext(context.Background(), testFun, time.Millisecond, 0)
+
+		require.NoError(t, err)
+		require.Equal(t, 1, retries)
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		testFun := func() (bool, error) {
+			return true, context.Canceled
+		}
+
+		retries, err := RetryUntilTimeoutWithContext(ctx, testFun, time.Minute, time.Hour)
+
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, 1, retries)
+	})
+}
@@ -0,0 +1,90 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+)
+
+// ImportIDSeparator separates the components of a resource import ID.
+const ImportIDSeparator = "/"
+
+// ImportIDFormat renders the expected import ID format for the given component keys, e.g. <management_cluster_name>/<provisioner_name>/<name>.
+func ImportIDFormat(keys ...string) string {
+	return fmt.Sprintf("<%s>", strings.Join(keys, fmt.Sprintf(">%s<", ImportIDSeparator)))
+}
+
+// ParseImportID splits an import ID into its components, expecting exactly one non-empty component per key.
+func ParseImportID(id string, keys ...string) ([]string, error) {
+	parts := strings.Split(id, ImportIDSeparator)
+
+	if len(parts) != len(keys) {
+		return nil, errors.Errorf("invalid import ID %q: expected format %s", id, ImportIDFormat(keys...))
+	}
+
+	for i, part := range parts {
+		if part == "" {
+			return nil, errors.Errorf("invalid import ID %q: %s must not be empty", id, keys[i])
+		}
+	}
+
+	return parts, nil
+}
+
+// ParseScopedImportID splits an import ID whose first component is the scope, e.g. cluster_group/my-group/my-policy.
+// The components following the scope are validated against the keys registered for that scope in formats.
+func ParseScopedImportID(id string, formats map[string][]string) (scope string, parts []string, err error) {
+	scope, rest, _ := strings.Cut(id, ImportIDSeparator)
+
+	keys, ok := formats[scope]
+	if !ok {
+		allowed := make([]string, 0, len(formats))
+
+		for s, k := range formats {
+			allowed = append(allowed, fmt.Sprintf("%s%s%s", s, ImportIDSeparator, ImportIDFormat(k...)))
+		}
+
+		sort.Strings(allowed)
+
+		return "", nil, errors.Errorf("invalid import ID %q: expected one of the formats %s", id, strings.Join(allowed, ", "))
+	}
+
+	parts, err = ParseImportID(rest, keys...)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "invalid import ID %q for scope %s", id, scope)
+	}
+
+	return scope, parts, nil
+}
+
+// ReadImportedState populates an imported resource using its read function, failing when the read errors or the
+// resource is no longer present in Tanzu Mission Control.
+func ReadImportedState(ctx context.Context, d *schema.ResourceData, m interface{}, read schema.ReadContextFunc) ([]*schema.ResourceData, error) {
+	id := d.Id()
+
+	diags := read(ctx, d, m)
+	if diags.HasError() {
+		summaries := make([]string, 0, len(diags))
+
+		for _, diagnostic := range diags {
+			summaries = append(summaries, diagnostic.Summary)
+		}
+
+		return nil, errors.Errorf("unable to import %s: %s", id, strings.Join(summaries, "; "))
+	}
+
+	if d.Id() == "" {
+		return nil, errors.Errorf("unable to import %s: resource not found in Tanzu Mission Control", id)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
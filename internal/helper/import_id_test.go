@@ -0,0 +1,124 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImportID(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		id          string
+		keys        []string
+		expected    []string
+		expectError bool
+	}{
+		{
+			name:     "case for a single component",
+			id:       "ws1",
+			keys:     []string{"name"},
+			expected: []string{"ws1"},
+		},
+		{
+			name:     "case for multiple components",
+			id:       "mgmt/prov/cluster/ns",
+			keys:     []string{"management_cluster_name", "provisioner_name", "cluster_name", "name"},
+			expected: []string{"mgmt", "prov", "cluster", "ns"},
+		},
+		{
+			name:        "case for too few components",
+			id:          "mgmt/prov",
+			keys:        []string{"management_cluster_name", "provisioner_name", "name"},
+			expectError: true,
+		},
+		{
+			name:        "case for too many components",
+			id:          "mgmt/prov/cluster/ns",
+			keys:        []string{"management_cluster_name", "provisioner_name", "name"},
+			expectError: true,
+		},
+		{
+			name:        "case for an empty component",
+			id:          "mgmt//cluster",
+			keys:        []string{"management_cluster_name", "provisioner_name", "name"},
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := ParseImportID(test.id, test.keys...)
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseScopedImportID(t *testing.T) {
+	t.Parallel()
+
+	formats := map[string][]string{
+		"cluster":       {"management_cluster_name", "provisioner_name", "name", "policy"},
+		"cluster_group": {"cluster_group", "policy"},
+	}
+
+	cases := []struct {
+		name          string
+		id            string
+		expectedScope string
+		expected      []string
+		expectError   bool
+	}{
+		{
+			name:          "case for cluster scope",
+			id:            "cluster/attached/attached/cluster1/policy1",
+			expectedScope: "cluster",
+			expected:      []string{"attached", "attached", "cluster1", "policy1"},
+		},
+		{
+			name:          "case for cluster group scope",
+			id:            "cluster_group/cg1/policy1",
+			expectedScope: "cluster_group",
+			expected:      []string{"cg1", "policy1"},
+		},
+		{
+			name:        "case for unknown scope",
+			id:          "workspace/ws1/policy1",
+			expectError: true,
+		},
+		{
+			name:        "case for missing components",
+			id:          "cluster_group/policy1",
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			scope, actual, err := ParseScopedImportID(test.id, formats)
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expectedScope, scope)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
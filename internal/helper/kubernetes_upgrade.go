@@ -0,0 +1,96 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helper
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/pkg/errors"
+)
+
+const (
+	// UpgradeModeInPlace sends the changed Kubernetes version with the cluster update and waits for the cluster.
+	UpgradeModeInPlace = "IN_PLACE"
+	// UpgradeModeOrchestrated runs pre-flight checks, then upgrades the control plane and each nodepool in sequence.
+	UpgradeModeOrchestrated = "ORCHESTRATED"
+)
+
+// UpgradeModes lists the accepted values of the upgrade_mode attribute of cluster resources.
+var UpgradeModes = []string{UpgradeModeInPlace, UpgradeModeOrchestrated}
+
+// ValidateKubernetesUpgrade is the pre-flight check of an orchestrated upgrade from current to target. The target must
+// be one of the available upgrade versions reported by Tanzu Mission Control and must not skip a minor version.
+func ValidateKubernetesUpgrade(current, target string, available []string) error {
+	from, err := parseKubernetesVersion(current)
+	if err != nil {
+		return err
+	}
+
+	to, err := parseKubernetesVersion(target)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case to.major != from.major:
+		return errors.Errorf("upgrade from %s to %s changes the major version, which is not supported", current, target)
+	case to.minor < from.minor:
+		return errors.Errorf("upgrade from %s to %s is a downgrade, which is not supported", current, target)
+	case to.minor > from.minor+1:
+		return errors.Errorf("upgrade from %s to %s skips more than one minor version, upgrade to %d.%d first", current, target, from.major, from.minor+1)
+	}
+
+	for _, v := range available {
+		if v == target {
+			return nil
+		}
+	}
+
+	if len(available) == 0 {
+		return errors.Errorf("no upgrade versions are available for Kubernetes version %s", current)
+	}
+
+	return errors.Errorf("Kubernetes version %s is not an available upgrade version of %s, available versions: %s", target, current, strings.Join(available, ", "))
+}
+
+// UpgradeProgress records the steps of an orchestrated upgrade as warning diagnostics, so that they are reported back
+// to the user once the apply finishes.
+type UpgradeProgress struct {
+	Diagnostics diag.Diagnostics
+	resource    string
+	total       int
+	done        int
+}
+
+// NewUpgradeProgress creates the progress report of an upgrade of the given resource consisting of total steps.
+func NewUpgradeProgress(resource string, total int) *UpgradeProgress {
+	return &UpgradeProgress{resource: resource, total: total}
+}
+
+// Step records that the next step of the upgrade has completed.
+func (p *UpgradeProgress) Step(format string, args ...interface{}) {
+	p.done++
+
+	summary := fmt.Sprintf("Upgrade of %s, step %d/%d: %s", p.resource, p.done, p.total, fmt.Sprintf(format, args...))
+	log.Printf("[INFO] %s", summary)
+
+	p.Diagnostics = append(p.Diagnostics, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  summary,
+	})
+}
+
+// Fail appends the error of the step that was in progress to the recorded steps.
+func (p *UpgradeProgress) Fail(err error) diag.Diagnostics {
+	return append(p.Diagnostics, diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Upgrade of %s failed at step %d/%d", p.resource, p.done+1, p.total),
+		Detail:   err.Error(),
+	})
+}
@@ -0,0 +1,113 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateKubernetesUpgrade(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		current     string
+		target      string
+		available   []string
+		expectError bool
+	}{
+		{
+			name:      "case for a patch upgrade",
+			current:   "1.27.3",
+			target:    "1.27.7",
+			available: []string{"1.27.7", "1.28.3"},
+		},
+		{
+			name:      "case for a minor upgrade",
+			current:   "1.27",
+			target:    "1.28",
+			available: []string{"1.28"},
+		},
+		{
+			name:      "case for a version with a prefix and build metadata",
+			current:   "v1.26.5+vmware.2",
+			target:    "v1.27.5+vmware.1",
+			available: []string{"v1.27.5+vmware.1"},
+		},
+		{
+			name:        "case for an upgrade skipping a minor version",
+			current:     "1.26.0",
+			target:      "1.28.0",
+			available:   []string{"1.27.3", "1.28.0"},
+			expectError: true,
+		},
+		{
+			name:        "case for a downgrade",
+			current:     "1.27.3",
+			target:      "1.26.0",
+			available:   []string{"1.26.0"},
+			expectError: true,
+		},
+		{
+			name:        "case for a major upgrade",
+			current:     "1.27.3",
+			target:      "2.0.0",
+			available:   []string{"2.0.0"},
+			expectError: true,
+		},
+		{
+			name:        "case for a version which is not available",
+			current:     "1.27.3",
+			target:      "1.28.0",
+			available:   []string{"1.28.3"},
+			expectError: true,
+		},
+		{
+			name:        "case for no available versions",
+			current:     "1.27.3",
+			target:      "1.28.0",
+			expectError: true,
+		},
+		{
+			name:        "case for an invalid version",
+			current:     "latest",
+			target:      "1.28.0",
+			available:   []string{"1.28.0"},
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateKubernetesUpgrade(test.current, test.target, test.available)
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestUpgradeProgress(t *testing.T) {
+	progress := NewUpgradeProgress("EKS cluster test", 3)
+	progress.Step("pre-flight checks passed")
+	progress.Step("control plane upgraded to %s", "1.28")
+
+	require.Len(t, progress.Diagnostics, 2)
+	require.False(t, progress.Diagnostics.HasError())
+	require.Equal(t, "Upgrade of EKS cluster test, step 2/3: control plane upgraded to 1.28", progress.Diagnostics[1].Summary)
+
+	diags := progress.Fail(errors.New("nodepool np-1 in error state"))
+
+	require.True(t, diags.HasError())
+	require.Equal(t, "Upgrade of EKS cluster test failed at step 3/3", diags[2].Summary)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helper
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// kubernetesVersion is the numeric part of a Kubernetes version such as v1.27.3+vmware.1-tkg.1.
+type kubernetesVersion struct {
+	major int
+	minor int
+	patch int
+}
+
+func parseKubernetesVersion(version string) (kubernetesVersion, error) {
+	numeric, _, _ := strings.Cut(strings.TrimPrefix(version, "v"), "+")
+	numeric, _, _ = strings.Cut(numeric, "-")
+
+	parts := strings.Split(numeric, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return kubernetesVersion{}, errors.Errorf("invalid Kubernetes version %q: expected <major>.<minor>[.<patch>]", version)
+	}
+
+	numbers := make([]int, 3)
+
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return kubernetesVersion{}, errors.Wrapf(err, "invalid Kubernetes version %q", version)
+		}
+
+		numbers[i] = n
+	}
+
+	return kubernetesVersion{major: numbers[0], minor: numbers[1], patch: numbers[2]}, nil
+}
+
+func (v kubernetesVersion) less(other kubernetesVersion) bool {
+	if v.major != other.major {
+		return v.major < other.major
+	}
+
+	if v.minor != other.minor {
+		return v.minor < other.minor
+	}
+
+	return v.patch < other.patch
+}
+
+// SortKubernetesVersions returns the versions sorted from the oldest to the newest. Versions which can't be parsed are
+// sorted alphabetically before all the others, so that the last version is always the newest valid one.
+func SortKubernetesVersions(versions []string) []string {
+	sorted := make([]string, len(versions))
+	copy(sorted, versions)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		vi, errI := parseKubernetesVersion(sorted[i])
+		vj, errJ := parseKubernetesVersion(sorted[j])
+
+		switch {
+		case errI != nil && errJ != nil:
+			return sorted[i] < sorted[j]
+		case errI != nil || errJ != nil:
+			return errI != nil
+		case vi == vj:
+			return sorted[i] < sorted[j]
+		default:
+			return vi.less(vj)
+		}
+	})
+
+	return sorted
+}
@@ -0,0 +1,19 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortKubernetesVersions(t *testing.T) {
+	versions := []string{"1.28.0", "v1.26.5+vmware.2-tkg.1", "1.27.10", "1.27.9", "latest", "1.27"}
+
+	require.Equal(t, []string{"latest", "v1.26.5+vmware.2-tkg.1", "1.27", "1.27.9", "1.27.10", "1.28.0"}, SortKubernetesVersions(versions))
+	require.Equal(t, "1.28.0", versions[0], "expected the input to be left unchanged")
+}
@@ -6,9 +6,16 @@ SPDX-License-Identifier: MPL-2.0
 package helper
 
 import (
+	"context"
 	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// DefaultWaitTimeoutValue is the placeholder of the ready wait timeout attributes meaning no explicit value was set.
+const DefaultWaitTimeoutValue = "default"
+
 // Retryable is a simple function which can be retried, returns (retry[yes/no], error).
 type Retryable func() (bool, error)
 
@@ -60,3 +67,61 @@ func RetryUntilTimeout(f Retryable, interval time.Duration, timeout time.Duratio
 
 	return retries, err
 }
+
+// RetryUntilTimeoutWithContext is a wrapper to retry functions until they succeed, the timeout elapses or the context is done.
+// The function is always invoked at least once and the error of the last attempt is returned.
+func RetryUntilTimeoutWithContext(ctx context.Context, f Retryable, interval time.Duration, timeout time.Duration) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	retries := 0
+
+	for {
+		retries++
+
+		retry, err := f()
+		if !retry {
+			return retries, err
+		}
+
+		timer := time.NewTimer(interval)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return retries, err
+		case <-timer.C:
+		}
+	}
+}
+
+// GetWaitTimeout returns the duration set in the ready wait timeout attribute waitKey, falling back to the resource
+// timeout timeoutKey (schema.TimeoutCreate, schema.TimeoutUpdate, ...) when the attribute is not set in the configuration,
+// holds the default placeholder or is not a valid duration.
+// When no configuration is available, e.g. on delete, the value stored in the state is used instead.
+func GetWaitTimeout(d *schema.ResourceData, waitKey string, timeoutKey string) time.Duration {
+	if value, ok := waitTimeoutValue(d, waitKey); ok && value != DefaultWaitTimeoutValue {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+	}
+
+	return d.Timeout(timeoutKey)
+}
+
+func waitTimeoutValue(d *schema.ResourceData, key string) (string, bool) {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute(key) {
+		value, ok := d.Get(key).(string)
+
+		return value, ok && value != ""
+	}
+
+	value := config.GetAttr(key)
+	if value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.String) {
+		return "", false
+	}
+
+	return value.AsString(), true
+}
@@ -0,0 +1,53 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helper
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// SetValuesMatch returns whether every attribute set in the desired model has the same value in the existing one.
+// The models are compared through their JSON representation, in which unset attributes are omitted, so that the
+// attributes left unset in the configuration and defaulted by the server are ignored.
+func SetValuesMatch(desired, existing interface{}) bool {
+	var desiredValue, existingValue interface{}
+
+	if !decodeJSON(desired, &desiredValue) || !decodeJSON(existing, &existingValue) {
+		return false
+	}
+
+	return setValuesMatch(desiredValue, existingValue)
+}
+
+func decodeJSON(model interface{}, out *interface{}) bool {
+	data, err := json.Marshal(model)
+	if err != nil {
+		return false
+	}
+
+	return json.Unmarshal(data, out) == nil
+}
+
+func setValuesMatch(desired, existing interface{}) bool {
+	desiredObject, ok := desired.(map[string]interface{})
+	if !ok {
+		return desired == nil || reflect.DeepEqual(desired, existing)
+	}
+
+	existingObject, ok := existing.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	for key, value := range desiredObject {
+		if !setValuesMatch(value, existingObject[key]) {
+			return false
+		}
+	}
+
+	return true
+}
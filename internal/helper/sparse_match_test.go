@@ -0,0 +1,82 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type sparseTestNested struct {
+	Enabled bool     `json:"enabled,omitempty"`
+	Items   []string `json:"items"`
+}
+
+type sparseTestModel struct {
+	Name    string            `json:"name,omitempty"`
+	Region  string            `json:"region,omitempty"`
+	Tags    map[string]string `json:"tags,omitempty"`
+	Nested  *sparseTestNested `json:"nested,omitempty"`
+	Replica int32             `json:"replica,omitempty"`
+}
+
+func TestSetValuesMatch(t *testing.T) {
+	t.Parallel()
+
+	existing := &sparseTestModel{
+		Name:    "c1",
+		Region:  "eastus",
+		Tags:    map[string]string{"team": "a", "owner": "b"},
+		Nested:  &sparseTestNested{Enabled: true, Items: []string{"x", "y"}},
+		Replica: 3,
+	}
+
+	cases := []struct {
+		name     string
+		desired  *sparseTestModel
+		existing *sparseTestModel
+		expected bool
+	}{
+		{
+			name:     "case for an identical model",
+			desired:  existing,
+			existing: existing,
+			expected: true,
+		},
+		{
+			name:     "case for attributes defaulted by the server",
+			desired:  &sparseTestModel{Name: "c1", Nested: &sparseTestNested{Items: []string{"x", "y"}}},
+			existing: existing,
+			expected: true,
+		},
+		{
+			name:     "case for a changed attribute",
+			desired:  &sparseTestModel{Name: "c1", Region: "westus"},
+			existing: existing,
+			expected: false,
+		},
+		{
+			name:     "case for a changed list",
+			desired:  &sparseTestModel{Nested: &sparseTestNested{Items: []string{"x"}}},
+			existing: existing,
+			expected: false,
+		},
+		{
+			name:     "case for a nested attribute missing in the existing model",
+			desired:  &sparseTestModel{Nested: &sparseTestNested{Enabled: true}},
+			existing: &sparseTestModel{Name: "c1"},
+			expected: false,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, SetValuesMatch(test.desired, test.existing))
+		})
+	}
+}
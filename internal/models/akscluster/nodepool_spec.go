@@ -43,6 +43,9 @@ type VmwareTanzuManageV1alpha1AksclusterNodepoolSpec struct {
 	// The taints added to new nodes during nodepool create and scale.
 	NodeTaints []*VmwareTanzuManageV1alpha1AksclusterNodepoolTaint `json:"nodeTaints"`
 
+	// Kubernetes version of the nodepool.
+	OrchestratorVersion string `json:"orchestratorVersion,omitempty"`
+
 	// OS Disk Size in GB to be used to specify the disk size for every machine in the nodepool.
 	// If you specify 0, it will apply the default osDisk size according to the vmSize specified.
 	OsDiskSizeGb int32 `json:"osDiskSizeGb,omitempty"`
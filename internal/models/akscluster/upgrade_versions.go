@@ -0,0 +1,40 @@
+/*
+Copyright 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package models
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1AksclusterGetUpgradeVersionsResponse Response from getting the Kubernetes versions a cluster can be upgraded to.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.akscluster.GetUpgradeVersionsResponse
+type VmwareTanzuManageV1alpha1AksclusterGetUpgradeVersionsResponse struct {
+
+	// Kubernetes versions available for upgrade.
+	AvailableUpgradeVersions []string `json:"availableUpgradeVersions"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1AksclusterGetUpgradeVersionsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1AksclusterGetUpgradeVersionsResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1AksclusterGetUpgradeVersionsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,58 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package credentialmodels
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1AccountCredentialTypeAzureSpec Azure credential spec.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.account.credential.type.azure.Spec
+type VmwareTanzuManageV1alpha1AccountCredentialTypeAzureSpec struct {
+
+	// Azure service principal.
+	ServicePrincipal *VmwareTanzuManageV1alpha1AccountCredentialTypeAzureServicePrincipal `json:"servicePrincipal,omitempty"`
+}
+
+// MarshalBinary interface implementation
+func (m *VmwareTanzuManageV1alpha1AccountCredentialTypeAzureSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VmwareTanzuManageV1alpha1AccountCredentialTypeAzureSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1AccountCredentialTypeAzureSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1AccountCredentialTypeAzureServicePrincipal Azure service principal.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.account.credential.type.azure.ServicePrincipal
+type VmwareTanzuManageV1alpha1AccountCredentialTypeAzureServicePrincipal struct {
+
+	// Azure cloud name of the service principal.
+	AzureCloudName string `json:"azureCloudName,omitempty"`
+
+	// Client ID of the service principal.
+	ClientID string `json:"clientId,omitempty"`
+
+	// Client secret of the service principal.
+	ClientSecret string `json:"clientSecret,omitempty"`
+
+	// Subscription ID of the service principal.
+	SubscriptionID string `json:"subscriptionId,omitempty"`
+
+	// Tenant ID of the service principal.
+	TenantID string `json:"tenantId,omitempty"`
+}
@@ -11,12 +11,17 @@ package credentialmodels
 
 import (
 	"encoding/json"
+
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
 )
 
 // VmwareTanzuManageV1alpha1AccountCredentialStatus Status of the credential.
 //
 // swagger:model vmware.tanzu.manage.v1alpha1.account.credential.Status
 type VmwareTanzuManageV1alpha1AccountCredentialStatus struct {
+	// Conditions of the credential, reporting the validation of the credential data.
+	Conditions map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition `json:"conditions,omitempty"`
+
 	// Phase of the credential.
 	Phase *VmwareTanzuManageV1alpha1AccountCredentialStatusPhase `json:"phase,omitempty"`
 
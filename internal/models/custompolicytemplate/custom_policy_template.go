@@ -0,0 +1,131 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package custompolicytemplatemodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1PolicyTemplate A Policy Template wraps a Kubernetes resource that is a pre-requisite/dependency for creating policies.
+// An example of a policy template is OPA Gatekeeper based ConstraintTemplate.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.template.Template
+type VmwareTanzuManageV1alpha1PolicyTemplate struct {
+
+	// Full name for the policy template.
+	FullName *VmwareTanzuManageV1alpha1PolicyTemplateFullName `json:"fullName,omitempty"`
+
+	// Metadata for the policy template object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the policy template.
+	Spec *VmwareTanzuManageV1alpha1PolicyTemplateSpec `json:"spec,omitempty"`
+
+	// Metadata describing the type of the resource.
+	Type *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectType `json:"type,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyTemplate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyTemplate) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyTemplate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyTemplateSpec Spec of policy template.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.template.Spec
+type VmwareTanzuManageV1alpha1PolicyTemplateSpec struct {
+
+	// DataInventory lists the Kubernetes resources which need to be synced (replicated) into OPA so that the template can reference them in its policies.
+	DataInventory []*VmwareTanzuManageV1alpha1PolicyTemplateDataInventory `json:"dataInventory"`
+
+	// Deprecated specifies whether this version (latest version) of the policy template is deprecated.
+	// Updating a policy template to deprecated will prevent new policies from being created with the template.
+	Deprecated bool `json:"deprecated"`
+
+	// Object is the yaml representation of the kubernetes resource that the template wraps.
+	Object string `json:"object,omitempty"`
+
+	// ObjectType is the type of the kubernetes resource that the template wraps, e.g. ConstraintTemplate.
+	ObjectType string `json:"objectType,omitempty"`
+
+	// TemplateType is the type of the policy template, e.g. OPAGatekeeper.
+	TemplateType string `json:"templateType,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyTemplateSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyTemplateSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyTemplateSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyTemplateDataInventory A group, version and kind of the Kubernetes resources to be synced into OPA.
+//
+// swagger:model k8s.io.apimachinery.pkg.apis.meta.v1.GroupVersionKind
+type VmwareTanzuManageV1alpha1PolicyTemplateDataInventory struct {
+
+	// Group of the resource.
+	Group string `json:"group,omitempty"`
+
+	// Kind of the resource.
+	Kind string `json:"kind,omitempty"`
+
+	// Version of the resource.
+	Version string `json:"version,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyTemplateDataInventory) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyTemplateDataInventory) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyTemplateDataInventory
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package backupschedulemodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRequest Request to create or update a schedule.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.schedule.CreateScheduleRequest
+type VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRequest struct {
+
+	// Schedule to create or update.
+	Schedule *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSchedule `json:"schedule,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse Response with a schedule.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.schedule.GetScheduleResponse
+type VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse struct {
+
+	// Schedule.
+	Schedule *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSchedule `json:"schedule,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
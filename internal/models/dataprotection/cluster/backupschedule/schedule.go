@@ -0,0 +1,99 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package backupschedulemodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSchedule A schedule of backups of a cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.schedule.Schedule
+type VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSchedule struct {
+
+	// Full name for the schedule.
+	FullName *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName `json:"fullName,omitempty"`
+
+	// Metadata for the schedule object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the schedule.
+	Spec *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSpec `json:"spec,omitempty"`
+
+	// Status for the schedule.
+	Status *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatus `json:"status,omitempty"`
+
+	// Metadata describing the type of the resource.
+	Type *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectType `json:"type,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSchedule) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSchedule) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSchedule
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName Full name of the schedule.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.schedule.FullName
+type VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName struct {
+
+	// Name of Cluster.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Name of management cluster.
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Name of the schedule.
+	Name string `json:"name,omitempty"`
+
+	// ID of Organization.
+	OrgID string `json:"orgId,omitempty"`
+
+	// Name of Provisioner.
+	ProvisionerName string `json:"provisionerName,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
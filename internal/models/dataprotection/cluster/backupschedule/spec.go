@@ -0,0 +1,134 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package backupschedulemodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSpec Spec of the schedule.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.schedule.Spec
+type VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSpec struct {
+
+	// Pause the creation of backups.
+	Paused bool `json:"paused"`
+
+	// When the backups are created.
+	Schedule *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRate `json:"schedule,omitempty"`
+
+	// Template of the backups created by the schedule.
+	Template *VmwareTanzuManageV1alpha1ClusterDataprotectionBackupSpec `json:"template,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRate Rate of a schedule.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.schedule.Schedule
+type VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRate struct {
+
+	// Cron expression of the schedule.
+	Rate string `json:"rate,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRate) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionBackupSpec Spec of a backup.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.backup.Spec
+type VmwareTanzuManageV1alpha1ClusterDataprotectionBackupSpec struct {
+
+	// Back up the volumes with the file system backup by default.
+	DefaultVolumesToFsBackup bool `json:"defaultVolumesToFsBackup"`
+
+	// Namespaces excluded from the backup.
+	ExcludedNamespaces []string `json:"excludedNamespaces"`
+
+	// Resources excluded from the backup.
+	ExcludedResources []string `json:"excludedResources"`
+
+	// Include the cluster scoped resources in the backup.
+	IncludeClusterResources *bool `json:"includeClusterResources,omitempty"`
+
+	// Namespaces included in the backup, all namespaces when empty.
+	IncludedNamespaces []string `json:"includedNamespaces"`
+
+	// Resources included in the backup, all resources when empty.
+	IncludedResources []string `json:"includedResources"`
+
+	// Take snapshots of the persistent volumes.
+	SnapshotVolumes *bool `json:"snapshotVolumes,omitempty"`
+
+	// Name of the backup location storing the backups.
+	StorageLocation string `json:"storageLocation,omitempty"`
+
+	// How long the backups are retained, e.g. 720h0m0s.
+	TTL string `json:"ttl,omitempty"`
+
+	// Volume snapshot locations of the backups.
+	VolumeSnapshotLocations []string `json:"volumeSnapshotLocations"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionBackupSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionBackupSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionBackupSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
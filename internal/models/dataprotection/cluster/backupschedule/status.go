@@ -0,0 +1,119 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package backupschedulemodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/swag"
+
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatus Status of the schedule.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.schedule.Status
+type VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatus struct {
+
+	// Conditions of the schedule.
+	Conditions map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition `json:"conditions,omitempty"`
+
+	// Time of the last backup created by the schedule.
+	LastBackup string `json:"lastBackup,omitempty"`
+
+	// Phase of the schedule.
+	Phase *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase `json:"phase,omitempty"`
+
+	// Additional information about the phase.
+	PhaseInfo string `json:"phaseInfo,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatus) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase Phase of the schedule.
+//
+//   - PHASE_UNSPECIFIED: Unspecified phase.
+//   - PENDING: The schedule is waiting to be processed.
+//   - CREATING: The schedule is being created on the cluster.
+//   - NEW: The schedule is created and not validated yet.
+//   - ENABLED: The schedule creates backups.
+//   - PAUSED: The schedule is paused.
+//   - FAILED_VALIDATION: The schedule is invalid.
+//   - ERROR: An error occurred while creating the schedule.
+//   - DELETING: The schedule is being deleted.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.schedule.Status.Phase
+type VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase string
+
+func NewVmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase(value VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase) *VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase {
+	return &value
+}
+
+const (
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhasePHASEUNSPECIFIED captures enum value "PHASE_UNSPECIFIED".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhasePHASEUNSPECIFIED VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase = "PHASE_UNSPECIFIED"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhasePENDING captures enum value "PENDING".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhasePENDING VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase = "PENDING"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseCREATING captures enum value "CREATING".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseCREATING VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase = "CREATING"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseNEW captures enum value "NEW".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseNEW VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase = "NEW"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseENABLED captures enum value "ENABLED".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseENABLED VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase = "ENABLED"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhasePAUSED captures enum value "PAUSED".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhasePAUSED VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase = "PAUSED"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseFAILEDVALIDATION captures enum value "FAILED_VALIDATION".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseFAILEDVALIDATION VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase = "FAILED_VALIDATION"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseERROR captures enum value "ERROR".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseERROR VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase = "ERROR"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseDELETING captures enum value "DELETING".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseDELETING VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase = "DELETING"
+)
+
+// for schema.
+var vmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseEnum []interface{}
+
+func init() {
+	var res []VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase
+	if err := json.Unmarshal([]byte(`["PHASE_UNSPECIFIED","PENDING","CREATING","NEW","ENABLED","PAUSED","FAILED_VALIDATION","ERROR","DELETING"]`), &res); err != nil {
+		panic(err)
+	}
+
+	for _, v := range res {
+		vmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseEnum = append(vmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseEnum, v)
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package dataprotectionmodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionDataProtection Enable data protection on a cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.DataProtection
+type VmwareTanzuManageV1alpha1ClusterDataprotectionDataProtection struct {
+
+	// Full name for the data protection.
+	FullName *VmwareTanzuManageV1alpha1ClusterDataprotectionFullName `json:"fullName,omitempty"`
+
+	// Metadata for the data protection object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the data protection.
+	Spec *VmwareTanzuManageV1alpha1ClusterDataprotectionSpec `json:"spec,omitempty"`
+
+	// Status for the data protection.
+	Status *VmwareTanzuManageV1alpha1ClusterDataprotectionStatus `json:"status,omitempty"`
+
+	// Metadata describing the type of the resource.
+	Type *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectType `json:"type,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionDataProtection) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionDataProtection) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionDataProtection
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package dataprotectionmodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionRequest Request to enable data protection on a cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.CreateDataProtectionRequest
+type VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionRequest struct {
+
+	// Data protection to create.
+	DataProtection *VmwareTanzuManageV1alpha1ClusterDataprotectionDataProtection `json:"dataProtection,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionResponse Response from enabling data protection on a cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.CreateDataProtectionResponse
+type VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionResponse struct {
+
+	// Data protection created.
+	DataProtection *VmwareTanzuManageV1alpha1ClusterDataprotectionDataProtection `json:"dataProtection,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionListDataProtectionsResponse Response with the data protection of a cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.ListDataProtectionsResponse
+type VmwareTanzuManageV1alpha1ClusterDataprotectionListDataProtectionsResponse struct {
+
+	// List of data protections, at most one per cluster.
+	DataProtections []*VmwareTanzuManageV1alpha1ClusterDataprotectionDataProtection `json:"dataProtections"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionListDataProtectionsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionListDataProtectionsResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionListDataProtectionsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
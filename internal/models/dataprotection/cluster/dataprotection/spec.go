@@ -0,0 +1,47 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package dataprotectionmodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionSpec Spec of the data protection.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.Spec
+type VmwareTanzuManageV1alpha1ClusterDataprotectionSpec struct {
+
+	// Disable the restic daemonset used for file system backups of volumes.
+	DisableRestic bool `json:"disableRestic"`
+
+	// Back up all the API group versions supported by the cluster instead of only the preferred ones.
+	EnableAllAPIGroupVersionsBackup bool `json:"enableAllApiGroupVersionsBackup"`
+
+	// Enable CSI snapshots of volumes, requires the CSI snapshot controller on the cluster.
+	EnableCsiSnapshots bool `json:"enableCsiSnapshots"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
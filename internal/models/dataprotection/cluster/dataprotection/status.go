@@ -0,0 +1,111 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package dataprotectionmodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/swag"
+
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionStatus Status of the data protection.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.Status
+type VmwareTanzuManageV1alpha1ClusterDataprotectionStatus struct {
+
+	// Available phases of the data protection.
+	AvailablePhases []*VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhase `json:"availablePhases"`
+
+	// Conditions of the data protection.
+	Conditions map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition `json:"conditions,omitempty"`
+
+	// Phase of the data protection.
+	Phase *VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhase `json:"phase,omitempty"`
+
+	// Additional information about the phase.
+	PhaseInfo string `json:"phaseInfo,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionStatus) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhase Phase of the data protection.
+//
+//   - PHASE_UNSPECIFIED: Unspecified phase.
+//   - PENDING: Data protection is waiting to be processed.
+//   - CREATING: Velero is being installed on the cluster.
+//   - READY: Data protection is enabled on the cluster.
+//   - ERROR: An error occurred while enabling data protection.
+//   - DELETING: Velero is being removed from the cluster.
+//   - UPDATING: The data protection configuration is being updated.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.Status.Phase
+type VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhase string
+
+func NewVmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhase(value VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhase) *VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhase {
+	return &value
+}
+
+const (
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhasePHASEUNSPECIFIED captures enum value "PHASE_UNSPECIFIED".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhasePHASEUNSPECIFIED VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhase = "PHASE_UNSPECIFIED"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhasePENDING captures enum value "PENDING".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhasePENDING VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhase = "PENDING"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhaseCREATING captures enum value "CREATING".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhaseCREATING VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhase = "CREATING"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhaseREADY captures enum value "READY".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhaseREADY VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhase = "READY"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhaseERROR captures enum value "ERROR".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhaseERROR VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhase = "ERROR"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhaseDELETING captures enum value "DELETING".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhaseDELETING VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhase = "DELETING"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhaseUPDATING captures enum value "UPDATING".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhaseUPDATING VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhase = "UPDATING"
+)
+
+// for schema.
+var vmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhaseEnum []interface{}
+
+func init() {
+	var res []VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhase
+	if err := json.Unmarshal([]byte(`["PHASE_UNSPECIFIED","PENDING","CREATING","READY","ERROR","DELETING","UPDATING"]`), &res); err != nil {
+		panic(err)
+	}
+
+	for _, v := range res {
+		vmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhaseEnum = append(vmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhaseEnum, v)
+	}
+}
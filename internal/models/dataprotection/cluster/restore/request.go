@@ -0,0 +1,71 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package restoremodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRequest Request to create a restore.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.restore.CreateRestoreRequest
+type VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRequest struct {
+
+	// Restore to create.
+	Restore *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRestore `json:"restore,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreResponse Response with a restore.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.restore.GetRestoreResponse
+type VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreResponse struct {
+
+	// Restore.
+	Restore *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRestore `json:"restore,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package restoremodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRestore A restore of a backup on a cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.restore.Restore
+type VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRestore struct {
+
+	// Full name for the restore.
+	FullName *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName `json:"fullName,omitempty"`
+
+	// Metadata for the restore object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the restore.
+	Spec *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreSpec `json:"spec,omitempty"`
+
+	// Status for the restore.
+	Status *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatus `json:"status,omitempty"`
+
+	// Metadata describing the type of the resource.
+	Type *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectType `json:"type,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRestore) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRestore) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRestore
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName Full name of the restore.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.restore.FullName
+type VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName struct {
+
+	// Name of Cluster.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Name of management cluster.
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Name of the restore.
+	Name string `json:"name,omitempty"`
+
+	// ID of Organization.
+	OrgID string `json:"orgId,omitempty"`
+
+	// Name of Provisioner.
+	ProvisionerName string `json:"provisionerName,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreSpec Spec of the restore.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.restore.Spec
+type VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreSpec struct {
+
+	// Name of the backup to restore.
+	BackupName string `json:"backupName,omitempty"`
+
+	// Namespaces excluded from the restore.
+	ExcludedNamespaces []string `json:"excludedNamespaces"`
+
+	// Resources excluded from the restore.
+	ExcludedResources []string `json:"excludedResources"`
+
+	// Restore the cluster scoped resources of the backup.
+	IncludeClusterResources *bool `json:"includeClusterResources,omitempty"`
+
+	// Namespaces included in the restore, all the namespaces of the backup when empty.
+	IncludedNamespaces []string `json:"includedNamespaces"`
+
+	// Resources included in the restore, all the resources of the backup when empty.
+	IncludedResources []string `json:"includedResources"`
+
+	// Map of the backed up namespaces to the namespaces they are restored to.
+	NamespaceMapping map[string]string `json:"namespaceMapping,omitempty"`
+
+	// Restore the persistent volumes from their snapshots.
+	RestorePVs *bool `json:"restorePvs,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
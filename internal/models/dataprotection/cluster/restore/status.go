@@ -0,0 +1,126 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package restoremodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/swag"
+
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatus Status of the restore.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.restore.Status
+type VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatus struct {
+
+	// Conditions of the restore.
+	Conditions map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition `json:"conditions,omitempty"`
+
+	// Number of errors of the restore.
+	Errors int32 `json:"errors,omitempty"`
+
+	// Phase of the restore.
+	Phase *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase `json:"phase,omitempty"`
+
+	// Additional information about the phase.
+	PhaseInfo string `json:"phaseInfo,omitempty"`
+
+	// Number of warnings of the restore.
+	Warnings int32 `json:"warnings,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatus) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase Phase of the restore.
+//
+//   - PHASE_UNSPECIFIED: Unspecified phase.
+//   - PENDING: The restore is waiting to be processed.
+//   - CREATING: The restore is being created on the cluster.
+//   - NEW: The restore is created and not started yet.
+//   - INPROGRESS: The restore is running.
+//   - COMPLETED: The restore completed without errors.
+//   - PARTIALLYFAILED: The restore completed with errors.
+//   - FAILED: The restore failed.
+//   - FAILEDVALIDATION: The restore is invalid.
+//   - DELETING: The restore is being deleted.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.dataprotection.restore.Status.Phase
+type VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase string
+
+func NewVmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase(value VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase) *VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase {
+	return &value
+}
+
+const (
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhasePHASEUNSPECIFIED captures enum value "PHASE_UNSPECIFIED".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhasePHASEUNSPECIFIED VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase = "PHASE_UNSPECIFIED"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhasePENDING captures enum value "PENDING".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhasePENDING VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase = "PENDING"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseCREATING captures enum value "CREATING".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseCREATING VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase = "CREATING"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseNEW captures enum value "NEW".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseNEW VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase = "NEW"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseINPROGRESS captures enum value "INPROGRESS".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseINPROGRESS VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase = "INPROGRESS"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseCOMPLETED captures enum value "COMPLETED".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseCOMPLETED VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase = "COMPLETED"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhasePARTIALLYFAILED captures enum value "PARTIALLYFAILED".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhasePARTIALLYFAILED VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase = "PARTIALLYFAILED"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseFAILED captures enum value "FAILED".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseFAILED VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase = "FAILED"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseFAILEDVALIDATION captures enum value "FAILEDVALIDATION".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseFAILEDVALIDATION VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase = "FAILEDVALIDATION"
+
+	// VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseDELETING captures enum value "DELETING".
+	VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseDELETING VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase = "DELETING"
+)
+
+// for schema.
+var vmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseEnum []interface{}
+
+func init() {
+	var res []VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase
+	if err := json.Unmarshal([]byte(`["PHASE_UNSPECIFIED","PENDING","CREATING","NEW","INPROGRESS","COMPLETED","PARTIALLYFAILED","FAILED","FAILEDVALIDATION","DELETING"]`), &res); err != nil {
+		panic(err)
+	}
+
+	for _, v := range res {
+		vmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseEnum = append(vmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseEnum, v)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package targetlocationmodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationRequest Request to create or update a backup location.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.dataprotection.provider.backuplocation.CreateBackupLocationRequest
+type VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationRequest struct {
+
+	// Backup location to create or update.
+	BackupLocation *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationBackupLocation `json:"backupLocation,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse Response with a backup location.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.dataprotection.provider.backuplocation.GetBackupLocationResponse
+type VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse struct {
+
+	// Backup location.
+	BackupLocation *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationBackupLocation `json:"backupLocation,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,336 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package targetlocationmodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationSpec Spec of the backup location.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.dataprotection.provider.backuplocation.Spec
+type VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationSpec struct {
+
+	// Clusters and cluster groups the backup location is assigned to.
+	AssignedGroups []*VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAssignedGroup `json:"assignedGroups"`
+
+	// Name of the bucket or container of the backups.
+	Bucket string `json:"bucket,omitempty"`
+
+	// CA certificate of the storage endpoint.
+	CaCert string `json:"caCert,omitempty"`
+
+	// Provider specific configuration of the backup location.
+	Config *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationConfig `json:"config,omitempty"`
+
+	// Credential used to access the backup location.
+	Credential *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationCredential `json:"credential,omitempty"`
+
+	// Region of the bucket.
+	Region string `json:"region,omitempty"`
+
+	// Provider of the storage of the backups.
+	TargetProvider *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProvider `json:"targetProvider,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAssignedGroup Cluster or cluster group a backup location is assigned to.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.dataprotection.provider.backuplocation.AssignedGroup
+type VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAssignedGroup struct {
+
+	// Cluster the backup location is assigned to.
+	Cluster *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterFullName `json:"cluster,omitempty"`
+
+	// Cluster group the backup location is assigned to.
+	Clustergroup *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterGroupFullName `json:"clustergroup,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAssignedGroup) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAssignedGroup) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAssignedGroup
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterFullName Full name of an assigned cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.FullName
+type VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterFullName struct {
+
+	// Name of management cluster.
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Name of the cluster.
+	Name string `json:"name,omitempty"`
+
+	// Name of Provisioner.
+	ProvisionerName string `json:"provisionerName,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterFullName) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterFullName) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterFullName
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterGroupFullName Full name of an assigned cluster group.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.FullName
+type VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterGroupFullName struct {
+
+	// Name of the cluster group.
+	Name string `json:"name,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterGroupFullName) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterGroupFullName) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterGroupFullName
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationConfig Provider specific configuration of the backup location.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.dataprotection.provider.backuplocation.Config
+type VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationConfig struct {
+
+	// Configuration of an Azure blob container.
+	AzureConfig *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAzureConfig `json:"azureConfig,omitempty"`
+
+	// Configuration of an S3 compatible bucket.
+	S3Config *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationS3Config `json:"s3Config,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationConfig) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationConfig) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationConfig
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationS3Config Configuration of an S3 compatible bucket.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.dataprotection.provider.backuplocation.S3Config
+type VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationS3Config struct {
+
+	// Public URL of the storage, used to download the backups.
+	PublicURL string `json:"publicUrl,omitempty"`
+
+	// Use path style addressing of the bucket.
+	S3ForcePathStyle bool `json:"s3ForcePathStyle"`
+
+	// URL of the storage, required for storages other than AWS S3.
+	URL string `json:"url,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationS3Config) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationS3Config) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationS3Config
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAzureConfig Configuration of an Azure blob container.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.dataprotection.provider.backuplocation.AzureConfig
+type VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAzureConfig struct {
+
+	// Resource group of the storage account.
+	ResourceGroup string `json:"resourceGroup,omitempty"`
+
+	// Name of the storage account.
+	StorageAccount string `json:"storageAccount,omitempty"`
+
+	// ID of the subscription of the storage account.
+	SubscriptionID string `json:"subscriptionId,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAzureConfig) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAzureConfig) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAzureConfig
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationCredential Reference to a data protection credential.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.dataprotection.provider.backuplocation.Credential
+type VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationCredential struct {
+
+	// Name of the credential.
+	Name string `json:"name,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationCredential) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationCredential) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationCredential
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProvider Provider of the storage of the backups.
+//
+//   - TARGET_PROVIDER_UNSPECIFIED: Unspecified provider.
+//   - AWS: AWS S3 or S3 compatible storage.
+//   - AZURE: Azure blob storage.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.dataprotection.provider.backuplocation.TargetProvider
+type VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProvider string
+
+func NewVmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProvider(value VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProvider) *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProvider {
+	return &value
+}
+
+const (
+
+	// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProviderTARGETPROVIDERUNSPECIFIED captures enum value "TARGET_PROVIDER_UNSPECIFIED".
+	VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProviderTARGETPROVIDERUNSPECIFIED VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProvider = "TARGET_PROVIDER_UNSPECIFIED"
+
+	// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProviderAWS captures enum value "AWS".
+	VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProviderAWS VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProvider = "AWS"
+
+	// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProviderAZURE captures enum value "AZURE".
+	VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProviderAZURE VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProvider = "AZURE"
+)
+
+// for schema.
+var vmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProviderEnum []interface{}
+
+func init() {
+	var res []VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProvider
+	if err := json.Unmarshal([]byte(`["TARGET_PROVIDER_UNSPECIFIED","AWS","AZURE"]`), &res); err != nil {
+		panic(err)
+	}
+
+	for _, v := range res {
+		vmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProviderEnum = append(vmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProviderEnum, v)
+	}
+}
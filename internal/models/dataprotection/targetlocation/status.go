@@ -0,0 +1,108 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package targetlocationmodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/swag"
+
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatus Status of the backup location.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.dataprotection.provider.backuplocation.Status
+type VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatus struct {
+
+	// Conditions of the backup location.
+	Conditions map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition `json:"conditions,omitempty"`
+
+	// Phase of the backup location.
+	Phase *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhase `json:"phase,omitempty"`
+
+	// Additional information about the phase.
+	PhaseInfo string `json:"phaseInfo,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatus) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhase Phase of the backup location.
+//
+//   - PHASE_UNSPECIFIED: Unspecified phase.
+//   - PENDING: The backup location is waiting to be processed.
+//   - CREATING: The backup location is being created.
+//   - READY: The backup location can be used.
+//   - ERROR: An error occurred while creating the backup location.
+//   - UPDATING: The backup location is being updated.
+//   - DELETING: The backup location is being deleted.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.dataprotection.provider.backuplocation.Status.Phase
+type VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhase string
+
+func NewVmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhase(value VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhase) *VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhase {
+	return &value
+}
+
+const (
+
+	// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhasePHASEUNSPECIFIED captures enum value "PHASE_UNSPECIFIED".
+	VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhasePHASEUNSPECIFIED VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhase = "PHASE_UNSPECIFIED"
+
+	// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhasePENDING captures enum value "PENDING".
+	VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhasePENDING VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhase = "PENDING"
+
+	// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhaseCREATING captures enum value "CREATING".
+	VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhaseCREATING VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhase = "CREATING"
+
+	// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhaseREADY captures enum value "READY".
+	VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhaseREADY VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhase = "READY"
+
+	// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhaseERROR captures enum value "ERROR".
+	VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhaseERROR VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhase = "ERROR"
+
+	// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhaseUPDATING captures enum value "UPDATING".
+	VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhaseUPDATING VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhase = "UPDATING"
+
+	// VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhaseDELETING captures enum value "DELETING".
+	VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhaseDELETING VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhase = "DELETING"
+)
+
+// for schema.
+var vmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhaseEnum []interface{}
+
+func init() {
+	var res []VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhase
+	if err := json.Unmarshal([]byte(`["PHASE_UNSPECIFIED","PENDING","CREATING","READY","ERROR","UPDATING","DELETING"]`), &res); err != nil {
+		panic(err)
+	}
+
+	for _, v := range res {
+		vmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhaseEnum = append(vmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhaseEnum, v)
+	}
+}
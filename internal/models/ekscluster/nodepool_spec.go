@@ -56,6 +56,9 @@ type VmwareTanzuManageV1alpha1EksclusterNodepoolSpec struct {
 
 	// Update config for the nodepool.
 	UpdateConfig *VmwareTanzuManageV1alpha1EksclusterNodepoolUpdateConfig `json:"updateConfig,omitempty"`
+
+	// Kubernetes version of the nodepool.
+	Version string `json:"version,omitempty"`
 }
 
 // MarshalBinary interface implementation.
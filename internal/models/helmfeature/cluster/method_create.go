@@ -0,0 +1,68 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmfeatureclustermodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmRequest Request to create a Helm.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.fluxcd.helm.CreateHelmRequest
+type VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmRequest struct {
+
+	// Helm to create.
+	Helm *VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelm `json:"helm,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmResponse Response from creating a Helm.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.fluxcd.helm.CreateHelmResponse
+type VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmResponse struct {
+
+	// Helm created.
+	Helm *VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelm `json:"helm,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmfeatureclustergroupmodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmRequest Request to create a Helm.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.fluxcd.helm.CreateHelmRequest
+type VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmRequest struct {
+
+	// Helm to create.
+	Helm *VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelm `json:"helm,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmResponse Response from creating a Helm.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.fluxcd.helm.CreateHelmResponse
+type VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmResponse struct {
+
+	// Helm created.
+	Helm *VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelm `json:"helm,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
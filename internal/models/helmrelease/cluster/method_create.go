@@ -0,0 +1,68 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmreleaseclustermodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseRequest Request to create a Helm release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.fluxcd.helmrelease.CreateReleaseRequest
+type VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseRequest struct {
+
+	// Helm release to create.
+	Release *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRelease `json:"release,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseResponse Response from creating a Helm release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.fluxcd.helmrelease.CreateReleaseResponse
+type VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseResponse struct {
+
+	// Helm release created.
+	Release *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRelease `json:"release,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,54 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmreleaseclustermodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRelease Represents a Helm chart release that needs to be applied to cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.fluxcd.helmrelease.Release
+type VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRelease struct {
+
+	// Full name for the Helm release.
+	FullName *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseFullName `json:"fullName,omitempty"`
+
+	// Metadata for the Helm release object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the Helm release.
+	Spec *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec `json:"spec,omitempty"`
+
+	// Status for the Helm release.
+	Status *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseStatus `json:"status,omitempty"`
+
+	// Metadata describing the type of the resource.
+	Type *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectType `json:"type,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRelease) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRelease) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRelease
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
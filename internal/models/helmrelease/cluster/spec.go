@@ -0,0 +1,134 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmreleaseclustermodel
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec Spec of the Helm release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.fluxcd.helmrelease.Spec
+type VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec struct {
+
+	// Reference to the chart which will be installed.
+	ChartRef *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef `json:"chartRef,omitempty"`
+
+	// Inline values of the chart, as a YAML document.
+	InlineConfiguration string `json:"inlineConfiguration,omitempty"`
+
+	// Interval at which to reconcile the Helm release.
+	Interval string `json:"interval,omitempty"`
+
+	// TargetNamespace to target when performing operations for the Helm release.
+	// Defaults to the namespace of the Helm release.
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef Reference to the chart of the Helm release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.fluxcd.helmrelease.ChartRef
+type VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef struct {
+
+	// Name of the chart in a Helm repository, or path of the chart in a git repository.
+	Chart string `json:"chart,omitempty"`
+
+	// Name of the repository holding the chart.
+	RepositoryName string `json:"repositoryName,omitempty"`
+
+	// Namespace of the repository holding the chart.
+	RepositoryNamespace string `json:"repositoryNamespace,omitempty"`
+
+	// Type of the repository holding the chart.
+	RepositoryType *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryType `json:"repositoryType,omitempty"`
+
+	// Version of the chart, only used for charts of a Helm repository.
+	Version string `json:"version,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryType Type of the repository holding the chart.
+//
+//   - GIT: Chart from a git repository.
+//   - HELM: Chart from a Helm repository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.fluxcd.helmrelease.RepositoryType
+type VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryType string
+
+func NewVmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryType(value VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryType) *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryType {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryType.
+func (m VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryType) Pointer() *VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryType {
+	return &m
+}
+
+const (
+
+	// VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeGIT captures enum value "GIT".
+	VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeGIT VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryType = "GIT"
+
+	// VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeHELM captures enum value "HELM".
+	VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeHELM VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryType = "HELM"
+)
+
+// for schema.
+var vmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeEnum []interface{}
+
+func init() {
+	var res []VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryType
+	if err := json.Unmarshal([]byte(`["GIT","HELM"]`), &res); err != nil {
+		panic(err)
+	}
+
+	for _, v := range res {
+		vmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeEnum = append(vmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeEnum, v)
+	}
+}
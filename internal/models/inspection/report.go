@@ -0,0 +1,176 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package inspectionmodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1ClusterInspectionScanReport Report of a complete inspection.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.inspection.scan.Report
+type VmwareTanzuManageV1alpha1ClusterInspectionScanReport struct {
+
+	// Results of the checks of the inspection.
+	Results []*VmwareTanzuManageV1alpha1ClusterInspectionScanReportResult `json:"results"`
+
+	// Summary of the results of the checks.
+	Summary *VmwareTanzuManageV1alpha1ClusterInspectionScanReportSummary `json:"summary,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanReport) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanReport) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterInspectionScanReport
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterInspectionScanReportSummary Number of checks of the inspection by result.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.inspection.scan.Report.Summary
+type VmwareTanzuManageV1alpha1ClusterInspectionScanReportSummary struct {
+
+	// Number of failed checks.
+	Failed int32 `json:"failed,omitempty"`
+
+	// Number of passed checks.
+	Passed int32 `json:"passed,omitempty"`
+
+	// Number of skipped checks.
+	Skipped int32 `json:"skipped,omitempty"`
+
+	// Total number of checks.
+	Total int32 `json:"total,omitempty"`
+
+	// Number of checks passed with warnings.
+	Warned int32 `json:"warned,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanReportSummary) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanReportSummary) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterInspectionScanReportSummary
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterInspectionScanReportResult Result of a check of the inspection.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.inspection.scan.Report.Result
+type VmwareTanzuManageV1alpha1ClusterInspectionScanReportResult struct {
+
+	// Description of the check.
+	Description string `json:"description,omitempty"`
+
+	// ID of the check, the CIS benchmark control ID or the conformance test name.
+	ID string `json:"id,omitempty"`
+
+	// Remediation of a failed check.
+	Remediation string `json:"remediation,omitempty"`
+
+	// Result of the check.
+	Status *VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus `json:"status,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanReportResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanReportResult) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterInspectionScanReportResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus Result of a check.
+//
+//   - STATUS_UNSPECIFIED: Unspecified result.
+//   - PASS: The check passed.
+//   - FAIL: The check failed.
+//   - WARN: The check passed with warnings, or needs a manual review.
+//   - SKIP: The check was skipped.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.inspection.scan.Report.Result.Status
+type VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus string
+
+func NewVmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus(value VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus) *VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus {
+	return &value
+}
+
+const (
+
+	// VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusSTATUSUNSPECIFIED captures enum value "STATUS_UNSPECIFIED".
+	VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusSTATUSUNSPECIFIED VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus = "STATUS_UNSPECIFIED"
+
+	// VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusPASS captures enum value "PASS".
+	VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusPASS VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus = "PASS"
+
+	// VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusFAIL captures enum value "FAIL".
+	VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusFAIL VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus = "FAIL"
+
+	// VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusWARN captures enum value "WARN".
+	VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusWARN VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus = "WARN"
+
+	// VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusSKIP captures enum value "SKIP".
+	VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusSKIP VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus = "SKIP"
+)
+
+// for schema.
+var vmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusEnum []interface{}
+
+func init() {
+	var res []VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus
+	if err := json.Unmarshal([]byte(`["STATUS_UNSPECIFIED","PASS","FAIL","WARN","SKIP"]`), &res); err != nil {
+		panic(err)
+	}
+
+	for _, v := range res {
+		vmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusEnum = append(vmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusEnum, v)
+	}
+}
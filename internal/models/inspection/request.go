@@ -0,0 +1,71 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package inspectionmodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClusterInspectionScanRequest Request to create an inspection.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.inspection.scan.CreateScanRequest
+type VmwareTanzuManageV1alpha1ClusterInspectionScanRequest struct {
+
+	// Inspection to create.
+	Scan *VmwareTanzuManageV1alpha1ClusterInspectionScanScan `json:"scan,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterInspectionScanRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterInspectionScanResponse Response with an inspection.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.inspection.scan.GetScanResponse
+type VmwareTanzuManageV1alpha1ClusterInspectionScanResponse struct {
+
+	// Inspection.
+	Scan *VmwareTanzuManageV1alpha1ClusterInspectionScanScan `json:"scan,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterInspectionScanResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,213 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package inspectionmodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1ClusterInspectionScanScan An inspection scan of a cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.inspection.scan.Scan
+type VmwareTanzuManageV1alpha1ClusterInspectionScanScan struct {
+
+	// Full name for the inspection.
+	FullName *VmwareTanzuManageV1alpha1ClusterInspectionScanFullName `json:"fullName,omitempty"`
+
+	// Metadata for the inspection object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the inspection.
+	Spec *VmwareTanzuManageV1alpha1ClusterInspectionScanSpec `json:"spec,omitempty"`
+
+	// Status for the inspection.
+	Status *VmwareTanzuManageV1alpha1ClusterInspectionScanStatus `json:"status,omitempty"`
+
+	// Metadata describing the type of the resource.
+	Type *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectType `json:"type,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanScan) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanScan) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterInspectionScanScan
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterInspectionScanFullName Full name of the inspection.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.inspection.scan.FullName
+type VmwareTanzuManageV1alpha1ClusterInspectionScanFullName struct {
+
+	// Name of Cluster.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Name of management cluster.
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Name of the inspection.
+	Name string `json:"name,omitempty"`
+
+	// ID of Organization.
+	OrgID string `json:"orgId,omitempty"`
+
+	// Name of Provisioner.
+	ProvisionerName string `json:"provisionerName,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanFullName) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanFullName) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterInspectionScanFullName
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterInspectionScanSpec Spec of the inspection, exactly one of the specs is set.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.inspection.scan.Spec
+type VmwareTanzuManageV1alpha1ClusterInspectionScanSpec struct {
+
+	// CIS benchmark inspection of the cluster.
+	CisSpec *VmwareTanzuManageV1alpha1ClusterInspectionScanCISSpec `json:"cisSpec,omitempty"`
+
+	// Full Kubernetes conformance inspection of the cluster.
+	ConformanceSpec *VmwareTanzuManageV1alpha1ClusterInspectionScanConformanceSpec `json:"conformanceSpec,omitempty"`
+
+	// Lite Kubernetes conformance inspection of the cluster.
+	LiteSpec *VmwareTanzuManageV1alpha1ClusterInspectionScanLiteSpec `json:"liteSpec,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterInspectionScanSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterInspectionScanCISSpec Spec of a CIS benchmark inspection.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.inspection.scan.CISSpec
+type VmwareTanzuManageV1alpha1ClusterInspectionScanCISSpec struct{}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanCISSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanCISSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterInspectionScanCISSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterInspectionScanConformanceSpec Spec of a full Kubernetes conformance inspection.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.inspection.scan.ConformanceSpec
+type VmwareTanzuManageV1alpha1ClusterInspectionScanConformanceSpec struct{}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanConformanceSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanConformanceSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterInspectionScanConformanceSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterInspectionScanLiteSpec Spec of a lite Kubernetes conformance inspection.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.inspection.scan.LiteSpec
+type VmwareTanzuManageV1alpha1ClusterInspectionScanLiteSpec struct{}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanLiteSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanLiteSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterInspectionScanLiteSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package inspectionmodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/swag"
+
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+// VmwareTanzuManageV1alpha1ClusterInspectionScanStatus Status of the inspection.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.inspection.scan.Status
+type VmwareTanzuManageV1alpha1ClusterInspectionScanStatus struct {
+
+	// Conditions of the inspection.
+	Conditions map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition `json:"conditions,omitempty"`
+
+	// Phase of the inspection.
+	Phase *VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhase `json:"phase,omitempty"`
+
+	// Additional information about the phase.
+	PhaseInfo string `json:"phaseInfo,omitempty"`
+
+	// Report of the inspection, set once the inspection is complete.
+	Report *VmwareTanzuManageV1alpha1ClusterInspectionScanReport `json:"report,omitempty"`
+
+	// URL to download the tarball of the inspection results.
+	TarballDownloadURL string `json:"tarballDownloadUrl,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterInspectionScanStatus) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterInspectionScanStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhase Phase of the inspection.
+//
+//   - PHASE_UNSPECIFIED: Unspecified phase.
+//   - PENDING: The inspection is waiting to be started.
+//   - RUNNING: The inspection is running on the cluster.
+//   - COMPLETE: The inspection is complete and its report is available.
+//   - ERROR: The inspection failed to run.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.inspection.scan.Status.Phase
+type VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhase string
+
+func NewVmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhase(value VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhase) *VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhase {
+	return &value
+}
+
+const (
+
+	// VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhasePHASEUNSPECIFIED captures enum value "PHASE_UNSPECIFIED".
+	VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhasePHASEUNSPECIFIED VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhase = "PHASE_UNSPECIFIED"
+
+	// VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhasePENDING captures enum value "PENDING".
+	VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhasePENDING VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhase = "PENDING"
+
+	// VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhaseRUNNING captures enum value "RUNNING".
+	VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhaseRUNNING VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhase = "RUNNING"
+
+	// VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhaseCOMPLETE captures enum value "COMPLETE".
+	VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhaseCOMPLETE VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhase = "COMPLETE"
+
+	// VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhaseERROR captures enum value "ERROR".
+	VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhaseERROR VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhase = "ERROR"
+)
+
+// for schema.
+var vmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhaseEnum []interface{}
+
+func init() {
+	var res []VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhase
+	if err := json.Unmarshal([]byte(`["PHASE_UNSPECIFIED","PENDING","RUNNING","COMPLETE","ERROR"]`), &res); err != nil {
+		panic(err)
+	}
+
+	for _, v := range res {
+		vmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhaseEnum = append(vmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhaseEnum, v)
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package managementclustermodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1ManagementclusterManagementCluster A management cluster registered with Tanzu Mission Control.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.ManagementCluster
+type VmwareTanzuManageV1alpha1ManagementclusterManagementCluster struct {
+
+	// Full name for the ManagementCluster.
+	FullName *VmwareTanzuManageV1alpha1ManagementclusterFullName `json:"fullName,omitempty"`
+
+	// Metadata for the ManagementCluster object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the ManagementCluster.
+	Spec *VmwareTanzuManageV1alpha1ManagementclusterSpec `json:"spec,omitempty"`
+
+	// Status for the ManagementCluster.
+	Status *VmwareTanzuManageV1alpha1ManagementclusterStatus `json:"status,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterManagementCluster) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterManagementCluster) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterManagementCluster
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterSpec Spec of the management cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.Spec
+type VmwareTanzuManageV1alpha1ManagementclusterSpec struct {
+
+	// Kubernetes provider type of the management cluster.
+	KubernetesProviderType string `json:"kubernetesProviderType,omitempty"`
+
+	// Default cluster group for the workload clusters managed by this management cluster.
+	DefaultClusterGroup string `json:"defaultClusterGroup,omitempty"`
+
+	// Name of the proxy configuration used by the management cluster.
+	ProxyName string `json:"proxyName,omitempty"`
+
+	// Name of the image registry configuration used by the management cluster.
+	ImageRegistry string `json:"imageRegistry,omitempty"`
+
+	// Default proxy configuration for the workload clusters.
+	DefaultWorkloadClusterProxyName string `json:"defaultWorkloadClusterProxyName,omitempty"`
+
+	// Default image registry configuration for the workload clusters.
+	DefaultWorkloadClusterImageRegistry string `json:"defaultWorkloadClusterImageRegistry,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterStatus Status of the management cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.Status
+type VmwareTanzuManageV1alpha1ManagementclusterStatus struct {
+
+	// Phase of the management cluster resource.
+	Phase string `json:"phase,omitempty"`
+
+	// Health of the management cluster.
+	Health string `json:"health,omitempty"`
+
+	// Kubernetes Server Git Version.
+	KubeServerVersion string `json:"kubeServerVersion,omitempty"`
+
+	// Link to the registration manifest of the management cluster.
+	RegistrationURL string `json:"registrationUrl,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterStatus) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package managementclustermodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ManagementclusterRequest Request to create a ManagementCluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.CreateManagementClusterRequest
+type VmwareTanzuManageV1alpha1ManagementclusterRequest struct {
+
+	// ManagementCluster to create.
+	ManagementCluster *VmwareTanzuManageV1alpha1ManagementclusterManagementCluster `json:"managementCluster,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterResponse Response from creating a ManagementCluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.CreateManagementClusterResponse
+type VmwareTanzuManageV1alpha1ManagementclusterResponse struct {
+
+	// ManagementCluster created.
+	ManagementCluster *VmwareTanzuManageV1alpha1ManagementclusterManagementCluster `json:"managementCluster,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
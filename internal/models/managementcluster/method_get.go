@@ -0,0 +1,38 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package managementclustermodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ManagementclusterGetManagementClusterResponse Response from getting a ManagementCluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.GetManagementClusterResponse
+type VmwareTanzuManageV1alpha1ManagementclusterGetManagementClusterResponse struct {
+
+	// ManagementCluster returned.
+	ManagementCluster *VmwareTanzuManageV1alpha1ManagementclusterManagementCluster `json:"managementCluster,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterGetManagementClusterResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterGetManagementClusterResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterGetManagementClusterResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
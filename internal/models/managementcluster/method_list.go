@@ -0,0 +1,96 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package managementclustermodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+// VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersRequestParameters Request parameters to list ManagementClusters.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.ListManagementClustersRequestParameters
+type VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersRequestParameters struct {
+
+	// Scope to search by, any fields left empty will be considered all (*).
+	SearchScope *VmwareTanzuManageV1alpha1ManagementclusterSearchScope `json:"searchScope,omitempty"`
+
+	// Sort Order.
+	SortBy string `json:"sortBy,omitempty"`
+
+	// TQL query string.
+	Query string `json:"query,omitempty"`
+
+	// Pagination.
+	Pagination *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions `json:"pagination,omitempty"`
+
+	// Include total count.
+	IncludeTotalCount bool `json:"includeTotalCount,omitempty"`
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterSearchScope Scope to search by, any fields left empty will be considered all (*).
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.SearchScope
+type VmwareTanzuManageV1alpha1ManagementclusterSearchScope struct {
+
+	// Scope search to the specified name; supports globbing; default (*).
+	Name string `json:"name,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterSearchScope) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterSearchScope) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterSearchScope
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersResponse Response from listing ManagementClusters.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.ListManagementClustersResponse
+type VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersResponse struct {
+
+	// List of management clusters.
+	ManagementClusters []*VmwareTanzuManageV1alpha1ManagementclusterManagementCluster `json:"managementClusters"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
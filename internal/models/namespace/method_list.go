@@ -0,0 +1,105 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package namespacemodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesRequestParameters Request parameters to list Namespaces.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.ListNamespacesRequestParameters
+type VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesRequestParameters struct {
+
+	// Scope to search by, any fields left empty will be considered all (*).
+	SearchScope *VmwareTanzuManageV1alpha1ClusterNamespaceSearchScope `json:"searchScope,omitempty"`
+
+	// Sort Order.
+	SortBy string `json:"sortBy,omitempty"`
+
+	// TQL query string.
+	Query string `json:"query,omitempty"`
+
+	// Pagination.
+	Pagination *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions `json:"pagination,omitempty"`
+
+	// Include total count.
+	IncludeTotalCount bool `json:"includeTotalCount,omitempty"`
+}
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceSearchScope Scope to search by, any fields left empty will be considered all (*).
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.SearchScope
+type VmwareTanzuManageV1alpha1ClusterNamespaceSearchScope struct {
+
+	// Scope search to the specified cluster_name; supports globbing; default (*).
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Scope search to the specified management_cluster_name; supports globbing; default (*).
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Scope search to the specified name; supports globbing; default (*).
+	Name string `json:"name,omitempty"`
+
+	// Scope search to the specified provisioner_name; supports globbing; default (*).
+	ProvisionerName string `json:"provisionerName,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceSearchScope) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceSearchScope) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceSearchScope
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesResponse Response from listing Namespaces.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.ListNamespacesResponse
+type VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesResponse struct {
+
+	// List of namespaces.
+	Namespaces []*VmwareTanzuManageV1alpha1ClusterNamespaceNamespace `json:"namespaces"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
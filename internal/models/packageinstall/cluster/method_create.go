@@ -0,0 +1,71 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packageinstallclustermodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest Request to create/update an Install.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.tanzupackage.install.InstallRequest
+type VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest struct {
+
+	// Install to create/update.
+	Install *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall `json:"install,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallResponse Response from creating/updating an Install.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.tanzupackage.install.InstallResponse
+type VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallResponse struct {
+
+	// Install created/updated.
+	Install *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall `json:"install,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,41 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packageinstallclustermodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallGetInstallResponse Response from getting an Install.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.tanzupackage.install.GetInstallResponse
+type VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallGetInstallResponse struct {
+
+	// Install returned.
+	Install *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall `json:"install,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallGetInstallResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallGetInstallResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallGetInstallResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packageinstallclustermodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec Spec of the package install.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.tanzupackage.install.Spec
+type VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec struct {
+
+	// Inline values to configure the package install.
+	InlineValues map[string]interface{} `json:"inlineValues,omitempty"`
+
+	// Reference to the package to install.
+	PackageRef *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallPackageRef `json:"packageRef,omitempty"`
+
+	// Role binding scope of the service account used to install the package, CLUSTER or NAMESPACE.
+	RoleBindingScope string `json:"roleBindingScope,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallPackageRef Reference to a package.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.tanzupackage.install.PackageRef
+type VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallPackageRef struct {
+
+	// Name of the package metadata.
+	PackageMetadataName string `json:"packageMetadataName,omitempty"`
+
+	// Selection of the version of the package.
+	VersionSelection *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallVersionSelection `json:"versionSelection,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallPackageRef) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallPackageRef) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallPackageRef
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallVersionSelection Version selection of a package.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.tanzupackage.install.VersionSelection
+type VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallVersionSelection struct {
+
+	// Constraints to select the version of the package, a version or a semver range.
+	Constraints string `json:"constraints,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallVersionSelection) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallVersionSelection) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallVersionSelection
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,57 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packagerepositoryclustermodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepository Represents a Carvel package repository, a collection of packages available for installation on the cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.tanzupackage.repository.Repository
+type VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepository struct {
+
+	// Full name for the package repository.
+	FullName *VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryFullName `json:"fullName,omitempty"`
+
+	// Metadata for the package repository object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the package repository.
+	Spec *VmwareTanzuManageV1alpha1ClusterTanzupackageRepositorySpec `json:"spec,omitempty"`
+
+	// Status for the package repository.
+	Status *VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryStatus `json:"status,omitempty"`
+
+	// Metadata describing the type of the resource.
+	Type *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectType `json:"type,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepository) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepository) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepository
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
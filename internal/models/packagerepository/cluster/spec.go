@@ -0,0 +1,71 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packagerepositoryclustermodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClusterTanzupackageRepositorySpec Spec of the package repository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.tanzupackage.repository.Spec
+type VmwareTanzuManageV1alpha1ClusterTanzupackageRepositorySpec struct {
+
+	// Image bundle containing the packages of the repository.
+	ImgpkgBundle *VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryImgPkgBundleSpec `json:"imgpkgBundle,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterTanzupackageRepositorySpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterTanzupackageRepositorySpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterTanzupackageRepositorySpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryImgPkgBundleSpec Imgpkg bundle of the package repository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.tanzupackage.repository.ImgPkgBundleSpec
+type VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryImgPkgBundleSpec struct {
+
+	// Image URL of the imgpkg bundle.
+	Image string `json:"image,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryImgPkgBundleSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryImgPkgBundleSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryImgPkgBundleSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packagerepositoryclustergroupmodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryRequest Request to create/update a Repository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.tanzupackage.repository.RepositoryRequest
+type VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryRequest struct {
+
+	// Repository to create/update.
+	Repository *VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepository `json:"repository,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryResponse Response from creating/updating a Repository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.tanzupackage.repository.RepositoryResponse
+type VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryResponse struct {
+
+	// Repository created/updated.
+	Repository *VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepository `json:"repository,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,41 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packagerepositoryclustergroupmodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryGetRepositoryResponse Response from getting a Repository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.tanzupackage.repository.GetRepositoryResponse
+type VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryGetRepositoryResponse struct {
+
+	// Repository returned.
+	Repository *VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepository `json:"repository,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryGetRepositoryResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryGetRepositoryResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryGetRepositoryResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,57 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packagerepositoryclustergroupmodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepository Represents a Carvel package repository applied on the member clusters of a cluster group.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.tanzupackage.repository.Repository
+type VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepository struct {
+
+	// Full name for the package repository.
+	FullName *VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryFullName `json:"fullName,omitempty"`
+
+	// Metadata for the package repository object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the package repository.
+	Spec *VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositorySpec `json:"spec,omitempty"`
+
+	// Status for the package repository.
+	Status *VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryStatus `json:"status,omitempty"`
+
+	// Metadata describing the type of the resource.
+	Type *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectType `json:"type,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepository) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepository) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepository
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,45 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packagerepositoryclustergroupmodel
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/swag"
+
+	packagerepositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packagerepository/cluster"
+)
+
+// VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositorySpec Spec of the cluster group package repository.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.clustergroup.tanzupackage.repository.Spec
+type VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositorySpec struct {
+
+	// Spec of the package repository applied on the member clusters.
+	AtomicSpec *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositorySpec `json:"atomicSpec,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositorySpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositorySpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositorySpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
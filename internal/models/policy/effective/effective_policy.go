@@ -0,0 +1,160 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyeffectivemodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+// VmwareTanzuManageV1alpha1PolicyEffectivePolicy The set of policies effective on a cluster or a namespace,
+// made of the policies attached directly to it and those inherited from its parents.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.effective.EffectivePolicy
+type VmwareTanzuManageV1alpha1PolicyEffectivePolicy struct {
+
+	// Full name of the cluster or namespace the policies are effective on.
+	FullName *VmwareTanzuManageV1alpha1PolicyEffectivePolicyFullName `json:"fullName,omitempty"`
+
+	// Spec of the effective policy.
+	Spec *VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec `json:"spec,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicy) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyEffectivePolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyEffectivePolicyFullName Full name of the cluster or namespace the policies are effective on.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.effective.FullName
+type VmwareTanzuManageV1alpha1PolicyEffectivePolicyFullName struct {
+
+	// Name of the cluster.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Name of the management cluster.
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Name of the namespace, empty for the policies effective on the cluster.
+	NamespaceName string `json:"namespaceName,omitempty"`
+
+	// ID of Organization.
+	OrgID string `json:"orgId,omitempty"`
+
+	// Name of the cluster provisioner.
+	ProvisionerName string `json:"provisionerName,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicyFullName) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicyFullName) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyEffectivePolicyFullName
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec Spec of the effective policy.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.effective.Spec
+type VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec struct {
+
+	// Type of the effective policies.
+	PolicyType string `json:"policyType,omitempty"`
+
+	// Policies effective on the cluster or namespace.
+	PolicySpecs []*VmwareTanzuManageV1alpha1PolicyEffectivePolicySpecPolicySpec `json:"policySpecs"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyEffectivePolicySpecPolicySpec A policy effective on the cluster or namespace.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.effective.Spec.PolicySpec
+type VmwareTanzuManageV1alpha1PolicyEffectivePolicySpecPolicySpec struct {
+
+	// Inherited is set when the policy is attached to a parent of the cluster or namespace.
+	Inherited bool `json:"inherited"`
+
+	// Name of the policy.
+	PolicyName string `json:"policyName,omitempty"`
+
+	// Resource ID of the object the policy is attached to.
+	SourceRid string `json:"sourceRid,omitempty"`
+
+	// Spec of the policy.
+	Spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec `json:"spec,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicySpecPolicySpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicySpecPolicySpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyEffectivePolicySpecPolicySpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,115 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyeffectivemodel
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1PolicyEffectivePolicyListRequestParameters Request to list the effective policies.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.effective.ListEffectivePoliciesRequest
+type VmwareTanzuManageV1alpha1PolicyEffectivePolicyListRequestParameters struct {
+
+	// Scope to search by.
+	SearchScope *VmwareTanzuManageV1alpha1PolicyEffectivePolicySearchScope `json:"searchScope,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicyListRequestParameters) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicyListRequestParameters) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyEffectivePolicyListRequestParameters
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyEffectivePolicySearchScope Scope to search effective policies by.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.effective.SearchScope
+type VmwareTanzuManageV1alpha1PolicyEffectivePolicySearchScope struct {
+
+	// Name of the cluster.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Name of the management cluster.
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Name of the namespace.
+	NamespaceName string `json:"namespaceName,omitempty"`
+
+	// Type of the policies.
+	PolicyType string `json:"policyType,omitempty"`
+
+	// Name of the cluster provisioner.
+	ProvisionerName string `json:"provisionerName,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicySearchScope) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicySearchScope) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyEffectivePolicySearchScope
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1PolicyEffectivePolicyListResponse Response from listing the effective policies.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.policy.effective.ListEffectivePoliciesResponse
+type VmwareTanzuManageV1alpha1PolicyEffectivePolicyListResponse struct {
+
+	// List of effective policies, one per policy type.
+	EffectivePolicies []*VmwareTanzuManageV1alpha1PolicyEffectivePolicy `json:"effectivePolicies"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicyListResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1PolicyEffectivePolicyListResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1PolicyEffectivePolicyListResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policynamespacemodel
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName Full name of the namespace policy. This includes the object
+// name along with any parents or further identifiers.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.policy.FullName
+type VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName struct {
+
+	// Name of the cluster.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// Name of the management cluster.
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Name of the namespace.
+	NamespaceName string `json:"namespaceName,omitempty"`
+
+	// Name of the policy.
+	Name string `json:"name,omitempty"`
+
+	// ID of Organization.
+	OrgID string `json:"orgId,omitempty"`
+
+	// Name of the cluster provisioner.
+	ProvisionerName string `json:"provisionerName,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+func (m *VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName) ToString() string {
+	if m == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%s:%s:%s:%s:%s", m.OrgID, m.ManagementClusterName, m.ProvisionerName, m.ClusterName, m.NamespaceName, m.Name)
+}
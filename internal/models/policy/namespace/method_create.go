@@ -0,0 +1,68 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policynamespacemodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyRequest Request to create a Policy.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.policy.CreatePolicyRequest
+type VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyRequest struct {
+
+	// Policy to create.
+	Policy *VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicy `json:"policy,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyResponse Response from creating a Policy.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.policy.CreatePolicyResponse
+type VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyResponse struct {
+
+	// Policy created.
+	Policy *VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicy `json:"policy,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,38 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policynamespacemodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ClusterNamespacePolicyGetPolicyResponse Response from getting a Policy.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.policy.GetPolicyResponse
+type VmwareTanzuManageV1alpha1ClusterNamespacePolicyGetPolicyResponse struct {
+
+	// Policy returned.
+	Policy *VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicy `json:"policy,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespacePolicyGetPolicyResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespacePolicyGetPolicyResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespacePolicyGetPolicyResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,52 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policynamespacemodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+)
+
+// VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicy A Policy to apply on a Kubernetes namespace.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.cluster.namespace.policy.Policy
+type VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicy struct {
+
+	// Full name for the Namespace policy.
+	FullName *VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName `json:"fullName,omitempty"`
+
+	// Metadata for the Namespace policy.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the Namespace policy.
+	Spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec `json:"spec,omitempty"`
+
+	// Metadata describing the type of the resource.
+	Type *policymodel.VmwareTanzuCoreV1alpha1ObjectType `json:"type,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicy) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
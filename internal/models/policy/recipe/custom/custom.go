@@ -0,0 +1,53 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyrecipecustommodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	policyrecipecustomcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom/common"
+)
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1Custom is model for custom policies using a custom policy template.
+//
+// The input schema for a recipe backed by a custom policy template.
+//
+// swagger:model VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1Custom
+type VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1Custom struct {
+
+	// Audit (dry-run).
+	// Creates this policy for dry-run. Violations will be logged but not denied. Defaults to false (deny).
+	Audit bool `json:"audit,omitempty"`
+
+	// Parameters are the values passed to the custom policy template, matching the parameters schema of the template.
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+
+	// TargetKubernetesResources is a list of kubernetes api resources on which the policy will be enforced, identified using apiGroups and kinds. You can use 'kubectl api-resources' to view the list of available api resources on your cluster.
+	// Required: true
+	// Min Items: 1
+	TargetKubernetesResources []*policyrecipecustomcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TargetKubernetesResources `json:"targetKubernetesResources"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1Custom) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1Custom) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1Custom
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+The contents of this file are not auto-generated using swagger CLI as the schema defined for the recipes are not a part of the TMC API models.
+The models defined here are used to map the API request and response bodies to and from the terraform provider schema.
+*/
+
+package policyrecipemutationcommonmodel
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1TargetKubernetesResources TargetKubernetes Resources is a list of kubernetes api resources on which the policy will be enforced, identified using apiGroups and kinds.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.common.policy.spec.mutation.v1.TargetKubernetesResources
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1TargetKubernetesResources struct {
+
+	// APIGroup is a group containing the resource type, for example 'apps', '' (some resources like Namespace, Pod have empty apiGroup).
+	// Required: true
+	APIGroups []string `json:"apiGroups"`
+
+	// Kind is the name of the object schema (resource type), for example 'Namespace', 'Pod', 'Deployment'.
+	// Required: true
+	// Min Items: 1
+	Kinds []string `json:"kinds"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1TargetKubernetesResources) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1TargetKubernetesResources) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1TargetKubernetesResources
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1KeyValue Key and value of the label or annotation to set on the target resources.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.common.policy.spec.mutation.v1.KeyValue
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1KeyValue struct {
+
+	// Key.
+	// Required: true
+	Key string `json:"key"`
+
+	// Value.
+	Value string `json:"value,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1KeyValue) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1KeyValue) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1KeyValue
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope Scope of the target resources.
+//
+//   - *: Cluster scoped and namespaced resources.
+//   - Cluster: Cluster scoped resources.
+//   - Namespaced: Namespaced resources.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.common.policy.spec.mutation.v1.Scope
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope string
+
+func NewVmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope(value VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope) *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope {
+	v := value
+	return &v
+}
+
+const (
+
+	// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ScopeAll captures enum value "*".
+	VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ScopeAll VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope = "*"
+
+	// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ScopeCluster captures enum value "Cluster".
+	VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ScopeCluster VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope = "Cluster"
+
+	// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ScopeNamespaced captures enum value "Namespaced".
+	VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ScopeNamespaced VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope = "Namespaced"
+)
+
+// for schema.
+var vmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ScopeEnum []interface{}
+
+func init() {
+	var res []VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope
+	if err := json.Unmarshal([]byte(`["*","Cluster","Namespaced"]`), &res); err != nil {
+		panic(err)
+	}
+
+	for _, v := range res {
+		vmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ScopeEnum = append(vmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ScopeEnum, v)
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+The contents of this file are not auto-generated using swagger CLI as the schema defined for the recipes are not a part of the TMC API models.
+The models defined here are used to map the API request and response bodies to and from the terraform provider schema.
+*/
+
+package policyrecipemutationmodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	policyrecipemutationcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation/common"
+)
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label Input schema for mutation policy label recipe version v1.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.common.policy.spec.mutation.v1.Label
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label struct {
+
+	// Label to set on the target resources.
+	// Required: true
+	Label *policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1KeyValue `json:"label"`
+
+	// Scope of the target resources.
+	Scope *policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope `json:"scope,omitempty"`
+
+	// TargetKubernetesResources is a list of kubernetes api resources on which the policy will be enforced.
+	// Required: true
+	// Min Items: 1
+	TargetKubernetesResources []*policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1TargetKubernetesResources `json:"targetKubernetesResources"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,328 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+The contents of this file are not auto-generated using swagger CLI as the schema defined for the recipes are not a part of the TMC API models.
+The models defined here are used to map the API request and response bodies to and from the terraform provider schema.
+*/
+
+package policyrecipemutationmodel
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity Input schema for mutation policy pod security recipe version v1.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.common.policy.spec.mutation.v1.PodSecurity
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity struct {
+
+	// Allow privilege escalation.
+	AllowPrivilegeEscalation *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition `json:"allowPrivilegeEscalation,omitempty"`
+
+	// Capabilities to add.
+	CapabilitiesAdd *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCapabilities `json:"capabilitiesAdd,omitempty"`
+
+	// Capabilities to drop.
+	CapabilitiesDrop *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCapabilities `json:"capabilitiesDrop,omitempty"`
+
+	// Filesystem group.
+	FsGroup *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityIntCondition `json:"fsGroup,omitempty"`
+
+	// Privileged containers.
+	Privileged *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition `json:"privileged,omitempty"`
+
+	// Read only root filesystem.
+	ReadOnlyRootFilesystem *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition `json:"readOnlyRootFilesystem,omitempty"`
+
+	// Run as group.
+	RunAsGroup *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityIntCondition `json:"runAsGroup,omitempty"`
+
+	// Run as non root.
+	RunAsNonRoot *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition `json:"runAsNonRoot,omitempty"`
+
+	// Run as user.
+	RunAsUser *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityIntCondition `json:"runAsUser,omitempty"`
+
+	// SELinux options.
+	SeLinuxOptions *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySELinuxOptions `json:"seLinuxOptions,omitempty"`
+
+	// Supplemental groups.
+	SupplementalGroups *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySupplementalGroups `json:"supplementalGroups,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition Boolean security context field to mutate.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.common.policy.spec.mutation.v1.podSecurity.BooleanCondition
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition struct {
+
+	// Condition to mutate the field.
+	Condition VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCondition `json:"condition"`
+
+	// Value of the field.
+	Value bool `json:"value"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityIntCondition Integer security context field to mutate.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.common.policy.spec.mutation.v1.podSecurity.IntCondition
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityIntCondition struct {
+
+	// Condition to mutate the field.
+	Condition VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCondition `json:"condition"`
+
+	// Value of the field.
+	Value int64 `json:"value"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityIntCondition) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityIntCondition) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityIntCondition
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCapabilities Linux capabilities to mutate.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.common.policy.spec.mutation.v1.podSecurity.Capabilities
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCapabilities struct {
+
+	// Operation to mutate the capabilities.
+	Operation VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperation `json:"operation"`
+
+	// List of capabilities.
+	Values []string `json:"values"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCapabilities) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCapabilities) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCapabilities
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySELinuxOptions SELinux options to mutate.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.common.policy.spec.mutation.v1.podSecurity.SELinuxOptions
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySELinuxOptions struct {
+
+	// Condition to mutate the field.
+	Condition VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCondition `json:"condition"`
+
+	// SELinux level.
+	Level string `json:"level,omitempty"`
+
+	// SELinux role.
+	Role string `json:"role,omitempty"`
+
+	// SELinux type.
+	Type string `json:"type,omitempty"`
+
+	// SELinux user.
+	User string `json:"user,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySELinuxOptions) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySELinuxOptions) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySELinuxOptions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySupplementalGroups Supplemental groups to mutate.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.common.policy.spec.mutation.v1.podSecurity.SupplementalGroups
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySupplementalGroups struct {
+
+	// Condition to mutate the field.
+	Condition VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCondition `json:"condition"`
+
+	// List of group ids.
+	Values []int64 `json:"values"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySupplementalGroups) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySupplementalGroups) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySupplementalGroups
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCondition Condition to mutate a field.
+//
+//   - Always: Always set the field.
+//   - IfFieldDoesNotExist: Set the field if it does not exist.
+//   - IfFieldExists: Set the field if it exists.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.common.policy.spec.mutation.v1.podSecurity.Condition
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCondition string
+
+func NewVmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCondition(value VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCondition) *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCondition {
+	v := value
+	return &v
+}
+
+const (
+
+	// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionAlways captures enum value "Always".
+	VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionAlways VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCondition = "Always"
+
+	// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionIfFieldDoesNotExist captures enum value "IfFieldDoesNotExist".
+	VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionIfFieldDoesNotExist VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCondition = "IfFieldDoesNotExist"
+
+	// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionIfFieldExists captures enum value "IfFieldExists".
+	VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionIfFieldExists VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCondition = "IfFieldExists"
+)
+
+// for schema.
+var vmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionEnum []interface{}
+
+func init() {
+	var res []VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCondition
+	if err := json.Unmarshal([]byte(`["Always","IfFieldDoesNotExist","IfFieldExists"]`), &res); err != nil {
+		panic(err)
+	}
+
+	for _, v := range res {
+		vmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionEnum = append(vmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionEnum, v)
+	}
+}
+
+// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperation Operation to mutate a list.
+//
+//   - merge: Merge the values with the existing values.
+//   - override: Replace the existing values.
+//   - prune: Remove the values from the existing values.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.common.policy.spec.mutation.v1.podSecurity.Operation
+type VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperation string
+
+func NewVmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperation(value VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperation) *VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperation {
+	v := value
+	return &v
+}
+
+const (
+
+	// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperationMerge captures enum value "merge".
+	VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperationMerge VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperation = "merge"
+
+	// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperationOverride captures enum value "override".
+	VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperationOverride VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperation = "override"
+
+	// VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperationPrune captures enum value "prune".
+	VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperationPrune VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperation = "prune"
+)
+
+// for schema.
+var vmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperationEnum []interface{}
+
+func init() {
+	var res []VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperation
+	if err := json.Unmarshal([]byte(`["merge","override","prune"]`), &res); err != nil {
+		panic(err)
+	}
+
+	for _, v := range res {
+		vmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperationEnum = append(vmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperationEnum, v)
+	}
+}
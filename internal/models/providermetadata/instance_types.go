@@ -0,0 +1,38 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package providermetadatamodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ProvidermetadataInstanceTypesResponse Response listing the instance types available for cluster nodes.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.providermetadata.InstanceTypesResponse
+type VmwareTanzuManageV1alpha1ProvidermetadataInstanceTypesResponse struct {
+
+	// Instance types available in the region.
+	InstanceTypes []string `json:"instanceTypes"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ProvidermetadataInstanceTypesResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ProvidermetadataInstanceTypesResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ProvidermetadataInstanceTypesResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,38 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package providermetadatamodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ProvidermetadataKubernetesVersionsResponse Response listing the Kubernetes versions a cloud provider offers for new clusters.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.providermetadata.KubernetesVersionsResponse
+type VmwareTanzuManageV1alpha1ProvidermetadataKubernetesVersionsResponse struct {
+
+	// Kubernetes versions available in the region.
+	Versions []string `json:"versions"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ProvidermetadataKubernetesVersionsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ProvidermetadataKubernetesVersionsResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ProvidermetadataKubernetesVersionsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
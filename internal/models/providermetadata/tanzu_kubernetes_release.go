@@ -0,0 +1,44 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package providermetadatamodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesRelease A Tanzu Kubernetes release available to a provisioner.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.providermetadata.TanzuKubernetesRelease
+type VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesRelease struct {
+
+	// Name of the Tanzu Kubernetes release.
+	Name string `json:"name,omitempty"`
+
+	// Spec of the Tanzu Kubernetes release.
+	Spec *VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesReleaseSpec `json:"spec,omitempty"`
+
+	// Status of the Tanzu Kubernetes release.
+	Status *VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesReleaseStatus `json:"status,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesRelease) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesRelease) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesRelease
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,41 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package providermetadatamodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesReleaseSpec Spec of a Tanzu Kubernetes release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.providermetadata.TanzuKubernetesRelease.Spec
+type VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesReleaseSpec struct {
+
+	// Version of the Tanzu Kubernetes release, used as the version of a cluster.
+	Version string `json:"version,omitempty"`
+
+	// Kubernetes version shipped with the release.
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesReleaseSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesReleaseSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesReleaseSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,38 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package providermetadatamodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesReleaseStatus Status of a Tanzu Kubernetes release.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.providermetadata.TanzuKubernetesRelease.Status
+type VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesReleaseStatus struct {
+
+	// Whether the release is compatible with the management cluster.
+	Compatible bool `json:"compatible,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesReleaseStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesReleaseStatus) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesReleaseStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
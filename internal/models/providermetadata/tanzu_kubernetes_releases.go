@@ -0,0 +1,41 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package providermetadatamodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ProvidermetadataListTanzuKubernetesReleasesResponse Response listing the Tanzu Kubernetes releases of a provisioner.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.providermetadata.ListTanzuKubernetesReleasesResponse
+type VmwareTanzuManageV1alpha1ProvidermetadataListTanzuKubernetesReleasesResponse struct {
+
+	// List of Tanzu Kubernetes releases.
+	TanzuKubernetesReleases []*VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesRelease `json:"tanzuKubernetesReleases"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ProvidermetadataListTanzuKubernetesReleasesResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ProvidermetadataListTanzuKubernetesReleasesResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ProvidermetadataListTanzuKubernetesReleasesResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
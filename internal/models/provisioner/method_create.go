@@ -0,0 +1,68 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package provisionermodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerRequest Request to create a Provisioner.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.CreateProvisionerRequest
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerRequest struct {
+
+	// Provisioner to create.
+	Provisioner *VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner `json:"provisioner,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerRequest) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerResponse Response from creating a Provisioner.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.CreateProvisionerResponse
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerResponse struct {
+
+	// Provisioner created.
+	Provisioner *VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner `json:"provisioner,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,38 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package provisionermodel
+
+import "github.com/go-openapi/swag"
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerGetProvisionerResponse Response from getting a Provisioner.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.GetProvisionerResponse
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerGetProvisionerResponse struct {
+
+	// Provisioner returned.
+	Provisioner *VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner `json:"provisioner,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerGetProvisionerResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerGetProvisionerResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerGetProvisionerResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package provisionermodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerListProvisionersRequestParameters Request parameters to list Provisioners.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.ListProvisionersRequestParameters
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerListProvisionersRequestParameters struct {
+
+	// Scope to search by, any fields left empty will be considered all (*).
+	SearchScope *VmwareTanzuManageV1alpha1ManagementclusterProvisionerSearchScope `json:"searchScope,omitempty"`
+
+	// Sort Order.
+	SortBy string `json:"sortBy,omitempty"`
+
+	// TQL query string.
+	Query string `json:"query,omitempty"`
+
+	// Pagination.
+	Pagination *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions `json:"pagination,omitempty"`
+
+	// Include total count.
+	IncludeTotalCount bool `json:"includeTotalCount,omitempty"`
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerSearchScope Scope to search by, any fields left empty will be considered all (*).
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.SearchScope
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerSearchScope struct {
+
+	// Scope search to the specified management cluster name.
+	ManagementClusterName string `json:"managementClusterName,omitempty"`
+
+	// Scope search to the specified name; supports globbing; default (*).
+	Name string `json:"name,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerSearchScope) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerSearchScope) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerSearchScope
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerListProvisionersResponse Response from listing Provisioners.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.ListProvisionersResponse
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerListProvisionersResponse struct {
+
+	// List of provisioners.
+	Provisioners []*VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner `json:"provisioners"`
+
+	// Total count.
+	TotalCount string `json:"totalCount,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerListProvisionersResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerListProvisionersResponse) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerListProvisionersResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,79 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package provisionermodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner A provisioner of a management cluster, which maps to a namespace on it
+// where workload clusters are provisioned.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.Provisioner
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner struct {
+
+	// Full name for the Provisioner.
+	FullName *VmwareTanzuManageV1alpha1ManagementclusterProvisionerFullName `json:"fullName,omitempty"`
+
+	// Metadata for the Provisioner object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Status for the Provisioner.
+	Status *VmwareTanzuManageV1alpha1ManagementclusterProvisionerStatus `json:"status,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerStatus Status of the provisioner.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.Status
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerStatus struct {
+
+	// Phase of the provisioner resource.
+	Phase string `json:"phase,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerStatus) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
@@ -0,0 +1,161 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzukubernetesclustermodel
+
+import (
+	"github.com/go-openapi/swag"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTanzuKubernetesCluster A ClusterClass based Tanzu Kubernetes cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetescluster.TanzuKubernetesCluster
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTanzuKubernetesCluster struct {
+
+	// Full name for the cluster.
+	FullName *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterFullName `json:"fullName,omitempty"`
+
+	// Metadata for the cluster object.
+	Meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta `json:"meta,omitempty"`
+
+	// Spec for the cluster.
+	Spec *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterSpec `json:"spec,omitempty"`
+
+	// Status for the cluster.
+	Status *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterStatus `json:"status,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTanzuKubernetesCluster) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTanzuKubernetesCluster) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTanzuKubernetesCluster
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterSpec Spec of the Tanzu Kubernetes cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetescluster.Spec
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterSpec struct {
+
+	// Name of the cluster group to which this cluster belongs.
+	ClusterGroupName string `json:"clusterGroupName,omitempty"`
+
+	// Name of the image registry configuration to use.
+	ImageRegistry string `json:"imageRegistry,omitempty"`
+
+	// Name of the proxy configuration to use.
+	ProxyName string `json:"proxyName,omitempty"`
+
+	// Whether the cluster is managed by Tanzu Mission Control.
+	TmcManaged bool `json:"tmcManaged,omitempty"`
+
+	// The cluster topology.
+	Topology *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTopology `json:"topology,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhase Phase of the Tanzu Kubernetes cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetescluster.Phase
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhase string
+
+const (
+
+	// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhasePHASEUNSPECIFIED captures enum value "PHASE_UNSPECIFIED".
+	VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhasePHASEUNSPECIFIED VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhase = "PHASE_UNSPECIFIED"
+
+	// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhasePENDING captures enum value "PENDING".
+	VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhasePENDING VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhase = "PENDING"
+
+	// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhaseCREATING captures enum value "CREATING".
+	VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhaseCREATING VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhase = "CREATING"
+
+	// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhaseREADY captures enum value "READY".
+	VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhaseREADY VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhase = "READY"
+
+	// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhaseUPGRADING captures enum value "UPGRADING".
+	VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhaseUPGRADING VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhase = "UPGRADING"
+
+	// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhaseUPDATING captures enum value "UPDATING".
+	VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhaseUPDATING VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhase = "UPDATING"
+
+	// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhaseDELETING captures enum value "DELETING".
+	VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhaseDELETING VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhase = "DELETING"
+
+	// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhaseERROR captures enum value "ERROR".
+	VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhaseERROR VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhase = "ERROR"
+)
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterStatus Status of the Tanzu Kubernetes cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetescluster.Status
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterStatus struct {
+
+	// Phase of the cluster resource.
+	Phase *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhase `json:"phase,omitempty"`
+
+	// Health of the cluster.
+	Health string `json:"health,omitempty"`
+
+	// Message describing the phase of the cluster.
+	Message string `json:"message,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterStatus) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
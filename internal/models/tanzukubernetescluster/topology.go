@@ -0,0 +1,301 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzukubernetesclustermodel
+
+import (
+	"github.com/go-openapi/swag"
+)
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTopology Topology of a ClusterClass based cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetescluster.Topology
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTopology struct {
+
+	// The name of the ClusterClass of the cluster.
+	ClusterClass string `json:"clusterClass,omitempty"`
+
+	// Variables configuring the ClusterClass.
+	ClusterVariables []*VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable `json:"variables"`
+
+	// Control plane of the cluster.
+	ControlPlane *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterControlPlane `json:"controlPlane,omitempty"`
+
+	// Network of the cluster.
+	Network *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNetwork `json:"network,omitempty"`
+
+	// Node pools of the cluster.
+	NodePools []*VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePool `json:"nodePools"`
+
+	// Kubernetes version of the cluster.
+	Version string `json:"version,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTopology) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTopology) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTopology
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable A ClusterClass variable, the value being any JSON value.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetescluster.ClusterVariable
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable struct {
+
+	// Name of the variable.
+	Name string `json:"name,omitempty"`
+
+	// Value of the variable.
+	Value interface{} `json:"value,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterControlPlane Control plane of the cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetescluster.ControlPlane
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterControlPlane struct {
+
+	// OS image of the control plane nodes.
+	OsImage *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterOsImage `json:"osImage,omitempty"`
+
+	// Number of control plane nodes.
+	Replicas int32 `json:"replicas,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterControlPlane) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterControlPlane) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterControlPlane
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterOsImage OS image of cluster nodes.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetescluster.OSImage
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterOsImage struct {
+
+	// Architecture of the OS image.
+	Arch string `json:"arch,omitempty"`
+
+	// Name of the OS image.
+	Name string `json:"name,omitempty"`
+
+	// Version of the OS image.
+	Version string `json:"version,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterOsImage) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterOsImage) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterOsImage
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePool A node pool of the cluster, backed by a machine deployment of a worker class.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetescluster.nodepool.Nodepool
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePool struct {
+
+	// Info of the node pool.
+	Info *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePoolInfo `json:"info,omitempty"`
+
+	// Spec of the node pool.
+	Spec *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePoolSpec `json:"spec,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePool) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePool) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePool
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePoolInfo Name and description of a node pool.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetescluster.nodepool.Info
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePoolInfo struct {
+
+	// Description of the node pool.
+	Description string `json:"description,omitempty"`
+
+	// Name of the node pool.
+	Name string `json:"name,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePoolInfo) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePoolInfo) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePoolInfo
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePoolSpec Spec of a node pool.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetescluster.nodepool.Spec
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePoolSpec struct {
+
+	// Failure domain of the node pool machines.
+	FailureDomain string `json:"failureDomain,omitempty"`
+
+	// OS image of the node pool machines.
+	OsImage *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterOsImage `json:"osImage,omitempty"`
+
+	// Variables overriding the cluster variables for the node pool.
+	OverrideVariables []*VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable `json:"overrides"`
+
+	// Number of nodes.
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Worker class of the ClusterClass backing the node pool.
+	WorkerClass string `json:"class,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePoolSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePoolSpec) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePoolSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
+
+// VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNetwork Network of the cluster.
+//
+// swagger:model vmware.tanzu.manage.v1alpha1.managementcluster.provisioner.tanzukubernetescluster.Network
+type VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNetwork struct {
+
+	// Pod CIDR blocks.
+	PodCidrBlocks []string `json:"pods,omitempty"`
+
+	// Service CIDR blocks.
+	ServiceCidrBlocks []string `json:"services,omitempty"`
+
+	// Domain name for services.
+	ServiceDomain string `json:"serviceDomain,omitempty"`
+}
+
+// MarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNetwork) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation.
+func (m *VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNetwork) UnmarshalBinary(b []byte) error {
+	var res VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNetwork
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+
+	*m = res
+
+	return nil
+}
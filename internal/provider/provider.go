@@ -15,22 +15,37 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/cluster/nodepools"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/clustergroup"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/credential"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/custompolicytemplate"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/dataprotection"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/ekscluster"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/gitrepository"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmfeature"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrelease"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/iampolicy"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/inspection"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/kustomization"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/managementcluster"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/namespace"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/packageinstall"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/packagerepository"
+	effectivepolicy "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/effective"
 	custompolicy "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom"
 	custompolicyresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom/resource"
 	imagepolicy "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/image"
 	imagepolicyresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/image/resource"
+	mutationpolicy "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation"
+	mutationpolicyresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation/resource"
 	networkpolicy "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/network"
 	networkpolicyresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/network/resource"
 	quotapolicy "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/quota"
 	quotapolicyresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/quota/resource"
 	securitypolicy "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security"
 	securitypolicyresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security/resource"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/providermetadata"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/provisioner"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/sourcesecret"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzukubernetescluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/tanzupackage"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/workspace"
 )
 
@@ -39,37 +54,66 @@ func Provider() *schema.Provider {
 	return &schema.Provider{
 		Schema: authctx.ProviderAuthSchema(),
 		ResourcesMap: map[string]*schema.Resource{
-			cluster.ResourceName:        cluster.ResourceTMCCluster(),
-			ekscluster.ResourceName:     ekscluster.ResourceTMCEKSCluster(),
-			akscluster.ResourceName:     akscluster.ResourceTMCAKSCluster(),
-			workspace.ResourceName:      workspace.ResourceWorkspace(),
-			namespace.ResourceName:      namespace.ResourceNamespace(),
-			clustergroup.ResourceName:   clustergroup.ResourceClusterGroup(),
-			nodepools.ResourceName:      nodepools.ResourceNodePool(),
-			iampolicy.ResourceName:      iampolicy.ResourceIAMPolicy(),
-			custompolicy.ResourceName:   custompolicyresource.ResourceCustomPolicy(),
-			securitypolicy.ResourceName: securitypolicyresource.ResourceSecurityPolicy(),
-			imagepolicy.ResourceName:    imagepolicyresource.ResourceImagePolicy(),
-			quotapolicy.ResourceName:    quotapolicyresource.ResourceQuotaPolicy(),
-			networkpolicy.ResourceName:  networkpolicyresource.ResourceNetworkPolicy(),
-			credential.ResourceName:     credential.ResourceCredential(),
-			integration.ResourceName:    integration.ResourceIntegration(),
-			gitrepository.ResourceName:  gitrepository.ResourceGitRepository(),
-			kustomization.ResourceName:  kustomization.ResourceKustomization(),
-			sourcesecret.ResourceName:   sourcesecret.ResourceSourceSecret(),
+			cluster.ResourceName:                            cluster.ResourceTMCCluster(),
+			ekscluster.ResourceName:                         ekscluster.ResourceTMCEKSCluster(),
+			ekscluster.NodepoolResourceName:                 ekscluster.ResourceTMCEKSNodepool(),
+			akscluster.ResourceName:                         akscluster.ResourceTMCAKSCluster(),
+			akscluster.NodepoolResourceName:                 akscluster.ResourceTMCAKSNodepool(),
+			workspace.ResourceName:                          workspace.ResourceWorkspace(),
+			namespace.ResourceName:                          namespace.ResourceNamespace(),
+			clustergroup.ResourceName:                       clustergroup.ResourceClusterGroup(),
+			nodepools.ResourceName:                          nodepools.ResourceNodePool(),
+			iampolicy.ResourceName:                          iampolicy.ResourceIAMPolicy(),
+			custompolicy.ResourceName:                       custompolicyresource.ResourceCustomPolicy(),
+			custompolicytemplate.ResourceName:               custompolicytemplate.ResourceCustomPolicyTemplate(),
+			securitypolicy.ResourceName:                     securitypolicyresource.ResourceSecurityPolicy(),
+			imagepolicy.ResourceName:                        imagepolicyresource.ResourceImagePolicy(),
+			quotapolicy.ResourceName:                        quotapolicyresource.ResourceQuotaPolicy(),
+			networkpolicy.ResourceName:                      networkpolicyresource.ResourceNetworkPolicy(),
+			mutationpolicy.ResourceName:                     mutationpolicyresource.ResourceMutationPolicy(),
+			credential.ResourceName:                         credential.ResourceCredential(),
+			integration.ResourceName:                        integration.ResourceIntegration(),
+			gitrepository.ResourceName:                      gitrepository.ResourceGitRepository(),
+			kustomization.ResourceName:                      kustomization.ResourceKustomization(),
+			sourcesecret.ResourceName:                       sourcesecret.ResourceSourceSecret(),
+			dataprotection.EnableDataProtectionResourceName: dataprotection.ResourceEnableDataProtection(),
+			dataprotection.TargetLocationResourceName:       dataprotection.ResourceTargetLocation(),
+			dataprotection.BackupScheduleResourceName:       dataprotection.ResourceBackupSchedule(),
+			dataprotection.RestoreResourceName:              dataprotection.ResourceRestore(),
+			inspection.ResourceName:                         inspection.ResourceInspection(),
+			packagerepository.ResourceName:                  packagerepository.ResourcePackageRepository(),
+			packageinstall.ResourceName:                     packageinstall.ResourcePackageInstall(),
+			helmfeature.ResourceName:                        helmfeature.ResourceHelmFeature(),
+			helmrelease.ResourceName:                        helmrelease.ResourceHelmRelease(),
+			managementcluster.ResourceName:                  managementcluster.ResourceManagementCluster(),
+			provisioner.ResourceName:                        provisioner.ResourceProvisioner(),
+			tanzukubernetescluster.ResourceName:             tanzukubernetescluster.ResourceTanzuKubernetesCluster(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			cluster.ResourceName:       cluster.DataSourceTMCCluster(),
-			ekscluster.ResourceName:    ekscluster.DataSourceTMCEKSCluster(),
-			akscluster.ResourceName:    akscluster.DataSourceTMCAKSCluster(),
-			workspace.ResourceName:     workspace.DataSourceWorkspace(),
-			namespace.ResourceName:     namespace.DataSourceNamespace(),
-			clustergroup.ResourceName:  clustergroup.DataSourceClusterGroup(),
-			nodepools.ResourceName:     nodepools.DataSourceClusterNodePool(),
-			credential.ResourceName:    credential.DataSourceCredential(),
-			integration.ResourceName:   integration.DataSourceIntegration(),
-			gitrepository.ResourceName: gitrepository.DataSourceGitRepository(),
-			sourcesecret.ResourceName:  sourcesecret.DataSourceSourcesecret(),
+			cluster.ResourceName:                               cluster.DataSourceTMCCluster(),
+			cluster.ClustersResourceName:                       cluster.DataSourceTMCClusters(),
+			ekscluster.ResourceName:                            ekscluster.DataSourceTMCEKSCluster(),
+			akscluster.ResourceName:                            akscluster.DataSourceTMCAKSCluster(),
+			workspace.ResourceName:                             workspace.DataSourceWorkspace(),
+			workspace.WorkspacesResourceName:                   workspace.DataSourceWorkspaces(),
+			namespace.ResourceName:                             namespace.DataSourceNamespace(),
+			namespace.NamespacesResourceName:                   namespace.DataSourceNamespaces(),
+			clustergroup.ResourceName:                          clustergroup.DataSourceClusterGroup(),
+			clustergroup.ClusterGroupsResourceName:             clustergroup.DataSourceClusterGroups(),
+			nodepools.ResourceName:                             nodepools.DataSourceClusterNodePool(),
+			credential.ResourceName:                            credential.DataSourceCredential(),
+			integration.ResourceName:                           integration.DataSourceIntegration(),
+			gitrepository.ResourceName:                         gitrepository.DataSourceGitRepository(),
+			sourcesecret.ResourceName:                          sourcesecret.DataSourceSourcesecret(),
+			inspection.ResultsResourceName:                     inspection.DataSourceInspectionResults(),
+			tanzupackage.VersionsResourceName:                  tanzupackage.DataSourcePackageVersions(),
+			effectivepolicy.ResourceName:                       effectivepolicy.DataSourceEffectivePolicy(),
+			managementcluster.ManagementClustersResourceName:   managementcluster.DataSourceManagementClusters(),
+			provisioner.ProvisionersResourceName:               provisioner.DataSourceProvisioners(),
+			providermetadata.EKSKubernetesVersionsResourceName: providermetadata.DataSourceEKSKubernetesVersions(),
+			providermetadata.AKSKubernetesVersionsResourceName: providermetadata.DataSourceAKSKubernetesVersions(),
+			providermetadata.TKGAWSInstanceTypesResourceName:   providermetadata.DataSourceTKGAWSInstanceTypes(),
+			providermetadata.TKGVsphereTKRVersionsResourceName: providermetadata.DataSourceTKGVsphereTKRVersions(),
 		},
 		ConfigureContextFunc: authctx.ProviderConfigureContext,
 	}
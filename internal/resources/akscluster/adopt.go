@@ -0,0 +1,70 @@
+/*
+Copyright 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package akscluster
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	models "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/akscluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+// adoptExistingCluster takes over the AKS cluster which made the create request fail with a conflict. Once the cluster
+// is ready, its config is updated if it differs from the configuration and its nodepools are reconciled like on update.
+func adoptExistingCluster(ctx context.Context, data *schema.ResourceData, tc authctx.TanzuContext) diag.Diagnostics {
+	name := data.Get(NameKey)
+	diags := diag.Diagnostics{common.AdoptedWarning("AKS cluster", name)}
+
+	pollCtx, cancel := context.WithTimeout(ctx, getTimeOut(data, schema.TimeoutCreate))
+	defer cancel()
+
+	if err := pollUntilReady(pollCtx, data, tc.TMCConnection, getPollInterval(ctx)); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	clusterResp, nodepoolResp, err := getClusterAndNodepools(ctx, data, tc.TMCConnection)
+	if err != nil || clusterResp == nil || nodepoolResp == nil {
+		return append(diags, diag.FromErr(errors.Errorf("Unable to get Tanzu Mission Control AKS cluster entry, name : %s", name))...)
+	}
+
+	if clusterSpecMatches(ConstructCluster(data).Spec, clusterResp.AksCluster.Spec) {
+		log.Printf("[INFO] existing AKS cluster %s matches the configuration", name)
+	} else if err := updateClusterConfig(ctx, data, clusterResp, tc); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	if err := handleNodepoolChanges(ctx, nodepoolResp.Nodepools, data, tc.TMCConnection); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, dataSourceTMCAKSClusterRead(ctx, data, tc)...)
+}
+
+// clusterSpecMatches compares the attributes of the cluster spec which are set by the configuration, the config
+// attributes left unset are defaulted by the server and ignored.
+func clusterSpecMatches(desired, existing *models.VmwareTanzuManageV1alpha1AksclusterSpec) bool {
+	if existing == nil {
+		return false
+	}
+
+	if desired.ClusterGroupName != existing.ClusterGroupName || desired.ProxyName != existing.ProxyName {
+		return false
+	}
+
+	if desired.Config == nil {
+		return true
+	}
+
+	return existing.Config != nil && helper.SetValuesMatch(desired.Config, existing.Config)
+}
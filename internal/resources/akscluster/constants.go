@@ -9,6 +9,7 @@ import "time"
 
 const (
 	ResourceName                               = "tanzu-mission-control_akscluster"
+	NodepoolResourceName                       = "tanzu-mission-control_akscluster_nodepool"
 	RetryInterval                              = retryInterval("retry-interval")
 	defaultTimeout                             = 30 * time.Minute
 	defaultInterval                            = 10 * time.Second
@@ -16,9 +17,12 @@ const (
 	SubscriptionIDKey                          = "subscription_id"
 	ResourceGroupNameKey                       = "resource_group"
 	NameKey                                    = "name"
+	ClusterNameKey                             = "cluster_name"
+	statusKey                                  = "status"
 	clusterSpecKey                             = "spec"
 	nodepoolSpecKey                            = "spec"
 	waitKey                                    = "ready_wait_timeout"
+	upgradeModeKey                             = "upgrade_mode"
 	clusterGroupKey                            = "cluster_group"
 	clusterGroupDefaultValue                   = "default"
 	proxyNameKey                               = "proxy"
@@ -27,6 +31,7 @@ const (
 	resourceIDKey                              = "resource_id"
 	nodepoolKey                                = "nodepool"
 	kubernetesVersionKey                       = "kubernetes_version"
+	kubernetesVersionPath                      = "spec.0.config.0.kubernetes_version"
 	locationKey                                = "location"
 	nodeResourceGroupNameKey                   = "node_resource_group_name"
 	diskEncryptionSetKey                       = "disk_encryption_set"
@@ -99,4 +104,10 @@ const (
 	maxSpotPriceKey                            = "spot_max_price"
 	upgradeConfigKey                           = "upgrade_config"
 	maxSurgeKey                                = "max_surge"
+
+	// standaloneNodepoolLabelKey marks nodepools owned by the
+	// tanzu-mission-control_akscluster_nodepool resource, so that the
+	// cluster resource leaves them alone.
+	standaloneNodepoolLabelKey   = "tmc.cloud.vmware.com/terraform-nodepool-resource"
+	standaloneNodepoolLabelValue = "true"
 )
@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	models "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/akscluster"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 )
@@ -26,6 +27,9 @@ func setResourceState(data *schema.ResourceData, cluster *models.VmwareTanzuMana
 		return err
 	}
 
+	// Nodepools owned by the nodepool resource are not part of the cluster resource state.
+	nodepools = clusterManagedNodepools(nodepools)
+
 	sort.Slice(nodepools, func(i, j int) bool { return nodepools[i].FullName.Name < nodepools[j].FullName.Name })
 
 	specMap := toClusterSpecMap(cluster.Spec, nodepools)
@@ -77,18 +81,9 @@ func getErrorReason(conditions map[string]models.VmwareTanzuCoreV1alpha1StatusCo
 	return string(msg)
 }
 
-func getTimeOut(data *schema.ResourceData) time.Duration {
-	timeout, ok := data.GetOk(waitKey)
-	if !ok {
-		return defaultTimeout
-	}
-
-	duration, err := time.ParseDuration(timeout.(string))
-	if err != nil {
-		return defaultTimeout
-	}
-
-	return duration
+// getTimeOut returns the configured ready wait timeout, or the resource timeout for the operation when it is not set.
+func getTimeOut(data *schema.ResourceData, timeoutKey string) time.Duration {
+	return helper.GetWaitTimeout(data, waitKey, timeoutKey)
 }
 
 func getPollInterval(ctx context.Context) time.Duration {
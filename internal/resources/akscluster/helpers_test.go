@@ -190,6 +190,36 @@ func withDNSPrefix(prefix string) mapWither {
 	}
 }
 
+func withKubernetesVersion(version string) mapWither {
+	return func(m map[string]any) {
+		specs := m["spec"].([]any)
+		spec := specs[0].(map[string]any)
+		configs := spec["config"].([]any)
+		config := configs[0].(map[string]any)
+		config["kubernetes_version"] = version
+	}
+}
+
+func withoutNodeResourceGroup(m map[string]any) {
+	specs := m["spec"].([]any)
+	spec := specs[0].(map[string]any)
+	configs := spec["config"].([]any)
+	config := configs[0].(map[string]any)
+	delete(config, "node_resource_group_name")
+}
+
+func withUpgradeMode(mode string) mapWither {
+	return func(m map[string]any) {
+		m["upgrade_mode"] = mode
+	}
+}
+
+func withAdoptExisting(adopt bool) mapWither {
+	return func(m map[string]any) {
+		m["adopt_existing"] = adopt
+	}
+}
+
 func withNodepools(nps []any) mapWither {
 	return func(m map[string]any) {
 		specs := m["spec"].([]any)
@@ -333,6 +363,17 @@ func withUserMode(np *models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool
 	np.Spec.Mode = models.VmwareTanzuManageV1alpha1AksclusterNodepoolModeUSER.Pointer()
 }
 
+func withNodepoolUID(np *models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool) {
+	np.Meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "np-uid"}
+}
+
+func withStandaloneLabel(np *models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool) {
+	np.Meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+		UID:    "np-uid",
+		Labels: map[string]string{"tmc.cloud.vmware.com/terraform-nodepool-resource": "true"},
+	}
+}
+
 func forCluster(c *models.VmwareTanzuManageV1alpha1AksclusterFullName) nodepoolWither {
 	return func(np *models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool) {
 		np.FullName.CredentialName = c.CredentialName
@@ -451,6 +492,7 @@ type mockClusterClient struct {
 	AksClusterResourceServiceDeleteCalledWith *models.VmwareTanzuManageV1alpha1AksclusterFullName
 	AksUpdateClusterWasCalledWith             *models.VmwareTanzuManageV1alpha1AksCluster
 	getClusterByIDResp                        *models.VmwareTanzuManageV1alpha1AksCluster
+	upgradeVersions                           []string
 	AksClusterResourceServiceGetCallCount     int
 	AksCreateClusterWasCalled                 bool
 	createErr                                 error
@@ -480,6 +522,10 @@ func (m *mockClusterClient) AksClusterResourceServiceGetByID(id string) (*models
 	return &models.VmwareTanzuManageV1alpha1AksclusterGetAksClusterResponse{AksCluster: m.getClusterByIDResp}, m.getErr
 }
 
+func (m *mockClusterClient) AksClusterResourceServiceGetUpgradeVersions(_ *models.VmwareTanzuManageV1alpha1AksclusterFullName) (*models.VmwareTanzuManageV1alpha1AksclusterGetUpgradeVersionsResponse, error) {
+	return &models.VmwareTanzuManageV1alpha1AksclusterGetUpgradeVersionsResponse{AvailableUpgradeVersions: m.upgradeVersions}, nil
+}
+
 func (m *mockClusterClient) AksClusterResourceServiceUpdate(ucr *models.VmwareTanzuManageV1alpha1AksclusterUpdateAksClusterRequest) (*models.VmwareTanzuManageV1alpha1AksclusterUpdateAksClusterResponse, error) {
 	m.AksUpdateClusterWasCalledWith = ucr.AksCluster
 
@@ -7,6 +7,8 @@ package akscluster
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,7 +20,9 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/akscluster"
 	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	models "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/akscluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 )
 
 func ResourceTMCAKSCluster() *schema.Resource {
@@ -31,6 +35,11 @@ func ResourceTMCAKSCluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceClusterImporter,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		Description: "Tanzu Mission Control AKS Cluster Resource",
 	}
 }
@@ -47,22 +56,29 @@ func resourceClusterCreate(ctx context.Context, data *schema.ResourceData, confi
 		return diag.FromErr(err)
 	}
 
-	if err := createOrUpdateCluster(data, tc.TMCConnection.AKSClusterResourceService); err != nil {
+	adopted, err := createOrAdoptCluster(data, tc.TMCConnection.AKSClusterResourceService)
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	diags := userNodepoolWarnings(nodepools)
+
+	if adopted {
+		return append(diags, adoptExistingCluster(ctx, data, tc)...)
+	}
+
 	if err := createNodepools(ctx, nodepools, tc.TMCConnection.AKSNodePoolResourceService); err != nil {
 		return diag.FromErr(err)
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, getTimeOut(data))
+	ctx, cancel := context.WithTimeout(ctx, getTimeOut(data, schema.TimeoutCreate))
 	defer cancel()
 
 	if err := pollUntilReady(ctx, data, tc.TMCConnection, getPollInterval(ctx)); err != nil {
 		return diag.FromErr(err)
 	}
 
-	return dataSourceTMCAKSClusterRead(ctx, data, tc)
+	return append(diags, dataSourceTMCAKSClusterRead(ctx, data, tc)...)
 }
 
 // resourceClusterRead read state of existing AKS cluster and assigned nodepools.
@@ -83,8 +99,20 @@ func resourceClusterInPlaceUpdate(ctx context.Context, data *schema.ResourceData
 		return diag.FromErr(errors.Errorf("Unable to get Tanzu Mission Control AKS cluster entry, name : %s", data.Get(NameKey)))
 	}
 
-	// Make changes to the cluster config.
-	if clusterChange := data.HasChange("spec.0.config.0"); clusterChange {
+	var diags diag.Diagnostics
+
+	// Make changes to the cluster config, upgrading the control plane and nodepools step by step if requested.
+	if isOrchestratedUpgrade(data) {
+		if diags = upgradeKubernetesVersion(ctx, data, clusterResp, nodepoolResp.Nodepools, tc); diags.HasError() {
+			return diags
+		}
+
+		// The upgrade has rolled out mutable nodepool changes already.
+		_, nodepoolResp, getErr = getClusterAndNodepools(ctx, data, tc.TMCConnection)
+		if getErr != nil || nodepoolResp == nil {
+			return append(diags, diag.FromErr(errors.Errorf("Unable to get Tanzu Mission Control AKS nodepools for cluster %s", data.Get(NameKey)))...)
+		}
+	} else if clusterChange := data.HasChange("spec.0.config.0"); clusterChange {
 		if updateErr := updateClusterConfig(ctx, data, clusterResp, tc); updateErr != nil {
 			return diag.FromErr(updateErr)
 		}
@@ -92,12 +120,14 @@ func resourceClusterInPlaceUpdate(ctx context.Context, data *schema.ResourceData
 
 	// Make changes to cluster nodepools.
 	if data.HasChange("spec.0.nodepool") {
+		diags = append(diags, userNodepoolWarnings(ConstructNodepools(data))...)
+
 		if npChangeErr := handleNodepoolChanges(ctx, nodepoolResp.Nodepools, data, tc.TMCConnection); npChangeErr != nil {
-			return diag.FromErr(npChangeErr)
+			return append(diags, diag.FromErr(npChangeErr)...)
 		}
 	}
 
-	return dataSourceTMCAKSClusterRead(ctx, data, config)
+	return append(diags, dataSourceTMCAKSClusterRead(ctx, data, config)...)
 }
 
 // resourceClusterDelete deletes an AKS cluster and all associated node pools.
@@ -109,10 +139,10 @@ func resourceClusterDelete(ctx context.Context, data *schema.ResourceData, confi
 
 	fn := extractClusterFullName(data)
 	if err := tc.TMCConnection.AKSClusterResourceService.AksClusterResourceServiceDelete(fn, "false"); err != nil && !clienterrors.IsNotFoundError(err) {
-		return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control AKS cluster entry, name : %s", data.Get(NameKey)))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control AKS cluster entry, name : %s", data.Get(NameKey)))
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, getTimeOut(data))
+	ctx, cancel := context.WithTimeout(ctx, getTimeOut(data, schema.TimeoutDelete))
 	defer cancel()
 
 	if err := pollUntilClusterDeleted(ctx, data, tc.TMCConnection.AKSClusterResourceService, getPollInterval(ctx)); err != nil {
@@ -135,7 +165,7 @@ func resourceClusterImporter(_ context.Context, data *schema.ResourceData, confi
 		return nil, errors.New("ID is needed to import an TMC AKS cluster")
 	}
 
-	resp, err := tc.TMCConnection.AKSClusterResourceService.AksClusterResourceServiceGetByID(id)
+	resp, err := getClusterForImport(tc, id)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Unable to get Tanzu Mission Control AKS cluster entry for id %s", id)
 	}
@@ -168,6 +198,26 @@ func resourceClusterImporter(_ context.Context, data *schema.ResourceData, confi
 	return []*schema.ResourceData{data}, nil
 }
 
+// getClusterForImport looks up the cluster either by its UID or by a full name of the form
+// <credential_name>/<subscription_id>/<resource_group>/<name>.
+func getClusterForImport(tc authctx.TanzuContext, id string) (*models.VmwareTanzuManageV1alpha1AksclusterGetAksClusterResponse, error) {
+	if !strings.Contains(id, helper.ImportIDSeparator) {
+		return tc.TMCConnection.AKSClusterResourceService.AksClusterResourceServiceGetByID(id)
+	}
+
+	parts, err := helper.ParseImportID(id, CredentialNameKey, SubscriptionIDKey, ResourceGroupNameKey, NameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return tc.TMCConnection.AKSClusterResourceService.AksClusterResourceServiceGet(&models.VmwareTanzuManageV1alpha1AksclusterFullName{
+		CredentialName:    parts[0],
+		SubscriptionID:    parts[1],
+		ResourceGroupName: parts[2],
+		Name:              parts[3],
+	})
+}
+
 // validate returns an error configuration will result in a cluster that will fail to create.
 func validate(nodepools []*models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool) error {
 	for _, n := range nodepools {
@@ -179,28 +229,53 @@ func validate(nodepools []*models.VmwareTanzuManageV1alpha1AksclusterNodepoolNod
 	return errors.New("AKS cluster must contain at least 1 SYSTEM nodepool")
 }
 
-// createOrUpdateCluster creates an AKS cluster in TMC.  It is possible the cluster already exists in which case the
-// existing cluster is updated with any node pools defined in the configuration.
-func createOrUpdateCluster(data *schema.ResourceData, client akscluster.ClientService) error {
+// userNodepoolWarnings warns about USER nodepools defined in the cluster resource, which is meant to manage only the
+// SYSTEM nodepools of the cluster.
+func userNodepoolWarnings(nodepools []*models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, n := range nodepools {
+		if n.Spec.Mode == nil || *n.Spec.Mode != models.VmwareTanzuManageV1alpha1AksclusterNodepoolModeUSER {
+			continue
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("USER nodepool %s is defined in the cluster resource", n.FullName.Name),
+			Detail: fmt.Sprintf("The %s resource should only define the SYSTEM nodepools of the cluster, "+
+				"manage USER nodepools with the %s resource instead.", ResourceName, NodepoolResourceName),
+		})
+	}
+
+	return diags
+}
+
+// createOrAdoptCluster creates an AKS cluster in TMC.  It is possible the cluster already exists in which case the
+// existing cluster is adopted when the resource is configured to, and reported as adopted.
+func createOrAdoptCluster(data *schema.ResourceData, client akscluster.ClientService) (adopted bool, err error) {
 	cluster := ConstructCluster(data)
 	clusterReq := &models.VmwareTanzuManageV1alpha1AksclusterCreateAksClusterRequest{AksCluster: cluster}
 	createResp, err := client.AksClusterResourceServiceCreate(clusterReq)
 
 	if clienterrors.IsAlreadyExistsError(err) {
+		if !common.ShouldAdoptExisting(data) {
+			return false, common.AlreadyExistsError(err, "AKS cluster", data.Get(NameKey))
+		}
+
 		if getErr := getExistingCluster(data, client, clusterReq); getErr != nil {
-			return errors.Wrapf(getErr, "Failed to created cluster do to conflict but conflicting cluster not found")
+			return false, errors.Wrapf(getErr, "Failed to created cluster do to conflict but conflicting cluster not found")
 		}
 
-		return nil
+		return true, nil
 	}
 
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	data.SetId(createResp.AksCluster.Meta.UID)
 
-	return nil
+	return false, nil
 }
 
 func getExistingCluster(data *schema.ResourceData, client akscluster.ClientService, clusterReq *models.VmwareTanzuManageV1alpha1AksclusterCreateAksClusterRequest) error {
@@ -223,7 +298,7 @@ func updateClusterConfig(ctx context.Context, data *schema.ResourceData, cluster
 		return errors.Wrapf(updateErr, "Unable to update Tanzu Mission Control AKS cluster entry, name : %s", data.Get(NameKey))
 	}
 
-	ctxTimeout, cancel := context.WithTimeout(ctx, getTimeOut(data))
+	ctxTimeout, cancel := context.WithTimeout(ctx, getTimeOut(data, schema.TimeoutUpdate))
 	defer cancel()
 
 	return pollUntilReady(ctxTimeout, data, tc.TMCConnection, getPollInterval(ctx))
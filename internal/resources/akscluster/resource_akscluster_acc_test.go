@@ -563,6 +563,10 @@ func (m *mockClusterService) AksClusterResourceServiceGetByID(id string) (*aksmo
 	panic("not implemented")
 }
 
+func (m *mockClusterService) AksClusterResourceServiceGetUpgradeVersions(fn *aksmodel.VmwareTanzuManageV1alpha1AksclusterFullName) (*aksmodel.VmwareTanzuManageV1alpha1AksclusterGetUpgradeVersionsResponse, error) {
+	panic("not implemented")
+}
+
 func (m *mockClusterService) AksClusterResourceServiceUpdate(request *aksmodel.VmwareTanzuManageV1alpha1AksclusterUpdateAksClusterRequest) (*aksmodel.VmwareTanzuManageV1alpha1AksclusterUpdateAksClusterResponse, error) {
 	resp := m.updateResponse[m.updateCall]
 	m.updateCall += 1
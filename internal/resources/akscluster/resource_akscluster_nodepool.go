@@ -0,0 +1,346 @@
+/*
+Copyright 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package akscluster
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	models "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/akscluster"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+func ResourceTMCAKSNodepool() *schema.Resource {
+	return &schema.Resource{
+		Schema:        NodepoolResourceSchema,
+		CreateContext: resourceNodepoolCreate,
+		ReadContext:   resourceNodepoolRead,
+		UpdateContext: resourceNodepoolInPlaceUpdate,
+		DeleteContext: resourceNodepoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNodepoolImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
+		Description: "Tanzu Mission Control AKS Cluster Nodepool Resource",
+	}
+}
+
+var NodepoolResourceSchema = map[string]*schema.Schema{
+	CredentialNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the Azure Credential in Tanzu Mission Control",
+		Required:    true,
+		ForceNew:    true,
+	},
+	SubscriptionIDKey: {
+		Type:        schema.TypeString,
+		Description: "Azure Subscription of the cluster",
+		Required:    true,
+		ForceNew:    true,
+	},
+	ResourceGroupNameKey: {
+		Type:        schema.TypeString,
+		Description: "Resource group of the cluster",
+		Required:    true,
+		ForceNew:    true,
+	},
+	ClusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the AKS cluster this nodepool belongs to",
+		Required:    true,
+		ForceNew:    true,
+	},
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of this nodepool",
+		Required:    true,
+		ForceNew:    true,
+	},
+	common.MetaKey:  common.Meta,
+	nodepoolSpecKey: NodepoolSpecSchema,
+	statusKey: {
+		Type:        schema.TypeMap,
+		Description: "Status of the nodepool",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	waitKey: {
+		Type:        schema.TypeString,
+		Description: "Wait timeout duration until nodepool resource reaches READY state. Accepted timeout duration values like 5s, 45m, or 3h, higher than zero. When not set, the timeouts of the resource apply, 30m by default.",
+		Default:     helper.DefaultWaitTimeoutValue,
+		Optional:    true,
+	},
+}
+
+// resourceNodepoolCreate creates a USER nodepool on an existing AKS cluster and waits for it to be ready.
+func resourceNodepoolCreate(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	tc, ok := config.(authctx.TanzuContext)
+	if !ok {
+		return diag.Errorf("error while retrieving Tanzu auth config")
+	}
+
+	nodepool := constructStandaloneNodepool(data)
+	if err := validateStandaloneNodepool(nodepool); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := addNodepool(ctx, nodepool, tc.TMCConnection, getTimeOut(data, schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control AKS nodepool entry, name : %s", nodepool.FullName.Name))
+	}
+
+	return resourceNodepoolRead(ctx, data, config)
+}
+
+// resourceNodepoolRead reads the state of an AKS nodepool.
+func resourceNodepoolRead(_ context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	tc, ok := config.(authctx.TanzuContext)
+	if !ok {
+		return diag.Errorf("error while retrieving Tanzu auth config")
+	}
+
+	fn := extractNodepoolFullName(data)
+
+	resp, err := tc.TMCConnection.AKSNodePoolResourceService.AksNodePoolResourceServiceGet(fn)
+	if clienterrors.IsNotFoundError(err) {
+		_ = schema.RemoveFromState(data, nil)
+		return diag.Diagnostics{}
+	}
+
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control AKS nodepool entry, name : %s", fn.Name))
+	}
+
+	if stateErr := setNodepoolResourceState(data, resp.Nodepool); stateErr != nil {
+		return diag.FromErr(stateErr)
+	}
+
+	return diag.Diagnostics{}
+}
+
+// resourceNodepoolInPlaceUpdate updates the nodepool in place, or deletes and recreates it when an immutable field
+// of the spec changed.
+func resourceNodepoolInPlaceUpdate(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	tc, ok := config.(authctx.TanzuContext)
+	if !ok {
+		return diag.Errorf("error while retrieving Tanzu auth config")
+	}
+
+	nodepool := constructStandaloneNodepool(data)
+	if err := validateStandaloneNodepool(nodepool); err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := tc.TMCConnection.AKSNodePoolResourceService.AksNodePoolResourceServiceGet(nodepool.FullName)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control AKS nodepool entry, name : %s", nodepool.FullName.Name))
+	}
+
+	existing := resp.Nodepool
+	timeout := getTimeOut(data, schema.TimeoutUpdate)
+
+	if hasImmutableChange(nodepool, existing) {
+		if err := deleteAndRecreateNodepool(ctx, existing, tc.TMCConnection, timeout, nodepool); err != nil {
+			return diag.FromErr(errors.Wrapf(err, "Unable to recreate Tanzu Mission Control AKS nodepool entry, name : %s", nodepool.FullName.Name))
+		}
+
+		return resourceNodepoolRead(ctx, data, config)
+	}
+
+	if existing.Meta != nil {
+		meta := *existing.Meta
+		meta.Description = nodepool.Meta.Description
+		meta.Labels = nodepool.Meta.Labels
+
+		if value, ok := existing.Meta.Labels[common.CreatorLabelKey]; ok {
+			meta.Labels[common.CreatorLabelKey] = value
+		}
+
+		nodepool.Meta = &meta
+	}
+
+	if err := updateNodepool(ctx, nodepool, tc.TMCConnection, timeout); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control AKS nodepool entry, name : %s", nodepool.FullName.Name))
+	}
+
+	return resourceNodepoolRead(ctx, data, config)
+}
+
+// resourceNodepoolDelete deletes an AKS nodepool and waits until it is removed.
+func resourceNodepoolDelete(ctx context.Context, data *schema.ResourceData, config any) diag.Diagnostics {
+	tc, ok := config.(authctx.TanzuContext)
+	if !ok {
+		return diag.Errorf("error while retrieving Tanzu auth config")
+	}
+
+	nodepool := &models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool{FullName: extractNodepoolFullName(data)}
+
+	if err := deleteNodepool(ctx, nodepool, tc.TMCConnection, getTimeOut(data, schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control AKS nodepool entry, name : %s", nodepool.FullName.Name))
+	}
+
+	data.SetId("") // explicitly delete
+
+	return diag.Diagnostics{}
+}
+
+// resourceNodepoolImporter imports a nodepool using an ID of the form
+// <credential_name>/<subscription_id>/<resource_group>/<cluster_name>/<name>.
+func resourceNodepoolImporter(ctx context.Context, data *schema.ResourceData, config any) ([]*schema.ResourceData, error) {
+	keys := []string{CredentialNameKey, SubscriptionIDKey, ResourceGroupNameKey, ClusterNameKey, NameKey}
+
+	parts, err := helper.ParseImportID(data.Id(), keys...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, key := range keys {
+		if err = data.Set(key, parts[i]); err != nil {
+			return nil, errors.Wrapf(err, "Failed to set %s for the nodepool %s", key, data.Id())
+		}
+	}
+
+	tc, ok := config.(authctx.TanzuContext)
+	if !ok {
+		return nil, errors.New("error while retrieving Tanzu auth config")
+	}
+
+	// The nodepool may have been created inline in the cluster resource or outside of Terraform, label it so that the
+	// cluster resource no longer manages or deletes it.
+	if err = labelStandaloneNodepool(extractNodepoolFullName(data), tc.TMCConnection); err != nil {
+		return nil, err
+	}
+
+	return helper.ReadImportedState(ctx, data, config, resourceNodepoolRead)
+}
+
+// labelStandaloneNodepool marks an existing USER nodepool as owned by the tanzu-mission-control_akscluster_nodepool
+// resource.
+func labelStandaloneNodepool(fn *models.VmwareTanzuManageV1alpha1AksclusterNodepoolFullName, tc *client.TanzuMissionControl) error {
+	resp, err := tc.AKSNodePoolResourceService.AksNodePoolResourceServiceGet(fn)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get Tanzu Mission Control AKS nodepool entry, name : %s", fn.Name)
+	}
+
+	nodepool := resp.Nodepool
+	if err = validateStandaloneNodepool(nodepool); err != nil {
+		return err
+	}
+
+	if isStandaloneNodepool(nodepool) {
+		return nil
+	}
+
+	if nodepool.Meta == nil {
+		nodepool.Meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}
+	}
+
+	if nodepool.Meta.Labels == nil {
+		nodepool.Meta.Labels = map[string]string{}
+	}
+
+	nodepool.Meta.Labels[standaloneNodepoolLabelKey] = standaloneNodepoolLabelValue
+
+	req := &models.VmwareTanzuManageV1alpha1AksclusterNodepoolUpdateNodepoolRequest{Nodepool: nodepool}
+	if _, err = tc.AKSNodePoolResourceService.AksNodePoolResourceServiceUpdate(req); err != nil {
+		return errors.Wrapf(err, "Unable to label Tanzu Mission Control AKS nodepool entry, name : %s", fn.Name)
+	}
+
+	return nil
+}
+
+// validateStandaloneNodepool returns an error when the nodepool is not a USER nodepool, SYSTEM nodepools are managed
+// by the cluster resource.
+func validateStandaloneNodepool(nodepool *models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool) error {
+	if nodepool.Spec == nil || nodepool.Spec.Mode == nil || *nodepool.Spec.Mode != models.VmwareTanzuManageV1alpha1AksclusterNodepoolModeUSER {
+		return errors.Errorf("nodepool %s must be a USER nodepool, SYSTEM nodepools are managed by the %s resource", nodepool.FullName.Name, ResourceName)
+	}
+
+	return nil
+}
+
+// isStandaloneNodepool reports whether the nodepool is owned by the tanzu-mission-control_akscluster_nodepool resource.
+func isStandaloneNodepool(np *models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool) bool {
+	return np != nil && np.Meta != nil && np.Meta.Labels[standaloneNodepoolLabelKey] == standaloneNodepoolLabelValue
+}
+
+// clusterManagedNodepools filters out the nodepools owned by the tanzu-mission-control_akscluster_nodepool resource.
+func clusterManagedNodepools(nodepools []*models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool) []*models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool {
+	managed := make([]*models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool, 0, len(nodepools))
+
+	for _, np := range nodepools {
+		if !isStandaloneNodepool(np) {
+			managed = append(managed, np)
+		}
+	}
+
+	return managed
+}
+
+func constructStandaloneNodepool(data *schema.ResourceData) *models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool {
+	meta := common.ConstructMeta(data)
+	meta.Labels[standaloneNodepoolLabelKey] = standaloneNodepoolLabelValue
+
+	return &models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool{
+		FullName: extractNodepoolFullName(data),
+		Meta:     meta,
+		Spec:     constructNodepoolSpec(map[string]any{nodepoolSpecKey: data.Get(nodepoolSpecKey)}),
+	}
+}
+
+func extractNodepoolFullName(data *schema.ResourceData) *models.VmwareTanzuManageV1alpha1AksclusterNodepoolFullName {
+	fn := &models.VmwareTanzuManageV1alpha1AksclusterNodepoolFullName{}
+	fn.CredentialName, _ = data.Get(CredentialNameKey).(string)
+	fn.SubscriptionID, _ = data.Get(SubscriptionIDKey).(string)
+	fn.ResourceGroupName, _ = data.Get(ResourceGroupNameKey).(string)
+	fn.AksClusterName, _ = data.Get(ClusterNameKey).(string)
+	fn.Name, _ = data.Get(NameKey).(string)
+
+	return fn
+}
+
+func setNodepoolResourceState(data *schema.ResourceData, nodepool *models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool) error {
+	data.SetId(nodepool.Meta.UID)
+
+	if err := data.Set(common.MetaKey, common.FlattenMeta(nodepool.Meta)); err != nil {
+		return err
+	}
+
+	if err := data.Set(nodepoolSpecKey, toNodepoolSpecMap(nodepool.Spec)); err != nil {
+		return err
+	}
+
+	return data.Set(statusKey, toNodepoolStatusMap(nodepool.Status))
+}
+
+func toNodepoolStatusMap(status *models.VmwareTanzuManageV1alpha1AksclusterNodepoolStatus) map[string]any {
+	data := make(map[string]any)
+	if status == nil {
+		return data
+	}
+
+	data["phase"] = helper.PtrString(status.Phase)
+
+	if c, ok := status.Conditions["Ready"]; ok {
+		data["ready_status"] = helper.PtrString(c.Status)
+		data["ready_reason"] = c.Reason
+		data["ready_message"] = c.Message
+	}
+
+	return data
+}
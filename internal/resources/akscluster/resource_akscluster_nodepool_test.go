@@ -0,0 +1,175 @@
+/*
+Copyright 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package akscluster_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	models "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/akscluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/akscluster"
+)
+
+func TestAKSNodepoolResource(t *testing.T) {
+	suite.Run(t, &NodepoolResourceTestSuite{})
+}
+
+type NodepoolResourceTestSuite struct {
+	suite.Suite
+	ctx              context.Context
+	mocks            mocks
+	nodepoolResource *schema.Resource
+	config           authctx.TanzuContext
+}
+
+func (s *NodepoolResourceTestSuite) SetupTest() {
+	s.mocks.nodepoolClient = &mockNodepoolClient{
+		nodepoolGetResp: aTestNodePool(forCluster(aTestCluster().FullName), withNodepoolName("user-np"), withUserMode, withStandaloneLabel, withNodepoolStatusSuccess),
+	}
+	s.config = authctx.TanzuContext{
+		TMCConnection: &client.TanzuMissionControl{
+			AKSNodePoolResourceService: s.mocks.nodepoolClient,
+		},
+	}
+	s.nodepoolResource = akscluster.ResourceTMCAKSNodepool()
+	s.ctx = context.WithValue(context.Background(), akscluster.RetryInterval, 10*time.Millisecond)
+}
+
+func (s *NodepoolResourceTestSuite) Test_resourceNodepoolCreate() {
+	d := schema.TestResourceDataRaw(s.T(), akscluster.NodepoolResourceSchema, aTestStandaloneNodepoolDataMap())
+
+	result := s.nodepoolResource.CreateContext(s.ctx, d, s.config)
+
+	s.Assert().False(result.HasError())
+	created := s.mocks.nodepoolClient.CreateNodepoolWasCalledWith
+	s.Assert().Equal(aTestNodePool(forCluster(aTestCluster().FullName), withNodepoolName("user-np"), withUserMode).FullName, created.FullName)
+	s.Assert().Equal("true", created.Meta.Labels["tmc.cloud.vmware.com/terraform-nodepool-resource"])
+	s.Assert().Equal(models.VmwareTanzuManageV1alpha1AksclusterNodepoolModeUSER.Pointer(), created.Spec.Mode)
+	s.Assert().Equal("np-uid", d.Id())
+	s.Assert().Equal("READY", d.Get("status.phase"))
+}
+
+func (s *NodepoolResourceTestSuite) Test_resourceNodepoolCreate_systemNodepool() {
+	d := schema.TestResourceDataRaw(s.T(), akscluster.NodepoolResourceSchema, aTestStandaloneNodepoolDataMap(withNodepoolMode("SYSTEM")))
+
+	result := s.nodepoolResource.CreateContext(s.ctx, d, s.config)
+
+	s.Assert().True(result.HasError())
+	s.Assert().Nil(s.mocks.nodepoolClient.CreateNodepoolWasCalledWith)
+}
+
+func (s *NodepoolResourceTestSuite) Test_resourceNodepoolRead_notFound() {
+	s.mocks.nodepoolClient.getErr = clienterrors.ErrorWithHTTPCode(http.StatusNotFound, nil)
+	d := schema.TestResourceDataRaw(s.T(), akscluster.NodepoolResourceSchema, aTestStandaloneNodepoolDataMap())
+	d.SetId("np-uid")
+
+	result := s.nodepoolResource.ReadContext(s.ctx, d, s.config)
+
+	s.Assert().False(result.HasError())
+	s.Assert().Empty(d.Id())
+}
+
+func (s *NodepoolResourceTestSuite) Test_resourceNodepoolUpdate() {
+	d := schema.TestResourceDataRaw(s.T(), akscluster.NodepoolResourceSchema, aTestStandaloneNodepoolDataMap(withNodepoolCount(5)))
+
+	result := s.nodepoolResource.UpdateContext(s.ctx, d, s.config)
+
+	s.Assert().False(result.HasError())
+	s.Assert().Nil(s.mocks.nodepoolClient.DeleteNodepoolWasCalledWith)
+	s.Assert().Equal(int32(5), s.mocks.nodepoolClient.UpdatedNodepoolWasCalledWith.Spec.Count)
+	s.Assert().Equal("np-uid", s.mocks.nodepoolClient.UpdatedNodepoolWasCalledWith.Meta.UID)
+}
+
+func (s *NodepoolResourceTestSuite) Test_resourceNodepoolUpdate_immutableChange_recreate() {
+	d := schema.TestResourceDataRaw(s.T(), akscluster.NodepoolResourceSchema, aTestStandaloneNodepoolDataMap(withNodepoolVMSize("STANDARD_NC6"), with5msTimeout))
+
+	result := s.nodepoolResource.UpdateContext(s.ctx, d, s.config)
+
+	s.Assert().True(result.HasError(), "the mock keeps returning the deleted nodepool")
+	s.Assert().Equal("user-np", s.mocks.nodepoolClient.DeleteNodepoolWasCalledWith.Name)
+	s.Assert().Nil(s.mocks.nodepoolClient.UpdatedNodepoolWasCalledWith)
+}
+
+func (s *NodepoolResourceTestSuite) Test_resourceNodepoolDelete() {
+	s.mocks.nodepoolClient.getErr = clienterrors.ErrorWithHTTPCode(http.StatusNotFound, nil)
+	d := schema.TestResourceDataRaw(s.T(), akscluster.NodepoolResourceSchema, aTestStandaloneNodepoolDataMap())
+	d.SetId("np-uid")
+
+	result := s.nodepoolResource.DeleteContext(s.ctx, d, s.config)
+
+	s.Assert().False(result.HasError())
+	s.Assert().Equal("user-np", s.mocks.nodepoolClient.DeleteNodepoolWasCalledWith.Name)
+	s.Assert().Empty(d.Id())
+}
+
+func (s *NodepoolResourceTestSuite) Test_resourceNodepoolImport() {
+	d := schema.TestResourceDataRaw(s.T(), akscluster.NodepoolResourceSchema, nil)
+	d.SetId("test-cred/sub-id/resource-group/test-cluster/user-np")
+
+	result, err := s.nodepoolResource.Importer.StateContext(s.ctx, d, s.config)
+
+	s.Assert().NoError(err)
+	s.Assert().Len(result, 1)
+	s.Assert().Equal("test-cluster", result[0].Get(akscluster.ClusterNameKey))
+	s.Assert().Equal("user-np", s.mocks.nodepoolClient.GetNodepoolCalledWith.Name)
+	s.Assert().Equal("USER", result[0].Get("spec.0.mode"))
+	s.Assert().Nil(s.mocks.nodepoolClient.UpdatedNodepoolWasCalledWith, "the nodepool is labelled already")
+}
+
+func (s *NodepoolResourceTestSuite) Test_resourceNodepoolImport_labelsNodepool() {
+	s.mocks.nodepoolClient.nodepoolGetResp = aTestNodePool(forCluster(aTestCluster().FullName), withNodepoolName("user-np"), withUserMode, withNodepoolUID, withNodepoolStatusSuccess)
+	d := schema.TestResourceDataRaw(s.T(), akscluster.NodepoolResourceSchema, nil)
+	d.SetId("test-cred/sub-id/resource-group/test-cluster/user-np")
+
+	_, err := s.nodepoolResource.Importer.StateContext(s.ctx, d, s.config)
+
+	s.Assert().NoError(err)
+	s.Assert().Equal("user-np", s.mocks.nodepoolClient.UpdatedNodepoolWasCalledWith.FullName.Name)
+	s.Assert().Equal("true", s.mocks.nodepoolClient.UpdatedNodepoolWasCalledWith.Meta.Labels["tmc.cloud.vmware.com/terraform-nodepool-resource"])
+}
+
+func (s *NodepoolResourceTestSuite) Test_resourceNodepoolImport_systemNodepool() {
+	s.mocks.nodepoolClient.nodepoolGetResp = aTestNodePool(forCluster(aTestCluster().FullName), withNodepoolStatusSuccess)
+	d := schema.TestResourceDataRaw(s.T(), akscluster.NodepoolResourceSchema, nil)
+	d.SetId("test-cred/sub-id/resource-group/test-cluster/system-np")
+
+	_, err := s.nodepoolResource.Importer.StateContext(s.ctx, d, s.config)
+
+	s.Assert().Error(err)
+	s.Assert().Nil(s.mocks.nodepoolClient.UpdatedNodepoolWasCalledWith)
+}
+
+func (s *NodepoolResourceTestSuite) Test_resourceNodepoolImport_invalidID() {
+	d := schema.TestResourceDataRaw(s.T(), akscluster.NodepoolResourceSchema, nil)
+	d.SetId("test-cluster/user-np")
+
+	_, err := s.nodepoolResource.Importer.StateContext(s.ctx, d, s.config)
+
+	s.Assert().Error(err)
+}
+
+func aTestStandaloneNodepoolDataMap(w ...mapWither) map[string]any {
+	m := aTestNodepoolDataMap(withName("user-np"), withNodepoolMode("USER"))
+	m["credential_name"] = "test-cred"
+	m["subscription_id"] = "sub-id"
+	m["resource_group"] = "resource-group"
+	m["cluster_name"] = "test-cluster"
+
+	for _, f := range w {
+		f(m)
+	}
+
+	return m
+}
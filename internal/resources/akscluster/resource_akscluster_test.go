@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/pkg/errors"
@@ -132,6 +133,55 @@ func (s *CreatClusterTestSuite) Test_resourceClusterCreate_ClusterCreate_already
 	s.Assert().Equal("test-uid", d.Id())
 }
 
+func (s *CreatClusterTestSuite) Test_resourceClusterCreate_ClusterCreate_alreadyExists_adoptsMatchingCluster() {
+	s.mocks.clusterClient.createErr = clienterrors.ErrorWithHTTPCode(http.StatusConflict, nil)
+	s.mocks.nodepoolClient.nodepoolListResp = []*models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool{aTestNodePool(forCluster(aTestCluster().FullName))}
+	d := schema.TestResourceDataRaw(s.T(), akscluster.ClusterSchema, aTestClusterDataMap())
+
+	result := s.aksClusterResource.CreateContext(s.ctx, d, s.config)
+
+	s.Assert().False(result.HasError())
+	s.Assert().Len(result, 1, "expected only the adoption warning")
+	s.Assert().Nil(s.mocks.clusterClient.AksUpdateClusterWasCalledWith, "matching cluster should not be updated")
+	s.Assert().Nil(s.mocks.nodepoolClient.CreateNodepoolWasCalledWith, "existing nodepool should not be created")
+	s.Assert().Equal("test-uid", d.Id())
+}
+
+func (s *CreatClusterTestSuite) Test_resourceClusterCreate_ClusterCreate_alreadyExists_ignoresServerDefaults() {
+	s.mocks.clusterClient.createErr = clienterrors.ErrorWithHTTPCode(http.StatusConflict, nil)
+	s.mocks.nodepoolClient.nodepoolListResp = []*models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool{aTestNodePool(forCluster(aTestCluster().FullName))}
+	d := schema.TestResourceDataRaw(s.T(), akscluster.ClusterSchema, aTestClusterDataMap(withoutNodeResourceGroup))
+
+	result := s.aksClusterResource.CreateContext(s.ctx, d, s.config)
+
+	s.Assert().False(result.HasError())
+	s.Assert().Nil(s.mocks.clusterClient.AksUpdateClusterWasCalledWith, "node resource group defaulted by the server should not update the cluster")
+	s.Assert().Equal("test-uid", d.Id())
+}
+
+func (s *CreatClusterTestSuite) Test_resourceClusterCreate_ClusterCreate_alreadyExists_updatesCluster() {
+	s.mocks.clusterClient.createErr = clienterrors.ErrorWithHTTPCode(http.StatusConflict, nil)
+	d := schema.TestResourceDataRaw(s.T(), akscluster.ClusterSchema, aTestClusterDataMap(withKubernetesVersion("1.27.3")))
+
+	result := s.aksClusterResource.CreateContext(s.ctx, d, s.config)
+
+	s.Assert().False(result.HasError())
+	s.Assert().Equal("1.27.3", s.mocks.clusterClient.AksUpdateClusterWasCalledWith.Spec.Config.Version)
+	s.Assert().Equal("test-uid", d.Id())
+}
+
+func (s *CreatClusterTestSuite) Test_resourceClusterCreate_ClusterCreate_alreadyExists_adoptDisabled() {
+	s.mocks.clusterClient.createErr = clienterrors.ErrorWithHTTPCode(http.StatusConflict, nil)
+	d := schema.TestResourceDataRaw(s.T(), akscluster.ClusterSchema, aTestClusterDataMap(withAdoptExisting(false)))
+
+	result := s.aksClusterResource.CreateContext(s.ctx, d, s.config)
+
+	s.Assert().True(result.HasError())
+	s.Assert().Contains(result[0].Summary, "adopt_existing")
+	s.Assert().Nil(s.mocks.nodepoolClient.CreateNodepoolWasCalledWith)
+	s.Assert().Empty(d.Id())
+}
+
 func (s *CreatClusterTestSuite) Test_resourceClusterCreate_ClusterCreate_alreadyExists_but_notFound() {
 	s.mocks.clusterClient.createErr = clienterrors.ErrorWithHTTPCode(http.StatusConflict, nil)
 	s.mocks.clusterClient.getErr = clienterrors.ErrorWithHTTPCode(http.StatusNotFound, nil)
@@ -223,6 +273,20 @@ func (s *ReadClusterTestSuite) Test_resourceClusterRead() {
 	s.Assert().NotNil(d.Get("spec"), "expected cluster spec from REST request")
 }
 
+func (s *ReadClusterTestSuite) Test_resourceClusterRead_ignoresStandaloneNodepools() {
+	s.mocks.nodepoolClient.nodepoolListResp = []*models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool{
+		aTestNodePool(),
+		aTestNodePool(withNodepoolName("user-np"), withUserMode, withStandaloneLabel),
+	}
+	d := schema.TestResourceDataRaw(s.T(), akscluster.ClusterSchema, aTestClusterDataMap())
+
+	result := s.aksClusterResource.ReadContext(s.ctx, d, s.config)
+
+	s.Assert().False(result.HasError())
+	s.Assert().Len(d.Get("spec.0.nodepool"), 1)
+	s.Assert().Equal("system-np", d.Get("spec.0.nodepool.0.name"))
+}
+
 type UpdateClusterTestSuite struct {
 	suite.Suite
 	ctx                context.Context
@@ -312,6 +376,93 @@ func (s *UpdateClusterTestSuite) Test_resourceClusterUpdate_deleteNodepool() {
 	s.Assert().Equal(expected, s.mocks.nodepoolClient.DeleteNodepoolWasCalledWith)
 }
 
+func (s *UpdateClusterTestSuite) Test_resourceClusterUpdate_ignoresStandaloneNodepools() {
+	s.mocks.nodepoolClient.nodepoolListResp = []*models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool{
+		aTestNodePool(forCluster(aTestCluster().FullName), withNodepoolName("np1")),
+		aTestNodePool(forCluster(aTestCluster().FullName), withNodepoolName("user-np"), withUserMode, withStandaloneLabel)}
+	originalNodepools := []any{aTestNodepoolDataMap(withName("np1"))}
+	updatedNodepools := []any{aTestNodepoolDataMap(withName("np1"), withNodepoolCount(5))}
+	d := dataDiffFrom(s.T(), aTestClusterDataMap(withNodepools(originalNodepools)), aTestClusterDataMap(withNodepools(updatedNodepools)))
+
+	result := s.aksClusterResource.UpdateContext(s.ctx, d, s.config)
+
+	s.Assert().False(result.HasError())
+	s.Assert().Equal("np1", s.mocks.nodepoolClient.UpdatedNodepoolWasCalledWith.FullName.Name)
+	s.Assert().Nil(s.mocks.nodepoolClient.DeleteNodepoolWasCalledWith)
+}
+
+func (s *UpdateClusterTestSuite) Test_resourceClusterUpdate_keepsImportedNodepool() {
+	s.mocks.nodepoolClient.nodepoolGetResp = aTestNodePool(forCluster(aTestCluster().FullName), withNodepoolName("user-np"), withUserMode, withNodepoolUID, withNodepoolStatusSuccess)
+	importData := schema.TestResourceDataRaw(s.T(), akscluster.NodepoolResourceSchema, nil)
+	importData.SetId("test-cred/sub-id/resource-group/test-cluster/user-np")
+
+	_, err := akscluster.ResourceTMCAKSNodepool().Importer.StateContext(s.ctx, importData, s.config)
+	s.Require().NoError(err)
+
+	s.mocks.nodepoolClient.nodepoolListResp = []*models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool{
+		aTestNodePool(forCluster(aTestCluster().FullName), withNodepoolName("np1")),
+		s.mocks.nodepoolClient.UpdatedNodepoolWasCalledWith}
+	s.mocks.nodepoolClient.nodepoolGetResp = aTestNodePool(forCluster(aTestCluster().FullName), withNodepoolStatusSuccess)
+	s.mocks.nodepoolClient.UpdatedNodepoolWasCalledWith = nil
+	originalNodepools := []any{aTestNodepoolDataMap(withName("np1")), aTestNodepoolDataMap(withName("user-np"), withNodepoolMode("USER"))}
+	updatedNodepools := []any{aTestNodepoolDataMap(withName("np1"), withNodepoolCount(5)), nil}
+	d := dataDiffFrom(s.T(), aTestClusterDataMap(withNodepools(originalNodepools)), aTestClusterDataMap(withNodepools(updatedNodepools)))
+
+	result := s.aksClusterResource.UpdateContext(s.ctx, d, s.config)
+
+	s.Assert().False(result.HasError())
+	s.Assert().Equal("np1", s.mocks.nodepoolClient.UpdatedNodepoolWasCalledWith.FullName.Name)
+	s.Assert().Nil(s.mocks.nodepoolClient.DeleteNodepoolWasCalledWith)
+}
+
+func (s *UpdateClusterTestSuite) Test_resourceClusterUpdate_warnsOnUserNodepool() {
+	s.mocks.nodepoolClient.nodepoolListResp = []*models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool{
+		aTestNodePool(forCluster(aTestCluster().FullName), withNodepoolName("np1")),
+	}
+	originalNodepools := []any{aTestNodepoolDataMap(withName("np1"))}
+	updatedNodepools := []any{aTestNodepoolDataMap(withName("np1")), aTestNodepoolDataMap(withName("user-np"), withNodepoolMode("USER"))}
+	d := dataDiffFrom(s.T(), aTestClusterDataMap(withNodepools(originalNodepools)), aTestClusterDataMap(withNodepools(updatedNodepools)))
+
+	result := s.aksClusterResource.UpdateContext(s.ctx, d, s.config)
+
+	s.Assert().False(result.HasError())
+	s.Assert().Len(result, 1)
+	s.Assert().Equal(diag.Warning, result[0].Severity)
+	s.Assert().Equal("USER nodepool user-np is defined in the cluster resource", result[0].Summary)
+}
+
+func (s *UpdateClusterTestSuite) Test_resourceClusterUpdate_orchestratedUpgrade() {
+	s.mocks.clusterClient.upgradeVersions = []string{"1.27.3"}
+	originalCluster := aTestClusterDataMap(withUpgradeMode("ORCHESTRATED"))
+	updatedCluster := aTestClusterDataMap(withUpgradeMode("ORCHESTRATED"), withKubernetesVersion("1.27.3"))
+	d := dataDiffFrom(s.T(), originalCluster, updatedCluster)
+	expected := aTestCluster()
+	expected.Spec.Config.Version = "1.27.3"
+	expectedNodepool := aTestNodePool(forCluster(aTestCluster().FullName))
+	expectedNodepool.Spec.OrchestratorVersion = "1.27.3"
+
+	result := s.aksClusterResource.UpdateContext(s.ctx, d, s.config)
+
+	s.Assert().False(result.HasError())
+	s.Assert().Equal(expected, s.mocks.clusterClient.AksUpdateClusterWasCalledWith)
+	s.Assert().Equal(expectedNodepool, s.mocks.nodepoolClient.UpdatedNodepoolWasCalledWith)
+	s.Assert().Len(result, 3, "expected a progress diagnostic for the pre-flight checks, the control plane and the nodepool")
+	s.Assert().Contains(result[2].Summary, "nodepool system-np upgraded to 1.27.3")
+}
+
+func (s *UpdateClusterTestSuite) Test_resourceClusterUpdate_orchestratedUpgrade_skipsMinorVersion() {
+	s.mocks.clusterClient.upgradeVersions = []string{"1.27.3", "1.28.0"}
+	originalCluster := aTestClusterDataMap(withUpgradeMode("ORCHESTRATED"))
+	updatedCluster := aTestClusterDataMap(withUpgradeMode("ORCHESTRATED"), withKubernetesVersion("1.28.0"))
+	d := dataDiffFrom(s.T(), originalCluster, updatedCluster)
+
+	result := s.aksClusterResource.UpdateContext(s.ctx, d, s.config)
+
+	s.Assert().True(result.HasError())
+	s.Assert().Nil(s.mocks.clusterClient.AksUpdateClusterWasCalledWith)
+	s.Assert().Nil(s.mocks.nodepoolClient.UpdatedNodepoolWasCalledWith)
+}
+
 func (s *UpdateClusterTestSuite) Test_resourceClusterUpdate_invalidConfig() {
 	d := schema.TestResourceDataRaw(s.T(), akscluster.ClusterSchema, aTestClusterDataMap())
 
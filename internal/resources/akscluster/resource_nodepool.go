@@ -66,13 +66,14 @@ func createNodepools(ctx context.Context, nodepools []*aksmodel.VmwareTanzuManag
 }
 
 // handleNodepoolChanges if nodepool changes are detected delegates to the appropriate node pool operation: `Create`, `Update`, `Delete`.
+// Nodepools owned by the nodepool resource are left untouched.
 func handleNodepoolChanges(ctx context.Context, existing []*aksmodel.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool, data *schema.ResourceData, tc *client.TanzuMissionControl) error {
 	npData := nodePoolOperations{
-		existing: existing,
+		existing: clusterManagedNodepools(existing),
 		desired:  ConstructNodepools(data),
 	}
 
-	return applyUpdates(ctx, npData, tc, getTimeOut(data))
+	return applyUpdates(ctx, npData, tc, getTimeOut(data, schema.TimeoutUpdate))
 }
 
 func applyUpdates(ctx context.Context, npData nodePoolOperations, tc *client.TanzuMissionControl, timeout time.Duration) error {
@@ -246,7 +247,15 @@ func checkIfNodepoolExists(new *aksmodel.VmwareTanzuManageV1alpha1AksclusterNode
 	return nil
 }
 
+// identical compares the nodepool specs, the Kubernetes version set by the server is ignored unless it is requested.
 func identical(new *aksmodel.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool, old *aksmodel.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool) bool {
+	if new.Spec != nil && old.Spec != nil && new.Spec.OrchestratorVersion == "" {
+		oldSpec := *old.Spec
+		oldSpec.OrchestratorVersion = ""
+
+		return reflect.DeepEqual(new.Spec, &oldSpec)
+	}
+
 	return reflect.DeepEqual(new.Spec, old.Spec)
 }
 
@@ -9,7 +9,9 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	aksmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/akscluster"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 )
@@ -45,10 +47,18 @@ var ClusterSchema = map[string]*schema.Schema{
 	clusterSpecKey: ClusterSpecSchema,
 	waitKey: {
 		Type:        schema.TypeString,
-		Description: "Wait timeout duration until cluster resource reaches READY state. Accepted timeout duration values like 5s, 45m, or 3h, higher than zero.  The default duration is 30m",
+		Description: "Wait timeout duration until cluster resource reaches READY state. Accepted timeout duration values like 5s, 45m, or 3h, higher than zero. When not set, the timeouts of the resource apply, 30m by default.",
 		Default:     "default",
 		Optional:    true,
 	},
+	upgradeModeKey: {
+		Type:         schema.TypeString,
+		Description:  "How a change of the Kubernetes version is applied. `IN_PLACE` updates the cluster with the new version. `ORCHESTRATED` first checks the new version against the available upgrade versions and refuses to skip a minor version, then upgrades the control plane and each nodepool in sequence, reporting the progress of each step. Each nodepool is rolled according to its `max_surge` upgrade config.",
+		Default:      helper.UpgradeModeInPlace,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(helper.UpgradeModes, false),
+	},
+	common.AdoptExistingKey: common.AdoptExistingByDefault,
 }
 
 var ClusterSpecSchema = &schema.Schema{
@@ -89,7 +99,7 @@ var ClusterSpecSchema = &schema.Schema{
 			},
 			nodepoolKey: {
 				Type:        schema.TypeList,
-				Description: "Nodepool definitions for the cluster",
+				Description: "SYSTEM nodepool definitions for the cluster. USER nodepools should be managed with the tanzu-mission-control_akscluster_nodepool resource, a warning is reported for USER nodepools defined here. Nodepools managed by the tanzu-mission-control_akscluster_nodepool resource are ignored",
 				Required:    true,
 				MinItems:    1,
 				Elem:        NodepoolConfig,
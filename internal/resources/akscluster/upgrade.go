@@ -0,0 +1,111 @@
+/*
+Copyright 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package akscluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	models "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/akscluster"
+)
+
+// isOrchestratedUpgrade returns true when the Kubernetes version changes and the cluster is configured to upgrade
+// in the orchestrated mode.
+func isOrchestratedUpgrade(data *schema.ResourceData) bool {
+	return data.Get(upgradeModeKey) == helper.UpgradeModeOrchestrated && data.HasChange(kubernetesVersionPath)
+}
+
+// upgradeKubernetesVersion upgrades the cluster to the configured Kubernetes version. The target version is checked
+// against the available upgrade versions first, then the control plane is upgraded along with any other cluster config
+// change, and finally each nodepool is rolled one after the other, according to its own upgrade config.
+func upgradeKubernetesVersion(ctx context.Context, data *schema.ResourceData, clusterResp *models.VmwareTanzuManageV1alpha1AksclusterGetAksClusterResponse, existing []*models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool, tc authctx.TanzuContext) diag.Diagnostics {
+	fn := extractClusterFullName(data)
+	current := clusterResp.AksCluster.Spec.Config.Version
+	target, _ := data.Get(kubernetesVersionPath).(string)
+
+	nodepools := nodepoolUpgradeOrder(existing)
+	progress := helper.NewUpgradeProgress(fmt.Sprintf("AKS cluster %s", fn.Name), len(nodepools)+2)
+
+	versionsResp, err := tc.TMCConnection.AKSClusterResourceService.AksClusterResourceServiceGetUpgradeVersions(fn)
+	if err != nil {
+		return progress.Fail(errors.Wrapf(err, "Unable to get upgrade versions of Tanzu Mission Control AKS cluster entry, name : %s", fn.Name))
+	}
+
+	if err := helper.ValidateKubernetesUpgrade(current, target, versionsResp.AvailableUpgradeVersions); err != nil {
+		return progress.Fail(err)
+	}
+
+	progress.Step("pre-flight checks passed for the upgrade from %s to %s", current, target)
+
+	if err := updateClusterConfig(ctx, data, clusterResp, tc); err != nil {
+		return progress.Fail(err)
+	}
+
+	progress.Step("control plane upgraded to %s", target)
+
+	desired := ConstructNodepools(data)
+	timeout := getTimeOut(data, schema.TimeoutUpdate)
+
+	for _, np := range nodepools {
+		// Mutable changes of the nodepool are rolled out with the upgrade, immutable ones are handled afterwards.
+		if d := checkIfNodepoolExists(np, desired); d != nil && !hasImmutableChange(d, np) {
+			np = d
+		}
+
+		np = nodepoolWithVersion(np, target)
+
+		if err := updateNodepool(ctx, np, tc.TMCConnection, timeout); err != nil {
+			return progress.Fail(errors.Wrapf(err, "Unable to upgrade Tanzu Mission Control AKS nodepool entry, name : %s", np.FullName.Name))
+		}
+
+		progress.Step("nodepool %s upgraded to %s", np.FullName.Name, target)
+	}
+
+	return progress.Diagnostics
+}
+
+// nodepoolWithVersion returns a copy of the nodepool which requests the given Kubernetes version.
+func nodepoolWithVersion(np *models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool, version string) *models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool {
+	upgraded := *np
+
+	spec := models.VmwareTanzuManageV1alpha1AksclusterNodepoolSpec{}
+	if np.Spec != nil {
+		spec = *np.Spec
+	}
+
+	spec.OrchestratorVersion = version
+	upgraded.Spec = &spec
+
+	return &upgraded
+}
+
+// nodepoolUpgradeOrder sorts nodepools so that SYSTEM nodepools are upgraded before USER nodepools.
+func nodepoolUpgradeOrder(nodepools []*models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool) []*models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool {
+	ordered := make([]*models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool, len(nodepools))
+	copy(ordered, nodepools)
+
+	isSystem := func(np *models.VmwareTanzuManageV1alpha1AksclusterNodepoolNodepool) bool {
+		return np.Spec != nil && np.Spec.Mode != nil && *np.Spec.Mode == models.VmwareTanzuManageV1alpha1AksclusterNodepoolModeSYSTEM
+	}
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if isSystem(ordered[i]) != isSystem(ordered[j]) {
+			return isSystem(ordered[i])
+		}
+
+		return ordered[i].FullName.Name < ordered[j].FullName.Name
+	})
+
+	return ordered
+}
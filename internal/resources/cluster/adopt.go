@@ -0,0 +1,73 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package cluster
+
+import (
+	"log"
+
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+// adoptExistingCluster looks up the cluster which made the create request fail with a conflict and updates the
+// attributes the resource can update in place when they differ from the desired cluster.
+func adoptExistingCluster(config authctx.TanzuContext, desired *clustermodel.VmwareTanzuManageV1alpha1ClusterCluster) (*clustermodel.VmwareTanzuManageV1alpha1ClusterResponse, error) {
+	getResp, err := config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceGet(desired.FullName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get existing Tanzu Mission Control cluster entry, name : %s", desired.FullName.Name)
+	}
+
+	if !reconcileExistingCluster(getResp.Cluster, desired) {
+		log.Printf("[INFO] existing cluster %s matches the configuration", desired.FullName.Name)
+
+		return &clustermodel.VmwareTanzuManageV1alpha1ClusterResponse{Cluster: getResp.Cluster}, nil
+	}
+
+	updateResp, err := config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceUpdate(
+		&clustermodel.VmwareTanzuManageV1alpha1ClusterRequest{
+			Cluster: getResp.Cluster,
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to update existing Tanzu Mission Control cluster entry, name : %s", desired.FullName.Name)
+	}
+
+	return updateResp, nil
+}
+
+// reconcileExistingCluster applies the meta data, cluster group and distribution version of the desired cluster to
+// the existing one, the same attributes the resource updates in place, and returns whether any of them changed.
+func reconcileExistingCluster(existing, desired *clustermodel.VmwareTanzuManageV1alpha1ClusterCluster) bool {
+	updated := common.ReconcileExistingMeta(existing.Meta, desired.Meta)
+
+	if existing.Spec == nil || desired.Spec == nil {
+		return updated
+	}
+
+	if desired.Spec.ClusterGroupName != "" && desired.Spec.ClusterGroupName != existing.Spec.ClusterGroupName {
+		existing.Spec.ClusterGroupName = desired.Spec.ClusterGroupName
+		updated = true
+	}
+
+	if desired.Spec.TkgVsphere != nil && desired.Spec.TkgVsphere.Distribution != nil && desired.Spec.TkgVsphere.Distribution.Version != "" &&
+		existing.Spec.TkgVsphere != nil && existing.Spec.TkgVsphere.Distribution != nil &&
+		existing.Spec.TkgVsphere.Distribution.Version != desired.Spec.TkgVsphere.Distribution.Version {
+		existing.Spec.TkgVsphere.Distribution.Version = desired.Spec.TkgVsphere.Distribution.Version
+		updated = true
+	}
+
+	if desired.Spec.TkgServiceVsphere != nil && desired.Spec.TkgServiceVsphere.Distribution != nil && desired.Spec.TkgServiceVsphere.Distribution.Version != "" &&
+		existing.Spec.TkgServiceVsphere != nil && existing.Spec.TkgServiceVsphere.Distribution != nil &&
+		existing.Spec.TkgServiceVsphere.Distribution.Version != desired.Spec.TkgServiceVsphere.Distribution.Version {
+		existing.Spec.TkgServiceVsphere.Distribution.Version = desired.Spec.TkgServiceVsphere.Distribution.Version
+		updated = true
+	}
+
+	return updated
+}
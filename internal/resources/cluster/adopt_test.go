@@ -0,0 +1,81 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	tkgvspheremodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster/tkgvsphere"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+func TestReconcileExistingCluster(t *testing.T) {
+	t.Parallel()
+
+	aCluster := func(labels map[string]string, clusterGroup, version string) *clustermodel.VmwareTanzuManageV1alpha1ClusterCluster {
+		return &clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{
+			Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+				Description: "test cluster",
+				Labels:      labels,
+			},
+			Spec: &clustermodel.VmwareTanzuManageV1alpha1ClusterSpec{
+				ClusterGroupName: clusterGroup,
+				TkgVsphere: &tkgvspheremodel.VmwareTanzuManageV1alpha1ClusterInfrastructureTkgvsphereSpec{
+					Distribution: &tkgvspheremodel.VmwareTanzuManageV1alpha1ClusterInfrastructureTkgvsphereDistribution{
+						Version: version,
+					},
+				},
+			},
+		}
+	}
+
+	creatorLabel := map[string]string{common.CreatorLabelKey: "admin"}
+
+	cases := []struct {
+		description     string
+		existing        *clustermodel.VmwareTanzuManageV1alpha1ClusterCluster
+		desired         *clustermodel.VmwareTanzuManageV1alpha1ClusterCluster
+		expectedUpdated bool
+		expected        *clustermodel.VmwareTanzuManageV1alpha1ClusterCluster
+	}{
+		{
+			description:     "existing cluster matching the configuration",
+			existing:        aCluster(creatorLabel, "default", "v1.26.5+vmware.2-tkg.1"),
+			desired:         aCluster(map[string]string{}, "default", "v1.26.5+vmware.2-tkg.1"),
+			expectedUpdated: false,
+			expected:        aCluster(creatorLabel, "default", "v1.26.5+vmware.2-tkg.1"),
+		},
+		{
+			description:     "existing cluster with different labels, cluster group and version",
+			existing:        aCluster(creatorLabel, "default", "v1.25.7+vmware.2-tkg.1"),
+			desired:         aCluster(map[string]string{"env": "test"}, "test-group", "v1.26.5+vmware.2-tkg.1"),
+			expectedUpdated: true,
+			expected:        aCluster(map[string]string{"env": "test", common.CreatorLabelKey: "admin"}, "test-group", "v1.26.5+vmware.2-tkg.1"),
+		},
+		{
+			description:     "attributes left to the defaults of Tanzu Mission Control",
+			existing:        aCluster(nil, "default", "v1.26.5+vmware.2-tkg.1"),
+			desired:         aCluster(nil, "", ""),
+			expectedUpdated: false,
+			expected:        aCluster(nil, "default", "v1.26.5+vmware.2-tkg.1"),
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			updated := reconcileExistingCluster(test.existing, test.desired)
+			require.Equal(t, test.expectedUpdated, updated)
+			require.Equal(t, test.expected, test.existing)
+		})
+	}
+}
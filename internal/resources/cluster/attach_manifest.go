@@ -0,0 +1,172 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/cluster/manifest"
+)
+
+var attachManifestStatus = &schema.Schema{
+	Type: schema.TypeString,
+	Description: "Status of the attach manifest on the cluster, checked through the kubeconfig of the attach_k8s_cluster block on refresh: " +
+		"APPLIED, RESOURCES_MISSING when the agent resources are missing or unavailable, or DISCONNECTED when the cluster health is DISCONNECTED. " +
+		"The manifest is re-applied when the agent resources are missing, and once when the cluster becomes DISCONNECTED.",
+	Computed: true,
+}
+
+// resourceClusterRead reads the cluster and checks that the attach manifest is still applied on the cluster.
+func resourceClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	previousHealth, _ := d.Get(StatusKey).(map[string]interface{})[healthKey].(string)
+
+	diags := dataSourceClusterRead(helper.GetContextWithCaller(ctx, helper.RefreshState), d, m)
+	if diags.HasError() || d.Id() == "" {
+		return diags
+	}
+
+	return append(diags, checkAttachManifest(ctx, d, previousHealth)...)
+}
+
+// checkAttachManifest checks the agent resources through the kubeconfig of the attach_k8s_cluster block and records the
+// status of the attach manifest, so that a re-apply gets planned on drift. The health of the cluster before the refresh
+// tells whether the cluster just became disconnected. Failures to reach the cluster are reported as warnings and keep
+// the previous status.
+func checkAttachManifest(ctx context.Context, d *schema.ResourceData, previousHealth string) diag.Diagnostics {
+	if validateKubeConfig(d.Get(attachClusterKey)) != nil {
+		return nil
+	}
+
+	fn := constructFullname(d)
+
+	k8sclient, err := getAttachK8sClient(d)
+	if err != nil {
+		return unableToCheckAttachManifest(fn, err)
+	}
+
+	missing, err := manifest.MissingAgentResources(ctx, k8sclient)
+	if err != nil {
+		return unableToCheckAttachManifest(fn, err)
+	}
+
+	previousStatus, _ := d.Get(attachManifestStatusKey).(string)
+	health, _ := d.Get(StatusKey).(map[string]interface{})[healthKey].(string)
+	status := attachManifestStatusOf(previousStatus, previousHealth, health, missing)
+
+	if err := d.Set(attachManifestStatusKey, status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	switch status {
+	case attachManifestMissing:
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Tanzu Mission Control agent resources are missing on cluster %s, the attach manifest will be re-applied", fn.ToString()),
+			Detail:   fmt.Sprintf("Missing or unavailable resources: %s", strings.Join(missing, ", ")),
+		}}
+	case attachManifestDisconnected:
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Tanzu Mission Control cluster %s is disconnected, the attach manifest will be re-applied", fn.ToString()),
+			Detail:   "The attach manifest is re-applied once, it is not re-applied again while the cluster stays disconnected.",
+		}}
+	}
+
+	if health == disconnectedHealth {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Tanzu Mission Control cluster %s is still disconnected", fn.ToString()),
+			Detail:   "The attach manifest was re-applied after the cluster became disconnected, it is only re-applied again on the next disconnection.",
+		}}
+	}
+
+	return nil
+}
+
+func unableToCheckAttachManifest(fn *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName, err error) diag.Diagnostics {
+	log.Printf("[WARN] unable to check the attach manifest of cluster %s: %s", fn.ToString(), err)
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Unable to check the attach manifest of cluster %s through the provided kubeconfig", fn.ToString()),
+		Detail:   err.Error(),
+	}}
+}
+
+var disconnectedHealth = string(clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthDISCONNECTED)
+
+// attachManifestStatusOf derives the status of the attach manifest from the missing agent resources and the cluster health.
+// A cluster which becomes disconnected gets the DISCONNECTED status, so that a single re-apply is planned. Once the
+// manifest is re-applied, the APPLIED status is kept as long as the cluster stays disconnected.
+func attachManifestStatusOf(previousStatus string, previousHealth string, health string, missing []string) string {
+	switch {
+	case len(missing) != 0:
+		return attachManifestMissing
+	case health != disconnectedHealth:
+		return attachManifestApplied
+	case previousHealth != disconnectedHealth:
+		return attachManifestDisconnected
+	case previousStatus == attachManifestApplied:
+		return attachManifestApplied
+	default:
+		return attachManifestDisconnected
+	}
+}
+
+// customizeAttachManifestDiff plans a re-apply of the attach manifest when the last refresh found agent resources missing
+// or the cluster just became disconnected.
+func customizeAttachManifestDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || validateKubeConfig(diff.Get(attachClusterKey)) != nil {
+		return nil
+	}
+
+	if status, _ := diff.Get(attachManifestStatusKey).(string); status != attachManifestMissing && status != attachManifestDisconnected {
+		return nil
+	}
+
+	return diff.SetNew(attachManifestStatusKey, attachManifestApplied)
+}
+
+// reapplyAttachManifest re-applies the attach manifest of the cluster through the kubeconfig of the attach_k8s_cluster block.
+func reapplyAttachManifest(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+	fn := constructFullname(d)
+
+	k8sclient, err := getAttachK8sClient(d)
+	if err != nil {
+		return diag.FromErr(errors.WithMessagef(err, "Unable to re-apply the attach manifest of cluster %s", fn.ToString()))
+	}
+
+	clusterManifest, err := config.TMCConnection.ManifestResourceService.ClusterManifestHelperGetManifest(fn)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get attach manifest for cluster entry, name : %s", fn.Name))
+	}
+
+	log.Printf("[INFO] Re-applying %s cluster's attach manifest objects on to kubernetes cluster", fn.ToString())
+
+	if err := manifest.Apply(ctx, k8sclient, clusterManifest.Manifest); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(attachManifestStatusKey, attachManifestApplied); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Attach manifest re-applied to the cluster(%s) successfully", fn.ToString())
+
+	return nil
+}
@@ -0,0 +1,165 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachManifestStatusOf(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name           string
+		previousStatus string
+		previousHealth string
+		health         string
+		missing        []string
+		expected       string
+	}{
+		{
+			name:           "case for a healthy cluster",
+			previousStatus: attachManifestApplied,
+			previousHealth: "HEALTHY",
+			health:         "HEALTHY",
+			missing:        []string{},
+			expected:       attachManifestApplied,
+		},
+		{
+			name:           "case for a deleted agent namespace",
+			previousStatus: attachManifestApplied,
+			previousHealth: "HEALTHY",
+			health:         "HEALTHY",
+			missing:        []string{"namespace vmware-system-tmc"},
+			expected:       attachManifestMissing,
+		},
+		{
+			name:           "case for a cluster which became disconnected",
+			previousStatus: attachManifestApplied,
+			previousHealth: "HEALTHY",
+			health:         "DISCONNECTED",
+			missing:        nil,
+			expected:       attachManifestDisconnected,
+		},
+		{
+			name:           "case for a disconnected cluster not re-applied yet",
+			previousStatus: attachManifestDisconnected,
+			previousHealth: "DISCONNECTED",
+			health:         "DISCONNECTED",
+			missing:        nil,
+			expected:       attachManifestDisconnected,
+		},
+		{
+			name:           "case for a disconnected cluster already re-applied",
+			previousStatus: attachManifestApplied,
+			previousHealth: "DISCONNECTED",
+			health:         "DISCONNECTED",
+			missing:        nil,
+			expected:       attachManifestApplied,
+		},
+		{
+			name:           "case for a disconnected cluster which reconnected",
+			previousStatus: attachManifestApplied,
+			previousHealth: "DISCONNECTED",
+			health:         "HEALTHY",
+			missing:        nil,
+			expected:       attachManifestApplied,
+		},
+		{
+			name:           "case for a disconnected cluster with missing resources",
+			previousStatus: attachManifestApplied,
+			previousHealth: "DISCONNECTED",
+			health:         "DISCONNECTED",
+			missing:        []string{"deployment vmware-system-tmc/cluster-agent (unavailable)"},
+			expected:       attachManifestMissing,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, attachManifestStatusOf(test.previousStatus, test.previousHealth, test.health, test.missing))
+		})
+	}
+}
+
+func TestCustomizeAttachManifestDiff(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name           string
+		status         string
+		kubeConfig     bool
+		expectedUpdate bool
+	}{
+		{
+			name:           "case for an applied manifest",
+			status:         attachManifestApplied,
+			kubeConfig:     true,
+			expectedUpdate: false,
+		},
+		{
+			name:           "case for missing agent resources",
+			status:         attachManifestMissing,
+			kubeConfig:     true,
+			expectedUpdate: true,
+		},
+		{
+			name:           "case for a cluster which became disconnected",
+			status:         attachManifestDisconnected,
+			kubeConfig:     true,
+			expectedUpdate: true,
+		},
+		{
+			name:           "case for a cluster attached without kubeconfig",
+			status:         attachManifestDisconnected,
+			kubeConfig:     false,
+			expectedUpdate: false,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "c:uid",
+				Attributes: map[string]string{
+					"id":                     "c:uid",
+					NameKey:                  "c1",
+					ManagementClusterNameKey: attachedValue,
+					ProvisionerNameKey:       attachedValue,
+					waitKey:                  "default",
+					attachManifestStatusKey:  test.status,
+				},
+			}
+
+			config := map[string]interface{}{
+				NameKey: "c1",
+			}
+
+			if test.kubeConfig {
+				state.Attributes[attachClusterKey+".#"] = "1"
+				state.Attributes[attachClusterKey+".0."+attachClusterKubeConfigPathKey] = "/tmp/kubeconfig"
+				config[attachClusterKey] = []interface{}{map[string]interface{}{attachClusterKubeConfigPathKey: "/tmp/kubeconfig"}}
+			}
+
+			diff, err := ResourceTMCCluster().SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+			require.NoError(t, err)
+
+			attribute, ok := diff.Attributes[attachManifestStatusKey]
+			require.Equal(t, test.expectedUpdate, ok)
+
+			if test.expectedUpdate {
+				require.Equal(t, test.status, attribute.Old)
+				require.Equal(t, attachManifestApplied, attribute.New)
+			}
+		})
+	}
+}
@@ -27,4 +27,12 @@ const (
 	distributionKey                = "distribution"
 	versionKey                     = "version"
 	imageRegistryNameKey           = "image_registry"
+	attachManifestStatusKey        = "attach_manifest_status"
+)
+
+// Status of the attach manifest on an attached cluster, as checked through the kubeconfig of the attach_k8s_cluster block.
+const (
+	attachManifestApplied      = "APPLIED"
+	attachManifestMissing      = "RESOURCES_MISSING"
+	attachManifestDisconnected = "DISCONNECTED"
 )
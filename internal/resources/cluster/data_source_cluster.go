@@ -43,7 +43,7 @@ func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, m interf
 	)
 
 	getClusterResourceRetryableFn := func() (retry bool, err error) {
-		resp, err = config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceGet(constructFullname(d))
+		resp, err = config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceGetWithContext(ctx, constructFullname(d))
 		if err != nil {
 			if clienterrors.IsNotFoundError(err) && !helper.IsDataRead(ctx) {
 				_ = schema.RemoveFromState(d, m)
@@ -75,43 +75,22 @@ func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, m interf
 		return false, nil
 	}
 
+	_, attachClusterWithKubeconfig := d.GetOk(attachClusterKey)
+	fn := constructFullname(d)
 	timeoutValueData, _ := d.Get(waitKey).(string)
+	defaultWait := timeoutValueData == "" || timeoutValueData == helper.DefaultWaitTimeoutValue
 
-	if ctx.Value(contextMethodKey{}) != "create" {
-		timeoutValueData = "do_not_retry"
-	}
-
-	switch timeoutValueData {
-	case "do_not_retry":
+	switch {
+	case ctx.Value(contextMethodKey{}) != "create":
+		_, err = getClusterResourceRetryableFn()
+	case defaultWait && fn.ManagementClusterName == attachedValue && !attachClusterWithKubeconfig:
 		_, err = getClusterResourceRetryableFn()
-	case "":
-		fallthrough
-	case "default":
-		_, attachClusterWithKubeconfig := d.GetOk(attachClusterKey)
-		fn := constructFullname(d)
-
-		if fn.ManagementClusterName == attachedValue && !attachClusterWithKubeconfig {
-			_, err = getClusterResourceRetryableFn()
-			break
-		}
-
-		timeoutValueData = "3m"
-
-		fallthrough
 	default:
-		timeoutDuration, parseErr := time.ParseDuration(timeoutValueData)
-		if parseErr != nil {
-			log.Printf("[INFO] unable to prase the duration value for the key %s. Defaulting to 3 minutes(3m)"+
-				" Please refer to 'https://pkg.go.dev/time#ParseDuration' for providing the right value", waitKey)
-
-			timeoutDuration = 3 * time.Minute
-		}
-
-		_, err = helper.RetryUntilTimeout(getClusterResourceRetryableFn, 10*time.Second, timeoutDuration)
+		_, err = helper.RetryUntilTimeoutWithContext(ctx, getClusterResourceRetryableFn, 10*time.Second, helper.GetWaitTimeout(d, waitKey, schema.TimeoutCreate))
 	}
 
 	if err != nil || resp == nil || resp.Cluster == nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster entry, name : %s", d.Get(NameKey)))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster entry, name : %s", d.Get(NameKey)))
 	}
 
 	// always run
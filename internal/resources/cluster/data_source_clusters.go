@@ -0,0 +1,371 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/pagination"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+const (
+	ClustersResourceName = "tanzu-mission-control_clusters"
+
+	clustersKey = "clusters"
+	labelsKey   = "labels"
+	healthKey   = "health"
+	phaseKey    = "phase"
+	uidKey      = "uid"
+)
+
+func DataSourceTMCClusters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClustersRead,
+		Schema: map[string]*schema.Schema{
+			NameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the clusters to list, supports globbing with *.",
+				Optional:    true,
+			},
+			ManagementClusterNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the management cluster of the clusters to list, supports globbing with *.",
+				Optional:    true,
+			},
+			ProvisionerNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the provisioner of the clusters to list, supports globbing with *.",
+				Optional:    true,
+			},
+			clusterGroupKey: {
+				Type:        schema.TypeString,
+				Description: "Only list the clusters attached to this cluster group.",
+				Optional:    true,
+			},
+			labelsKey: {
+				Type:             schema.TypeMap,
+				Description:      "Only list the clusters having all these labels. The keys must be valid Kubernetes label keys.",
+				Optional:         true,
+				ValidateDiagFunc: validateLabelKeys,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			healthKey: {
+				Type:         schema.TypeString,
+				Description:  "Only list the clusters with this health, one of HEALTHY, WARNING, UNHEALTHY or DISCONNECTED.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(clusterHealthValues(), false),
+			},
+			phaseKey: {
+				Type:         schema.TypeString,
+				Description:  "Only list the clusters in this phase, e.g. READY or ERROR.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(clusterPhaseValues(), false),
+			},
+			clustersKey: {
+				Type:        schema.TypeList,
+				Description: "Clusters matching the filters, sorted by management cluster, provisioner and name.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						uidKey: {
+							Type:        schema.TypeString,
+							Description: "UID of the cluster.",
+							Computed:    true,
+						},
+						NameKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the cluster.",
+							Computed:    true,
+						},
+						ManagementClusterNameKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the management cluster of the cluster.",
+							Computed:    true,
+						},
+						ProvisionerNameKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the provisioner of the cluster.",
+							Computed:    true,
+						},
+						clusterGroupKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the cluster group of the cluster.",
+							Computed:    true,
+						},
+						labelsKey: {
+							Type:        schema.TypeMap,
+							Description: "Labels of the cluster.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						healthKey: {
+							Type:        schema.TypeString,
+							Description: "Health of the cluster.",
+							Computed:    true,
+						},
+						phaseKey: {
+							Type:        schema.TypeString,
+							Description: "Phase of the cluster.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceClustersRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+	filter := constructClustersFilter(d)
+	request := constructListClustersRequest(d, filter)
+
+	clusters, err := pagination.ListAll(pagination.DefaultPageSize, func(options *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions) (*pagination.Page[*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster], error) {
+		request.Pagination = options
+
+		resp, err := config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceList(request)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pagination.Page[*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster]{Items: resp.Clusters, TotalCount: resp.TotalCount}, nil
+	})
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrap(err, "Unable to list Tanzu Mission Control clusters"))
+	}
+
+	if err := d.Set(clustersKey, flattenClusters(filter.apply(clusters))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helper.ConstructListDataSourceID(
+		request.SearchScope.ManagementClusterName,
+		request.SearchScope.ProvisionerName,
+		request.SearchScope.Name,
+		filter.clusterGroup,
+		helper.LabelSelectorString(filter.labels),
+		filter.health,
+		filter.phase,
+	))
+
+	return nil
+}
+
+// constructListClustersRequest scopes the list request to the names of the clusters and filters it on the server side
+// by cluster group, labels, health and phase.
+func constructListClustersRequest(d *schema.ResourceData, filter clustersFilter) *clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersRequestParameters {
+	searchScope := &clustermodel.VmwareTanzuManageV1alpha1ClusterSearchScope{}
+
+	searchScope.Name, _ = d.Get(NameKey).(string)
+	searchScope.ManagementClusterName, _ = d.Get(ManagementClusterNameKey).(string)
+	searchScope.ProvisionerName, _ = d.Get(ProvisionerNameKey).(string)
+
+	return &clustermodel.VmwareTanzuManageV1alpha1ClusterListClustersRequestParameters{
+		SearchScope:       searchScope,
+		Query:             filter.query(),
+		IncludeTotalCount: true,
+	}
+}
+
+// clustersFilter holds the filters which are not part of the search scope of the list API. They are sent as the
+// query of the list request, and checked again on the listed clusters.
+type clustersFilter struct {
+	clusterGroup string
+	labels       map[string]string
+	health       string
+	phase        string
+}
+
+func constructClustersFilter(d *schema.ResourceData) clustersFilter {
+	filter := clustersFilter{
+		labels: helper.GetStringMap(d.Get(labelsKey)),
+	}
+
+	filter.clusterGroup, _ = d.Get(clusterGroupKey).(string)
+	filter.health, _ = d.Get(healthKey).(string)
+	filter.phase, _ = d.Get(phaseKey).(string)
+
+	return filter
+}
+
+// query returns the TQL query matching the clusters of the filter.
+func (f clustersFilter) query() string {
+	var terms []string
+
+	if f.clusterGroup != "" {
+		terms = append(terms, fmt.Sprintf("spec.clusterGroupName=%q", f.clusterGroup))
+	}
+
+	if f.health != "" {
+		terms = append(terms, fmt.Sprintf("status.health=%q", f.health))
+	}
+
+	if f.phase != "" {
+		terms = append(terms, fmt.Sprintf("status.phase=%q", f.phase))
+	}
+
+	keys := make([]string, 0, len(f.labels))
+	for key := range f.labels {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		terms = append(terms, fmt.Sprintf("meta.labels.%s=%q", key, f.labels[key]))
+	}
+
+	return strings.Join(terms, " AND ")
+}
+
+// validateLabelKeys checks that the label keys are valid Kubernetes label keys, as they are not quoted in the TQL query.
+func validateLabelKeys(value interface{}, path cty.Path) diag.Diagnostics {
+	labels, _ := value.(map[string]interface{})
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var diags diag.Diagnostics
+
+	for _, key := range keys {
+		if errs := k8svalidation.IsQualifiedName(key); len(errs) != 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Invalid label key %q", key),
+				Detail:        strings.Join(errs, ", "),
+				AttributePath: path,
+			})
+		}
+	}
+
+	return diags
+}
+
+func (f clustersFilter) apply(clusters []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster) []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster {
+	filtered := make([]*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster, 0, len(clusters))
+
+	for _, cluster := range clusters {
+		if cluster == nil || cluster.FullName == nil {
+			continue
+		}
+
+		if f.matches(cluster) {
+			filtered = append(filtered, cluster)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return clusterSortKey(filtered[i].FullName) < clusterSortKey(filtered[j].FullName)
+	})
+
+	return filtered
+}
+
+func (f clustersFilter) matches(cluster *clustermodel.VmwareTanzuManageV1alpha1ClusterCluster) bool {
+	if f.clusterGroup != "" && (cluster.Spec == nil || cluster.Spec.ClusterGroupName != f.clusterGroup) {
+		return false
+	}
+
+	if f.health != "" && (cluster.Status == nil || cluster.Status.Health == nil || string(*cluster.Status.Health) != f.health) {
+		return false
+	}
+
+	if f.phase != "" && (cluster.Status == nil || cluster.Status.Phase == nil || string(*cluster.Status.Phase) != f.phase) {
+		return false
+	}
+
+	var labels map[string]string
+	if cluster.Meta != nil {
+		labels = cluster.Meta.Labels
+	}
+
+	return helper.MatchLabels(labels, f.labels)
+}
+
+func flattenClusters(clusters []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster) []interface{} {
+	data := make([]interface{}, 0, len(clusters))
+
+	for _, cluster := range clusters {
+		flattenCluster := map[string]interface{}{
+			NameKey:                  cluster.FullName.Name,
+			ManagementClusterNameKey: cluster.FullName.ManagementClusterName,
+			ProvisionerNameKey:       cluster.FullName.ProvisionerName,
+		}
+
+		if cluster.Meta != nil {
+			flattenCluster[uidKey] = cluster.Meta.UID
+			flattenCluster[labelsKey] = cluster.Meta.Labels
+		}
+
+		if cluster.Spec != nil {
+			flattenCluster[clusterGroupKey] = cluster.Spec.ClusterGroupName
+		}
+
+		if cluster.Status != nil {
+			if cluster.Status.Health != nil {
+				flattenCluster[healthKey] = string(*cluster.Status.Health)
+			}
+
+			if cluster.Status.Phase != nil {
+				flattenCluster[phaseKey] = string(*cluster.Status.Phase)
+			}
+		}
+
+		data = append(data, flattenCluster)
+	}
+
+	return data
+}
+
+func clusterSortKey(fn *clustermodel.VmwareTanzuManageV1alpha1ClusterFullName) string {
+	return strings.Join([]string{fn.ManagementClusterName, fn.ProvisionerName, fn.Name}, "/")
+}
+
+func clusterHealthValues() []string {
+	return []string{
+		string(clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthHEALTHY),
+		string(clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthWARNING),
+		string(clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthUNHEALTHY),
+		string(clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthDISCONNECTED),
+	}
+}
+
+func clusterPhaseValues() []string {
+	return []string{
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhasePENDING),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhasePROCESSING),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseCREATING),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseREADY),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseDELETING),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseERROR),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseDETACHING),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseUPGRADING),
+		string(clustermodel.VmwareTanzuManageV1alpha1ClusterPhaseUPGRADEFAILED),
+	}
+}
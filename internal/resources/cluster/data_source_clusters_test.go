@@ -0,0 +1,175 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+func testCluster(name string, clusterGroup string, health clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealth, labels map[string]string) *clustermodel.VmwareTanzuManageV1alpha1ClusterCluster {
+	return &clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{
+		FullName: &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{
+			Name:                  name,
+			ManagementClusterName: attachedValue,
+			ProvisionerName:       attachedValue,
+		},
+		Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+			UID:    fmt.Sprintf("c:%s", name),
+			Labels: labels,
+		},
+		Spec: &clustermodel.VmwareTanzuManageV1alpha1ClusterSpec{
+			ClusterGroupName: clusterGroup,
+		},
+		Status: &clustermodel.VmwareTanzuManageV1alpha1ClusterStatus{
+			Health: clustermodel.NewVmwareTanzuManageV1alpha1CommonClusterHealth(health),
+		},
+	}
+}
+
+func TestClustersFilter(t *testing.T) {
+	t.Parallel()
+
+	clusters := []*clustermodel.VmwareTanzuManageV1alpha1ClusterCluster{
+		testCluster("c3", "prod", clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthHEALTHY, map[string]string{"env": "prod", "team": "a"}),
+		testCluster("c1", "prod", clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthUNHEALTHY, map[string]string{"env": "prod"}),
+		testCluster("c2", "dev", clustermodel.VmwareTanzuManageV1alpha1CommonClusterHealthHEALTHY, map[string]string{"env": "dev"}),
+		nil,
+	}
+
+	cases := []struct {
+		name     string
+		filter   clustersFilter
+		expected []string
+	}{
+		{
+			name:     "case for no filter",
+			filter:   clustersFilter{},
+			expected: []string{"c1", "c2", "c3"},
+		},
+		{
+			name:     "case for a cluster group",
+			filter:   clustersFilter{clusterGroup: "prod"},
+			expected: []string{"c1", "c3"},
+		},
+		{
+			name:     "case for a cluster group and health",
+			filter:   clustersFilter{clusterGroup: "prod", health: "HEALTHY"},
+			expected: []string{"c3"},
+		},
+		{
+			name:     "case for labels",
+			filter:   clustersFilter{labels: map[string]string{"env": "prod", "team": "a"}},
+			expected: []string{"c3"},
+		},
+		{
+			name:     "case for a phase no cluster is in",
+			filter:   clustersFilter{phase: "READY"},
+			expected: []string{},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			actual := make([]string, 0)
+
+			for _, cluster := range flattenClusters(test.filter.apply(clusters)) {
+				actual = append(actual, cluster.(map[string]interface{})[NameKey].(string))
+			}
+
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestClustersFilterQuery(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		filter   clustersFilter
+		expected string
+	}{
+		{
+			name:     "case for no filter",
+			filter:   clustersFilter{},
+			expected: "",
+		},
+		{
+			name:     "case for a cluster group",
+			filter:   clustersFilter{clusterGroup: "prod"},
+			expected: `spec.clusterGroupName="prod"`,
+		},
+		{
+			name: "case for all the filters",
+			filter: clustersFilter{
+				clusterGroup: "prod",
+				labels:       map[string]string{"team": "a", "env": "prod"},
+				health:       "HEALTHY",
+				phase:        "READY",
+			},
+			expected: `spec.clusterGroupName="prod" AND status.health="HEALTHY" AND status.phase="READY" AND meta.labels.env="prod" AND meta.labels.team="a"`,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, test.filter.query())
+		})
+	}
+}
+
+func TestValidateLabelKeys(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		labels      map[string]interface{}
+		expectedErr string
+	}{
+		{
+			name:   "case for simple label keys",
+			labels: map[string]interface{}{"env": "prod", "team-a": "true"},
+		},
+		{
+			name:   "case for a prefixed label key",
+			labels: map[string]interface{}{"tmc.cloud.vmware.com/creator": "admin"},
+		},
+		{
+			name:        "case for a label key injecting a TQL term",
+			labels:      map[string]interface{}{`env="prod" OR meta.labels.team`: "a"},
+			expectedErr: `Invalid label key "env=\"prod\" OR meta.labels.team"`,
+		},
+		{
+			name:        "case for an empty label key",
+			labels:      map[string]interface{}{"": "a"},
+			expectedErr: `Invalid label key ""`,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateLabelKeys(test.labels, nil)
+			if test.expectedErr == "" {
+				require.Empty(t, diags)
+				return
+			}
+
+			require.Len(t, diags, 1)
+			require.Equal(t, test.expectedErr, diags[0].Summary)
+		})
+	}
+}
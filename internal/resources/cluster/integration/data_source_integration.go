@@ -35,7 +35,7 @@ func (r *resourceIntegration) read(_ context.Context, d *schema.ResourceData, m
 			return
 		}
 
-		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control integration entry, name: %s", fn.Name))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control integration entry, name: %s", fn.Name))
 	}
 
 	if resp == nil || resp.Integration == nil {
@@ -6,6 +6,8 @@ SPDX-License-Identifier: MPL-2.0
 package integration
 
 import (
+	"context"
+
 	"github.com/pkg/errors"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster/integration"
@@ -23,6 +25,14 @@ func (d defaultClient) ManageV1alpha1ClusterIntegrationResourceServiceRead(*inte
 	panic(ErrMissingIntegrationClientService)
 }
 
+func (d defaultClient) ManageV1alpha1ClusterIntegrationResourceServiceReadWithContext(context.Context, *integration.VmwareTanzuManageV1alpha1ClusterIntegrationFullName) (*integration.VmwareTanzuManageV1alpha1ClusterIntegrationGetIntegrationResponse, error) {
+	panic(ErrMissingIntegrationClientService)
+}
+
+func (d defaultClient) ManageV1alpha1ClusterIntegrationResourceServiceUpdate(*integration.VmwareTanzuManageV1alpha1ClusterIntegrationUpdateIntegrationRequest) (*integration.VmwareTanzuManageV1alpha1ClusterIntegrationUpdateIntegrationResponse, error) {
+	panic(ErrMissingIntegrationClientService)
+}
+
 func (d defaultClient) ManageV1alpha1ClusterIntegrationResourceServiceDelete(*integration.VmwareTanzuManageV1alpha1ClusterIntegrationFullName) error {
 	panic(ErrMissingIntegrationClientService)
 }
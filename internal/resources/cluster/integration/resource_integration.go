@@ -29,7 +29,13 @@ func ResourceIntegration(alternateClients ...integrationclient.ClientService) *s
 		ReadContext:   ri.read,
 		UpdateContext: ri.update,
 		DeleteContext: ri.integrationDelete,
-		Schema:        integrationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: ri.importState,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+		Schema: integrationSchema,
 	}
 }
 
@@ -50,7 +56,7 @@ func (r *resourceIntegration) create(ctx context.Context, d *schema.ResourceData
 
 	resp, err := r.client(m).ManageV1alpha1ClusterIntegrationResourceServiceCreate(req)
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster integration entry, name : %s", fn.Name))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster integration entry, name : %s", fn.Name))
 	}
 
 	if resp == nil || resp.Integration == nil {
@@ -62,16 +68,97 @@ func (r *resourceIntegration) create(ctx context.Context, d *schema.ResourceData
 	return append(diags, r.read(ctx, d, m)...)
 }
 
-func (r *resourceIntegration) update(context.Context, *schema.ResourceData, interface{}) (diags diag.Diagnostics) {
-	return diag.FromErr(errors.New("update of Tanzu Mission Control integration is not supported"))
+// importState imports an integration using an ID of the form <management_cluster_name>/<provisioner_name>/<cluster_name>/<integration_name>.
+func (r *resourceIntegration) importState(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts, err := helper.ParseImportID(d.Id(), managementClusterNameKey, provisionerNameKey, clusterNameKey, integrationNameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	fn := &integration.VmwareTanzuManageV1alpha1ClusterIntegrationFullName{
+		ManagementClusterName: parts[0],
+		ProvisionerName:       parts[1],
+		ClusterName:           parts[2],
+		Name:                  parts[3],
+	}
+
+	if err = d.Set(managementClusterNameKey, fn.ManagementClusterName); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set management cluster name for the integration %s", fn.Name)
+	}
+
+	if err = d.Set(provisionerNameKey, fn.ProvisionerName); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set provisioner name for the integration %s", fn.Name)
+	}
+
+	if err = d.Set(clusterNameKey, fn.ClusterName); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set cluster name for the integration %s", fn.Name)
+	}
+
+	if err = d.Set(integrationNameKey, fn.Name); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the integration %s", fn.Name)
+	}
+
+	return helper.ReadImportedState(ctx, d, m, r.read)
+}
+
+func (r *resourceIntegration) update(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	specChanged := d.HasChange(specKey)
+	metaChanged := common.HasMetaChanged(d)
+
+	if !specChanged && !metaChanged {
+		return diags
+	}
+
+	fn := constructFullName(d)
+
+	getResp, err := r.client(m).ManageV1alpha1ClusterIntegrationResourceServiceRead(fn)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control integration entry, name: %s", fn.Name))
+	}
+
+	if getResp == nil || getResp.Integration == nil {
+		return diag.Errorf("invalid nil value reading resource %v", fn)
+	}
+
+	integrationToUpdate := getResp.Integration
+
+	if metaChanged {
+		meta := common.ConstructMeta(d)
+
+		if integrationToUpdate.Meta == nil {
+			integrationToUpdate.Meta = meta
+		} else {
+			if value, ok := integrationToUpdate.Meta.Labels[common.CreatorLabelKey]; ok {
+				meta.Labels[common.CreatorLabelKey] = value
+			}
+
+			integrationToUpdate.Meta.Labels = meta.Labels
+			integrationToUpdate.Meta.Description = meta.Description
+		}
+	}
+
+	if specChanged {
+		integrationToUpdate.Spec = constructSpec(d)
+	}
+
+	_, err = r.client(m).ManageV1alpha1ClusterIntegrationResourceServiceUpdate(
+		&integration.VmwareTanzuManageV1alpha1ClusterIntegrationUpdateIntegrationRequest{
+			Integration: integrationToUpdate,
+		},
+	)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control integration entry, name: %s", fn.Name))
+	}
+
+	return append(diags, r.read(ctx, d, m)...)
 }
 
-func (r *resourceIntegration) integrationDelete(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+func (r *resourceIntegration) integrationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
 	fn := constructFullName(d)
 
 	err := r.client(m).ManageV1alpha1ClusterIntegrationResourceServiceDelete(fn)
 	if err != nil && !clienterrors.IsNotFoundError(err) {
-		return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control integration entry, name: %s", fn.Name))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control integration entry, name: %s", fn.Name))
 	}
 
 	// d.SetId("") is automatically called assuming delete returns no errors, but
@@ -79,7 +166,7 @@ func (r *resourceIntegration) integrationDelete(_ context.Context, d *schema.Res
 	d.SetId("")
 
 	getIntegrationResourceRetryable := func() (retry bool, err error) {
-		if _, err = r.client(m).ManageV1alpha1ClusterIntegrationResourceServiceRead(fn); err == nil {
+		if _, err = r.client(m).ManageV1alpha1ClusterIntegrationResourceServiceReadWithContext(ctx, fn); err == nil {
 			return true, errors.New("integration deletion in progress")
 		}
 
@@ -90,8 +177,8 @@ func (r *resourceIntegration) integrationDelete(_ context.Context, d *schema.Res
 		return false, nil
 	}
 
-	if _, err = helper.Retry(getIntegrationResourceRetryable, 10*time.Second, 18); err != nil {
-		diag.FromErr(errors.Wrapf(err, "verify %s cluster integration resource clean up", fn.Name))
+	if _, err = helper.RetryUntilTimeoutWithContext(ctx, getIntegrationResourceRetryable, 10*time.Second, d.Timeout(schema.TimeoutDelete)); err != nil {
+		diags = clienterrors.ToDiagnostics(errors.Wrapf(err, "verify %s cluster integration resource clean up", fn.Name))
 	}
 
 	return diags
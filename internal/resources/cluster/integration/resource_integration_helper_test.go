@@ -166,6 +166,24 @@ func (t *testClient) ManageV1alpha1ClusterIntegrationResourceServiceRead(name *i
 	return &integration.VmwareTanzuManageV1alpha1ClusterIntegrationGetIntegrationResponse{Integration: r}, nil
 }
 
+func (t *testClient) ManageV1alpha1ClusterIntegrationResourceServiceReadWithContext(_ context.Context, name *integration.VmwareTanzuManageV1alpha1ClusterIntegrationFullName) (*integration.VmwareTanzuManageV1alpha1ClusterIntegrationGetIntegrationResponse, error) {
+	return t.ManageV1alpha1ClusterIntegrationResourceServiceRead(name)
+}
+
+func (t *testClient) ManageV1alpha1ClusterIntegrationResourceServiceUpdate(request *integration.VmwareTanzuManageV1alpha1ClusterIntegrationUpdateIntegrationRequest) (*integration.VmwareTanzuManageV1alpha1ClusterIntegrationUpdateIntegrationResponse, error) {
+	id := generateID(request.Integration.FullName)
+
+	r, ok := t.resources[id]
+	if !ok {
+		return nil, fmt.Errorf("cannot update %q: not found", id)
+	}
+
+	r.Meta = request.Integration.Meta
+	r.Spec = request.Integration.Spec
+
+	return &integration.VmwareTanzuManageV1alpha1ClusterIntegrationUpdateIntegrationResponse{Integration: r}, nil
+}
+
 func (t *testClient) ManageV1alpha1ClusterIntegrationResourceServiceDelete(name *integration.VmwareTanzuManageV1alpha1ClusterIntegrationFullName) error {
 	id := generateID(name)
 	if _, ok := t.resources[id]; !ok {
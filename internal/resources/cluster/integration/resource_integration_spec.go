@@ -8,6 +8,7 @@ package integration
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -55,7 +56,6 @@ var (
 			Type:        schema.TypeList,
 			Description: "Specification for the Integration",
 			Required:    true,
-			ForceNew:    true,
 			MinItems:    1,
 			MaxItems:    1,
 			Elem:        specResource,
@@ -75,8 +75,8 @@ var (
 				Type:             schema.TypeString,
 				Description:      "Integration specific configurations in JSON format",
 				Optional:         true,
-				ForceNew:         true,
 				ValidateDiagFunc: validateConfiguration,
+				DiffSuppressFunc: suppressEquivalentConfiguration,
 			},
 		},
 	}
@@ -129,3 +129,38 @@ func validateConfiguration(i interface{}, p cty.Path) diag.Diagnostics {
 
 	return nil
 }
+
+// suppressEquivalentConfiguration ignores the differences in formatting and key order between the configured
+// JSON and the configurations read back from Tanzu Mission Control, so that only actual changes are planned.
+func suppressEquivalentConfiguration(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	return equivalentConfiguration(oldValue, newValue)
+}
+
+func equivalentConfiguration(a, b string) bool {
+	aConfig, err := unmarshalConfiguration(a)
+	if err != nil {
+		return false
+	}
+
+	bConfig, err := unmarshalConfiguration(b)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(aConfig, bConfig)
+}
+
+// unmarshalConfiguration treats an unset configuration as an empty one, as Tanzu Mission Control does.
+func unmarshalConfiguration(v string) (map[string]interface{}, error) {
+	config := map[string]interface{}{}
+
+	if v == "" {
+		return config, nil
+	}
+
+	if err := json.Unmarshal([]byte(v), &config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
@@ -58,3 +58,20 @@ func TestFlattenSpec(t *testing.T) {
 		}
 	}
 }
+
+func TestEquivalentConfiguration(t *testing.T) {
+	for tcName, tc := range map[string]struct {
+		a, b   string
+		expect bool
+	}{
+		"same":          {a: `{"enableNamespaceExclusions":true}`, b: `{"enableNamespaceExclusions":true}`, expect: true},
+		"formatting":    {a: `{"a": 1, "b": [ "x" ]}`, b: `{"b":["x"],"a":1}`, expect: true},
+		"unset":         {a: ``, b: `{}`, expect: true},
+		"changed value": {a: `{"enableNamespaceExclusions":true}`, b: `{"enableNamespaceExclusions":false}`, expect: false},
+		"invalid":       {a: `{`, b: `{}`, expect: false},
+	} {
+		if got := equivalentConfiguration(tc.a, tc.b); got != tc.expect {
+			t.Errorf("%s: got %v, expected %v", tcName, got, tc.expect)
+		}
+	}
+}
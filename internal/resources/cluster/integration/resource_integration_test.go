@@ -6,11 +6,14 @@ SPDX-License-Identifier: MPL-2.0
 package integration
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
 
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster/integration"
 	testhelper "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/testing"
 )
@@ -70,3 +73,88 @@ func TestResourceIntegration(t *testing.T) {
 		},
 	})
 }
+
+func TestResourceIntegrationImport(t *testing.T) {
+	importClient := newTestClient()
+
+	_, err := importClient.ManageV1alpha1ClusterIntegrationResourceServiceCreate(&integration.VmwareTanzuManageV1alpha1ClusterIntegrationCreateIntegrationRequest{
+		Integration: validFullModel,
+	})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name        string
+		id          string
+		expectError bool
+	}{
+		{
+			name: "case for an existing integration",
+			id:   "attached-2/attached-2/test-cluster-2/tanzu-service-mesh",
+		},
+		{
+			name:        "case for a malformed import ID",
+			id:          "test-cluster-2/tanzu-service-mesh",
+			expectError: true,
+		},
+		{
+			name:        "case for a missing integration",
+			id:          "attached/attached/test-cluster/tanzu-service-mesh",
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, integrationSchema, map[string]interface{}{})
+			d.SetId(test.id)
+
+			result, err := ResourceIntegration().Importer.StateContext(context.Background(), d, importClient)
+			if test.expectError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, result, 1)
+			require.Equal(t, generateID(validFullModel.FullName), result[0].Id())
+			require.Equal(t, validFullModel.FullName.ManagementClusterName, result[0].Get(managementClusterNameKey))
+			require.Equal(t, validFullModel.FullName.ProvisionerName, result[0].Get(provisionerNameKey))
+			require.Equal(t, validFullModel.FullName.ClusterName, result[0].Get(clusterNameKey))
+			require.Equal(t, validFullModel.FullName.Name, result[0].Get(integrationNameKey))
+		})
+	}
+}
+
+func TestResourceIntegrationUpdate(t *testing.T) {
+	updateClient := newTestClient()
+
+	_, err := updateClient.ManageV1alpha1ClusterIntegrationResourceServiceCreate(&integration.VmwareTanzuManageV1alpha1ClusterIntegrationCreateIntegrationRequest{
+		Integration: &integration.VmwareTanzuManageV1alpha1ClusterIntegrationIntegration{
+			FullName: validMinimalModel.FullName,
+			Spec:     &integration.VmwareTanzuManageV1alpha1ClusterIntegrationSpec{},
+		},
+	})
+	require.NoError(t, err)
+
+	d := schema.TestResourceDataRaw(t, integrationSchema, map[string]interface{}{
+		managementClusterNameKey: validMinimalModel.FullName.ManagementClusterName,
+		provisionerNameKey:       validMinimalModel.FullName.ProvisionerName,
+		clusterNameKey:           validMinimalModel.FullName.ClusterName,
+		integrationNameKey:       validMinimalModel.FullName.Name,
+		specKey: []interface{}{
+			map[string]interface{}{
+				configurationKey: `{"enableNamespaceExclusions": true}`,
+			},
+		},
+	})
+	d.SetId(generateID(validMinimalModel.FullName))
+
+	diags := ResourceIntegration().UpdateContext(context.Background(), d, updateClient)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	resp, err := updateClient.ManageV1alpha1ClusterIntegrationResourceServiceRead(validMinimalModel.FullName)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{enableNamespaceExclusionsSpecKey: true}, resp.Integration.Spec.Configurations)
+	require.Equal(t, `{"enableNamespaceExclusions":true}`, d.Get(helper.GetFirstElementOf(specKey, configurationKey)))
+}
@@ -0,0 +1,86 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	runtimeSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AgentNamespace is the namespace the attach manifest installs the Tanzu Mission Control agents in.
+const AgentNamespace = "vmware-system-tmc"
+
+var (
+	namespaceGVK      = runtimeSchema.GroupVersionKind{Version: "v1", Kind: "Namespace"}
+	deploymentListGVK = runtimeSchema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "DeploymentList"}
+)
+
+// MissingAgentResources lists the agent resources of the attach manifest which are missing or unavailable on the cluster.
+// An empty list means the agent namespace exists and all the agent deployments are available.
+func MissingAgentResources(ctx context.Context, k8sclient *k8sClient.Client) ([]string, error) {
+	if k8sclient == nil {
+		return nil, errors.New("kubernetes client cannot be empty")
+	}
+
+	namespace := &unstructured.Unstructured{}
+	namespace.SetGroupVersionKind(namespaceGVK)
+
+	err := (*k8sclient).Get(ctx, types.NamespacedName{Name: AgentNamespace}, namespace)
+	if k8serrors.IsNotFound(err) {
+		return []string{fmt.Sprintf("namespace %s", AgentNamespace)}, nil
+	}
+
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to get namespace %s", AgentNamespace)
+	}
+
+	if namespace.GetDeletionTimestamp() != nil {
+		return []string{fmt.Sprintf("namespace %s (terminating)", AgentNamespace)}, nil
+	}
+
+	deployments := &unstructured.UnstructuredList{}
+	deployments.SetGroupVersionKind(deploymentListGVK)
+
+	if err := (*k8sclient).List(ctx, deployments, k8sClient.InNamespace(AgentNamespace)); err != nil {
+		return nil, errors.WithMessagef(err, "failed to list deployments in namespace %s", AgentNamespace)
+	}
+
+	if len(deployments.Items) == 0 {
+		return []string{fmt.Sprintf("deployments in namespace %s", AgentNamespace)}, nil
+	}
+
+	missing := make([]string, 0)
+
+	for i := range deployments.Items {
+		if !isDeploymentAvailable(&deployments.Items[i]) {
+			missing = append(missing, fmt.Sprintf("deployment %s/%s (unavailable)", AgentNamespace, deployments.Items[i].GetName()))
+		}
+	}
+
+	sort.Strings(missing)
+
+	return missing, nil
+}
+
+// isDeploymentAvailable checks that at least one replica of the deployment is available, or that it is scaled down on purpose.
+func isDeploymentAvailable(deployment *unstructured.Unstructured) bool {
+	replicas, found, _ := unstructured.NestedInt64(deployment.Object, "spec", "replicas")
+	if found && replicas == 0 {
+		return true
+	}
+
+	available, _, _ := unstructured.NestedInt64(deployment.Object, "status", "availableReplicas")
+
+	return available > 0
+}
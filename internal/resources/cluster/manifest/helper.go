@@ -108,7 +108,7 @@ func getManifests(manifestsBlob string) (manifests []manifest, err error) {
 	return manifests, nil
 }
 
-func objectsToBeCleaned(k8sclient *k8sClient.Client, manifests []manifest, clean bool) (tobeCleaned []string, err error) {
+func objectsToBeCleaned(ctx context.Context, k8sclient *k8sClient.Client, manifests []manifest, clean bool) (tobeCleaned []string, err error) {
 	if k8sclient == nil {
 		return tobeCleaned, fmt.Errorf("failed to get kube client")
 	}
@@ -117,10 +117,10 @@ func objectsToBeCleaned(k8sclient *k8sClient.Client, manifests []manifest, clean
 		unstruct := &unstructured.Unstructured{}
 		unstruct.SetGroupVersionKind(*manifest.gvk)
 
-		err := (*k8sclient).Get(context.Background(), manifest.namespacedName, unstruct)
+		err := (*k8sclient).Get(ctx, manifest.namespacedName, unstruct)
 		if err == nil {
 			if clean {
-				err := ensureObjectDeleted(k8sclient, unstruct)
+				err := ensureObjectDeleted(ctx, k8sclient, unstruct)
 				if err != nil {
 					return tobeCleaned, fmt.Errorf("failed to delete object %v of type %v, error:%v", manifest.namespacedName, manifest.gvk, err)
 				}
@@ -133,9 +133,9 @@ func objectsToBeCleaned(k8sclient *k8sClient.Client, manifests []manifest, clean
 	return
 }
 
-func createObjects(k8sclient *k8sClient.Client, manifests []manifest) error {
+func createObjects(ctx context.Context, k8sclient *k8sClient.Client, manifests []manifest) error {
 	for _, manifest := range manifests {
-		err := (*k8sclient).Create(context.Background(), &unstructured.Unstructured{Object: manifest.usObj})
+		err := (*k8sclient).Create(ctx, &unstructured.Unstructured{Object: manifest.usObj})
 		if err != nil {
 			return fmt.Errorf("error creating object with namespaced:%+v and gvk:%+v, error :%v", manifest.namespacedName, manifest.gvk, err)
 		}
@@ -144,9 +144,34 @@ func createObjects(k8sclient *k8sClient.Client, manifests []manifest) error {
 	return nil
 }
 
-func ensureObjectDeleted(k8sclient *k8sClient.Client, object *unstructured.Unstructured) (err error) {
+func applyObjects(ctx context.Context, k8sclient *k8sClient.Client, manifests []manifest) error {
+	for _, manifest := range manifests {
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(*manifest.gvk)
+
+		object := &unstructured.Unstructured{Object: manifest.usObj}
+
+		err := (*k8sclient).Get(ctx, manifest.namespacedName, existing)
+
+		switch {
+		case k8serrors.IsNotFound(err):
+			err = (*k8sclient).Create(ctx, object)
+		case err == nil:
+			object.SetResourceVersion(existing.GetResourceVersion())
+			err = (*k8sclient).Update(ctx, object)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error applying object with namespaced:%+v and gvk:%+v, error :%v", manifest.namespacedName, manifest.gvk, err)
+		}
+	}
+
+	return nil
+}
+
+func ensureObjectDeleted(ctx context.Context, k8sclient *k8sClient.Client, object *unstructured.Unstructured) (err error) {
 	deleteFn := func() (bool, error) {
-		err = (*k8sclient).Delete(context.Background(), object)
+		err = (*k8sclient).Delete(ctx, object)
 		if k8serrors.IsNotFound(err) || err == nil {
 			return false, nil
 		}
@@ -158,7 +183,7 @@ func ensureObjectDeleted(k8sclient *k8sClient.Client, object *unstructured.Unstr
 		return true, err
 	}
 
-	if _, err := helper.Retry(deleteFn, interval, retries); err != nil {
+	if _, err := helper.RetryUntilTimeoutWithContext(ctx, deleteFn, interval, retries*interval); err != nil {
 		return err
 	}
 
@@ -6,6 +6,7 @@ SPDX-License-Identifier: MPL-2.0
 package manifest
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/pkg/errors"
@@ -18,6 +19,7 @@ import (
 )
 
 func Create(
+	ctx context.Context,
 	k8sclient *k8sClient.Client,
 	k8sManifest string,
 	forceClean bool,
@@ -31,7 +33,7 @@ func Create(
 		return errors.WithMessage(err, "failure to fetch attach manifests")
 	}
 
-	toBeCleaned, err := objectsToBeCleaned(k8sclient, manifests, forceClean)
+	toBeCleaned, err := objectsToBeCleaned(ctx, k8sclient, manifests, forceClean)
 	if err != nil && forceClean {
 		return errors.WithMessage(err, "error while cleaning up the resources")
 	}
@@ -46,7 +48,7 @@ func Create(
 		return errors.New("please clean up the above mentioned k8s objects or follow cluster detach steps and retry")
 	}
 
-	err = createObjects(k8sclient, manifests)
+	err = createObjects(ctx, k8sclient, manifests)
 	if err != nil {
 		return errors.WithMessage(err, "error while attaching the cluster")
 	}
@@ -55,3 +57,27 @@ func Create(
 
 	return nil
 }
+
+// Apply re-applies the attach manifest on a cluster already attached, creating the missing objects and
+// replacing the existing ones with their manifest definition.
+func Apply(
+	ctx context.Context,
+	k8sclient *k8sClient.Client,
+	k8sManifest string,
+) error {
+	if k8sclient == nil {
+		return errors.New("kubernetes client cannot be empty")
+	}
+
+	manifests, err := getManifests(k8sManifest)
+	if err != nil {
+		return errors.WithMessage(err, "failure to fetch attach manifests")
+	}
+
+	err = applyObjects(ctx, k8sclient, manifests)
+	if err != nil {
+		return errors.WithMessage(err, "error while re-applying the attach manifest")
+	}
+
+	return nil
+}
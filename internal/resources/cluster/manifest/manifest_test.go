@@ -0,0 +1,118 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package manifest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func testNamespace() runtime.Object {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: AgentNamespace}}
+}
+
+func testDeployment(name string, replicas int32, available int32) runtime.Object {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: AgentNamespace},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     appsv1.DeploymentStatus{AvailableReplicas: available},
+	}
+}
+
+func TestMissingAgentResources(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		objects  []runtime.Object
+		expected []string
+	}{
+		{
+			name:     "case for a deleted agent namespace",
+			objects:  nil,
+			expected: []string{"namespace vmware-system-tmc"},
+		},
+		{
+			name:     "case for an agent namespace without deployments",
+			objects:  []runtime.Object{testNamespace()},
+			expected: []string{"deployments in namespace vmware-system-tmc"},
+		},
+		{
+			name: "case for an unavailable agent deployment",
+			objects: []runtime.Object{
+				testNamespace(),
+				testDeployment("cluster-agent", 1, 0),
+				testDeployment("agent-updater", 1, 1),
+			},
+			expected: []string{"deployment vmware-system-tmc/cluster-agent (unavailable)"},
+		},
+		{
+			name: "case for healthy agent deployments",
+			objects: []runtime.Object{
+				testNamespace(),
+				testDeployment("cluster-agent", 1, 1),
+				testDeployment("agent-updater", 0, 0),
+			},
+			expected: []string{},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			var client k8sClient.Client = fake.NewFakeClientWithScheme(scheme.Scheme, test.objects...)
+
+			actual, err := MissingAgentResources(context.Background(), &client)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+
+	_, err := MissingAgentResources(context.Background(), nil)
+	require.Error(t, err)
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	k8sManifest := `apiVersion: v1
+kind: Namespace
+metadata:
+  name: vmware-system-tmc
+  labels:
+    tmc.cloud.vmware.com/managed: "true"
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: stack-config
+  namespace: vmware-system-tmc
+data:
+  cluster_name: c1
+`
+
+	var client k8sClient.Client = fake.NewFakeClientWithScheme(scheme.Scheme, testNamespace())
+
+	require.NoError(t, Apply(context.Background(), &client, k8sManifest))
+
+	namespace := &corev1.Namespace{}
+	require.NoError(t, client.Get(context.Background(), types.NamespacedName{Name: AgentNamespace}, namespace))
+	require.Equal(t, "true", namespace.Labels["tmc.cloud.vmware.com/managed"])
+
+	configMap := &corev1.ConfigMap{}
+	require.NoError(t, client.Get(context.Background(), types.NamespacedName{Namespace: AgentNamespace, Name: "stack-config"}, configMap))
+	require.Equal(t, "c1", configMap.Data["cluster_name"])
+}
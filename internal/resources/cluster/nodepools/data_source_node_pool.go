@@ -25,6 +25,9 @@ func DataSourceClusterNodePool() *schema.Resource {
 		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 			return dataSourceClusterNodePoolRead(helper.GetContextWithCaller(ctx, helper.DataRead), d, m)
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: nodePoolSchema,
 	}
 }
@@ -38,7 +41,7 @@ func dataSourceClusterNodePoolRead(ctx context.Context, d *schema.ResourceData,
 	)
 
 	getNodepoolResourceRetryableFn := func() (retry bool, err error) {
-		resp, err = config.TMCConnection.NodePoolResourceService.ManageV1alpha1ClusterNodePoolResourceServiceGet(constructFullName(d))
+		resp, err = config.TMCConnection.NodePoolResourceService.ManageV1alpha1ClusterNodePoolResourceServiceGetWithContext(ctx, constructFullName(d))
 		if err != nil {
 			if clienterrors.IsNotFoundError(err) && !helper.IsDataRead(ctx) {
 				_ = schema.RemoveFromState(d, m)
@@ -65,14 +68,19 @@ func dataSourceClusterNodePoolRead(ctx context.Context, d *schema.ResourceData,
 
 		return false, nil
 	}
-	timeoutValueData, _ := d.Get(waitKey).(string)
+	timeoutKey := schema.TimeoutRead
 
-	timeoutDuration, _ := time.ParseDuration(timeoutValueData)
+	switch {
+	case helper.IsCreateState(ctx):
+		timeoutKey = schema.TimeoutCreate
+	case helper.IsUpdateState(ctx):
+		timeoutKey = schema.TimeoutUpdate
+	}
 
-	_, err = helper.RetryUntilTimeout(getNodepoolResourceRetryableFn, 10*time.Second, timeoutDuration)
+	_, err = helper.RetryUntilTimeoutWithContext(ctx, getNodepoolResourceRetryableFn, 10*time.Second, helper.GetWaitTimeout(d, waitKey, timeoutKey))
 
 	if err != nil || resp == nil || resp.Nodepool == nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to get tanzu cluster node pool entry"))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get tanzu cluster node pool entry"))
 	}
 
 	var readyConditon nodepoolsmodel.VmwareTanzuCoreV1alpha1StatusCondition
@@ -33,7 +33,16 @@ func ResourceNodePool() *schema.Resource {
 		CreateContext: resourceNodePoolCreate,
 		UpdateContext: resourceClusterNodePoolInPlaceUpdate,
 		DeleteContext: resourceClusterNodePoolDelete,
-		Schema:        nodePoolSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNodePoolImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: nodePoolSchema,
 	}
 }
 
@@ -72,7 +81,7 @@ var nodePoolSchema = map[string]*schema.Schema{
 	},
 	waitKey: {
 		Type:        schema.TypeString,
-		Description: "Wait timeout duration until nodepool resource reaches READY state. Accepted timeout duration values like 5s, 45m, or 3h, higher than zero.",
+		Description: "Wait timeout duration until nodepool resource reaches READY state. Accepted timeout duration values like 5s, 45m, or 3h, higher than zero. When not set in the configuration, the timeouts of the resource apply.",
 		Default:     "10m",
 		Optional:    true,
 	},
@@ -184,6 +193,43 @@ var NodePoolTkgAWS = &schema.Schema{
 	},
 }
 
+// resourceNodePoolImporter imports a node pool using an ID of the form <management_cluster_name>/<provisioner_name>/<cluster_name>/<name>.
+func resourceNodePoolImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts, err := helper.ParseImportID(d.Id(), managementClusterNameKey, provisionerNameKey, clusterNameKey, nodePoolNameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	fullname := &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName{
+		ManagementClusterName: parts[0],
+		ProvisionerName:       parts[1],
+		ClusterName:           parts[2],
+		Name:                  parts[3],
+	}
+
+	if err = d.Set(managementClusterNameKey, fullname.ManagementClusterName); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set management cluster name for the nodepool %s", fullname.Name)
+	}
+
+	if err = d.Set(provisionerNameKey, fullname.ProvisionerName); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set provisioner name for the nodepool %s", fullname.Name)
+	}
+
+	if err = d.Set(clusterNameKey, fullname.ClusterName); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set cluster name for the nodepool %s", fullname.Name)
+	}
+
+	if err = d.Set(nodePoolNameKey, fullname.Name); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the nodepool %s", fullname.Name)
+	}
+
+	if err = d.Set(waitKey, nodePoolSchema[waitKey].Default); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set wait timeout for the nodepool %s", fullname.Name)
+	}
+
+	return helper.ReadImportedState(helper.GetContextWithCaller(ctx, helper.RefreshState), d, m, dataSourceClusterNodePoolRead)
+}
+
 func constructFullName(d *schema.ResourceData) (fullname *nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName) {
 	fullname = &nodepoolmodel.VmwareTanzuManageV1alpha1ClusterNodepoolFullName{}
 
@@ -489,7 +535,7 @@ func resourceNodePoolCreate(ctx context.Context, d *schema.ResourceData, m inter
 	nodePoolResponse, err := config.TMCConnection.NodePoolResourceService.ManageV1alpha1ClusterNodePoolResourceServiceCreate(nodePoolRequest)
 
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "unable to create tanzu node pool entry"))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to create tanzu node pool entry"))
 	}
 
 	if nodePoolResponse.Nodepool.Status == nil {
@@ -498,7 +544,7 @@ func resourceNodePoolCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	d.SetId(nodePoolResponse.Nodepool.FullName.Name + ":" + nodePoolResponse.Nodepool.FullName.ClusterName)
 
-	return dataSourceClusterNodePoolRead(ctx, d, m)
+	return dataSourceClusterNodePoolRead(helper.GetContextWithCaller(ctx, helper.CreateState), d, m)
 }
 
 func resourceClusterNodePoolInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
@@ -508,7 +554,7 @@ func resourceClusterNodePoolInPlaceUpdate(ctx context.Context, d *schema.Resourc
 
 	getResp, err := config.TMCConnection.NodePoolResourceService.ManageV1alpha1ClusterNodePoolResourceServiceGet(constructFullName(d))
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to get tanzu cluster node pool entry"))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get tanzu cluster node pool entry"))
 	}
 
 	switch {
@@ -563,19 +609,19 @@ func resourceClusterNodePoolInPlaceUpdate(ctx context.Context, d *schema.Resourc
 		},
 	)
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to update tanzu cluster node pool entry"))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update tanzu cluster node pool entry"))
 	}
 
-	return dataSourceClusterNodePoolRead(ctx, d, m)
+	return dataSourceClusterNodePoolRead(helper.GetContextWithCaller(ctx, helper.UpdateState), d, m)
 }
 
-func resourceClusterNodePoolDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceClusterNodePoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(authctx.TanzuContext)
 
 	var diags diag.Diagnostics
 
 	deleteNodepoolResourceRetryableFn := func() (retry bool, err error) {
-		err = config.TMCConnection.NodePoolResourceService.ManageV1alpha1ClusterNodePoolResourceServiceDelete(constructFullName(d))
+		err = config.TMCConnection.NodePoolResourceService.ManageV1alpha1ClusterNodePoolResourceServiceDeleteWithContext(ctx, constructFullName(d))
 		if err != nil {
 			if clienterrors.IsNotFoundError(err) {
 				return true, nil
@@ -589,11 +635,7 @@ func resourceClusterNodePoolDelete(_ context.Context, d *schema.ResourceData, m
 
 		return false, nil
 	}
-	timeoutValueData, _ := d.Get(waitKey).(string)
-
-	timeoutDuration, _ := time.ParseDuration(timeoutValueData)
-
-	_, err := helper.RetryUntilTimeout(deleteNodepoolResourceRetryableFn, 10*time.Second, timeoutDuration)
+	_, err := helper.RetryUntilTimeoutWithContext(ctx, deleteNodepoolResourceRetryableFn, 10*time.Second, helper.GetWaitTimeout(d, waitKey, schema.TimeoutDelete))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -38,14 +38,34 @@ type (
 
 func ResourceTMCCluster() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-			return dataSourceClusterRead(helper.GetContextWithCaller(ctx, helper.RefreshState), d, m)
-		},
+		ReadContext:   resourceClusterRead,
 		CreateContext: resourceClusterCreate,
 		UpdateContext: resourceClusterInPlaceUpdate,
 		DeleteContext: resourceClusterDelete,
-		Schema:        clusterSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceClusterImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema:        resourceClusterSchema(),
+		CustomizeDiff: customizeAttachManifestDiff,
+	}
+}
+
+// resourceClusterSchema extends the cluster schema shared with the data source with the attributes only managed by the resource.
+func resourceClusterSchema() map[string]*schema.Schema {
+	resourceSchema := make(map[string]*schema.Schema, len(clusterSchema)+2)
+
+	for key, value := range clusterSchema {
+		resourceSchema[key] = value
 	}
+
+	resourceSchema[attachManifestStatusKey] = attachManifestStatus
+	resourceSchema[common.AdoptExistingKey] = common.AdoptExisting
+
+	return resourceSchema
 }
 
 var clusterSchema = map[string]*schema.Schema{
@@ -80,7 +100,7 @@ var clusterSchema = map[string]*schema.Schema{
 	},
 	waitKey: {
 		Type:        schema.TypeString,
-		Description: "Wait timeout duration until cluster resource reaches READY state. Accepted timeout duration values like 5s, 45m, or 3h, higher than zero. Should be set to 0 in case of simple attach cluster where kubeconfig input is not provided.",
+		Description: "Wait timeout duration until cluster resource reaches READY state. Accepted timeout duration values like 5s, 45m, or 3h, higher than zero. Should be set to 0 in case of simple attach cluster where kubeconfig input is not provided. When not set, the create timeout of the resource applies.",
 		Default:     "default",
 		Optional:    true,
 	},
@@ -100,6 +120,38 @@ func constructFullname(d *schema.ResourceData) (fullname *clustermodel.VmwareTan
 	return fullname
 }
 
+// resourceClusterImporter imports a cluster using an ID of the form <management_cluster_name>/<provisioner_name>/<name>.
+func resourceClusterImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts, err := helper.ParseImportID(d.Id(), ManagementClusterNameKey, ProvisionerNameKey, NameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	fullname := &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{
+		ManagementClusterName: parts[0],
+		ProvisionerName:       parts[1],
+		Name:                  parts[2],
+	}
+
+	if err = d.Set(ManagementClusterNameKey, fullname.ManagementClusterName); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set management cluster name for the cluster %s", fullname.Name)
+	}
+
+	if err = d.Set(ProvisionerNameKey, fullname.ProvisionerName); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set provisioner name for the cluster %s", fullname.Name)
+	}
+
+	if err = d.Set(NameKey, fullname.Name); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the cluster %s", fullname.Name)
+	}
+
+	if err = d.Set(waitKey, "default"); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set wait timeout for the cluster %s", fullname.Name)
+	}
+
+	return helper.ReadImportedState(helper.GetContextWithCaller(ctx, helper.RefreshState), d, m, dataSourceClusterRead)
+}
+
 var (
 	attachCluster = &schema.Schema{
 		Type:     schema.TypeList,
@@ -278,10 +330,9 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 	config := m.(authctx.TanzuContext)
 
 	var (
-		k8sclient  *k8sClient.Client
-		err        error
-		kubeConfig interface{}
-		manifests  string
+		k8sclient *k8sClient.Client
+		err       error
+		manifests string
 	)
 
 	if v, ok := d.GetOk(attachClusterKey); ok {
@@ -294,46 +345,11 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 			return diag.FromErr(err)
 		}
 
-		isKubeConfigPresent := func(typeKey string) bool {
-			if value, ok := d.GetOk(helper.GetFirstElementOf(attachClusterKey, typeKey)); ok {
-				if value != nil {
-					kubeConfig = value
-					return true
-				}
-			}
-
-			return false
-		}
-
-		switch {
-		case isKubeConfigPresent(attachClusterKubeConfigPathKey):
-			kubeConfigFile, _ := kubeConfig.(string)
-			if strings.TrimSpace(kubeConfigFile) == "" {
-				return diag.FromErr(fmt.Errorf("expected kubeconfig file path to not be an empty string or whitespace"))
-			}
-
-			k8sclient, err = getK8sClient(withPath(kubeConfigFile))
-
-		case isKubeConfigPresent(attachClusterKubeConfigRawKey):
-			rawKubeConfig, _ := kubeConfig.(string)
-			if strings.TrimSpace(rawKubeConfig) == "" {
-				return diag.FromErr(fmt.Errorf("expected raw kubeconfig to not be an empty string or whitespace"))
-			}
-
-			k8sclient, err = getK8sClient(withRaw(rawKubeConfig))
-		}
-
+		k8sclient, err = getAttachK8sClient(d)
 		if err != nil {
 			log.Println("[ERROR] error while creating kubernetes client: ", err.Error())
 			return diag.FromErr(err)
 		}
-
-		if k8sclient == nil {
-			err = errors.New("error while obtaining k8s client from REST config")
-			log.Println("[ERROR] error while creating kubernetes client: ", err.Error())
-
-			return diag.FromErr(err)
-		}
 	}
 
 	clusterReq := &clustermodel.VmwareTanzuManageV1alpha1ClusterRequest{
@@ -344,15 +360,39 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 		},
 	}
 
+	var adopted bool
+
 	clusterResponse, err := config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceCreate(clusterReq)
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster entry, name : %s", d.Get(NameKey)))
+		if !clienterrors.IsAlreadyExistsError(err) {
+			return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster entry, name : %s", d.Get(NameKey)))
+		}
+
+		if !common.ShouldAdoptExisting(d) {
+			return clienterrors.ToDiagnostics(common.AlreadyExistsError(err, "cluster", d.Get(NameKey)))
+		}
+
+		clusterResponse, err = adoptExistingCluster(config, clusterReq.Cluster)
+		if err != nil {
+			return clienterrors.ToDiagnostics(err)
+		}
+
+		adopted = true
+
+		diags = append(diags, common.AdoptedWarning("cluster", d.Get(NameKey)))
 	}
 
 	// always run
 	d.SetId(clusterResponse.Cluster.Meta.UID)
 
-	if _, ok := d.GetOk(attachClusterKey); ok {
+	_, attach := d.GetOk(attachClusterKey)
+
+	if attach && adopted {
+		// The attach manifest of an adopted cluster may have been applied partly by the failed apply.
+		if diags = append(diags, reapplyAttachManifest(ctx, d, m)...); diags.HasError() {
+			return diags
+		}
+	} else if attach {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Warning,
 			Summary:  "Kubernetes cluster's kubeconfig provided. Proceeding to attach the cluster TMC",
@@ -376,17 +416,69 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 
 		log.Printf("[INFO] Applying %s cluster's deployment link manifest objects on to kubernetes cluster", constructFullname(d).ToString())
 
-		err = manifest.Create(k8sclient, manifests, true)
+		err = manifest.Create(ctx, k8sclient, manifests, true)
 		if err != nil {
 			return append(diags, diag.FromErr(err)...)
 		}
 
+		if err := d.Set(attachManifestStatusKey, attachManifestApplied); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+
 		log.Printf("[INFO] Cluster attach successful. Tanzu Mission Control resources applied to the cluster(%s) successfully", constructFullname(d).ToString())
 	}
 
 	return append(diags, dataSourceClusterRead(context.WithValue(ctx, contextMethodKey{}, "create"), d, m)...)
 }
 
+// getAttachK8sClient creates the kubernetes client of the cluster from the kubeconfig of the attach_k8s_cluster block.
+func getAttachK8sClient(d *schema.ResourceData) (*k8sClient.Client, error) {
+	var (
+		k8sclient  *k8sClient.Client
+		err        error
+		kubeConfig interface{}
+	)
+
+	isKubeConfigPresent := func(typeKey string) bool {
+		if value, ok := d.GetOk(helper.GetFirstElementOf(attachClusterKey, typeKey)); ok {
+			if value != nil {
+				kubeConfig = value
+				return true
+			}
+		}
+
+		return false
+	}
+
+	switch {
+	case isKubeConfigPresent(attachClusterKubeConfigPathKey):
+		kubeConfigFile, _ := kubeConfig.(string)
+		if strings.TrimSpace(kubeConfigFile) == "" {
+			return nil, fmt.Errorf("expected kubeconfig file path to not be an empty string or whitespace")
+		}
+
+		k8sclient, err = getK8sClient(withPath(kubeConfigFile))
+
+	case isKubeConfigPresent(attachClusterKubeConfigRawKey):
+		rawKubeConfig, _ := kubeConfig.(string)
+		if strings.TrimSpace(rawKubeConfig) == "" {
+			return nil, fmt.Errorf("expected raw kubeconfig to not be an empty string or whitespace")
+		}
+
+		k8sclient, err = getK8sClient(withRaw(rawKubeConfig))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if k8sclient == nil {
+		return nil, errors.New("error while obtaining k8s client from REST config")
+	}
+
+	return k8sclient, nil
+}
+
 type (
 	kubeConfigOption func(*kubeConfig)
 
@@ -408,6 +500,12 @@ func withRaw(r string) kubeConfigOption {
 	}
 }
 
+// NewK8sClient creates a kubernetes client from either a kubeconfig file path or a raw kubeconfig, the file path
+// taking precedence when both are set.
+func NewK8sClient(filePath, raw string) (*k8sClient.Client, error) {
+	return getK8sClient(withPath(filePath), withRaw(raw))
+}
+
 func getK8sClient(opts ...kubeConfigOption) (*k8sClient.Client, error) {
 	cfg := &kubeConfig{}
 
@@ -447,7 +545,7 @@ func getK8sClient(opts ...kubeConfigOption) (*k8sClient.Client, error) {
 	return &client, nil
 }
 
-func resourceClusterDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(authctx.TanzuContext)
 
 	// Warning or errors can be collected in a slice type
@@ -455,7 +553,7 @@ func resourceClusterDelete(_ context.Context, d *schema.ResourceData, m interfac
 
 	err := config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceDelete(constructFullname(d), "false")
 	if err != nil && !clienterrors.IsNotFoundError(err) {
-		return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster entry, name : %s", d.Get(NameKey)))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster entry, name : %s", d.Get(NameKey)))
 	}
 
 	// d.SetId("") is automatically called assuming delete returns no errors, but
@@ -463,7 +561,7 @@ func resourceClusterDelete(_ context.Context, d *schema.ResourceData, m interfac
 	_ = schema.RemoveFromState(d, m)
 
 	getClusterResourceRetryableFn := func() (retry bool, err error) {
-		_, err = config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceGet(constructFullname(d))
+		_, err = config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceGetWithContext(ctx, constructFullname(d))
 		if err == nil {
 			return true, errors.New("cluster deletion in progress")
 		}
@@ -475,7 +573,7 @@ func resourceClusterDelete(_ context.Context, d *schema.ResourceData, m interfac
 		return false, nil
 	}
 
-	_, err = helper.Retry(getClusterResourceRetryableFn, 10*time.Second, 25)
+	_, err = helper.RetryUntilTimeoutWithContext(ctx, getClusterResourceRetryableFn, 10*time.Second, d.Timeout(schema.TimeoutDelete))
 	if err == nil {
 		return diags
 	}
@@ -487,13 +585,13 @@ func resourceClusterDelete(_ context.Context, d *schema.ResourceData, m interfac
 
 		log.Printf("[INFO] Cluster deletion in progress. Initiating force detach of the cluster entry as k8s cluster might not be responsive %s", constructFullname(d).ToString())
 
-		diags = diag.FromErr(errors.Wrapf(err, "Initiating force detach for %s cluster."+
+		diags = clienterrors.ToDiagnostics(errors.Wrapf(err, "Initiating force detach for %s cluster."+
 			"Ideally clean up of tmc agents and vmware-system-tmc namespace should have happened if not please remove them manually following "+
 			"https://docs.vmware.com/en/VMware-Tanzu-Mission-Control/services/tanzumc-using/GUID-3061A796-CA3D-4354-A0B7-19F50F2617CE.html", d.Get(NameKey)))
 	}
 
 	if err != nil {
-		diags = diag.FromErr(errors.Wrapf(err, "verify %s cluster resource clean up", d.Get(NameKey)))
+		diags = clienterrors.ToDiagnostics(errors.Wrapf(err, "verify %s cluster resource clean up", d.Get(NameKey)))
 	}
 
 	return diags
@@ -568,10 +666,17 @@ func resourceClusterInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	var updateAvailable bool
 
+	if d.HasChange(attachManifestStatusKey) && d.Get(attachManifestStatusKey) == attachManifestApplied {
+		diags = reapplyAttachManifest(ctx, d, m)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	// Get call to initialise the cluster struct
 	getResp, err := config.TMCConnection.ClusterResourceService.ManageV1alpha1ClusterResourceServiceGet(constructFullname(d))
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster entry, name : %s", d.Get(NameKey)))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster entry, name : %s", d.Get(NameKey)))
 	}
 
 	updates := updateCheck{withMetaUpdate, withClusterGroupUpdate, withTKGsVsphereVersionUpdate, withTKGmVsphereVersionUpdate}
@@ -589,11 +694,11 @@ func resourceClusterInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 			},
 		)
 		if err != nil {
-			return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster entry, name : %s", d.Get(NameKey)))
+			return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster entry, name : %s", d.Get(NameKey)))
 		}
 
 		log.Printf("[INFO] cluster update successful")
 	}
 
-	return dataSourceClusterRead(ctx, d, m)
+	return append(diags, dataSourceClusterRead(ctx, d, m)...)
 }
@@ -6,6 +6,11 @@ SPDX-License-Identifier: MPL-2.0
 package clustergroup
 
 const (
-	ResourceName = "tanzu-mission-control_cluster_group"
-	NameKey      = "name"
+	ResourceName              = "tanzu-mission-control_cluster_group"
+	ClusterGroupsResourceName = "tanzu-mission-control_cluster_groups"
+	NameKey                   = "name"
+	clusterGroupsKey          = "cluster_groups"
+	uidKey                    = "uid"
+	descriptionKey            = "description"
+	labelsKey                 = "labels"
 )
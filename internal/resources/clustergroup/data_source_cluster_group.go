@@ -47,7 +47,7 @@ func dataSourceClusterGroupRead(ctx context.Context, d *schema.ResourceData, m i
 			return
 		}
 
-		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster group entry, name : %s", clusterGroupName))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster group entry, name : %s", clusterGroupName))
 	}
 
 	d.SetId(resp.ClusterGroup.Meta.UID)
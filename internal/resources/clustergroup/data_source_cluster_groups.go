@@ -0,0 +1,156 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package clustergroup
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/pagination"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	clustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/clustergroup"
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+func DataSourceClusterGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceClusterGroupsRead,
+		Schema: map[string]*schema.Schema{
+			NameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the cluster groups to list, supports globbing with *.",
+				Optional:    true,
+			},
+			labelsKey: {
+				Type:        schema.TypeMap,
+				Description: "Only list the cluster groups having all these labels.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			clusterGroupsKey: {
+				Type:        schema.TypeList,
+				Description: "Cluster groups matching the filters, sorted by name.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						uidKey: {
+							Type:        schema.TypeString,
+							Description: "UID of the cluster group.",
+							Computed:    true,
+						},
+						NameKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the cluster group.",
+							Computed:    true,
+						},
+						descriptionKey: {
+							Type:        schema.TypeString,
+							Description: "Description of the cluster group.",
+							Computed:    true,
+						},
+						labelsKey: {
+							Type:        schema.TypeMap,
+							Description: "Labels of the cluster group.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceClusterGroupsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+
+	name, _ := d.Get(NameKey).(string)
+	selector := helper.GetStringMap(d.Get(labelsKey))
+
+	request := &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupListClusterGroupsRequestParameters{
+		SearchScope: &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupSearchScope{
+			Name: name,
+		},
+		IncludeTotalCount: true,
+	}
+
+	clusterGroups, err := pagination.ListAll(pagination.DefaultPageSize, func(options *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions) (*pagination.Page[*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup], error) {
+		request.Pagination = options
+
+		resp, err := config.TMCConnection.ClusterGroupResourceService.ManageV1alpha1ClusterGroupResourceServiceList(request)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pagination.Page[*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup]{Items: resp.ClusterGroups, TotalCount: resp.TotalCount}, nil
+	})
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrap(err, "Unable to list Tanzu Mission Control cluster groups"))
+	}
+
+	if err := d.Set(clusterGroupsKey, flattenClusterGroups(filterClusterGroups(clusterGroups, selector))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helper.ConstructListDataSourceID(name, helper.LabelSelectorString(selector)))
+
+	return nil
+}
+
+func filterClusterGroups(clusterGroups []*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup, selector map[string]string) []*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup {
+	filtered := make([]*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup, 0, len(clusterGroups))
+
+	for _, clusterGroup := range clusterGroups {
+		if clusterGroup == nil || clusterGroup.FullName == nil {
+			continue
+		}
+
+		var labels map[string]string
+		if clusterGroup.Meta != nil {
+			labels = clusterGroup.Meta.Labels
+		}
+
+		if helper.MatchLabels(labels, selector) {
+			filtered = append(filtered, clusterGroup)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].FullName.Name < filtered[j].FullName.Name
+	})
+
+	return filtered
+}
+
+func flattenClusterGroups(clusterGroups []*clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupClusterGroup) []interface{} {
+	data := make([]interface{}, 0, len(clusterGroups))
+
+	for _, clusterGroup := range clusterGroups {
+		flattenClusterGroup := map[string]interface{}{
+			NameKey: clusterGroup.FullName.Name,
+		}
+
+		if clusterGroup.Meta != nil {
+			flattenClusterGroup[uidKey] = clusterGroup.Meta.UID
+			flattenClusterGroup[descriptionKey] = clusterGroup.Meta.Description
+			flattenClusterGroup[labelsKey] = clusterGroup.Meta.Labels
+		}
+
+		data = append(data, flattenClusterGroup)
+	}
+
+	return data
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
 	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	clustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/clustergroup"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 )
@@ -24,7 +25,10 @@ func ResourceClusterGroup() *schema.Resource {
 		ReadContext:   dataSourceClusterGroupRead,
 		UpdateContext: resourceClusterGroupInPlaceUpdate,
 		DeleteContext: resourceClusterGroupDelete,
-		Schema:        clusterGroupSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceClusterGroupImporter,
+		},
+		Schema: clusterGroupSchema,
 	}
 }
 
@@ -57,7 +61,7 @@ func resourceClusterGroupInPlaceUpdate(ctx context.Context, d *schema.ResourceDa
 
 	getResp, err := config.TMCConnection.ClusterGroupResourceService.ManageV1alpha1ClusterGroupResourceServiceGet(fn)
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to get tanzu cluster group entry, name : %s", clusterGroupName))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get tanzu cluster group entry, name : %s", clusterGroupName))
 	}
 
 	if updateRequired {
@@ -77,7 +81,7 @@ func resourceClusterGroupInPlaceUpdate(ctx context.Context, d *schema.ResourceDa
 		},
 	)
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to update tanzu TMC cluster group entry, name : %s", clusterGroupName))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update tanzu TMC cluster group entry, name : %s", clusterGroupName))
 	}
 
 	return dataSourceClusterGroupRead(ctx, d, m)
@@ -94,7 +98,7 @@ func resourceClusterGroupDelete(_ context.Context, d *schema.ResourceData, m int
 
 	err := config.TMCConnection.ClusterGroupResourceService.ManageV1alpha1ClusterGroupResourceServiceDelete(fn)
 	if err != nil && !clienterrors.IsNotFoundError(err) {
-		return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group entry, name : %s", clusterGroupName))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group entry, name : %s", clusterGroupName))
 	}
 
 	_ = schema.RemoveFromState(d, m)
@@ -122,10 +126,24 @@ func resourceClusterGroupCreate(_ context.Context, d *schema.ResourceData, m int
 	clusterGroupResponse, err := config.TMCConnection.ClusterGroupResourceService.ManageV1alpha1ClusterGroupResourceServiceCreate(clusterGroupRequest)
 
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group entry, name : %s", clusterGroupName))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group entry, name : %s", clusterGroupName))
 	}
 
 	d.SetId(clusterGroupResponse.ClusterGroup.Meta.UID)
 
 	return diags
 }
+
+// resourceClusterGroupImporter imports a cluster group using an ID of the form <name>.
+func resourceClusterGroupImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts, err := helper.ParseImportID(d.Id(), NameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.Set(NameKey, parts[0]); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the cluster group %s", parts[0])
+	}
+
+	return helper.ReadImportedState(ctx, d, m, dataSourceClusterGroupRead)
+}
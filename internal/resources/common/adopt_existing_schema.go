@@ -0,0 +1,97 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package common
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+const AdoptExistingKey = "adopt_existing"
+
+var AdoptExisting = adoptExistingSchema(false)
+
+// AdoptExistingByDefault is the adopt_existing attribute of the resources which adopted an existing cluster before
+// the attribute was introduced, and keep doing so unless it is disabled.
+var AdoptExistingByDefault = adoptExistingSchema(true)
+
+func adoptExistingSchema(defaultValue bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Adopt the cluster if it already exists in Tanzu Mission Control, for example after an apply which failed half way, instead of failing with a conflict error. The existing cluster is updated to match the configuration and recorded in the state. Only applies on create.",
+		Default:     defaultValue,
+		Optional:    true,
+	}
+}
+
+// ShouldAdoptExisting returns whether the resource is configured to adopt the existing object which made its create
+// request fail with a conflict.
+func ShouldAdoptExisting(d *schema.ResourceData) bool {
+	adopt, _ := d.Get(AdoptExistingKey).(bool)
+
+	return adopt
+}
+
+// AlreadyExistsError wraps the conflict error of a create request with the hint to adopt or import the existing object.
+func AlreadyExistsError(err error, kind string, name interface{}) error {
+	return errors.Wrapf(err, "Unable to create Tanzu Mission Control %s entry, name : %v. It already exists, set %s to adopt it or import it", kind, name, AdoptExistingKey)
+}
+
+// AdoptedWarning is reported by the resources which adopted the existing cluster instead of creating it. The adopted
+// cluster may still be getting created by the previous apply, so the resources wait for it to be ready before
+// updating it.
+func AdoptedWarning(kind string, name interface{}) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Adopted existing %s %v", kind, name),
+		Detail:   "The cluster already existed in Tanzu Mission Control, it is updated to match the configuration.",
+	}
+}
+
+// ReconcileExistingMeta applies the description and labels of the desired meta data to the existing one and returns
+// whether they changed. The creator label set by Tanzu Mission Control on the existing object is kept.
+func ReconcileExistingMeta(existing, desired *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) bool {
+	if existing == nil || desired == nil {
+		return false
+	}
+
+	labels := make(map[string]string, len(desired.Labels)+1)
+	for k, v := range desired.Labels {
+		labels[k] = v
+	}
+
+	if value, ok := existing.Labels[CreatorLabelKey]; ok {
+		labels[CreatorLabelKey] = value
+	}
+
+	if existing.Description == desired.Description && labelsEqual(labels, existing.Labels) {
+		return false
+	}
+
+	existing.Description = desired.Description
+	existing.Labels = labels
+
+	return true
+}
+
+func labelsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		if value, ok := b[k]; !ok || value != v {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,73 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+func TestReconcileExistingMeta(t *testing.T) {
+	t.Parallel()
+
+	aMeta := func(description string, labels map[string]string) *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta {
+		return &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+			Description: description,
+			Labels:      labels,
+		}
+	}
+
+	cases := []struct {
+		name            string
+		existing        *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+		desired         *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+		expectedUpdated bool
+		expected        *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+	}{
+		{
+			name:            "existing meta data matching the configuration except for the creator label",
+			existing:        aMeta("test", map[string]string{CreatorLabelKey: "admin", "env": "test"}),
+			desired:         aMeta("test", map[string]string{"env": "test"}),
+			expectedUpdated: false,
+			expected:        aMeta("test", map[string]string{CreatorLabelKey: "admin", "env": "test"}),
+		},
+		{
+			name:            "existing meta data with a different description and labels",
+			existing:        aMeta("old", map[string]string{CreatorLabelKey: "admin", "env": "dev"}),
+			desired:         aMeta("test", map[string]string{"env": "test", "team": "a"}),
+			expectedUpdated: true,
+			expected:        aMeta("test", map[string]string{CreatorLabelKey: "admin", "env": "test", "team": "a"}),
+		},
+		{
+			name:            "existing meta data without labels",
+			existing:        aMeta("test", nil),
+			desired:         aMeta("test", nil),
+			expectedUpdated: false,
+			expected:        aMeta("test", nil),
+		},
+		{
+			name:            "no desired meta data",
+			existing:        aMeta("test", map[string]string{"env": "dev"}),
+			desired:         nil,
+			expectedUpdated: false,
+			expected:        aMeta("test", map[string]string{"env": "dev"}),
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			updated := ReconcileExistingMeta(test.existing, test.desired)
+			require.Equal(t, test.expectedUpdated, updated)
+			require.Equal(t, test.expected, test.existing)
+		})
+	}
+}
@@ -110,3 +110,21 @@ func ValidateScope(scopesAllowed []string) ValidateScopeType {
 		return nil
 	}
 }
+
+// ImportIDFormats returns, for each allowed scope, the import ID components identifying the scope followed by the trailing components.
+func ImportIDFormats(scopesAllowed []string, trailing ...string) map[string][]string {
+	scopeComponents := map[string][]string{
+		ClusterKey:      {ManagementClusterNameKey, ProvisionerNameKey, "cluster_name"},
+		ClusterGroupKey: {"cluster_group_name"},
+	}
+
+	formats := make(map[string][]string, len(scopesAllowed))
+
+	for _, scope := range scopesAllowed {
+		if components, ok := scopeComponents[scope]; ok {
+			formats[scope] = append(append([]string{}, components...), trailing...)
+		}
+	}
+
+	return formats
+}
@@ -22,4 +22,44 @@ const (
 	iamRoleExtIDKey  = "ext_id"
 	typeKey          = "type"
 	ResourceName     = "tanzu-mission-control_credential"
+	waitForValidKey  = "wait_for_valid"
+
+	// Typed credential data.
+	awsIAMRoleCredentialKey     = "aws_iam_role"
+	azureServicePrincipalKey    = "azure_service_principal"
+	genericS3Key                = "generic_s3"
+	imageRegistryKey            = "image_registry"
+	proxyKey                    = "proxy"
+	tanzuObservabilityKey       = "tanzu_observability"
+	subscriptionIDKey           = "subscription_id"
+	tenantIDKey                 = "tenant_id"
+	clientIDKey                 = "client_id"
+	clientSecretKey             = "client_secret"
+	azureCloudNameKey           = "azure_cloud_name"
+	accessKeyIDKey              = "access_key_id"
+	secretAccessKeyKey          = "secret_access_key"
+	registryURLKey              = "registry_url"
+	usernameKey                 = "username"
+	passwordKey                 = "password"
+	caBundleKey                 = "ca_bundle"
+	httpProxyKey                = "http_proxy"
+	httpsProxyKey               = "https_proxy"
+	httpUsernameKey             = "http_username"
+	httpPasswordKey             = "http_password"
+	httpsUsernameKey            = "https_username"
+	httpsPasswordKey            = "https_password"
+	noProxyKey                  = "no_proxy"
+	urlKey                      = "url"
+	tokenKey                    = "token"
+	azurePublicCloudValue       = "AzurePublicCloud"
+	azureUSGovernmentCloudValue = "AzureUSGovernmentCloud"
+	azureChinaCloudValue        = "AzureChinaCloud"
+
+	// Capabilities of a credential.
+	dataProtectionCapability     = "DATA_PROTECTION"
+	tanzuObservabilityCapability = "TANZU_OBSERVABILITY"
+	tanzuServiceMeshCapability   = "TANZU_SERVICE_MESH"
+	proxyConfigCapability        = "PROXY_CONFIG"
+	managedK8sProviderCapability = "MANAGED_K8S_PROVIDER"
+	imageRegistryCapability      = "IMAGE_REGISTRY"
 )
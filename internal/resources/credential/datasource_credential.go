@@ -46,7 +46,7 @@ func dataSourceCredentialRead(ctx context.Context, d *schema.ResourceData, m int
 			return diags
 		}
 
-		return diag.FromErr(errors.Wrapf(err, "unable to get Tanzu Mission Control credential entry, name : %s", name))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to get Tanzu Mission Control credential entry, name : %s", name))
 	}
 
 	d.SetId(resp.Credential.Meta.UID)
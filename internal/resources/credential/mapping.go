@@ -71,6 +71,13 @@ func constructSpec(d *schema.ResourceData) (spec *credentialsmodels.VmwareTanzuM
 	spec.Capability = credMapping.Capability
 	spec.Meta.Provider = credentialsmodels.NewVmwareTanzuManageV1alpha1AccountCredentialProvider(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialProvider(credMapping.Provider))
 
+	if credData, ok := specData[dataKey].([]interface{}); ok && len(credData) != 0 && credData[0] != nil {
+		if typedData, ok := constructTypedData(credData[0].(map[string]interface{})); ok {
+			spec.Data = typedData
+			return spec
+		}
+	}
+
 	switch len(credMapping.Data) != 0 {
 	case false:
 		return
@@ -110,7 +117,6 @@ func constructFullname(d *schema.ResourceData) (fullname *credentialsmodels.Vmwa
 	return fullname
 }
 
-// nolint:deadcode,unused
 func flattenSpec(spec *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialSpec) (data []interface{}) {
 	if spec == nil {
 		return data
@@ -126,6 +132,13 @@ func flattenSpec(spec *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredent
 
 	flattenSpecCredData := make(map[string]interface{})
 
+	if key, block, ok := flattenTypedData(spec.Capability, spec.Data); ok {
+		flattenSpecCredData[key] = []interface{}{block}
+		flattenSpecData[dataKey] = []interface{}{flattenSpecCredData}
+
+		return []interface{}{flattenSpecData}
+	}
+
 	switch spec.Data != nil {
 	case false:
 		return
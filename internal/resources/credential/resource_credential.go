@@ -7,14 +7,20 @@ package credential
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
 	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	credentialsmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/credential"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 )
 
@@ -24,7 +30,14 @@ func ResourceCredential() *schema.Resource {
 		ReadContext:   dataSourceCredentialRead,
 		UpdateContext: resourceCredentialUpdate,
 		DeleteContext: resourceCredentialDelete,
-		Schema:        credentialSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCredentialImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema:        resourceCredentialSchema(),
+		CustomizeDiff: validateCredentialSpec,
 	}
 }
 
@@ -45,6 +58,23 @@ var credentialSchema = map[string]*schema.Schema{
 	},
 }
 
+// resourceCredentialSchema adds the attributes which only make sense when managing the credential to the credential schema.
+func resourceCredentialSchema() map[string]*schema.Schema {
+	resourceSchema := make(map[string]*schema.Schema, len(credentialSchema)+1)
+	for key, value := range credentialSchema {
+		resourceSchema[key] = value
+	}
+
+	resourceSchema[waitForValidKey] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Wait for Tanzu Mission Control to validate the credential when it is created, failing if it is found invalid. Defaults to true.",
+		Optional:    true,
+		Default:     true,
+	}
+
+	return resourceSchema
+}
+
 var credSpec = &schema.Schema{
 	Type:        schema.TypeList,
 	Description: "Spec of credential resource",
@@ -53,15 +83,17 @@ var credSpec = &schema.Schema{
 	Elem: &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			capabilityKey: {
-				Type:        schema.TypeString,
-				Description: "The Tanzu capability for which the credential shall be used. Value must be in list [DATA_PROTECTION TANZU_OBSERVABILITY TANZU_SERVICE_MESH PROXY_CONFIG MANAGED_K8S_PROVIDER IMAGE_REGISTRY]",
-				Optional:    true,
+				Type:         schema.TypeString,
+				Description:  "The Tanzu capability for which the credential shall be used. Value must be in list [DATA_PROTECTION TANZU_OBSERVABILITY TANZU_SERVICE_MESH PROXY_CONFIG MANAGED_K8S_PROVIDER IMAGE_REGISTRY]",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(capabilityValues(), false),
 			},
 			providerKey: {
-				Type:        schema.TypeString,
-				Description: "The Tanzu provider for which describes credential data type. Value must be in list [PROVIDER_UNSPECIFIED,AWS_EC2,GENERIC_S3,AZURE_AD,AWS_EKS,AZURE_AKS,GENERIC_KEY_VALUE]",
-				Default:     string(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialProviderPROVIDERUNSPECIFIED),
-				Optional:    true,
+				Type:         schema.TypeString,
+				Description:  "The Tanzu provider for which describes credential data type. Value must be in list [PROVIDER_UNSPECIFIED,AWS_EC2,GENERIC_S3,AZURE_AD,AWS_EKS,AZURE_AKS,GENERIC_KEY_VALUE]",
+				Default:      string(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialProviderPROVIDERUNSPECIFIED),
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(providerValues(), false),
 			},
 			dataKey: dataSpec,
 		},
@@ -81,8 +113,14 @@ var dataSpec = &schema.Schema{
 				Description: "Generic credential data type used to hold a blob of data represented as string",
 				Optional:    true,
 			},
-			awsCredentialKey: awsCredSpec,
-			keyValueKey:      keyValueSpec,
+			awsCredentialKey:         awsCredSpec,
+			keyValueKey:              keyValueSpec,
+			awsIAMRoleCredentialKey:  awsIAMRoleCredSpec,
+			azureServicePrincipalKey: azureServicePrincipalSpec,
+			genericS3Key:             genericS3Spec,
+			imageRegistryKey:         imageRegistrySpec,
+			proxyKey:                 proxySpec,
+			tanzuObservabilityKey:    tanzuObservabilitySpec,
 		},
 	},
 }
@@ -101,12 +139,111 @@ func resourceCredentialCreate(ctx context.Context, d *schema.ResourceData, m int
 	response, err := config.TMCConnection.CredentialResourceService.CredentialResourceServiceCreate(request)
 
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "unable to create Tanzu Mission Control credential entry, name : %s", NameKey))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to create Tanzu Mission Control credential entry, name : %s", NameKey))
 	}
 
 	d.SetId(response.Credential.Meta.UID)
 
-	return dataSourceCredentialRead(ctx, d, m)
+	if waitForValid, _ := d.Get(waitForValidKey).(bool); waitForValid {
+		diags = append(diags, waitForCredentialValid(ctx, config, constructFullname(d), d.Timeout(schema.TimeoutCreate))...)
+	}
+
+	return append(diags, dataSourceCredentialRead(ctx, d, m)...)
+}
+
+// waitForCredentialValid polls the credential until Tanzu Mission Control has validated it, as the credential cannot be
+// used to create clusters or backups before. The conditions of a credential found invalid are reported as diagnostics.
+func waitForCredentialValid(ctx context.Context, config authctx.TanzuContext, fn *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialFullName, timeout time.Duration) diag.Diagnostics {
+	var (
+		status *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialStatus
+		getErr error
+	)
+
+	getCredentialValidRetryable := func() (retry bool, err error) {
+		resp, err := config.TMCConnection.CredentialResourceService.CredentialResourceServiceGetWithContext(ctx, fn)
+		if err != nil {
+			getErr = err
+			return false, err
+		}
+
+		if resp == nil || resp.Credential == nil || resp.Credential.Status == nil || resp.Credential.Status.Phase == nil {
+			return true, errors.Errorf("credential %s has no status yet", fn.Name)
+		}
+
+		status = resp.Credential.Status
+
+		if isCredentialValidated(status) {
+			return false, nil
+		}
+
+		return true, errors.Errorf("credential %s is still %s", fn.Name, *status.Phase)
+	}
+
+	if _, err := helper.RetryUntilTimeoutWithContext(ctx, getCredentialValidRetryable, 5*time.Second, timeout); err != nil {
+		if getErr != nil {
+			return clienterrors.ToDiagnostics(errors.Wrapf(getErr, "unable to get Tanzu Mission Control credential entry, name : %s", fn.Name))
+		}
+
+		return diag.FromErr(errors.Wrapf(err, "timed out waiting for Tanzu Mission Control to validate credential %s", fn.Name))
+	}
+
+	return credentialStatusDiagnostics(fn.Name, status)
+}
+
+func isCredentialValidated(status *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialStatus) bool {
+	switch *status.Phase {
+	case credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialStatusPhaseVALID,
+		credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialStatusPhaseINVALID,
+		credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialStatusPhaseERROR:
+		return true
+	default:
+		return false
+	}
+}
+
+// credentialStatusDiagnostics reports an error for a credential which is not valid, detailed by the conditions that
+// are not met. The conditions of a valid credential which are not met are reported as warnings.
+func credentialStatusDiagnostics(name string, status *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialStatus) (diags diag.Diagnostics) {
+	valid := *status.Phase == credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialStatusPhaseVALID
+
+	if !valid {
+		summary := fmt.Sprintf("Tanzu Mission Control credential %s is %s", name, *status.Phase)
+		if status.PhaseInfo != "" {
+			summary = fmt.Sprintf("%s: %s", summary, status.PhaseInfo)
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  summary,
+		})
+	}
+
+	conditionTypes := make([]string, 0, len(status.Conditions))
+	for conditionType := range status.Conditions {
+		conditionTypes = append(conditionTypes, conditionType)
+	}
+
+	sort.Strings(conditionTypes)
+
+	for _, conditionType := range conditionTypes {
+		condition := status.Conditions[conditionType]
+		if condition.Status != nil && *condition.Status == statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE {
+			continue
+		}
+
+		severity := diag.Warning
+		if !valid && (condition.Severity == nil || *condition.Severity == statusmodel.VmwareTanzuCoreV1alpha1StatusConditionSeverityERROR) {
+			severity = diag.Error
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("Credential %s condition %s is not met: %s", name, conditionType, condition.Reason),
+			Detail:   condition.Message,
+		})
+	}
+
+	return diags
 }
 
 func resourceCredentialDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -119,7 +256,7 @@ func resourceCredentialDelete(_ context.Context, d *schema.ResourceData, m inter
 
 	err := config.TMCConnection.CredentialResourceService.CredentialResourceServiceDelete(constructFullname(d))
 	if err != nil && !clienterrors.IsNotFoundError(err) {
-		return diag.FromErr(errors.Wrapf(err, "unable to delete Tanzu Mission Control credential entry, name : %s", namespaceName))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to delete Tanzu Mission Control credential entry, name : %s", namespaceName))
 	}
 
 	// d.SetId("") is automatically called assuming delete returns no errors, but
@@ -130,5 +267,39 @@ func resourceCredentialDelete(_ context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
-	return diag.FromErr(errors.New("update of Tanzu Mission Control credential is not supported"))
+	if d.HasChangesExcept(waitForValidKey) {
+		return diag.FromErr(errors.New("update of Tanzu Mission Control credential is not supported"))
+	}
+
+	return dataSourceCredentialRead(ctx, d, m)
+}
+
+// resourceCredentialImporter imports a credential using an ID of the form <name>.
+// The credential data is imported into the typed data block matching it; sensitive values are never returned by Tanzu Mission Control.
+func resourceCredentialImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	config := m.(authctx.TanzuContext)
+
+	parts, err := helper.ParseImportID(d.Id(), NameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.Set(NameKey, parts[0]); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the credential %s", parts[0])
+	}
+
+	resp, err := config.TMCConnection.CredentialResourceService.CredentialResourceServiceGet(constructFullname(d))
+	if err != nil || resp == nil || resp.Credential == nil {
+		return nil, errors.Wrapf(err, "Unable to get Tanzu Mission Control credential entry, name : %s", parts[0])
+	}
+
+	if err = d.Set(specKey, flattenSpec(resp.Credential.Spec)); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set spec for the credential %s", parts[0])
+	}
+
+	if err = d.Set(waitForValidKey, true); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set %s for the credential %s", waitForValidKey, parts[0])
+	}
+
+	return helper.ReadImportedState(ctx, d, m, dataSourceCredentialRead)
 }
@@ -0,0 +1,80 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package credential
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/require"
+
+	credentialsmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/credential"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+func TestCredentialStatusDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	condition := func(status statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatus, severity statusmodel.VmwareTanzuCoreV1alpha1StatusConditionSeverity, reason string) statusmodel.VmwareTanzuCoreV1alpha1StatusCondition {
+		return statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+			Status:   statusmodel.NewVmwareTanzuCoreV1alpha1StatusConditionStatus(status),
+			Severity: statusmodel.NewVmwareTanzuCoreV1alpha1StatusConditionSeverity(severity),
+			Reason:   reason,
+			Message:  reason + " message",
+		}
+	}
+
+	cases := []struct {
+		name     string
+		status   *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialStatus
+		expected diag.Diagnostics
+	}{
+		{
+			name: "case for a valid credential",
+			status: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialStatus{
+				Phase: credentialsmodels.NewVmwareTanzuManageV1alpha1AccountCredentialStatusPhase(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialStatusPhaseVALID),
+				Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					"Ready": condition(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE, statusmodel.VmwareTanzuCoreV1alpha1StatusConditionSeverityINFO, "Validated"),
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "case for a valid credential with a warning",
+			status: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialStatus{
+				Phase: credentialsmodels.NewVmwareTanzuManageV1alpha1AccountCredentialStatusPhase(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialStatusPhaseVALID),
+				Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					"Permissions": condition(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusFALSE, statusmodel.VmwareTanzuCoreV1alpha1StatusConditionSeverityWARNING, "MissingPermissions"),
+				},
+			},
+			expected: diag.Diagnostics{
+				{Severity: diag.Warning, Summary: "Credential cred condition Permissions is not met: MissingPermissions", Detail: "MissingPermissions message"},
+			},
+		},
+		{
+			name: "case for an invalid credential",
+			status: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialStatus{
+				Phase:     credentialsmodels.NewVmwareTanzuManageV1alpha1AccountCredentialStatusPhase(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialStatusPhaseINVALID),
+				PhaseInfo: "unable to assume role",
+				Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					"Ready":     condition(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusFALSE, statusmodel.VmwareTanzuCoreV1alpha1StatusConditionSeverityERROR, "AssumeRoleFailed"),
+					"Reachable": condition(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE, statusmodel.VmwareTanzuCoreV1alpha1StatusConditionSeverityERROR, "Reached"),
+				},
+			},
+			expected: diag.Diagnostics{
+				{Severity: diag.Error, Summary: "Tanzu Mission Control credential cred is INVALID: unable to assume role"},
+				{Severity: diag.Error, Summary: "Credential cred condition Ready is not met: AssumeRoleFailed", Detail: "AssumeRoleFailed message"},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, credentialStatusDiagnostics("cred", test.status))
+		})
+	}
+}
@@ -0,0 +1,437 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package credential
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	credentialsmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/credential"
+)
+
+// Keys of the key value data Tanzu Mission Control expects for the typed credentials stored as key value secrets.
+const (
+	registryURLDataKey   = "registry-url"
+	registryCADataKey    = "ca-cert"
+	usernameDataKey      = "username"
+	passwordDataKey      = "password"
+	httpProxyDataKey     = "httpProxy"
+	httpsProxyDataKey    = "httpsProxy"
+	noProxyDataKey       = "noProxyList"
+	httpUserNameDataKey  = "httpUserName"
+	httpPasswordDataKey  = "httpPassword"
+	httpsUserNameDataKey = "httpsUserName"
+	httpsPasswordDataKey = "httpsPassword"
+	proxyCADataKey       = "proxyCABundle"
+	urlDataKey           = "url"
+	tokenDataKey         = "token"
+)
+
+var (
+	iamRoleARNRegex = regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/.+$`)
+	uuidRegex       = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+var awsIAMRoleCredSpec = &schema.Schema{
+	Type:        schema.TypeList,
+	Optional:    true,
+	Description: "AWS IAM role assumed by Tanzu Mission Control, to be used with the AWS_EKS or AWS_EC2 provider",
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			iamRoleARNKey: {
+				Type:         schema.TypeString,
+				Description:  "ARN of the IAM role, as output by the CloudFormation stack created from the Tanzu Mission Control template",
+				Required:     true,
+				ValidateFunc: validation.StringMatch(iamRoleARNRegex, "must be the ARN of an AWS IAM role, e.g. arn:aws:iam::123456789012:role/clusterlifecycle.tmc.cloud.vmware.com"),
+			},
+			iamRoleExtIDKey: {
+				Type:        schema.TypeString,
+				Description: "An external ID used to assume the IAM role",
+				Optional:    true,
+			},
+		},
+	},
+}
+
+var azureServicePrincipalSpec = &schema.Schema{
+	Type:        schema.TypeList,
+	Optional:    true,
+	Description: "Azure service principal, to be used with the AZURE_AKS or AZURE_AD provider",
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			subscriptionIDKey: {
+				Type:         schema.TypeString,
+				Description:  "ID of the Azure subscription",
+				Required:     true,
+				ValidateFunc: validation.StringMatch(uuidRegex, "must be a UUID"),
+			},
+			tenantIDKey: {
+				Type:         schema.TypeString,
+				Description:  "ID of the Azure AD tenant of the service principal",
+				Required:     true,
+				ValidateFunc: validation.StringMatch(uuidRegex, "must be a UUID"),
+			},
+			clientIDKey: {
+				Type:         schema.TypeString,
+				Description:  "Client ID of the service principal",
+				Required:     true,
+				ValidateFunc: validation.StringMatch(uuidRegex, "must be a UUID"),
+			},
+			clientSecretKey: {
+				Type:         schema.TypeString,
+				Description:  "Client secret of the service principal",
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			azureCloudNameKey: {
+				Type:         schema.TypeString,
+				Description:  "Azure cloud of the service principal, one of AzurePublicCloud, AzureUSGovernmentCloud or AzureChinaCloud",
+				Optional:     true,
+				Default:      azurePublicCloudValue,
+				ValidateFunc: validation.StringInSlice([]string{azurePublicCloudValue, azureUSGovernmentCloudValue, azureChinaCloudValue}, false),
+			},
+		},
+	},
+}
+
+var genericS3Spec = &schema.Schema{
+	Type:        schema.TypeList,
+	Optional:    true,
+	Description: "Access key of an S3 compatible storage used as a data protection target, to be used with the GENERIC_S3 provider",
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			accessKeyIDKey: {
+				Type:         schema.TypeString,
+				Description:  "Access key ID",
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			secretAccessKeyKey: {
+				Type:         schema.TypeString,
+				Description:  "Secret access key of the access key ID",
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	},
+}
+
+var imageRegistrySpec = &schema.Schema{
+	Type:        schema.TypeList,
+	Optional:    true,
+	Description: "Image registry credential, to be used with the GENERIC_KEY_VALUE provider",
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			registryURLKey: {
+				Type:         schema.TypeString,
+				Description:  "URL of the image registry",
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			usernameKey: {
+				Type:        schema.TypeString,
+				Description: "User name to authenticate to the image registry",
+				Optional:    true,
+			},
+			passwordKey: {
+				Type:        schema.TypeString,
+				Description: "Password to authenticate to the image registry",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			caBundleKey: {
+				Type:        schema.TypeString,
+				Description: "PEM encoded CA bundle of the image registry",
+				Optional:    true,
+			},
+		},
+	},
+}
+
+var proxySpec = &schema.Schema{
+	Type:        schema.TypeList,
+	Optional:    true,
+	Description: "Proxy configuration, to be used with the GENERIC_KEY_VALUE provider",
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			httpProxyKey: {
+				Type:         schema.TypeString,
+				Description:  "URL of the proxy for HTTP traffic",
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			httpsProxyKey: {
+				Type:         schema.TypeString,
+				Description:  "URL of the proxy for HTTPS traffic",
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			noProxyKey: {
+				Type:        schema.TypeList,
+				Description: "Hosts, domains and CIDRs which are not reached through the proxy",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			httpUsernameKey: {
+				Type:        schema.TypeString,
+				Description: "User name to authenticate to the HTTP proxy",
+				Optional:    true,
+			},
+			httpPasswordKey: {
+				Type:        schema.TypeString,
+				Description: "Password to authenticate to the HTTP proxy",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			httpsUsernameKey: {
+				Type:        schema.TypeString,
+				Description: "User name to authenticate to the HTTPS proxy",
+				Optional:    true,
+			},
+			httpsPasswordKey: {
+				Type:        schema.TypeString,
+				Description: "Password to authenticate to the HTTPS proxy",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			caBundleKey: {
+				Type:        schema.TypeString,
+				Description: "PEM encoded CA bundle of the proxy",
+				Optional:    true,
+			},
+		},
+	},
+}
+
+var tanzuObservabilitySpec = &schema.Schema{
+	Type:        schema.TypeList,
+	Optional:    true,
+	Description: "Tanzu Observability API token, to be used with the GENERIC_KEY_VALUE provider",
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			urlKey: {
+				Type:         schema.TypeString,
+				Description:  "URL of the Tanzu Observability instance",
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			tokenKey: {
+				Type:         schema.TypeString,
+				Description:  "API token of the Tanzu Observability instance",
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	},
+}
+
+// constructTypedData builds the credential data of the typed credential block set in the data of the spec, if any.
+func constructTypedData(data map[string]interface{}) (*credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData, bool) {
+	switch {
+	case hasBlock(data, awsIAMRoleCredentialKey):
+		block := firstBlock(data, awsIAMRoleCredentialKey)
+
+		return &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData{
+			AwsCredential: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeAwsSpec{
+				IamRole: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeAwsIAMRole{
+					Arn:   stringValue(block, iamRoleARNKey),
+					ExtID: stringValue(block, iamRoleExtIDKey),
+				},
+			},
+		}, true
+	case hasBlock(data, azureServicePrincipalKey):
+		block := firstBlock(data, azureServicePrincipalKey)
+
+		return &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData{
+			AzureCredential: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeAzureSpec{
+				ServicePrincipal: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeAzureServicePrincipal{
+					SubscriptionID: stringValue(block, subscriptionIDKey),
+					TenantID:       stringValue(block, tenantIDKey),
+					ClientID:       stringValue(block, clientIDKey),
+					ClientSecret:   stringValue(block, clientSecretKey),
+					AzureCloudName: stringValue(block, azureCloudNameKey),
+				},
+			},
+		}, true
+	case hasBlock(data, genericS3Key):
+		block := firstBlock(data, genericS3Key)
+
+		return &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData{
+			AwsCredential: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeAwsSpec{
+				AccessKey: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeAwsAccessKey{
+					AccessKeyID:     stringValue(block, accessKeyIDKey),
+					SecretAccessKey: stringValue(block, secretAccessKeyKey),
+				},
+			},
+		}, true
+	case hasBlock(data, imageRegistryKey):
+		block := firstBlock(data, imageRegistryKey)
+
+		return keyValueData(map[string]string{
+			registryURLDataKey: stringValue(block, registryURLKey),
+			usernameDataKey:    stringValue(block, usernameKey),
+			passwordDataKey:    stringValue(block, passwordKey),
+			registryCADataKey:  stringValue(block, caBundleKey),
+		}), true
+	case hasBlock(data, proxyKey):
+		block := firstBlock(data, proxyKey)
+
+		noProxy := make([]string, 0)
+		if hosts, ok := block[noProxyKey].([]interface{}); ok {
+			for _, host := range hosts {
+				if h, ok := host.(string); ok {
+					noProxy = append(noProxy, h)
+				}
+			}
+		}
+
+		return keyValueData(map[string]string{
+			httpProxyDataKey:     stringValue(block, httpProxyKey),
+			httpsProxyDataKey:    stringValue(block, httpsProxyKey),
+			noProxyDataKey:       strings.Join(noProxy, ","),
+			httpUserNameDataKey:  stringValue(block, httpUsernameKey),
+			httpPasswordDataKey:  stringValue(block, httpPasswordKey),
+			httpsUserNameDataKey: stringValue(block, httpsUsernameKey),
+			httpsPasswordDataKey: stringValue(block, httpsPasswordKey),
+			proxyCADataKey:       stringValue(block, caBundleKey),
+		}), true
+	case hasBlock(data, tanzuObservabilityKey):
+		block := firstBlock(data, tanzuObservabilityKey)
+
+		return keyValueData(map[string]string{
+			urlDataKey:   stringValue(block, urlKey),
+			tokenDataKey: stringValue(block, tokenKey),
+		}), true
+	}
+
+	return nil, false
+}
+
+// flattenTypedData flattens the credential data read from Tanzu Mission Control into the typed credential block
+// it was built from, if any. Sensitive values are not returned by Tanzu Mission Control and are left out.
+func flattenTypedData(capability string, data *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData) (string, map[string]interface{}, bool) {
+	switch {
+	case data == nil:
+		return "", nil, false
+	case data.AzureCredential != nil && data.AzureCredential.ServicePrincipal != nil:
+		servicePrincipal := data.AzureCredential.ServicePrincipal
+
+		return azureServicePrincipalKey, map[string]interface{}{
+			subscriptionIDKey: servicePrincipal.SubscriptionID,
+			tenantIDKey:       servicePrincipal.TenantID,
+			clientIDKey:       servicePrincipal.ClientID,
+			azureCloudNameKey: servicePrincipal.AzureCloudName,
+		}, true
+	case data.AwsCredential != nil && data.AwsCredential.AccessKey != nil:
+		return genericS3Key, map[string]interface{}{
+			accessKeyIDKey: data.AwsCredential.AccessKey.AccessKeyID,
+		}, true
+	case data.AwsCredential != nil && data.AwsCredential.IamRole != nil &&
+		data.AwsCredential.AccountID == "" && data.AwsCredential.GenericCredential == "":
+		return awsIAMRoleCredentialKey, map[string]interface{}{
+			iamRoleARNKey:   data.AwsCredential.IamRole.Arn,
+			iamRoleExtIDKey: data.AwsCredential.IamRole.ExtID,
+		}, true
+	case data.KeyValue == nil:
+		return "", nil, false
+	}
+
+	values := make(map[string]string)
+	for key, value := range data.KeyValue.Data {
+		values[key] = string(value)
+	}
+
+	switch capability {
+	case imageRegistryCapability:
+		return imageRegistryKey, map[string]interface{}{
+			registryURLKey: values[registryURLDataKey],
+			usernameKey:    values[usernameDataKey],
+			passwordKey:    values[passwordDataKey],
+			caBundleKey:    values[registryCADataKey],
+		}, true
+	case proxyConfigCapability:
+		noProxy := make([]interface{}, 0)
+		for _, host := range strings.Split(values[noProxyDataKey], ",") {
+			if host != "" {
+				noProxy = append(noProxy, host)
+			}
+		}
+
+		return proxyKey, map[string]interface{}{
+			httpProxyKey:     values[httpProxyDataKey],
+			httpsProxyKey:    values[httpsProxyDataKey],
+			noProxyKey:       noProxy,
+			httpUsernameKey:  values[httpUserNameDataKey],
+			httpPasswordKey:  values[httpPasswordDataKey],
+			httpsUsernameKey: values[httpsUserNameDataKey],
+			httpsPasswordKey: values[httpsPasswordDataKey],
+			caBundleKey:      values[proxyCADataKey],
+		}, true
+	case tanzuObservabilityCapability:
+		return tanzuObservabilityKey, map[string]interface{}{
+			urlKey:   values[urlDataKey],
+			tokenKey: values[tokenDataKey],
+		}, true
+	}
+
+	return "", nil, false
+}
+
+// keyValueData builds an opaque key value credential data, leaving out the keys which are not set.
+func keyValueData(values map[string]string) *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData {
+	data := make(map[string]strfmt.Base64)
+
+	for key, value := range values {
+		if value != "" {
+			data[key] = []byte(value)
+		}
+	}
+
+	return &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData{
+		KeyValue: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpec{
+			Type: credentialsmodels.NewVmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpecSecretType(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpecSecretTypeOPAQUESECRETTYPE),
+			Data: data,
+		},
+	}
+}
+
+func hasBlock(data map[string]interface{}, key string) bool {
+	blocks, ok := data[key].([]interface{})
+
+	return ok && len(blocks) != 0 && blocks[0] != nil
+}
+
+func firstBlock(data map[string]interface{}, key string) map[string]interface{} {
+	if !hasBlock(data, key) {
+		return map[string]interface{}{}
+	}
+
+	block, _ := data[key].([]interface{})[0].(map[string]interface{})
+
+	return block
+}
+
+func stringValue(block map[string]interface{}, key string) string {
+	value, _ := block[key].(string)
+
+	return value
+}
@@ -0,0 +1,203 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package credential
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	credentialsmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/credential"
+)
+
+const (
+	awsEC2Provider          = string(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialProviderAWSEC2)
+	awsEKSProvider          = string(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialProviderAWSEKS)
+	azureADProvider         = string(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialProviderAZUREAD)
+	azureAKSProvider        = string(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialProviderAZUREAKS)
+	genericS3Provider       = string(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialProviderGENERICS3)
+	genericKeyValueProvider = string(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialProviderGENERICKEYVALUE)
+	unspecifiedProvider     = string(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialProviderPROVIDERUNSPECIFIED)
+)
+
+// capabilityProviders lists the providers supported by Tanzu Mission Control for each capability.
+// A provider left unspecified is accepted for any capability, the server then infers it from the credential data.
+var capabilityProviders = map[string][]string{
+	dataProtectionCapability:     {awsEC2Provider, genericS3Provider, azureADProvider},
+	managedK8sProviderCapability: {awsEC2Provider, awsEKSProvider, azureAKSProvider},
+	tanzuObservabilityCapability: {genericKeyValueProvider},
+	tanzuServiceMeshCapability:   {genericKeyValueProvider},
+	proxyConfigCapability:        {genericKeyValueProvider},
+	imageRegistryCapability:      {genericKeyValueProvider},
+}
+
+// typedDataCapabilities lists the capabilities each typed credential block can be used for, and for each of them
+// the providers it can be used with.
+var typedDataCapabilities = map[string]map[string][]string{
+	awsIAMRoleCredentialKey: {
+		dataProtectionCapability:     {awsEC2Provider},
+		managedK8sProviderCapability: {awsEC2Provider, awsEKSProvider},
+	},
+	azureServicePrincipalKey: {
+		dataProtectionCapability:     {azureADProvider},
+		managedK8sProviderCapability: {azureAKSProvider},
+	},
+	genericS3Key: {
+		dataProtectionCapability: {genericS3Provider},
+	},
+	imageRegistryKey: {
+		imageRegistryCapability: {genericKeyValueProvider},
+	},
+	proxyKey: {
+		proxyConfigCapability: {genericKeyValueProvider},
+	},
+	tanzuObservabilityKey: {
+		tanzuObservabilityCapability: {genericKeyValueProvider},
+	},
+}
+
+var credentialDataKeys = []string{
+	genericCredentialKey,
+	awsCredentialKey,
+	keyValueKey,
+	awsIAMRoleCredentialKey,
+	azureServicePrincipalKey,
+	genericS3Key,
+	imageRegistryKey,
+	proxyKey,
+	tanzuObservabilityKey,
+}
+
+func capabilityValues() []string {
+	return sortedKeys(capabilityProviders)
+}
+
+func providerValues() []string {
+	return []string{
+		unspecifiedProvider,
+		awsEC2Provider,
+		genericS3Provider,
+		azureADProvider,
+		awsEKSProvider,
+		azureAKSProvider,
+		genericKeyValueProvider,
+	}
+}
+
+// validateCredentialSpec checks at plan time that the capability, provider and data of the credential go together.
+func validateCredentialSpec(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	value, ok := diff.GetOk(specKey)
+	if !ok {
+		return nil
+	}
+
+	specs, _ := value.([]interface{})
+	if len(specs) == 0 || specs[0] == nil {
+		return nil
+	}
+
+	// Values known only once applied, e.g. the ARN of a role created in the same run, are validated by the server.
+	for _, key := range []string{
+		helper.GetFirstElementOf(specKey, capabilityKey),
+		helper.GetFirstElementOf(specKey, providerKey),
+		helper.GetFirstElementOf(specKey, dataKey, imageRegistryKey, usernameKey),
+		helper.GetFirstElementOf(specKey, dataKey, imageRegistryKey, passwordKey),
+	} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	spec, _ := specs[0].(map[string]interface{})
+
+	return validateSpec(spec)
+}
+
+func validateSpec(spec map[string]interface{}) error {
+	capability := stringValue(spec, capabilityKey)
+	provider := stringValue(spec, providerKey)
+
+	if capability != "" && provider != "" && provider != unspecifiedProvider {
+		if !contains(capabilityProviders[capability], provider) {
+			return fmt.Errorf("provider %s is not supported for the %s capability, supported providers: %s",
+				provider, capability, strings.Join(capabilityProviders[capability], ", "))
+		}
+	}
+
+	data := firstBlock(spec, dataKey)
+
+	set := make([]string, 0)
+
+	for _, key := range credentialDataKeys {
+		if key == genericCredentialKey {
+			if stringValue(data, key) != "" {
+				set = append(set, key)
+			}
+
+			continue
+		}
+
+		if hasBlock(data, key) {
+			set = append(set, key)
+		}
+	}
+
+	if len(set) > 1 {
+		return fmt.Errorf("only one kind of credential data can be set, got: %s", strings.Join(set, ", "))
+	}
+
+	if len(set) == 0 {
+		return nil
+	}
+
+	capabilities, typed := typedDataCapabilities[set[0]]
+	if !typed {
+		return nil
+	}
+
+	providers, ok := capabilities[capability]
+	if !ok {
+		return fmt.Errorf("%s credential data requires the capability to be one of: %s", set[0], strings.Join(sortedKeys(capabilities), ", "))
+	}
+
+	if !contains(providers, provider) {
+		return fmt.Errorf("%s credential data for the %s capability requires the provider to be one of: %s", set[0], capability, strings.Join(providers, ", "))
+	}
+
+	if set[0] == imageRegistryKey {
+		block := firstBlock(data, imageRegistryKey)
+		if (stringValue(block, usernameKey) == "") != (stringValue(block, passwordKey) == "") {
+			return fmt.Errorf("%s credential data requires both %s and %s to authenticate to the registry", imageRegistryKey, usernameKey, passwordKey)
+		}
+	}
+
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,293 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package credential
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	credentialsmodels "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/credential"
+)
+
+func testSpec(capability string, provider string, dataType string, data map[string]interface{}) map[string]interface{} {
+	spec := map[string]interface{}{
+		capabilityKey: capability,
+		providerKey:   provider,
+	}
+
+	if dataType != "" {
+		spec[dataKey] = []interface{}{map[string]interface{}{
+			dataType: []interface{}{data},
+		}}
+	}
+
+	return spec
+}
+
+func TestValidateSpec(t *testing.T) {
+	t.Parallel()
+
+	iamRole := map[string]interface{}{iamRoleARNKey: "arn:aws:iam::123456789012:role/clusterlifecycle.tmc.cloud.vmware.com"}
+
+	cases := []struct {
+		name        string
+		spec        map[string]interface{}
+		expectError string
+	}{
+		{
+			name: "case for an EKS credential with an IAM role",
+			spec: testSpec(managedK8sProviderCapability, awsEKSProvider, awsIAMRoleCredentialKey, iamRole),
+		},
+		{
+			name: "case for a capability without data",
+			spec: testSpec(tanzuServiceMeshCapability, unspecifiedProvider, "", nil),
+		},
+		{
+			name:        "case for a provider not supported by the capability",
+			spec:        testSpec(managedK8sProviderCapability, genericS3Provider, "", nil),
+			expectError: "provider GENERIC_S3 is not supported for the MANAGED_K8S_PROVIDER capability",
+		},
+		{
+			name:        "case for an IAM role with an Azure provider",
+			spec:        testSpec(managedK8sProviderCapability, azureAKSProvider, awsIAMRoleCredentialKey, iamRole),
+			expectError: "aws_iam_role credential data for the MANAGED_K8S_PROVIDER capability requires the provider to be one of: AWS_EC2, AWS_EKS",
+		},
+		{
+			name:        "case for a proxy with the image registry capability",
+			spec:        testSpec(imageRegistryCapability, genericKeyValueProvider, proxyKey, map[string]interface{}{httpProxyKey: "http://proxy:3128"}),
+			expectError: "proxy credential data requires the capability to be one of: PROXY_CONFIG",
+		},
+		{
+			name:        "case for an image registry with a user name and no password",
+			spec:        testSpec(imageRegistryCapability, genericKeyValueProvider, imageRegistryKey, map[string]interface{}{registryURLKey: "harbor.example.com", usernameKey: "robot"}),
+			expectError: "image_registry credential data requires both username and password",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			err := validateSpec(test.spec)
+			if test.expectError == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, test.expectError)
+		})
+	}
+}
+
+func TestValidateSpecWithSeveralData(t *testing.T) {
+	t.Parallel()
+
+	spec := map[string]interface{}{
+		capabilityKey: dataProtectionCapability,
+		providerKey:   genericS3Provider,
+		dataKey: []interface{}{map[string]interface{}{
+			genericCredentialKey: "blob",
+			genericS3Key:         []interface{}{map[string]interface{}{accessKeyIDKey: "id", secretAccessKeyKey: "secret"}},
+		}},
+	}
+
+	require.ErrorContains(t, validateSpec(spec), "only one kind of credential data can be set, got: generic_credential, generic_s3")
+}
+
+func TestConstructTypedData(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		data     map[string]interface{}
+		expected *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData
+	}{
+		{
+			name: "case for an Azure service principal",
+			data: map[string]interface{}{azureServicePrincipalKey: []interface{}{map[string]interface{}{
+				subscriptionIDKey: "s",
+				tenantIDKey:       "t",
+				clientIDKey:       "c",
+				clientSecretKey:   "secret",
+				azureCloudNameKey: azurePublicCloudValue,
+			}}},
+			expected: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData{
+				AzureCredential: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeAzureSpec{
+					ServicePrincipal: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeAzureServicePrincipal{
+						SubscriptionID: "s",
+						TenantID:       "t",
+						ClientID:       "c",
+						ClientSecret:   "secret",
+						AzureCloudName: azurePublicCloudValue,
+					},
+				},
+			},
+		},
+		{
+			name: "case for a proxy",
+			data: map[string]interface{}{proxyKey: []interface{}{map[string]interface{}{
+				httpsProxyKey: "https://proxy:3128",
+				noProxyKey:    []interface{}{"localhost", "10.0.0.0/8"},
+			}}},
+			expected: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData{
+				KeyValue: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpec{
+					Type: credentialsmodels.NewVmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpecSecretType(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpecSecretTypeOPAQUESECRETTYPE),
+					Data: map[string]strfmt.Base64{
+						httpsProxyDataKey: []byte("https://proxy:3128"),
+						noProxyDataKey:    []byte("localhost,10.0.0.0/8"),
+					},
+				},
+			},
+		},
+		{
+			name: "case for a proxy with separate HTTP and HTTPS credentials",
+			data: map[string]interface{}{proxyKey: []interface{}{map[string]interface{}{
+				httpProxyKey:     "http://proxy:3128",
+				httpsProxyKey:    "https://proxy:3129",
+				httpUsernameKey:  "http-user",
+				httpPasswordKey:  "http-secret",
+				httpsUsernameKey: "https-user",
+				httpsPasswordKey: "https-secret",
+			}}},
+			expected: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData{
+				KeyValue: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpec{
+					Type: credentialsmodels.NewVmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpecSecretType(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpecSecretTypeOPAQUESECRETTYPE),
+					Data: map[string]strfmt.Base64{
+						httpProxyDataKey:     []byte("http://proxy:3128"),
+						httpsProxyDataKey:    []byte("https://proxy:3129"),
+						httpUserNameDataKey:  []byte("http-user"),
+						httpPasswordDataKey:  []byte("http-secret"),
+						httpsUserNameDataKey: []byte("https-user"),
+						httpsPasswordDataKey: []byte("https-secret"),
+					},
+				},
+			},
+		},
+		{
+			name:     "case for untyped data",
+			data:     map[string]interface{}{genericCredentialKey: "blob"},
+			expected: nil,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			actual, ok := constructTypedData(test.data)
+			require.Equal(t, test.expected != nil, ok)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFlattenSpecIntoTypedData(t *testing.T) {
+	t.Parallel()
+
+	keyValue := func(data map[string]strfmt.Base64) *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData {
+		return &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData{
+			KeyValue: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpec{
+				Type: credentialsmodels.NewVmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpecSecretType(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpecSecretTypeOPAQUESECRETTYPE),
+				Data: data,
+			},
+		}
+	}
+
+	cases := []struct {
+		name       string
+		capability string
+		data       *credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData
+		expected   map[string]interface{}
+	}{
+		{
+			name:       "case for an Azure service principal",
+			capability: managedK8sProviderCapability,
+			data: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData{
+				AzureCredential: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeAzureSpec{
+					ServicePrincipal: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeAzureServicePrincipal{
+						SubscriptionID: "s",
+						TenantID:       "t",
+						ClientID:       "c",
+						AzureCloudName: azurePublicCloudValue,
+					},
+				},
+			},
+			expected: map[string]interface{}{azureServicePrincipalKey: []interface{}{map[string]interface{}{
+				subscriptionIDKey: "s",
+				tenantIDKey:       "t",
+				clientIDKey:       "c",
+				azureCloudNameKey: azurePublicCloudValue,
+			}}},
+		},
+		{
+			name:       "case for an AWS IAM role",
+			capability: managedK8sProviderCapability,
+			data: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialData{
+				AwsCredential: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeAwsSpec{
+					IamRole: &credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeAwsIAMRole{Arn: "arn", ExtID: "ext"},
+				},
+			},
+			expected: map[string]interface{}{awsIAMRoleCredentialKey: []interface{}{map[string]interface{}{
+				iamRoleARNKey:   "arn",
+				iamRoleExtIDKey: "ext",
+			}}},
+		},
+		{
+			name:       "case for a proxy",
+			capability: proxyConfigCapability,
+			data: keyValue(map[string]strfmt.Base64{
+				httpProxyDataKey:     []byte("http://proxy:3128"),
+				httpsProxyDataKey:    []byte("https://proxy:3129"),
+				noProxyDataKey:       []byte("localhost,10.0.0.0/8"),
+				httpUserNameDataKey:  []byte("http-user"),
+				httpsUserNameDataKey: []byte("https-user"),
+			}),
+			expected: map[string]interface{}{proxyKey: []interface{}{map[string]interface{}{
+				httpProxyKey:     "http://proxy:3128",
+				httpsProxyKey:    "https://proxy:3129",
+				noProxyKey:       []interface{}{"localhost", "10.0.0.0/8"},
+				httpUsernameKey:  "http-user",
+				httpPasswordKey:  "",
+				httpsUsernameKey: "https-user",
+				httpsPasswordKey: "",
+				caBundleKey:      "",
+			}}},
+		},
+		{
+			name:       "case for an image registry",
+			capability: imageRegistryCapability,
+			data:       keyValue(map[string]strfmt.Base64{registryURLDataKey: []byte("harbor.example.com"), usernameDataKey: []byte("robot")}),
+			expected: map[string]interface{}{imageRegistryKey: []interface{}{map[string]interface{}{
+				registryURLKey: "harbor.example.com",
+				usernameKey:    "robot",
+				passwordKey:    "",
+				caBundleKey:    "",
+			}}},
+		},
+		{
+			name:       "case for key value data of a capability without typed data",
+			capability: tanzuServiceMeshCapability,
+			data:       keyValue(map[string]strfmt.Base64{"key": []byte("value")}),
+			expected: map[string]interface{}{keyValueKey: []interface{}{map[string]interface{}{
+				typeKey: credentialsmodels.NewVmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpecSecretType(credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialTypeKeyvalueSpecSecretTypeOPAQUESECRETTYPE),
+				dataKey: map[string]string{"key": "value"},
+			}}},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			spec := flattenSpec(&credentialsmodels.VmwareTanzuManageV1alpha1AccountCredentialSpec{
+				Capability: test.capability,
+				Data:       test.data,
+			})
+
+			require.Len(t, spec, 1)
+			require.Equal(t, []interface{}{test.expected}, spec[0].(map[string]interface{})[dataKey])
+		})
+	}
+}
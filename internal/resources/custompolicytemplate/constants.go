@@ -0,0 +1,30 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package custompolicytemplate
+
+const (
+	ResourceName = "tanzu-mission-control_custom_policy_template"
+
+	NameKey               = "name"
+	SpecKey               = "spec"
+	objectTypeKey         = "object_type"
+	templateTypeKey       = "template_type"
+	isDeprecatedKey       = "is_deprecated"
+	dataInventoryKey      = "data_inventory"
+	groupKey              = "group"
+	versionKey            = "version"
+	kindKey               = "kind"
+	templateManifestKey   = "template_manifest"
+	constraintTemplateKey = "constraint_template"
+	regoKey               = "rego"
+	targetKey             = "target"
+	parametersKey         = "parameters"
+
+	constraintTemplateObjectType = "ConstraintTemplate"
+	opaGatekeeperTemplateType    = "OPAGatekeeper"
+	gatekeeperAdmissionTarget    = "admission.k8s.gatekeeper.sh"
+	constraintTemplateAPIVersion = "templates.gatekeeper.sh/v1beta1"
+)
@@ -0,0 +1,169 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package custompolicytemplate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	custompolicytemplatemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/custompolicytemplate"
+)
+
+const testRego = `package k8srequiredreplicas
+
+violation[{"msg": msg}] {
+  input.review.object.spec.replicas < input.parameters.min
+  msg := "not enough replicas"
+}
+`
+
+func TestFlattenSpec(t *testing.T) {
+	t.Parallel()
+
+	manifest, err := buildConstraintTemplateManifest("k8srequiredreplicas", map[string]interface{}{
+		kindKey:       "K8sRequiredReplicas",
+		regoKey:       testRego,
+		targetKey:     gatekeeperAdmissionTarget,
+		parametersKey: `{"properties":{"min":{"type":"integer"}}}`,
+	})
+	require.NoError(t, err)
+
+	cases := []struct {
+		description string
+		input       *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateSpec
+		structured  bool
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil spec",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with a template manifest",
+			input: &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateSpec{
+				DataInventory: []*custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateDataInventory{
+					{
+						Group:   "",
+						Version: "v1",
+						Kind:    "Namespace",
+					},
+				},
+				Deprecated:   true,
+				Object:       manifest,
+				ObjectType:   constraintTemplateObjectType,
+				TemplateType: opaGatekeeperTemplateType,
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					objectTypeKey:   constraintTemplateObjectType,
+					templateTypeKey: opaGatekeeperTemplateType,
+					isDeprecatedKey: true,
+					dataInventoryKey: []interface{}{
+						map[string]interface{}{
+							groupKey:   "",
+							versionKey: "v1",
+							kindKey:    "Namespace",
+						},
+					},
+					templateManifestKey: manifest,
+				},
+			},
+		},
+		{
+			description: "normal scenario with a structured constraint template",
+			input: &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateSpec{
+				Object:       manifest,
+				ObjectType:   constraintTemplateObjectType,
+				TemplateType: opaGatekeeperTemplateType,
+			},
+			structured: true,
+			expected: []interface{}{
+				map[string]interface{}{
+					objectTypeKey:    constraintTemplateObjectType,
+					templateTypeKey:  opaGatekeeperTemplateType,
+					isDeprecatedKey:  false,
+					dataInventoryKey: []interface{}{},
+					constraintTemplateKey: []interface{}{
+						map[string]interface{}{
+							kindKey:       "K8sRequiredReplicas",
+							regoKey:       testRego,
+							targetKey:     gatekeeperAdmissionTarget,
+							parametersKey: "properties:\n  min:\n    type: integer\n",
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "scenario with a structured constraint template and a manifest that can't be represented as one",
+			input: &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateSpec{
+				Object:       "kind: ConstraintTemplate\n",
+				ObjectType:   constraintTemplateObjectType,
+				TemplateType: opaGatekeeperTemplateType,
+			},
+			structured: true,
+			expected: []interface{}{
+				map[string]interface{}{
+					objectTypeKey:       constraintTemplateObjectType,
+					templateTypeKey:     opaGatekeeperTemplateType,
+					isDeprecatedKey:     false,
+					dataInventoryKey:    []interface{}{},
+					templateManifestKey: "kind: ConstraintTemplate\n",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenSpec(test.input, test.structured)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestBuildConstraintTemplateManifest(t *testing.T) {
+	t.Parallel()
+
+	manifest, err := buildConstraintTemplateManifest("k8srequiredreplicas", map[string]interface{}{
+		kindKey:   "K8sRequiredReplicas",
+		regoKey:   testRego,
+		targetKey: gatekeeperAdmissionTarget,
+	})
+	require.NoError(t, err)
+
+	expected := `apiVersion: templates.gatekeeper.sh/v1beta1
+kind: ConstraintTemplate
+metadata:
+  name: k8srequiredreplicas
+spec:
+  crd:
+    spec:
+      names:
+        kind: K8sRequiredReplicas
+  targets:
+  - rego: |
+      package k8srequiredreplicas
+
+      violation[{"msg": msg}] {
+        input.review.object.spec.replicas < input.parameters.min
+        msg := "not enough replicas"
+      }
+    target: admission.k8s.gatekeeper.sh
+`
+	require.Equal(t, expected, manifest)
+	require.True(t, suppressEquivalentDocuments("", expected, manifest, nil))
+
+	_, err = buildConstraintTemplateManifest("k8srequiredreplicas", map[string]interface{}{
+		kindKey:       "K8sRequiredReplicas",
+		regoKey:       testRego,
+		parametersKey: "properties: [",
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,202 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package custompolicytemplate
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	custompolicytemplatemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/custompolicytemplate"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+func ResourceCustomPolicyTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCustomPolicyTemplateCreate,
+		ReadContext:   resourceCustomPolicyTemplateRead,
+		UpdateContext: resourceCustomPolicyTemplateInPlaceUpdate,
+		DeleteContext: resourceCustomPolicyTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCustomPolicyTemplateImporter,
+		},
+		Schema: customPolicyTemplateSchema,
+	}
+}
+
+var customPolicyTemplateSchema = map[string]*schema.Schema{
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the custom policy template.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	common.MetaKey: common.Meta,
+	SpecKey:        specSchema,
+}
+
+func resourceCustomPolicyTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	templateName, ok := d.Get(NameKey).(string)
+	if !ok {
+		return diag.Errorf("unable to read custom policy template name")
+	}
+
+	spec, err := constructSpec(d)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control custom policy template entry, name : %s", templateName))
+	}
+
+	request := &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData{
+		Template: &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplate{
+			FullName: &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName{
+				Name: templateName,
+			},
+			Meta: common.ConstructMeta(d),
+			Spec: spec,
+		},
+	}
+
+	response, err := config.TMCConnection.CustomPolicyTemplateResourceService.CustomPolicyTemplateResourceServiceCreate(request)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control custom policy template entry, name : %s", templateName))
+	}
+
+	d.SetId(response.Template.Meta.UID)
+
+	return resourceCustomPolicyTemplateRead(ctx, d, m)
+}
+
+func resourceCustomPolicyTemplateRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	templateName, ok := d.Get(NameKey).(string)
+	if !ok {
+		return diag.Errorf("unable to read custom policy template name")
+	}
+
+	fn := &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName{
+		Name: templateName,
+	}
+
+	resp, err := config.TMCConnection.CustomPolicyTemplateResourceService.CustomPolicyTemplateResourceServiceGet(fn)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			_ = schema.RemoveFromState(d, m)
+			return
+		}
+
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control custom policy template entry, name : %s", templateName))
+	}
+
+	d.SetId(resp.Template.Meta.UID)
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(resp.Template.Meta)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	structured, _ := d.Get(helper.GetFirstElementOf(SpecKey, constraintTemplateKey)).([]interface{})
+
+	if err := d.Set(SpecKey, flattenSpec(resp.Template.Spec, len(structured) != 0)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceCustomPolicyTemplateInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	updateRequired := common.HasMetaChanged(d) || hasSpecChanged(d)
+	if !updateRequired {
+		return diags
+	}
+
+	templateName, ok := d.Get(NameKey).(string)
+	if !ok {
+		return diag.Errorf("unable to read custom policy template name")
+	}
+
+	fn := &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName{
+		Name: templateName,
+	}
+
+	getResp, err := config.TMCConnection.CustomPolicyTemplateResourceService.CustomPolicyTemplateResourceServiceGet(fn)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control custom policy template entry, name : %s", templateName))
+	}
+
+	if common.HasMetaChanged(d) {
+		meta := common.ConstructMeta(d)
+
+		if value, ok := getResp.Template.Meta.Labels[common.CreatorLabelKey]; ok {
+			meta.Labels[common.CreatorLabelKey] = value
+		}
+
+		getResp.Template.Meta.Labels = meta.Labels
+		getResp.Template.Meta.Description = meta.Description
+	}
+
+	if hasSpecChanged(d) {
+		spec, err := constructSpec(d)
+		if err != nil {
+			return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control custom policy template entry, name : %s", templateName))
+		}
+
+		getResp.Template.Spec = spec
+	}
+
+	_, err = config.TMCConnection.CustomPolicyTemplateResourceService.CustomPolicyTemplateResourceServiceUpdate(
+		&custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData{
+			Template: getResp.Template,
+		},
+	)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control custom policy template entry, name : %s", templateName))
+	}
+
+	return resourceCustomPolicyTemplateRead(ctx, d, m)
+}
+
+func resourceCustomPolicyTemplateDelete(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	templateName, _ := d.Get(NameKey).(string)
+
+	fn := &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName{
+		Name: templateName,
+	}
+
+	err := config.TMCConnection.CustomPolicyTemplateResourceService.CustomPolicyTemplateResourceServiceDelete(fn)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control custom policy template entry, name : %s", templateName))
+	}
+
+	_ = schema.RemoveFromState(d, m)
+
+	return diags
+}
+
+// resourceCustomPolicyTemplateImporter imports a custom policy template using an ID of the form <name>.
+// The template object is imported as a raw template_manifest.
+func resourceCustomPolicyTemplateImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts, err := helper.ParseImportID(d.Id(), NameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.Set(NameKey, parts[0]); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the custom policy template %s", parts[0])
+	}
+
+	return helper.ReadImportedState(ctx, d, m, resourceCustomPolicyTemplateRead)
+}
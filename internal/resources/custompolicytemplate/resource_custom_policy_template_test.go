@@ -0,0 +1,179 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package custompolicytemplate
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/proxy"
+	custompolicytemplatemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/custompolicytemplate"
+	testhelper "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/testing"
+)
+
+const (
+	customPolicyTemplateResourceVar = "test_custom_policy_template"
+)
+
+func TestAcceptanceForCustomPolicyTemplateResource(t *testing.T) {
+	var provider = initTestProvider(t)
+
+	resourceName := fmt.Sprintf("%s.%s", ResourceName, customPolicyTemplateResourceVar)
+	// Gatekeeper requires the ConstraintTemplate name to be the lowercase form of the constraint kind.
+	templateKind := "K8sTfTest" + acctest.RandStringFromCharSet(6, acctest.CharSetAlpha)
+	templateName := strings.ToLower(templateKind)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testhelper.TestPreCheck(t),
+		ProviderFactories: testhelper.GetTestProviderFactories(provider),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: getTestResourceCustomPolicyTemplateConfigValue(templateName, templateKind),
+				Check: resource.ComposeTestCheckFunc(
+					verifyCustomPolicyTemplateResourceCreation(provider, resourceName, templateName),
+					resource.TestCheckResourceAttr(resourceName, "name", templateName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.constraint_template.0.kind", templateKind),
+				),
+			},
+			{
+				Config: getTestResourceCustomPolicyTemplateManifestConfigValue(templateName, templateKind),
+				Check: resource.ComposeTestCheckFunc(
+					verifyCustomPolicyTemplateResourceCreation(provider, resourceName, templateName),
+					resource.TestCheckResourceAttr(resourceName, "name", templateName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.data_inventory.0.kind", "Namespace"),
+				),
+			},
+		},
+	},
+	)
+	t.Log("custom policy template resource acceptance test complete!")
+}
+
+func getTestResourceCustomPolicyTemplateConfigValue(templateName, templateKind string) string {
+	return fmt.Sprintf(`
+resource "%s" "%s" {
+  name = "%s"
+
+  spec {
+    constraint_template {
+      kind = "%s"
+      rego = <<EOT
+package k8srequiredreplicas
+
+violation[{"msg": msg}] {
+  input.review.object.spec.replicas < input.parameters.min
+  msg := sprintf("replicas must be at least %%v", [input.parameters.min])
+}
+EOT
+
+      parameters = jsonencode({
+        properties = {
+          min = {
+            type = "integer"
+          }
+        }
+      })
+    }
+  }
+}
+`, ResourceName, customPolicyTemplateResourceVar, templateName, templateKind)
+}
+
+func getTestResourceCustomPolicyTemplateManifestConfigValue(templateName, templateKind string) string {
+	return fmt.Sprintf(`
+resource "%s" "%s" {
+  name = "%s"
+
+  spec {
+    data_inventory {
+      group   = ""
+      version = "v1"
+      kind    = "Namespace"
+    }
+
+    template_manifest = <<YAML
+apiVersion: templates.gatekeeper.sh/v1beta1
+kind: ConstraintTemplate
+metadata:
+  name: %s
+spec:
+  crd:
+    spec:
+      names:
+        kind: %s
+  targets:
+    - target: admission.k8s.gatekeeper.sh
+      rego: |
+        package k8sblockall
+
+        violation[{"msg": msg}] {
+          data.inventory.cluster["v1"]["Namespace"][input.review.object.metadata.namespace]
+          msg := "resources are not allowed"
+        }
+YAML
+  }
+}
+`, ResourceName, customPolicyTemplateResourceVar, templateName, templateName, templateKind)
+}
+
+func verifyCustomPolicyTemplateResourceCreation(
+	provider *schema.Provider,
+	resourceName string,
+	templateName string,
+) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if provider == nil {
+			return fmt.Errorf("provider not initialised")
+		}
+
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("not found resource %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID not set, resource %s", resourceName)
+		}
+
+		config := authctx.TanzuContext{
+			ServerEndpoint:   os.Getenv(authctx.ServerEndpointEnvVar),
+			Token:            os.Getenv(authctx.VMWCloudAPITokenEnvVar),
+			VMWCloudEndPoint: os.Getenv(authctx.VMWCloudEndpointEnvVar),
+			TLSConfig:        &proxy.TLSConfig{},
+		}
+
+		err := config.Setup()
+		if err != nil {
+			return errors.Wrap(err, "unable to set the context")
+		}
+
+		fn := &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName{
+			Name: templateName,
+		}
+
+		resp, err := config.TMCConnection.CustomPolicyTemplateResourceService.CustomPolicyTemplateResourceServiceGet(fn)
+		if err != nil {
+			return fmt.Errorf("custom policy template resource not found: %s", err)
+		}
+
+		if resp == nil {
+			return fmt.Errorf("custom policy template resource is empty, resource: %s", resourceName)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,391 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package custompolicytemplate
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	custompolicytemplatemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/custompolicytemplate"
+)
+
+var specSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Spec for the custom policy template.",
+	Required:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			objectTypeKey: {
+				Type:        schema.TypeString,
+				Description: "The type of Kubernetes resource encoded in the template.",
+				Optional:    true,
+				Default:     constraintTemplateObjectType,
+			},
+			templateTypeKey: {
+				Type:        schema.TypeString,
+				Description: "The type of policy template.",
+				Optional:    true,
+				Default:     opaGatekeeperTemplateType,
+			},
+			isDeprecatedKey: {
+				Type:        schema.TypeBool,
+				Description: "Flag representing whether the custom policy template is deprecated. A deprecated template can't be used to create new policies.",
+				Optional:    true,
+				Default:     false,
+			},
+			dataInventoryKey: dataInventorySchema,
+			templateManifestKey: {
+				Type:             schema.TypeString,
+				Description:      "YAML manifest of the ConstraintTemplate wrapped by the custom policy template. Conflicts with `constraint_template`.",
+				Optional:         true,
+				ValidateFunc:     validateDocument,
+				DiffSuppressFunc: suppressEquivalentDocuments,
+				ExactlyOneOf:     []string{helper.GetFirstElementOf(SpecKey, templateManifestKey), helper.GetFirstElementOf(SpecKey, constraintTemplateKey)},
+			},
+			constraintTemplateKey: constraintTemplateSchema,
+		},
+	},
+}
+
+var dataInventorySchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "List of Kubernetes resources to be synced into OPA so that the template can reference them in its Rego.",
+	Optional:    true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			groupKey: {
+				Type:        schema.TypeString,
+				Description: "API group of the Kubernetes resource.",
+				Required:    true,
+			},
+			versionKey: {
+				Type:        schema.TypeString,
+				Description: "API version of the Kubernetes resource.",
+				Required:    true,
+			},
+			kindKey: {
+				Type:        schema.TypeString,
+				Description: "Kind of the Kubernetes resource.",
+				Required:    true,
+			},
+		},
+	},
+}
+
+var constraintTemplateSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Structured definition of the ConstraintTemplate wrapped by the custom policy template. The manifest is built from these fields and the template name, which must be the lowercase form of `kind`. Conflicts with `template_manifest`.",
+	Optional:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			kindKey: {
+				Type:        schema.TypeString,
+				Description: "Kind of the constraint defined by the template, e.g. K8sRequiredLabels.",
+				Required:    true,
+			},
+			regoKey: {
+				Type:         schema.TypeString,
+				Description:  "Rego source of the template.",
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			targetKey: {
+				Type:        schema.TypeString,
+				Description: "Target of the Rego source.",
+				Optional:    true,
+				Default:     gatekeeperAdmissionTarget,
+			},
+			parametersKey: {
+				Type:             schema.TypeString,
+				Description:      "OpenAPI v3 schema of the parameters accepted by the template, as a YAML or JSON document. Use `jsonencode()` to provide the schema as structured HCL.",
+				Optional:         true,
+				ValidateFunc:     validateDocument,
+				DiffSuppressFunc: suppressEquivalentDocuments,
+			},
+		},
+	},
+}
+
+type (
+	constraintTemplate struct {
+		APIVersion string                     `json:"apiVersion"`
+		Kind       string                     `json:"kind"`
+		Metadata   constraintTemplateMetadata `json:"metadata"`
+		Spec       constraintTemplateSpec     `json:"spec"`
+	}
+
+	constraintTemplateMetadata struct {
+		Name string `json:"name"`
+	}
+
+	constraintTemplateSpec struct {
+		CRD     constraintTemplateCRD      `json:"crd"`
+		Targets []constraintTemplateTarget `json:"targets"`
+	}
+
+	constraintTemplateCRD struct {
+		Spec constraintTemplateCRDSpec `json:"spec"`
+	}
+
+	constraintTemplateCRDSpec struct {
+		Names      constraintTemplateNames       `json:"names"`
+		Validation *constraintTemplateValidation `json:"validation,omitempty"`
+	}
+
+	constraintTemplateNames struct {
+		Kind string `json:"kind"`
+	}
+
+	constraintTemplateValidation struct {
+		OpenAPIV3Schema interface{} `json:"openAPIV3Schema,omitempty"`
+	}
+
+	constraintTemplateTarget struct {
+		Target string `json:"target"`
+		Rego   string `json:"rego"`
+	}
+)
+
+func constructSpec(d *schema.ResourceData) (spec *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateSpec, err error) {
+	value, ok := d.GetOk(SpecKey)
+	if !ok {
+		return spec, nil
+	}
+
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return spec, nil
+	}
+
+	specData, _ := data[0].(map[string]interface{})
+
+	spec = &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateSpec{
+		DataInventory: make([]*custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateDataInventory, 0),
+	}
+
+	if v, ok := specData[objectTypeKey]; ok {
+		helper.SetPrimitiveValue(v, &spec.ObjectType, objectTypeKey)
+	}
+
+	if v, ok := specData[templateTypeKey]; ok {
+		helper.SetPrimitiveValue(v, &spec.TemplateType, templateTypeKey)
+	}
+
+	if v, ok := specData[isDeprecatedKey]; ok {
+		helper.SetPrimitiveValue(v, &spec.Deprecated, isDeprecatedKey)
+	}
+
+	if v, ok := specData[dataInventoryKey]; ok {
+		inventories, _ := v.([]interface{})
+
+		for _, raw := range inventories {
+			inventoryData, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			inventory := &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateDataInventory{}
+
+			helper.SetPrimitiveValue(inventoryData[groupKey], &inventory.Group, groupKey)
+			helper.SetPrimitiveValue(inventoryData[versionKey], &inventory.Version, versionKey)
+			helper.SetPrimitiveValue(inventoryData[kindKey], &inventory.Kind, kindKey)
+
+			spec.DataInventory = append(spec.DataInventory, inventory)
+		}
+	}
+
+	if v, ok := specData[templateManifestKey]; ok {
+		helper.SetPrimitiveValue(v, &spec.Object, templateManifestKey)
+	}
+
+	if v, ok := specData[constraintTemplateKey]; ok {
+		if templateData, ok := v.([]interface{}); ok && len(templateData) != 0 && templateData[0] != nil {
+			name, _ := d.Get(NameKey).(string)
+
+			spec.Object, err = buildConstraintTemplateManifest(name, templateData[0].(map[string]interface{}))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return spec, nil
+}
+
+// flattenSpec flattens the spec returned by the API. The template object is reported back in the same
+// form it was configured in: as a raw manifest, or as a structured constraint_template block.
+func flattenSpec(spec *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateSpec, structured bool) (data []interface{}) {
+	if spec == nil {
+		return data
+	}
+
+	flattenSpecData := make(map[string]interface{})
+
+	flattenSpecData[objectTypeKey] = spec.ObjectType
+	flattenSpecData[templateTypeKey] = spec.TemplateType
+	flattenSpecData[isDeprecatedKey] = spec.Deprecated
+
+	inventories := make([]interface{}, 0, len(spec.DataInventory))
+
+	for _, inventory := range spec.DataInventory {
+		if inventory == nil {
+			continue
+		}
+
+		inventories = append(inventories, map[string]interface{}{
+			groupKey:   inventory.Group,
+			versionKey: inventory.Version,
+			kindKey:    inventory.Kind,
+		})
+	}
+
+	flattenSpecData[dataInventoryKey] = inventories
+
+	if structured {
+		if templateData, err := flattenConstraintTemplateManifest(spec.Object); err == nil {
+			flattenSpecData[constraintTemplateKey] = templateData
+			return []interface{}{flattenSpecData}
+		}
+	}
+
+	flattenSpecData[templateManifestKey] = spec.Object
+
+	return []interface{}{flattenSpecData}
+}
+
+func buildConstraintTemplateManifest(name string, templateData map[string]interface{}) (string, error) {
+	kind, _ := templateData[kindKey].(string)
+	rego, _ := templateData[regoKey].(string)
+	target, _ := templateData[targetKey].(string)
+
+	template := &constraintTemplate{
+		APIVersion: constraintTemplateAPIVersion,
+		Kind:       constraintTemplateObjectType,
+		Metadata:   constraintTemplateMetadata{Name: name},
+		Spec: constraintTemplateSpec{
+			CRD: constraintTemplateCRD{
+				Spec: constraintTemplateCRDSpec{
+					Names: constraintTemplateNames{Kind: kind},
+				},
+			},
+			Targets: []constraintTemplateTarget{
+				{
+					Target: target,
+					Rego:   rego,
+				},
+			},
+		},
+	}
+
+	if parameters, _ := templateData[parametersKey].(string); strings.TrimSpace(parameters) != "" {
+		parametersSchema, err := parseDocument(parameters)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid %s", parametersKey)
+		}
+
+		template.Spec.CRD.Spec.Validation = &constraintTemplateValidation{OpenAPIV3Schema: parametersSchema}
+	}
+
+	manifest, err := yaml.Marshal(template)
+	if err != nil {
+		return "", errors.Wrap(err, "Unable to build the ConstraintTemplate manifest")
+	}
+
+	return string(manifest), nil
+}
+
+func flattenConstraintTemplateManifest(manifest string) (data []interface{}, err error) {
+	template := &constraintTemplate{}
+
+	if err = yaml.Unmarshal([]byte(manifest), template); err != nil {
+		return data, err
+	}
+
+	if len(template.Spec.Targets) != 1 {
+		return data, errors.Errorf("expected exactly one target in the ConstraintTemplate, found %d", len(template.Spec.Targets))
+	}
+
+	flattenTemplateData := map[string]interface{}{
+		kindKey:   template.Spec.CRD.Spec.Names.Kind,
+		regoKey:   template.Spec.Targets[0].Rego,
+		targetKey: template.Spec.Targets[0].Target,
+	}
+
+	if template.Spec.CRD.Spec.Validation != nil && template.Spec.CRD.Spec.Validation.OpenAPIV3Schema != nil {
+		parameters, err := yaml.Marshal(template.Spec.CRD.Spec.Validation.OpenAPIV3Schema)
+		if err != nil {
+			return data, err
+		}
+
+		flattenTemplateData[parametersKey] = string(parameters)
+	}
+
+	return []interface{}{flattenTemplateData}, nil
+}
+
+// ConstraintTemplateKind returns the kind of the constraint defined by a ConstraintTemplate manifest.
+func ConstraintTemplateKind(manifest string) (string, error) {
+	template := &constraintTemplate{}
+
+	if err := yaml.Unmarshal([]byte(manifest), template); err != nil {
+		return "", err
+	}
+
+	if template.Spec.CRD.Spec.Names.Kind == "" {
+		return "", errors.New("no kind found in the ConstraintTemplate")
+	}
+
+	return template.Spec.CRD.Spec.Names.Kind, nil
+}
+
+// parseDocument parses a YAML or JSON document.
+func parseDocument(document string) (parsed interface{}, err error) {
+	err = yaml.Unmarshal([]byte(document), &parsed)
+
+	return parsed, err
+}
+
+func validateDocument(value interface{}, key string) (warnings []string, errs []error) {
+	document, ok := value.(string)
+	if !ok {
+		return nil, []error{errors.Errorf("expected %s to be a string", key)}
+	}
+
+	if _, err := parseDocument(document); err != nil {
+		return nil, []error{errors.Wrapf(err, "invalid %s", key)}
+	}
+
+	return nil, nil
+}
+
+// suppressEquivalentDocuments ignores formatting differences between YAML or JSON documents holding the same data,
+// such as the output of jsonencode() and the YAML document returned by Tanzu Mission Control.
+func suppressEquivalentDocuments(_, old, new string, _ *schema.ResourceData) bool {
+	oldDocument, err := parseDocument(old)
+	if err != nil {
+		return false
+	}
+
+	newDocument, err := parseDocument(new)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldDocument, newDocument)
+}
+
+func hasSpecChanged(d *schema.ResourceData) bool {
+	return d.HasChange(SpecKey)
+}
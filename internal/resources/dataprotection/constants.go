@@ -0,0 +1,77 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package dataprotection
+
+const (
+	EnableDataProtectionResourceName = "tanzu-mission-control_enable_data_protection"
+	TargetLocationResourceName       = "tanzu-mission-control_target_location"
+	BackupScheduleResourceName       = "tanzu-mission-control_backup_schedule"
+	RestoreResourceName              = "tanzu-mission-control_restore"
+
+	NameKey                  = "name"
+	ClusterNameKey           = "cluster_name"
+	ManagementClusterNameKey = "management_cluster_name"
+	ProvisionerNameKey       = "provisioner_name"
+	ProviderNameKey          = "provider_name"
+	attachedValue            = "attached"
+	providerNameDefaultValue = "tmc"
+	specKey                  = "spec"
+	statusKey                = "status"
+	phaseKey                 = "phase"
+	phaseInfoKey             = "phase_info"
+
+	// Data protection.
+	deleteBackupsKey                   = "delete_backups"
+	enableCSISnapshotsKey              = "enable_csi_snapshots"
+	disableResticKey                   = "disable_restic"
+	enableAllAPIGroupVersionsBackupKey = "enable_all_api_group_versions_backup"
+
+	// Target location.
+	targetProviderKey   = "target_provider"
+	credentialNameKey   = "credential_name"
+	bucketKey           = "bucket"
+	regionKey           = "region"
+	caCertKey           = "ca_cert"
+	assignedGroupsKey   = "assigned_groups"
+	clusterKey          = "cluster"
+	clusterGroupsKey    = "cluster_groups"
+	configKey           = "config"
+	awsKey              = "aws"
+	azureKey            = "azure"
+	urlKey              = "url"
+	publicURLKey        = "public_url"
+	s3ForcePathStyleKey = "s3_force_path_style"
+	resourceGroupKey    = "resource_group"
+	storageAccountKey   = "storage_account"
+	subscriptionIDKey   = "subscription_id"
+
+	// Backup schedule.
+	pausedKey                   = "paused"
+	scheduleKey                 = "schedule"
+	rateKey                     = "rate"
+	templateKey                 = "template"
+	storageLocationKey          = "storage_location"
+	ttlKey                      = "ttl"
+	ttlDefaultValue             = "720h0m0s"
+	snapshotVolumesKey          = "snapshot_volumes"
+	defaultVolumesToFsBackupKey = "default_volumes_to_fs_backup"
+	volumeSnapshotLocationsKey  = "volume_snapshot_locations"
+	lastBackupKey               = "last_backup"
+
+	// Backup schedule template and restore.
+	includedNamespacesKey      = "included_namespaces"
+	excludedNamespacesKey      = "excluded_namespaces"
+	includedResourcesKey       = "included_resources"
+	excludedResourcesKey       = "excluded_resources"
+	includeClusterResourcesKey = "include_cluster_resources"
+
+	// Restore.
+	backupNameKey       = "backup_name"
+	restorePVsKey       = "restore_pvs"
+	namespaceMappingKey = "namespace_mapping"
+	warningsKey         = "warnings"
+	errorsKey           = "errors"
+)
@@ -0,0 +1,166 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+)
+
+// pollInterval is the interval between two gets of a data protection object while waiting for it.
+var pollInterval = 5 * time.Second
+
+// phaseGetter gets the phase and the phase info of a data protection object, found is false once the object is deleted.
+type phaseGetter func(ctx context.Context) (phase string, phaseInfo string, found bool, err error)
+
+// waitForPhase polls a data protection object until it reaches one of the ready phases, failing when it reaches one of
+// the failed phases or when the timeout elapses. The phase the object reached is returned.
+func waitForPhase(ctx context.Context, kind string, name string, get phaseGetter, ready []string, failed []string, timeout time.Duration) (string, diag.Diagnostics) {
+	var (
+		phase, phaseInfo string
+		getErr           error
+	)
+
+	phaseRetryable := func() (retry bool, err error) {
+		var found bool
+
+		phase, phaseInfo, found, err = get(ctx)
+		if err != nil {
+			getErr = err
+			return false, err
+		}
+
+		switch {
+		case !found:
+			getErr = errors.Errorf("%s %s not found", kind, name)
+			return false, getErr
+		case slices.Contains(ready, phase), slices.Contains(failed, phase):
+			return false, nil
+		}
+
+		return true, errors.Errorf("%s %s is still in phase %s", kind, name, phase)
+	}
+
+	if _, err := helper.RetryUntilTimeoutWithContext(ctx, phaseRetryable, pollInterval, timeout); err != nil {
+		if getErr != nil {
+			return phase, clienterrors.ToDiagnostics(errors.Wrapf(getErr, "Unable to get Tanzu Mission Control %s entry, name : %s", kind, name))
+		}
+
+		return phase, diag.FromErr(errors.Wrapf(err, "timed out waiting for Tanzu Mission Control %s %s to be ready", kind, name))
+	}
+
+	if slices.Contains(failed, phase) {
+		return phase, diag.Errorf("Tanzu Mission Control %s %s is in phase %s: %s", kind, name, phase, phaseInfo)
+	}
+
+	return phase, nil
+}
+
+// waitForDeletion polls a data protection object until Tanzu Mission Control has deleted it.
+func waitForDeletion(ctx context.Context, kind string, name string, get phaseGetter, timeout time.Duration) diag.Diagnostics {
+	var getErr error
+
+	deletionRetryable := func() (retry bool, err error) {
+		phase, _, found, err := get(ctx)
+		if err != nil {
+			getErr = err
+			return false, err
+		}
+
+		if !found {
+			return false, nil
+		}
+
+		return true, errors.Errorf("%s %s is still in phase %s", kind, name, phase)
+	}
+
+	if _, err := helper.RetryUntilTimeoutWithContext(ctx, deletionRetryable, pollInterval, timeout); err != nil {
+		if getErr != nil {
+			return clienterrors.ToDiagnostics(errors.Wrapf(getErr, "Unable to get Tanzu Mission Control %s entry, name : %s", kind, name))
+		}
+
+		return diag.FromErr(errors.Wrapf(err, "timed out waiting for Tanzu Mission Control %s %s to be deleted", kind, name))
+	}
+
+	return nil
+}
+
+// forceNewSchema copies a schema, setting ForceNew on the configurable attributes at every level so that it can be
+// used in resources which cannot be updated.
+func forceNewSchema(s *schema.Schema) *schema.Schema {
+	forceNew := *s
+
+	if forceNew.Optional || forceNew.Required {
+		forceNew.ForceNew = true
+	}
+
+	if elem, ok := forceNew.Elem.(*schema.Resource); ok {
+		nested := make(map[string]*schema.Schema, len(elem.Schema))
+
+		for key, value := range elem.Schema {
+			nested[key] = forceNewSchema(value)
+		}
+
+		forceNew.Elem = &schema.Resource{Schema: nested}
+	}
+
+	return &forceNew
+}
+
+func expandStringList(data interface{}) []string {
+	list := helper.SetPrimitiveList[string](data)
+	if list == nil {
+		return []string{}
+	}
+
+	return list
+}
+
+func firstBlock(data interface{}) map[string]interface{} {
+	list, ok := data.([]interface{})
+	if !ok || len(list) == 0 || list[0] == nil {
+		return map[string]interface{}{}
+	}
+
+	block, _ := list[0].(map[string]interface{})
+
+	return block
+}
+
+func boolValue(value *bool, defaultValue bool) bool {
+	if value == nil {
+		return defaultValue
+	}
+
+	return *value
+}
+
+// setClusterScopedImportID sets the name and the cluster of a cluster scoped data protection object from an import ID
+// of the form <management_cluster_name>/<provisioner_name>/<cluster_name>/<name>.
+func setClusterScopedImportID(d *schema.ResourceData) error {
+	keys := []string{ManagementClusterNameKey, ProvisionerNameKey, ClusterNameKey, NameKey}
+
+	parts, err := helper.ParseImportID(d.Id(), keys...)
+	if err != nil {
+		return err
+	}
+
+	for i, key := range keys {
+		if err = d.Set(key, parts[i]); err != nil {
+			return errors.Wrapf(err, "Failed to set %s for %s", key, parts[3])
+		}
+	}
+
+	return nil
+}
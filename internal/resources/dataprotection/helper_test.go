@@ -0,0 +1,125 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+type mockPhase struct {
+	phase string
+	found bool
+	err   error
+}
+
+// mockPhaseGetter returns the given phases one after the other, repeating the last one.
+func mockPhaseGetter(phases ...mockPhase) phaseGetter {
+	calls := 0
+
+	return func(_ context.Context) (string, string, bool, error) {
+		current := phases[calls]
+
+		if calls < len(phases)-1 {
+			calls++
+		}
+
+		return current.phase, "phase info", current.found, current.err
+	}
+}
+
+func TestWaitForPhase(t *testing.T) {
+	pollInterval = time.Millisecond
+
+	cases := []struct {
+		name          string
+		phases        []mockPhase
+		timeout       time.Duration
+		expectedPhase string
+		expectedError string
+	}{
+		{
+			name:          "case for an object becoming ready",
+			phases:        []mockPhase{{phase: "PENDING", found: true}, {phase: "CREATING", found: true}, {phase: "READY", found: true}},
+			timeout:       time.Minute,
+			expectedPhase: "READY",
+		},
+		{
+			name:          "case for an object failing",
+			phases:        []mockPhase{{phase: "CREATING", found: true}, {phase: "ERROR", found: true}},
+			timeout:       time.Minute,
+			expectedPhase: "ERROR",
+			expectedError: "Tanzu Mission Control test object test is in phase ERROR: phase info",
+		},
+		{
+			name:          "case for an object deleted while waiting",
+			phases:        []mockPhase{{phase: "CREATING", found: true}, {found: false}},
+			timeout:       time.Minute,
+			expectedError: "Unable to get Tanzu Mission Control test object entry, name : test: test object test not found",
+		},
+		{
+			name:          "case for an error getting the object",
+			phases:        []mockPhase{{err: errors.New("internal error")}},
+			timeout:       time.Minute,
+			expectedError: "Unable to get Tanzu Mission Control test object entry, name : test: internal error",
+		},
+		{
+			name:          "case for a timeout",
+			phases:        []mockPhase{{phase: "PENDING", found: true}},
+			timeout:       10 * time.Millisecond,
+			expectedPhase: "PENDING",
+			expectedError: "timed out waiting for Tanzu Mission Control test object test to be ready: test object test is still in phase PENDING",
+		},
+	}
+
+	for _, test := range cases {
+		phase, diags := waitForPhase(context.Background(), "test object", "test", mockPhaseGetter(test.phases...), []string{"READY"}, []string{"ERROR"}, test.timeout)
+
+		require.Equal(t, test.expectedPhase, phase, test.name)
+
+		if test.expectedError == "" {
+			require.False(t, diags.HasError(), test.name)
+			continue
+		}
+
+		require.True(t, diags.HasError(), test.name)
+		require.Equal(t, test.expectedError, diags[0].Summary, test.name)
+	}
+}
+
+func TestWaitForDeletion(t *testing.T) {
+	pollInterval = time.Millisecond
+
+	diags := waitForDeletion(context.Background(), "test object", "test", mockPhaseGetter(mockPhase{phase: "DELETING", found: true}, mockPhase{found: false}), time.Minute)
+	require.False(t, diags.HasError())
+
+	diags = waitForDeletion(context.Background(), "test object", "test", mockPhaseGetter(mockPhase{phase: "DELETING", found: true}), 10*time.Millisecond)
+	require.True(t, diags.HasError())
+	require.Equal(t, "timed out waiting for Tanzu Mission Control test object test to be deleted: test object test is still in phase DELETING", diags[0].Summary)
+}
+
+func TestForceNewSchema(t *testing.T) {
+	t.Parallel()
+
+	meta := forceNewSchema(common.Meta)
+	nested := meta.Elem.(*schema.Resource).Schema
+
+	require.True(t, meta.ForceNew)
+	require.True(t, nested[common.LabelsKey].ForceNew)
+	require.True(t, nested[common.DescriptionKey].ForceNew)
+	require.False(t, nested["uid"].ForceNew)
+
+	// The shared schema is left untouched.
+	require.False(t, common.Meta.ForceNew)
+	require.False(t, common.Meta.Elem.(*schema.Resource).Schema[common.LabelsKey].ForceNew)
+}
@@ -0,0 +1,429 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	backupschedulemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/dataprotection/cluster/backupschedule"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+const backupScheduleKind = "backup schedule"
+
+func ResourceBackupSchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBackupScheduleCreate,
+		ReadContext:   resourceBackupScheduleRead,
+		UpdateContext: resourceBackupScheduleInPlaceUpdate,
+		DeleteContext: resourceBackupScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBackupScheduleImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: backupScheduleSchema,
+	}
+}
+
+var backupScheduleSchema = map[string]*schema.Schema{
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the backup schedule.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	ClusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the cluster.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	ManagementClusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the management cluster.",
+		Default:     attachedValue,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	ProvisionerNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the provisioner.",
+		Default:     attachedValue,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	common.MetaKey: common.Meta,
+	specKey: {
+		Type:        schema.TypeList,
+		Description: "Spec for the backup schedule.",
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				pausedKey: {
+					Type:        schema.TypeBool,
+					Description: "Pause the backup schedule.",
+					Default:     false,
+					Optional:    true,
+				},
+				scheduleKey: {
+					Type:        schema.TypeList,
+					Description: "Schedule of the backups.",
+					Required:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							rateKey: {
+								Type:        schema.TypeString,
+								Description: "Cron expression of the backups, e.g. \"0 2 * * *\" for a daily backup at 2 AM.",
+								Required:    true,
+							},
+						},
+					},
+				},
+				templateKey: {
+					Type:        schema.TypeList,
+					Description: "Template of the backups taken by the schedule.",
+					Required:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: backupTemplateSchema,
+					},
+				},
+			},
+		},
+	},
+	statusKey: {
+		Type:        schema.TypeMap,
+		Description: "Status of the backup schedule: phase, phase_info and last_backup.",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+}
+
+var backupTemplateSchema = map[string]*schema.Schema{
+	storageLocationKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the target location the backups are stored in.",
+		Required:    true,
+	},
+	ttlKey: {
+		Type:        schema.TypeString,
+		Description: "Retention period of the backups.",
+		Default:     ttlDefaultValue,
+		Optional:    true,
+	},
+	includedNamespacesKey: {
+		Type:        schema.TypeList,
+		Description: "Namespaces included in the backups, all the namespaces when empty.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	excludedNamespacesKey: {
+		Type:        schema.TypeList,
+		Description: "Namespaces excluded from the backups.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	includedResourcesKey: {
+		Type:        schema.TypeList,
+		Description: "Resources included in the backups, all the resources when empty.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	excludedResourcesKey: {
+		Type:        schema.TypeList,
+		Description: "Resources excluded from the backups.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	includeClusterResourcesKey: {
+		Type:        schema.TypeBool,
+		Description: "Back up the cluster scoped resources.",
+		Default:     true,
+		Optional:    true,
+	},
+	snapshotVolumesKey: {
+		Type:        schema.TypeBool,
+		Description: "Back up the persistent volumes through volume snapshots.",
+		Default:     true,
+		Optional:    true,
+	},
+	defaultVolumesToFsBackupKey: {
+		Type:        schema.TypeBool,
+		Description: "Back up all the persistent volumes through a file system copy, instead of the annotated ones only.",
+		Default:     false,
+		Optional:    true,
+	},
+	volumeSnapshotLocationsKey: {
+		Type:        schema.TypeList,
+		Description: "Names of the volume snapshot locations the volume snapshots are stored in.",
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+}
+
+func constructBackupScheduleFullname(d *schema.ResourceData) (fullname *backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName) {
+	fullname = &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName{}
+
+	fullname.ClusterName, _ = d.Get(ClusterNameKey).(string)
+
+	fullname.ManagementClusterName, _ = d.Get(ManagementClusterNameKey).(string)
+
+	fullname.Name, _ = d.Get(NameKey).(string)
+
+	fullname.ProvisionerName, _ = d.Get(ProvisionerNameKey).(string)
+
+	return fullname
+}
+
+func expandBackupScheduleSpec(data interface{}) (spec *backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSpec) {
+	specData := firstBlock(data)
+
+	spec = &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSpec{
+		Schedule: &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRate{},
+	}
+
+	spec.Paused, _ = specData[pausedKey].(bool)
+
+	spec.Schedule.Rate, _ = firstBlock(specData[scheduleKey])[rateKey].(string)
+
+	template := firstBlock(specData[templateKey])
+	spec.Template = &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionBackupSpec{
+		IncludedNamespaces:      expandStringList(template[includedNamespacesKey]),
+		ExcludedNamespaces:      expandStringList(template[excludedNamespacesKey]),
+		IncludedResources:       expandStringList(template[includedResourcesKey]),
+		ExcludedResources:       expandStringList(template[excludedResourcesKey]),
+		VolumeSnapshotLocations: expandStringList(template[volumeSnapshotLocationsKey]),
+	}
+
+	spec.Template.StorageLocation, _ = template[storageLocationKey].(string)
+
+	spec.Template.TTL, _ = template[ttlKey].(string)
+
+	spec.Template.DefaultVolumesToFsBackup, _ = template[defaultVolumesToFsBackupKey].(bool)
+
+	if v, ok := template[includeClusterResourcesKey].(bool); ok {
+		spec.Template.IncludeClusterResources = helper.BoolPointer(v)
+	}
+
+	if v, ok := template[snapshotVolumesKey].(bool); ok {
+		spec.Template.SnapshotVolumes = helper.BoolPointer(v)
+	}
+
+	return spec
+}
+
+func flattenBackupScheduleSpec(spec *backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSpec) (data []interface{}) {
+	if spec == nil {
+		return data
+	}
+
+	flattenSpecData := make(map[string]interface{})
+
+	flattenSpecData[pausedKey] = spec.Paused
+
+	if spec.Schedule != nil {
+		flattenSpecData[scheduleKey] = []interface{}{map[string]interface{}{
+			rateKey: spec.Schedule.Rate,
+		}}
+	}
+
+	if spec.Template != nil {
+		flattenSpecData[templateKey] = []interface{}{map[string]interface{}{
+			storageLocationKey:          spec.Template.StorageLocation,
+			ttlKey:                      spec.Template.TTL,
+			includedNamespacesKey:       spec.Template.IncludedNamespaces,
+			excludedNamespacesKey:       spec.Template.ExcludedNamespaces,
+			includedResourcesKey:        spec.Template.IncludedResources,
+			excludedResourcesKey:        spec.Template.ExcludedResources,
+			includeClusterResourcesKey:  boolValue(spec.Template.IncludeClusterResources, true),
+			snapshotVolumesKey:          boolValue(spec.Template.SnapshotVolumes, true),
+			defaultVolumesToFsBackupKey: spec.Template.DefaultVolumesToFsBackup,
+			volumeSnapshotLocationsKey:  spec.Template.VolumeSnapshotLocations,
+		}}
+	}
+
+	return []interface{}{flattenSpecData}
+}
+
+func backupSchedulePhaseGetter(config authctx.TanzuContext, fn *backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName) phaseGetter {
+	return func(ctx context.Context) (string, string, bool, error) {
+		resp, err := config.TMCConnection.BackupScheduleResourceService.ManageV1alpha1ClusterDataProtectionScheduleResourceServiceGetWithContext(ctx, fn)
+		if err != nil {
+			if clienterrors.IsNotFoundError(err) {
+				return "", "", false, nil
+			}
+
+			return "", "", false, err
+		}
+
+		if resp.Schedule == nil || resp.Schedule.Status == nil {
+			return "", "", true, nil
+		}
+
+		return helper.PtrString(resp.Schedule.Status.Phase), resp.Schedule.Status.PhaseInfo, true, nil
+	}
+}
+
+func waitForBackupScheduleReady(ctx context.Context, config authctx.TanzuContext, fn *backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName, timeout time.Duration) diag.Diagnostics {
+	_, diags := waitForPhase(ctx, backupScheduleKind, fn.Name, backupSchedulePhaseGetter(config, fn),
+		[]string{
+			string(backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseENABLED),
+			string(backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhasePAUSED),
+		},
+		[]string{
+			string(backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseFAILEDVALIDATION),
+			string(backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseERROR),
+		},
+		timeout)
+
+	return diags
+}
+
+func resourceBackupScheduleRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructBackupScheduleFullname(d)
+
+	resp, err := config.TMCConnection.BackupScheduleResourceService.ManageV1alpha1ClusterDataProtectionScheduleResourceServiceGet(fn)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control backup schedule entry, name : %s", fn.Name))
+	}
+
+	schedule := resp.Schedule
+
+	d.SetId(schedule.Meta.UID)
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(schedule.Meta)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(specKey, flattenBackupScheduleSpec(schedule.Spec)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	status := map[string]interface{}{}
+
+	if schedule.Status != nil {
+		status[phaseKey] = helper.PtrString(schedule.Status.Phase)
+		status[phaseInfoKey] = schedule.Status.PhaseInfo
+		status[lastBackupKey] = schedule.Status.LastBackup
+	}
+
+	if err := d.Set(statusKey, status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceBackupScheduleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructBackupScheduleFullname(d)
+
+	request := &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRequest{
+		Schedule: &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSchedule{
+			FullName: fn,
+			Meta:     common.ConstructMeta(d),
+			Spec:     expandBackupScheduleSpec(d.Get(specKey)),
+		},
+	}
+
+	response, err := config.TMCConnection.BackupScheduleResourceService.ManageV1alpha1ClusterDataProtectionScheduleResourceServiceCreate(request)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control backup schedule entry, name : %s", fn.Name))
+	}
+
+	d.SetId(response.Schedule.Meta.UID)
+
+	diags = append(diags, waitForBackupScheduleReady(ctx, config, fn, d.Timeout(schema.TimeoutCreate))...)
+
+	return append(diags, resourceBackupScheduleRead(ctx, d, m)...)
+}
+
+func resourceBackupScheduleInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructBackupScheduleFullname(d)
+
+	resp, err := config.TMCConnection.BackupScheduleResourceService.ManageV1alpha1ClusterDataProtectionScheduleResourceServiceGet(fn)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control backup schedule entry, name : %s", fn.Name))
+	}
+
+	schedule := resp.Schedule
+
+	if common.HasMetaChanged(d) {
+		meta := common.ConstructMeta(d)
+
+		if value, ok := schedule.Meta.Labels[common.CreatorLabelKey]; ok {
+			meta.Labels[common.CreatorLabelKey] = value
+		}
+
+		schedule.Meta.Labels = meta.Labels
+		schedule.Meta.Description = meta.Description
+	}
+
+	schedule.Spec = expandBackupScheduleSpec(d.Get(specKey))
+
+	_, err = config.TMCConnection.BackupScheduleResourceService.ManageV1alpha1ClusterDataProtectionScheduleResourceServiceUpdate(
+		&backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRequest{
+			Schedule: schedule,
+		},
+	)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control backup schedule entry, name : %s", fn.Name))
+	}
+
+	diags = append(diags, waitForBackupScheduleReady(ctx, config, fn, d.Timeout(schema.TimeoutUpdate))...)
+
+	return append(diags, resourceBackupScheduleRead(ctx, d, m)...)
+}
+
+func resourceBackupScheduleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructBackupScheduleFullname(d)
+
+	err := config.TMCConnection.BackupScheduleResourceService.ManageV1alpha1ClusterDataProtectionScheduleResourceServiceDelete(fn)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control backup schedule entry, name : %s", fn.Name))
+	}
+
+	if diags = waitForDeletion(ctx, backupScheduleKind, fn.Name, backupSchedulePhaseGetter(config, fn), d.Timeout(schema.TimeoutDelete)); diags.HasError() {
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// resourceBackupScheduleImporter imports a backup schedule using an ID of the form <management_cluster_name>/<provisioner_name>/<cluster_name>/<name>.
+func resourceBackupScheduleImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if err := setClusterScopedImportID(d); err != nil {
+		return nil, err
+	}
+
+	return helper.ReadImportedState(ctx, d, m, resourceBackupScheduleRead)
+}
@@ -0,0 +1,331 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package dataprotection
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	backupschedulemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/dataprotection/cluster/backupschedule"
+	dataprotectionmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/dataprotection/cluster/dataprotection"
+	restoremodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/dataprotection/cluster/restore"
+	targetlocationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/dataprotection/targetlocation"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+const (
+	https                = "https:/"
+	clAPIVersionAndGroup = "v1alpha1/clusters"
+	tlAPIVersionAndGroup = "v1alpha1/dataprotection/providers"
+)
+
+// bodyInspectingResponder checks the body of the request against the expected content before responding.
+func bodyInspectingResponder(t *testing.T, expectedContent interface{}, successResponse int, successResponseBody interface{}) httpmock.Responder {
+	return func(r *http.Request) (*http.Response, error) {
+		if expectedContent != nil {
+			expectedBytes, err := json.Marshal(expectedContent)
+			if err != nil {
+				return nil, err
+			}
+
+			if r.Body == nil {
+				t.Errorf("expected body on request %s %s", r.Method, r.URL)
+				return httpmock.NewStringResponse(http.StatusBadRequest, "expected body on request"), nil
+			}
+
+			bodyBytes, err := io.ReadAll(r.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			var bodyInterface, expectedInterface map[string]interface{}
+
+			if err := json.Unmarshal(bodyBytes, &bodyInterface); err != nil {
+				return nil, err
+			}
+
+			if err := json.Unmarshal(expectedBytes, &expectedInterface); err != nil {
+				return nil, err
+			}
+
+			if diff := deep.Equal(bodyInterface, expectedInterface); diff != nil {
+				t.Errorf("unexpected body on request %s %s: %v", r.Method, r.URL, diff)
+				return httpmock.NewStringResponse(http.StatusBadRequest, "unexpected body on request"), nil
+			}
+		}
+
+		return httpmock.NewJsonResponse(successResponse, successResponseBody)
+	}
+}
+
+// Register a new responder when the given call is made.
+func changeStateResponder(registerFunc func(), successResponse int, successResponseBody interface{}) httpmock.Responder {
+	return func(r *http.Request) (*http.Response, error) {
+		registerFunc()
+		return httpmock.NewJsonResponse(successResponse, successResponseBody)
+	}
+}
+
+func (testConfig *testAcceptanceConfig) setupHTTPMocks(t *testing.T) {
+	httpmock.Activate()
+	t.Cleanup(httpmock.Deactivate)
+
+	endpoint := os.Getenv("TMC_ENDPOINT")
+
+	testConfig.setupTargetLocationHTTPMocks(t, endpoint)
+	testConfig.setupDataProtectionHTTPMocks(t, endpoint)
+	testConfig.setupBackupScheduleHTTPMocks(t, endpoint)
+	testConfig.setupRestoreHTTPMocks(t, endpoint)
+}
+
+func mockMeta(uid string) *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta {
+	return &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+		UID:             uid,
+		ResourceVersion: "v1",
+	}
+}
+
+func (testConfig *testAcceptanceConfig) setupTargetLocationHTTPMocks(t *testing.T, endpoint string) {
+	fullName := &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationFullName{
+		Name:         testConfig.TargetLocationName,
+		ProviderName: providerNameDefaultValue,
+	}
+
+	spec := &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationSpec{
+		AssignedGroups: []*targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAssignedGroup{
+			{
+				Cluster: &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterFullName{
+					Name:                  testConfig.ClusterName,
+					ManagementClusterName: attachedValue,
+					ProvisionerName:       attachedValue,
+				},
+			},
+		},
+		Bucket:         testConfig.Bucket,
+		Credential:     &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationCredential{Name: testConfig.CredentialName},
+		Region:         testConfig.Region,
+		TargetProvider: targetlocationmodel.NewVmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProvider(targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProviderAWS),
+	}
+
+	postRequest := &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationRequest{
+		BackupLocation: &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationBackupLocation{
+			FullName: fullName,
+			Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{},
+			Spec:     spec,
+		},
+	}
+
+	response := &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationResponse{
+		BackupLocation: &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationBackupLocation{
+			FullName: fullName,
+			Meta:     mockMeta("target-location-uid"),
+			Spec:     spec,
+			Status: &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatus{
+				Phase: targetlocationmodel.NewVmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhase(targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhaseREADY),
+			},
+		},
+	}
+
+	postEndpoint := helper.ConstructRequestURL(https, endpoint, tlAPIVersionAndGroup, providerNameDefaultValue, "backuplocations").String()
+	getEndpoint := helper.ConstructRequestURL(https, endpoint, tlAPIVersionAndGroup, providerNameDefaultValue, "backuplocations", testConfig.TargetLocationName).String()
+
+	httpmock.RegisterResponder("POST", postEndpoint,
+		bodyInspectingResponder(t, postRequest, http.StatusOK, response))
+
+	httpmock.RegisterResponder("GET", getEndpoint,
+		bodyInspectingResponder(t, nil, http.StatusOK, response))
+
+	httpmock.RegisterResponder("DELETE", getEndpoint, changeStateResponder(
+		// Set up the get to return 404 after the target location has been 'deleted'.
+		func() {
+			httpmock.RegisterResponder("GET", getEndpoint,
+				httpmock.NewStringResponder(http.StatusNotFound, "Not found"))
+		},
+		http.StatusOK,
+		nil))
+}
+
+func (testConfig *testAcceptanceConfig) setupDataProtectionHTTPMocks(t *testing.T, endpoint string) {
+	fullName := &dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionFullName{
+		ClusterName:           testConfig.ClusterName,
+		ManagementClusterName: attachedValue,
+		ProvisionerName:       attachedValue,
+	}
+
+	spec := &dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionSpec{
+		EnableCsiSnapshots: true,
+	}
+
+	postRequest := &dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionRequest{
+		DataProtection: &dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionDataProtection{
+			FullName: fullName,
+			Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{},
+			Spec:     spec,
+		},
+	}
+
+	dataProtection := &dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionDataProtection{
+		FullName: fullName,
+		Meta:     mockMeta("data-protection-uid"),
+		Spec:     spec,
+		Status: &dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionStatus{
+			Phase: dataprotectionmodel.NewVmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhase(dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhaseREADY),
+		},
+	}
+
+	postResponse := &dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionResponse{
+		DataProtection: dataProtection,
+	}
+
+	listResponse := &dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionListDataProtectionsResponse{
+		DataProtections: []*dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionDataProtection{dataProtection},
+		TotalCount:      "1",
+	}
+
+	dataProtectionEndpoint := helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ClusterName, "dataprotection").String()
+
+	httpmock.RegisterResponder("POST", dataProtectionEndpoint,
+		bodyInspectingResponder(t, postRequest, http.StatusOK, postResponse))
+
+	httpmock.RegisterResponder("GET", dataProtectionEndpoint,
+		bodyInspectingResponder(t, nil, http.StatusOK, listResponse))
+
+	httpmock.RegisterResponder("DELETE", dataProtectionEndpoint, changeStateResponder(
+		// Set up the list to return no data protection after it has been 'disabled'.
+		func() {
+			httpmock.RegisterResponder("GET", dataProtectionEndpoint,
+				bodyInspectingResponder(t, nil, http.StatusOK, &dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionListDataProtectionsResponse{}))
+		},
+		http.StatusOK,
+		nil))
+}
+
+func (testConfig *testAcceptanceConfig) setupBackupScheduleHTTPMocks(t *testing.T, endpoint string) {
+	fullName := &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleFullName{
+		ClusterName:           testConfig.ClusterName,
+		ManagementClusterName: attachedValue,
+		Name:                  testConfig.BackupScheduleName,
+		ProvisionerName:       attachedValue,
+	}
+
+	spec := &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSpec{
+		Schedule: &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRate{
+			Rate: "0 2 * * *",
+		},
+		Template: &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionBackupSpec{
+			ExcludedNamespaces:      []string{},
+			ExcludedResources:       []string{},
+			IncludeClusterResources: helper.BoolPointer(true),
+			IncludedNamespaces:      []string{"default"},
+			IncludedResources:       []string{},
+			SnapshotVolumes:         helper.BoolPointer(true),
+			StorageLocation:         testConfig.TargetLocationName,
+			TTL:                     ttlDefaultValue,
+			VolumeSnapshotLocations: []string{},
+		},
+	}
+
+	postRequest := &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRequest{
+		Schedule: &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSchedule{
+			FullName: fullName,
+			Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{},
+			Spec:     spec,
+		},
+	}
+
+	response := &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleResponse{
+		Schedule: &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSchedule{
+			FullName: fullName,
+			Meta:     mockMeta("backup-schedule-uid"),
+			Spec:     spec,
+			Status: &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatus{
+				Phase: backupschedulemodel.NewVmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhase(backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleStatusPhaseENABLED),
+			},
+		},
+	}
+
+	postEndpoint := helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ClusterName, "dataprotection/schedules").String()
+	getEndpoint := helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ClusterName, "dataprotection/schedules", testConfig.BackupScheduleName).String()
+
+	httpmock.RegisterResponder("POST", postEndpoint,
+		bodyInspectingResponder(t, postRequest, http.StatusOK, response))
+
+	httpmock.RegisterResponder("GET", getEndpoint,
+		bodyInspectingResponder(t, nil, http.StatusOK, response))
+
+	httpmock.RegisterResponder("DELETE", getEndpoint, changeStateResponder(
+		// Set up the get to return 404 after the backup schedule has been 'deleted'.
+		func() {
+			httpmock.RegisterResponder("GET", getEndpoint,
+				httpmock.NewStringResponder(http.StatusNotFound, "Not found"))
+		},
+		http.StatusOK,
+		nil))
+}
+
+func (testConfig *testAcceptanceConfig) setupRestoreHTTPMocks(t *testing.T, endpoint string) {
+	fullName := &restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName{
+		ClusterName:           testConfig.ClusterName,
+		ManagementClusterName: attachedValue,
+		Name:                  testConfig.RestoreName,
+		ProvisionerName:       attachedValue,
+	}
+
+	spec := &restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreSpec{
+		BackupName:              testConfig.BackupName,
+		ExcludedNamespaces:      []string{},
+		ExcludedResources:       []string{},
+		IncludeClusterResources: helper.BoolPointer(true),
+		IncludedNamespaces:      []string{"default"},
+		IncludedResources:       []string{},
+		NamespaceMapping:        map[string]string{"default": "restored"},
+		RestorePVs:              helper.BoolPointer(true),
+	}
+
+	postRequest := &restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRequest{
+		Restore: &restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRestore{
+			FullName: fullName,
+			Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{},
+			Spec:     spec,
+		},
+	}
+
+	response := &restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreResponse{
+		Restore: &restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRestore{
+			FullName: fullName,
+			Meta:     mockMeta("restore-uid"),
+			Spec:     spec,
+			Status: &restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatus{
+				Phase: restoremodel.NewVmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhase(restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseCOMPLETED),
+			},
+		},
+	}
+
+	postEndpoint := helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ClusterName, "dataprotection/restores").String()
+	getEndpoint := helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ClusterName, "dataprotection/restores", testConfig.RestoreName).String()
+
+	httpmock.RegisterResponder("POST", postEndpoint,
+		bodyInspectingResponder(t, postRequest, http.StatusOK, response))
+
+	httpmock.RegisterResponder("GET", getEndpoint,
+		bodyInspectingResponder(t, nil, http.StatusOK, response))
+
+	httpmock.RegisterResponder("DELETE", getEndpoint, changeStateResponder(
+		// Set up the get to return 404 after the restore has been 'deleted'.
+		func() {
+			httpmock.RegisterResponder("GET", getEndpoint,
+				httpmock.NewStringResponder(http.StatusNotFound, "Not found"))
+		},
+		http.StatusOK,
+		nil))
+}
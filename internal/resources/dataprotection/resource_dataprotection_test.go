@@ -0,0 +1,219 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	testhelper "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/testing"
+)
+
+const (
+	dataProtectionEnvTestFlag = "ENABLE_DATAPROTECTION_ENV_TEST"
+	dataProtectionResourceVar = "test_data_protection"
+	targetLocationResourceVar = "test_target_location"
+	backupScheduleResourceVar = "test_backup_schedule"
+	restoreResourceVar        = "test_restore"
+	dataProtectionNamePrefix  = "tf-dp-test"
+)
+
+type testAcceptanceConfig struct {
+	Provider                   *schema.Provider
+	ClusterName                string
+	CredentialName             string
+	Bucket                     string
+	Region                     string
+	TargetLocationName         string
+	BackupScheduleName         string
+	BackupName                 string
+	RestoreName                string
+	DataProtectionResourceName string
+	TargetLocationResourceName string
+	BackupScheduleResourceName string
+	RestoreResourceName        string
+}
+
+func testGetDefaultAcceptanceConfig(t *testing.T) *testAcceptanceConfig {
+	backupScheduleName := acctest.RandomWithPrefix(dataProtectionNamePrefix)
+
+	return &testAcceptanceConfig{
+		Provider:                   initTestProvider(t),
+		ClusterName:                getEnvOrDefault("DATAPROTECTION_CLUSTER_NAME", acctest.RandomWithPrefix(dataProtectionNamePrefix)),
+		CredentialName:             getEnvOrDefault("DATAPROTECTION_CREDENTIAL_NAME", acctest.RandomWithPrefix(dataProtectionNamePrefix)),
+		Bucket:                     getEnvOrDefault("DATAPROTECTION_BUCKET", "tf-dp-test-bucket"),
+		Region:                     getEnvOrDefault("DATAPROTECTION_REGION", "us-west-2"),
+		TargetLocationName:         acctest.RandomWithPrefix(dataProtectionNamePrefix),
+		BackupScheduleName:         backupScheduleName,
+		BackupName:                 getEnvOrDefault("DATAPROTECTION_BACKUP_NAME", fmt.Sprintf("%s-20230101000000", backupScheduleName)),
+		RestoreName:                acctest.RandomWithPrefix(dataProtectionNamePrefix),
+		DataProtectionResourceName: fmt.Sprintf("%s.%s", EnableDataProtectionResourceName, dataProtectionResourceVar),
+		TargetLocationResourceName: fmt.Sprintf("%s.%s", TargetLocationResourceName, targetLocationResourceVar),
+		BackupScheduleResourceName: fmt.Sprintf("%s.%s", BackupScheduleResourceName, backupScheduleResourceVar),
+		RestoreResourceName:        fmt.Sprintf("%s.%s", RestoreResourceName, restoreResourceVar),
+	}
+}
+
+func getEnvOrDefault(name string, defaultValue string) string {
+	if value, found := os.LookupEnv(name); found {
+		return value
+	}
+
+	return defaultValue
+}
+
+func getConfigureContextFunc() func(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	if _, found := os.LookupEnv(dataProtectionEnvTestFlag); !found {
+		return authctx.ProviderConfigureContextWithDefaultTransportForTesting
+	}
+
+	return authctx.ProviderConfigureContext
+}
+
+func TestAcceptanceForDataProtectionResources(t *testing.T) {
+	testConfig := testGetDefaultAcceptanceConfig(t)
+
+	// If the flag to execute data protection tests is not found, run this as a mock test by setting up an http intercept for each endpoint.
+	_, found := os.LookupEnv(dataProtectionEnvTestFlag)
+	if !found {
+		os.Setenv("TF_ACC", "true")
+		os.Setenv("TMC_ENDPOINT", "dummy.tmc.mock.vmware.com")
+		os.Setenv("VMW_CLOUD_API_TOKEN", "dummy")
+		os.Setenv("VMW_CLOUD_ENDPOINT", "console.cloud.vmware.com")
+
+		log.Println("Setting up the mock endpoints...")
+		testConfig.setupHTTPMocks(t)
+	} else {
+		// Environment variables with non default values required for a successful call to the data protection services.
+		requiredVars := []string{
+			"VMW_CLOUD_ENDPOINT",
+			"TMC_ENDPOINT",
+			"VMW_CLOUD_API_TOKEN",
+			"DATAPROTECTION_CLUSTER_NAME",
+			"DATAPROTECTION_CREDENTIAL_NAME",
+			"DATAPROTECTION_BUCKET",
+			"DATAPROTECTION_BACKUP_NAME",
+		}
+
+		// Check if the required environment variables are set.
+		for _, name := range requiredVars {
+			if _, found := os.LookupEnv(name); !found {
+				t.Errorf("required environment variable '%s' missing", name)
+			}
+		}
+	}
+
+	t.Log("start data protection resources acceptance tests!")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testhelper.TestPreCheck(t),
+		ProviderFactories: testhelper.GetTestProviderFactories(testConfig.Provider),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig.getTestDataProtectionResourcesConfigValue(),
+				Check:  testConfig.checkDataProtectionResourcesAttributes(),
+			},
+		},
+	},
+	)
+
+	t.Log("data protection resources acceptance test completed")
+}
+
+func (testConfig *testAcceptanceConfig) getTestDataProtectionResourcesConfigValue() string {
+	return fmt.Sprintf(`
+	resource "%s" "%s" {
+	  name = "%s"
+
+	  spec {
+	    target_provider = "AWS"
+	    credential_name = "%s"
+	    bucket          = "%s"
+	    region          = "%s"
+
+	    assigned_groups {
+	      cluster {
+	        name = "%s"
+	      }
+	    }
+	  }
+	}
+
+	resource "%s" "%s" {
+	  cluster_name = "%s"
+
+	  spec {
+	    enable_csi_snapshots = true
+	  }
+	}
+
+	resource "%s" "%s" {
+	  name         = "%s"
+	  cluster_name = "%s"
+
+	  spec {
+	    schedule {
+	      rate = "0 2 * * *"
+	    }
+
+	    template {
+	      storage_location    = %s.name
+	      included_namespaces = ["default"]
+	    }
+	  }
+
+	  depends_on = [%s]
+	}
+
+	resource "%s" "%s" {
+	  name         = "%s"
+	  cluster_name = "%s"
+
+	  spec {
+	    backup_name         = "%s"
+	    included_namespaces = ["default"]
+
+	    namespace_mapping = {
+	      default = "restored"
+	    }
+	  }
+
+	  depends_on = [%s]
+	}
+	`,
+		TargetLocationResourceName, targetLocationResourceVar, testConfig.TargetLocationName, testConfig.CredentialName, testConfig.Bucket, testConfig.Region, testConfig.ClusterName,
+		EnableDataProtectionResourceName, dataProtectionResourceVar, testConfig.ClusterName,
+		BackupScheduleResourceName, backupScheduleResourceVar, testConfig.BackupScheduleName, testConfig.ClusterName,
+		testConfig.TargetLocationResourceName, testConfig.DataProtectionResourceName,
+		RestoreResourceName, restoreResourceVar, testConfig.RestoreName, testConfig.ClusterName, testConfig.BackupName, testConfig.BackupScheduleResourceName,
+	)
+}
+
+func (testConfig *testAcceptanceConfig) checkDataProtectionResourcesAttributes() resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr(testConfig.TargetLocationResourceName, NameKey, testConfig.TargetLocationName),
+		resource.TestCheckResourceAttr(testConfig.TargetLocationResourceName, "status.phase", "READY"),
+		resource.TestCheckResourceAttr(testConfig.DataProtectionResourceName, ClusterNameKey, testConfig.ClusterName),
+		resource.TestCheckResourceAttr(testConfig.DataProtectionResourceName, "spec.0.enable_csi_snapshots", "true"),
+		resource.TestCheckResourceAttr(testConfig.DataProtectionResourceName, "status.phase", "READY"),
+		resource.TestCheckResourceAttr(testConfig.BackupScheduleResourceName, NameKey, testConfig.BackupScheduleName),
+		resource.TestCheckResourceAttr(testConfig.BackupScheduleResourceName, "spec.0.template.0.storage_location", testConfig.TargetLocationName),
+		resource.TestCheckResourceAttr(testConfig.BackupScheduleResourceName, "status.phase", "ENABLED"),
+		resource.TestCheckResourceAttr(testConfig.RestoreResourceName, NameKey, testConfig.RestoreName),
+		resource.TestCheckResourceAttr(testConfig.RestoreResourceName, "spec.0.namespace_mapping.default", "restored"),
+		resource.TestCheckResourceAttr(testConfig.RestoreResourceName, "status.phase", "COMPLETED"),
+	)
+}
@@ -0,0 +1,341 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	dataprotectionmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/dataprotection/cluster/dataprotection"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+const dataProtectionKind = "data protection"
+
+func ResourceEnableDataProtection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEnableDataProtectionCreate,
+		ReadContext:   resourceEnableDataProtectionRead,
+		UpdateContext: resourceEnableDataProtectionInPlaceUpdate,
+		DeleteContext: resourceEnableDataProtectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEnableDataProtectionImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: enableDataProtectionSchema,
+	}
+}
+
+var enableDataProtectionSchema = map[string]*schema.Schema{
+	ClusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the cluster to enable data protection on.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	ManagementClusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the management cluster.",
+		Default:     attachedValue,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	ProvisionerNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the provisioner.",
+		Default:     attachedValue,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	deleteBackupsKey: {
+		Type:        schema.TypeBool,
+		Description: "Delete the backups of the cluster when data protection is disabled.",
+		Default:     false,
+		Optional:    true,
+	},
+	common.MetaKey: common.Meta,
+	specKey: {
+		Type:        schema.TypeList,
+		Description: "Spec for the data protection of the cluster.",
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				enableCSISnapshotsKey: {
+					Type:        schema.TypeBool,
+					Description: "Back up persistent volumes through CSI volume snapshots.",
+					Default:     false,
+					Optional:    true,
+				},
+				disableResticKey: {
+					Type:        schema.TypeBool,
+					Description: "Do not install restic, which backs up persistent volumes through a file system copy.",
+					Default:     false,
+					Optional:    true,
+				},
+				enableAllAPIGroupVersionsBackupKey: {
+					Type:        schema.TypeBool,
+					Description: "Back up all the versions of the API groups of the cluster, not only the preferred one.",
+					Default:     false,
+					Optional:    true,
+				},
+			},
+		},
+	},
+	statusKey: {
+		Type:        schema.TypeMap,
+		Description: "Status of the data protection: phase and phase_info.",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+}
+
+func constructDataProtectionFullname(d *schema.ResourceData) (fullname *dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionFullName) {
+	fullname = &dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionFullName{}
+
+	fullname.ClusterName, _ = d.Get(ClusterNameKey).(string)
+
+	fullname.ManagementClusterName, _ = d.Get(ManagementClusterNameKey).(string)
+
+	fullname.ProvisionerName, _ = d.Get(ProvisionerNameKey).(string)
+
+	return fullname
+}
+
+func constructDataProtectionSpec(d *schema.ResourceData) (spec *dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionSpec) {
+	spec = &dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionSpec{}
+
+	specData := firstBlock(d.Get(specKey))
+
+	spec.EnableCsiSnapshots, _ = specData[enableCSISnapshotsKey].(bool)
+
+	spec.DisableRestic, _ = specData[disableResticKey].(bool)
+
+	spec.EnableAllAPIGroupVersionsBackup, _ = specData[enableAllAPIGroupVersionsBackupKey].(bool)
+
+	return spec
+}
+
+func flattenDataProtectionSpec(spec *dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionSpec) (data []interface{}) {
+	if spec == nil {
+		return data
+	}
+
+	flattenSpecData := make(map[string]interface{})
+
+	flattenSpecData[enableCSISnapshotsKey] = spec.EnableCsiSnapshots
+	flattenSpecData[disableResticKey] = spec.DisableRestic
+	flattenSpecData[enableAllAPIGroupVersionsBackupKey] = spec.EnableAllAPIGroupVersionsBackup
+
+	return []interface{}{flattenSpecData}
+}
+
+// getDataProtection gets the data protection of a cluster, which Tanzu Mission Control only returns through a list.
+func getDataProtection(ctx context.Context, config authctx.TanzuContext, fn *dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionFullName) (*dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionDataProtection, error) {
+	resp, err := config.TMCConnection.DataProtectionResourceService.ManageV1alpha1ClusterDataProtectionResourceServiceListWithContext(ctx, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil || len(resp.DataProtections) == 0 {
+		return nil, nil
+	}
+
+	return resp.DataProtections[0], nil
+}
+
+func dataProtectionPhaseGetter(config authctx.TanzuContext, fn *dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionFullName) phaseGetter {
+	return func(ctx context.Context) (string, string, bool, error) {
+		dataProtection, err := getDataProtection(ctx, config, fn)
+		if err != nil || dataProtection == nil {
+			return "", "", false, err
+		}
+
+		if dataProtection.Status == nil {
+			return "", "", true, nil
+		}
+
+		return helper.PtrString(dataProtection.Status.Phase), dataProtection.Status.PhaseInfo, true, nil
+	}
+}
+
+func waitForDataProtectionReady(ctx context.Context, config authctx.TanzuContext, fn *dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionFullName, timeout time.Duration) diag.Diagnostics {
+	_, diags := waitForPhase(ctx, dataProtectionKind, fn.ClusterName, dataProtectionPhaseGetter(config, fn),
+		[]string{string(dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhaseREADY)},
+		[]string{string(dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionStatusPhaseERROR)},
+		timeout)
+
+	return diags
+}
+
+func resourceEnableDataProtectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructDataProtectionFullname(d)
+
+	dataProtection, err := getDataProtection(ctx, config, fn)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control data protection entry, cluster name : %s", fn.ClusterName))
+	}
+
+	if dataProtection == nil {
+		log.Printf("[INFO] data protection is not enabled on cluster %s", fn.ClusterName)
+		d.SetId("")
+
+		return diags
+	}
+
+	if dataProtection.Meta != nil {
+		d.SetId(dataProtection.Meta.UID)
+	}
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(dataProtection.Meta)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(specKey, flattenDataProtectionSpec(dataProtection.Spec)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	status := map[string]interface{}{}
+
+	if dataProtection.Status != nil {
+		status[phaseKey] = helper.PtrString(dataProtection.Status.Phase)
+		status[phaseInfoKey] = dataProtection.Status.PhaseInfo
+	}
+
+	if err := d.Set(statusKey, status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceEnableDataProtectionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructDataProtectionFullname(d)
+
+	request := &dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionRequest{
+		DataProtection: &dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionDataProtection{
+			FullName: fn,
+			Meta:     common.ConstructMeta(d),
+			Spec:     constructDataProtectionSpec(d),
+		},
+	}
+
+	response, err := config.TMCConnection.DataProtectionResourceService.ManageV1alpha1ClusterDataProtectionResourceServiceCreate(request)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to enable Tanzu Mission Control data protection, cluster name : %s", fn.ClusterName))
+	}
+
+	d.SetId(response.DataProtection.Meta.UID)
+
+	diags = append(diags, waitForDataProtectionReady(ctx, config, fn, d.Timeout(schema.TimeoutCreate))...)
+
+	return append(diags, resourceEnableDataProtectionRead(ctx, d, m)...)
+}
+
+func resourceEnableDataProtectionInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	if !d.HasChange(specKey) && !common.HasMetaChanged(d) {
+		return resourceEnableDataProtectionRead(ctx, d, m)
+	}
+
+	config := m.(authctx.TanzuContext)
+	fn := constructDataProtectionFullname(d)
+
+	dataProtection, err := getDataProtection(ctx, config, fn)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control data protection entry, cluster name : %s", fn.ClusterName))
+	}
+
+	if dataProtection == nil {
+		return diag.Errorf("data protection is not enabled on Tanzu Mission Control cluster %s", fn.ClusterName)
+	}
+
+	if common.HasMetaChanged(d) {
+		meta := common.ConstructMeta(d)
+
+		if value, ok := dataProtection.Meta.Labels[common.CreatorLabelKey]; ok {
+			meta.Labels[common.CreatorLabelKey] = value
+		}
+
+		dataProtection.Meta.Labels = meta.Labels
+		dataProtection.Meta.Description = meta.Description
+	}
+
+	dataProtection.Spec = constructDataProtectionSpec(d)
+
+	_, err = config.TMCConnection.DataProtectionResourceService.ManageV1alpha1ClusterDataProtectionResourceServiceUpdate(
+		&dataprotectionmodel.VmwareTanzuManageV1alpha1ClusterDataprotectionCreateDataProtectionRequest{
+			DataProtection: dataProtection,
+		},
+	)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control data protection entry, cluster name : %s", fn.ClusterName))
+	}
+
+	diags = append(diags, waitForDataProtectionReady(ctx, config, fn, d.Timeout(schema.TimeoutUpdate))...)
+
+	return append(diags, resourceEnableDataProtectionRead(ctx, d, m)...)
+}
+
+func resourceEnableDataProtectionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructDataProtectionFullname(d)
+	deleteBackups, _ := d.Get(deleteBackupsKey).(bool)
+
+	err := config.TMCConnection.DataProtectionResourceService.ManageV1alpha1ClusterDataProtectionResourceServiceDelete(fn, deleteBackups)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to disable Tanzu Mission Control data protection, cluster name : %s", fn.ClusterName))
+	}
+
+	if diags = waitForDeletion(ctx, dataProtectionKind, fn.ClusterName, dataProtectionPhaseGetter(config, fn), d.Timeout(schema.TimeoutDelete)); diags.HasError() {
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// resourceEnableDataProtectionImporter imports the data protection of a cluster using an ID of the form <management_cluster_name>/<provisioner_name>/<cluster_name>.
+func resourceEnableDataProtectionImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts, err := helper.ParseImportID(d.Id(), ManagementClusterNameKey, ProvisionerNameKey, ClusterNameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, key := range []string{ManagementClusterNameKey, ProvisionerNameKey, ClusterNameKey} {
+		if err = d.Set(key, parts[i]); err != nil {
+			return nil, errors.Wrapf(err, "Failed to set %s for the data protection of cluster %s", key, parts[2])
+		}
+	}
+
+	if err = d.Set(deleteBackupsKey, false); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set %s for the data protection of cluster %s", deleteBackupsKey, parts[2])
+	}
+
+	return helper.ReadImportedState(ctx, d, m, resourceEnableDataProtectionRead)
+}
@@ -0,0 +1,344 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	restoremodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/dataprotection/cluster/restore"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+const restoreKind = "restore"
+
+// ResourceRestore restores a backup on a cluster. A restore cannot be updated, changing any of its arguments creates a new one,
+// and deleting it keeps the restored resources on the cluster.
+func ResourceRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRestoreCreate,
+		ReadContext:   resourceRestoreRead,
+		DeleteContext: resourceRestoreDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRestoreImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: restoreSchema,
+	}
+}
+
+var restoreSchema = map[string]*schema.Schema{
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the restore.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	ClusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the cluster the backup is restored on.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	ManagementClusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the management cluster.",
+		Default:     attachedValue,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	ProvisionerNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the provisioner.",
+		Default:     attachedValue,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	common.MetaKey: forceNewSchema(common.Meta),
+	specKey: {
+		Type:        schema.TypeList,
+		Description: "Spec for the restore.",
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				backupNameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the backup to restore.",
+					Required:    true,
+					ForceNew:    true,
+				},
+				includedNamespacesKey: {
+					Type:        schema.TypeList,
+					Description: "Namespaces included in the restore, all the namespaces of the backup when empty.",
+					Optional:    true,
+					ForceNew:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				excludedNamespacesKey: {
+					Type:        schema.TypeList,
+					Description: "Namespaces excluded from the restore.",
+					Optional:    true,
+					ForceNew:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				includedResourcesKey: {
+					Type:        schema.TypeList,
+					Description: "Resources included in the restore, all the resources of the backup when empty.",
+					Optional:    true,
+					ForceNew:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				excludedResourcesKey: {
+					Type:        schema.TypeList,
+					Description: "Resources excluded from the restore.",
+					Optional:    true,
+					ForceNew:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				includeClusterResourcesKey: {
+					Type:        schema.TypeBool,
+					Description: "Restore the cluster scoped resources of the backup.",
+					Default:     true,
+					Optional:    true,
+					ForceNew:    true,
+				},
+				restorePVsKey: {
+					Type:        schema.TypeBool,
+					Description: "Restore the persistent volumes from their snapshots.",
+					Default:     true,
+					Optional:    true,
+					ForceNew:    true,
+				},
+				namespaceMappingKey: {
+					Type:        schema.TypeMap,
+					Description: "Map of the backed up namespaces to the namespaces they are restored to.",
+					Optional:    true,
+					ForceNew:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	},
+	statusKey: {
+		Type:        schema.TypeMap,
+		Description: "Status of the restore: phase, phase_info, warnings and errors.",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+}
+
+func constructRestoreFullname(d *schema.ResourceData) (fullname *restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName) {
+	fullname = &restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName{}
+
+	fullname.ClusterName, _ = d.Get(ClusterNameKey).(string)
+
+	fullname.ManagementClusterName, _ = d.Get(ManagementClusterNameKey).(string)
+
+	fullname.Name, _ = d.Get(NameKey).(string)
+
+	fullname.ProvisionerName, _ = d.Get(ProvisionerNameKey).(string)
+
+	return fullname
+}
+
+func expandRestoreSpec(data interface{}) (spec *restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreSpec) {
+	specData := firstBlock(data)
+
+	spec = &restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreSpec{
+		IncludedNamespaces: expandStringList(specData[includedNamespacesKey]),
+		ExcludedNamespaces: expandStringList(specData[excludedNamespacesKey]),
+		IncludedResources:  expandStringList(specData[includedResourcesKey]),
+		ExcludedResources:  expandStringList(specData[excludedResourcesKey]),
+	}
+
+	spec.BackupName, _ = specData[backupNameKey].(string)
+
+	if v, ok := specData[includeClusterResourcesKey].(bool); ok {
+		spec.IncludeClusterResources = helper.BoolPointer(v)
+	}
+
+	if v, ok := specData[restorePVsKey].(bool); ok {
+		spec.RestorePVs = helper.BoolPointer(v)
+	}
+
+	if mapping := helper.GetStringMap(specData[namespaceMappingKey]); len(mapping) != 0 {
+		spec.NamespaceMapping = mapping
+	}
+
+	return spec
+}
+
+func flattenRestoreSpec(spec *restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreSpec) (data []interface{}) {
+	if spec == nil {
+		return data
+	}
+
+	flattenSpecData := make(map[string]interface{})
+
+	flattenSpecData[backupNameKey] = spec.BackupName
+	flattenSpecData[includedNamespacesKey] = spec.IncludedNamespaces
+	flattenSpecData[excludedNamespacesKey] = spec.ExcludedNamespaces
+	flattenSpecData[includedResourcesKey] = spec.IncludedResources
+	flattenSpecData[excludedResourcesKey] = spec.ExcludedResources
+	flattenSpecData[includeClusterResourcesKey] = boolValue(spec.IncludeClusterResources, true)
+	flattenSpecData[restorePVsKey] = boolValue(spec.RestorePVs, true)
+	flattenSpecData[namespaceMappingKey] = spec.NamespaceMapping
+
+	return []interface{}{flattenSpecData}
+}
+
+func restorePhaseGetter(config authctx.TanzuContext, fn *restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName) phaseGetter {
+	return func(ctx context.Context) (string, string, bool, error) {
+		resp, err := config.TMCConnection.RestoreResourceService.ManageV1alpha1ClusterDataProtectionRestoreResourceServiceGetWithContext(ctx, fn)
+		if err != nil {
+			if clienterrors.IsNotFoundError(err) {
+				return "", "", false, nil
+			}
+
+			return "", "", false, err
+		}
+
+		if resp.Restore == nil || resp.Restore.Status == nil {
+			return "", "", true, nil
+		}
+
+		return helper.PtrString(resp.Restore.Status.Phase), resp.Restore.Status.PhaseInfo, true, nil
+	}
+}
+
+// waitForRestoreCompleted waits for the restore to complete, a restore completed with errors is reported as a warning
+// as the resources which could be restored are kept on the cluster.
+func waitForRestoreCompleted(ctx context.Context, config authctx.TanzuContext, fn *restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreFullName, timeout time.Duration) diag.Diagnostics {
+	phase, diags := waitForPhase(ctx, restoreKind, fn.Name, restorePhaseGetter(config, fn),
+		[]string{
+			string(restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseCOMPLETED),
+			string(restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhasePARTIALLYFAILED),
+		},
+		[]string{
+			string(restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseFAILED),
+			string(restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhaseFAILEDVALIDATION),
+		},
+		timeout)
+
+	if phase == string(restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreStatusPhasePARTIALLYFAILED) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Tanzu Mission Control restore %s partially failed", fn.Name),
+			Detail:   "Some of the resources of the backup could not be restored, check the logs of the restore for the errors.",
+		})
+	}
+
+	return diags
+}
+
+func resourceRestoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructRestoreFullname(d)
+
+	resp, err := config.TMCConnection.RestoreResourceService.ManageV1alpha1ClusterDataProtectionRestoreResourceServiceGet(fn)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control restore entry, name : %s", fn.Name))
+	}
+
+	restore := resp.Restore
+
+	d.SetId(restore.Meta.UID)
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(restore.Meta)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(specKey, flattenRestoreSpec(restore.Spec)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	status := map[string]interface{}{}
+
+	if restore.Status != nil {
+		status[phaseKey] = helper.PtrString(restore.Status.Phase)
+		status[phaseInfoKey] = restore.Status.PhaseInfo
+		status[warningsKey] = strconv.Itoa(int(restore.Status.Warnings))
+		status[errorsKey] = strconv.Itoa(int(restore.Status.Errors))
+	}
+
+	if err := d.Set(statusKey, status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceRestoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructRestoreFullname(d)
+
+	request := &restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRequest{
+		Restore: &restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreRestore{
+			FullName: fn,
+			Meta:     common.ConstructMeta(d),
+			Spec:     expandRestoreSpec(d.Get(specKey)),
+		},
+	}
+
+	response, err := config.TMCConnection.RestoreResourceService.ManageV1alpha1ClusterDataProtectionRestoreResourceServiceCreate(request)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control restore entry, name : %s", fn.Name))
+	}
+
+	d.SetId(response.Restore.Meta.UID)
+
+	diags = append(diags, waitForRestoreCompleted(ctx, config, fn, d.Timeout(schema.TimeoutCreate))...)
+
+	return append(diags, resourceRestoreRead(ctx, d, m)...)
+}
+
+func resourceRestoreDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructRestoreFullname(d)
+
+	err := config.TMCConnection.RestoreResourceService.ManageV1alpha1ClusterDataProtectionRestoreResourceServiceDelete(fn)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control restore entry, name : %s", fn.Name))
+	}
+
+	if diags = waitForDeletion(ctx, restoreKind, fn.Name, restorePhaseGetter(config, fn), d.Timeout(schema.TimeoutDelete)); diags.HasError() {
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// resourceRestoreImporter imports a restore using an ID of the form <management_cluster_name>/<provisioner_name>/<cluster_name>/<name>.
+func resourceRestoreImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if err := setClusterScopedImportID(d); err != nil {
+		return nil, err
+	}
+
+	return helper.ReadImportedState(ctx, d, m, resourceRestoreRead)
+}
@@ -0,0 +1,524 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package dataprotection
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	targetlocationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/dataprotection/targetlocation"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+const targetLocationKind = "target location"
+
+func ResourceTargetLocation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTargetLocationCreate,
+		ReadContext:   resourceTargetLocationRead,
+		UpdateContext: resourceTargetLocationInPlaceUpdate,
+		DeleteContext: resourceTargetLocationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTargetLocationImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: targetLocationSchema,
+	}
+}
+
+var targetLocationSchema = map[string]*schema.Schema{
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the target location.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	ProviderNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the provider of the target location.",
+		Default:     providerNameDefaultValue,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	common.MetaKey: common.Meta,
+	specKey: {
+		Type:        schema.TypeList,
+		Description: "Spec for the target location.",
+		Required:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				targetProviderKey: {
+					Type:        schema.TypeString,
+					Description: "Provider of the storage of the backups: AWS for an S3 compatible storage or AZURE for an Azure blob storage.",
+					Required:    true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProviderAWS),
+						string(targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProviderAZURE),
+					}, false),
+				},
+				credentialNameKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the DATA_PROTECTION capability credential giving access to the storage.",
+					Required:    true,
+				},
+				bucketKey: {
+					Type:        schema.TypeString,
+					Description: "Name of the bucket, or of the blob container for Azure, the backups are stored in.",
+					Optional:    true,
+				},
+				regionKey: {
+					Type:        schema.TypeString,
+					Description: "Region of the bucket.",
+					Optional:    true,
+				},
+				caCertKey: {
+					Type:        schema.TypeString,
+					Description: "PEM encoded certificate authority of a self-hosted S3 compatible storage.",
+					Optional:    true,
+				},
+				assignedGroupsKey: {
+					Type:        schema.TypeList,
+					Description: "Clusters and cluster groups allowed to back up to the target location.",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							clusterKey: {
+								Type:        schema.TypeList,
+								Description: "Clusters allowed to back up to the target location.",
+								Optional:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										NameKey: {
+											Type:        schema.TypeString,
+											Description: "Name of the cluster.",
+											Required:    true,
+										},
+										ManagementClusterNameKey: {
+											Type:        schema.TypeString,
+											Description: "Name of the management cluster.",
+											Default:     attachedValue,
+											Optional:    true,
+										},
+										ProvisionerNameKey: {
+											Type:        schema.TypeString,
+											Description: "Name of the provisioner.",
+											Default:     attachedValue,
+											Optional:    true,
+										},
+									},
+								},
+							},
+							clusterGroupsKey: {
+								Type:        schema.TypeList,
+								Description: "Names of the cluster groups allowed to back up to the target location.",
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+				configKey: {
+					Type:        schema.TypeList,
+					Description: "Configuration of the storage.",
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							awsKey: {
+								Type:        schema.TypeList,
+								Description: "Configuration of an S3 compatible storage.",
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										urlKey: {
+											Type:        schema.TypeString,
+											Description: "URL of a self-hosted S3 compatible storage.",
+											Optional:    true,
+										},
+										publicURLKey: {
+											Type:        schema.TypeString,
+											Description: "Public URL of the storage, used to download the backup logs when it differs from url.",
+											Optional:    true,
+										},
+										s3ForcePathStyleKey: {
+											Type:        schema.TypeBool,
+											Description: "Use path style addressing of the buckets, required by most self-hosted S3 compatible storages.",
+											Default:     false,
+											Optional:    true,
+										},
+									},
+								},
+							},
+							azureKey: {
+								Type:        schema.TypeList,
+								Description: "Configuration of an Azure blob storage.",
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										resourceGroupKey: {
+											Type:        schema.TypeString,
+											Description: "Resource group of the storage account.",
+											Required:    true,
+										},
+										storageAccountKey: {
+											Type:        schema.TypeString,
+											Description: "Name of the storage account.",
+											Required:    true,
+										},
+										subscriptionIDKey: {
+											Type:        schema.TypeString,
+											Description: "ID of the subscription of the storage account.",
+											Optional:    true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+	statusKey: {
+		Type:        schema.TypeMap,
+		Description: "Status of the target location: phase and phase_info.",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+}
+
+func constructTargetLocationFullname(d *schema.ResourceData) (fullname *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationFullName) {
+	fullname = &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationFullName{}
+
+	fullname.Name, _ = d.Get(NameKey).(string)
+
+	fullname.ProviderName, _ = d.Get(ProviderNameKey).(string)
+
+	return fullname
+}
+
+func expandTargetLocationSpec(data interface{}) (spec *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationSpec) {
+	specData := firstBlock(data)
+
+	spec = &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationSpec{
+		AssignedGroups: make([]*targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAssignedGroup, 0),
+	}
+
+	if v, ok := specData[targetProviderKey].(string); ok && v != "" {
+		spec.TargetProvider = targetlocationmodel.NewVmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProvider(
+			targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProvider(v))
+	}
+
+	if v, ok := specData[credentialNameKey].(string); ok && v != "" {
+		spec.Credential = &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationCredential{Name: v}
+	}
+
+	spec.Bucket, _ = specData[bucketKey].(string)
+
+	spec.Region, _ = specData[regionKey].(string)
+
+	spec.CaCert, _ = specData[caCertKey].(string)
+
+	assignedGroups := firstBlock(specData[assignedGroupsKey])
+
+	if clusters, ok := assignedGroups[clusterKey].([]interface{}); ok {
+		for _, c := range clusters {
+			cluster, _ := c.(map[string]interface{})
+			fullName := &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterFullName{}
+
+			fullName.Name, _ = cluster[NameKey].(string)
+			fullName.ManagementClusterName, _ = cluster[ManagementClusterNameKey].(string)
+			fullName.ProvisionerName, _ = cluster[ProvisionerNameKey].(string)
+
+			spec.AssignedGroups = append(spec.AssignedGroups, &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAssignedGroup{
+				Cluster: fullName,
+			})
+		}
+	}
+
+	for _, name := range expandStringList(assignedGroups[clusterGroupsKey]) {
+		spec.AssignedGroups = append(spec.AssignedGroups, &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAssignedGroup{
+			Clustergroup: &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterGroupFullName{Name: name},
+		})
+	}
+
+	config := firstBlock(specData[configKey])
+	aws := firstBlock(config[awsKey])
+	azure := firstBlock(config[azureKey])
+
+	if len(aws) != 0 || len(azure) != 0 {
+		spec.Config = &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationConfig{}
+	}
+
+	if len(aws) != 0 {
+		spec.Config.S3Config = &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationS3Config{}
+
+		spec.Config.S3Config.URL, _ = aws[urlKey].(string)
+		spec.Config.S3Config.PublicURL, _ = aws[publicURLKey].(string)
+		spec.Config.S3Config.S3ForcePathStyle, _ = aws[s3ForcePathStyleKey].(bool)
+	}
+
+	if len(azure) != 0 {
+		spec.Config.AzureConfig = &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAzureConfig{}
+
+		spec.Config.AzureConfig.ResourceGroup, _ = azure[resourceGroupKey].(string)
+		spec.Config.AzureConfig.StorageAccount, _ = azure[storageAccountKey].(string)
+		spec.Config.AzureConfig.SubscriptionID, _ = azure[subscriptionIDKey].(string)
+	}
+
+	return spec
+}
+
+func flattenTargetLocationSpec(spec *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationSpec) (data []interface{}) {
+	if spec == nil {
+		return data
+	}
+
+	flattenSpecData := make(map[string]interface{})
+
+	flattenSpecData[targetProviderKey] = helper.PtrString(spec.TargetProvider)
+	flattenSpecData[bucketKey] = spec.Bucket
+	flattenSpecData[regionKey] = spec.Region
+	flattenSpecData[caCertKey] = spec.CaCert
+
+	if spec.Credential != nil {
+		flattenSpecData[credentialNameKey] = spec.Credential.Name
+	}
+
+	clusters := make([]interface{}, 0)
+	clusterGroups := make([]interface{}, 0)
+
+	for _, group := range spec.AssignedGroups {
+		switch {
+		case group == nil:
+		case group.Cluster != nil:
+			clusters = append(clusters, map[string]interface{}{
+				NameKey:                  group.Cluster.Name,
+				ManagementClusterNameKey: group.Cluster.ManagementClusterName,
+				ProvisionerNameKey:       group.Cluster.ProvisionerName,
+			})
+		case group.Clustergroup != nil:
+			clusterGroups = append(clusterGroups, group.Clustergroup.Name)
+		}
+	}
+
+	if len(clusters) != 0 || len(clusterGroups) != 0 {
+		flattenSpecData[assignedGroupsKey] = []interface{}{map[string]interface{}{
+			clusterKey:       clusters,
+			clusterGroupsKey: clusterGroups,
+		}}
+	}
+
+	if spec.Config != nil {
+		config := make(map[string]interface{})
+
+		if spec.Config.S3Config != nil {
+			config[awsKey] = []interface{}{map[string]interface{}{
+				urlKey:              spec.Config.S3Config.URL,
+				publicURLKey:        spec.Config.S3Config.PublicURL,
+				s3ForcePathStyleKey: spec.Config.S3Config.S3ForcePathStyle,
+			}}
+		}
+
+		if spec.Config.AzureConfig != nil {
+			config[azureKey] = []interface{}{map[string]interface{}{
+				resourceGroupKey:  spec.Config.AzureConfig.ResourceGroup,
+				storageAccountKey: spec.Config.AzureConfig.StorageAccount,
+				subscriptionIDKey: spec.Config.AzureConfig.SubscriptionID,
+			}}
+		}
+
+		if len(config) != 0 {
+			flattenSpecData[configKey] = []interface{}{config}
+		}
+	}
+
+	return []interface{}{flattenSpecData}
+}
+
+func targetLocationPhaseGetter(config authctx.TanzuContext, fn *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationFullName) phaseGetter {
+	return func(ctx context.Context) (string, string, bool, error) {
+		resp, err := config.TMCConnection.TargetLocationResourceService.ManageV1alpha1DataProtectionBackupLocationResourceServiceGetWithContext(ctx, fn)
+		if err != nil {
+			if clienterrors.IsNotFoundError(err) {
+				return "", "", false, nil
+			}
+
+			return "", "", false, err
+		}
+
+		if resp.BackupLocation == nil || resp.BackupLocation.Status == nil {
+			return "", "", true, nil
+		}
+
+		return helper.PtrString(resp.BackupLocation.Status.Phase), resp.BackupLocation.Status.PhaseInfo, true, nil
+	}
+}
+
+func waitForTargetLocationReady(ctx context.Context, config authctx.TanzuContext, fn *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationFullName, timeout time.Duration) diag.Diagnostics {
+	_, diags := waitForPhase(ctx, targetLocationKind, fn.Name, targetLocationPhaseGetter(config, fn),
+		[]string{string(targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhaseREADY)},
+		[]string{string(targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationStatusPhaseERROR)},
+		timeout)
+
+	return diags
+}
+
+func resourceTargetLocationRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructTargetLocationFullname(d)
+
+	resp, err := config.TMCConnection.TargetLocationResourceService.ManageV1alpha1DataProtectionBackupLocationResourceServiceGet(fn)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control target location entry, name : %s", fn.Name))
+	}
+
+	backupLocation := resp.BackupLocation
+
+	d.SetId(backupLocation.Meta.UID)
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(backupLocation.Meta)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(specKey, flattenTargetLocationSpec(backupLocation.Spec)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	status := map[string]interface{}{}
+
+	if backupLocation.Status != nil {
+		status[phaseKey] = helper.PtrString(backupLocation.Status.Phase)
+		status[phaseInfoKey] = backupLocation.Status.PhaseInfo
+	}
+
+	if err := d.Set(statusKey, status); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceTargetLocationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructTargetLocationFullname(d)
+
+	request := &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationRequest{
+		BackupLocation: &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationBackupLocation{
+			FullName: fn,
+			Meta:     common.ConstructMeta(d),
+			Spec:     expandTargetLocationSpec(d.Get(specKey)),
+		},
+	}
+
+	response, err := config.TMCConnection.TargetLocationResourceService.ManageV1alpha1DataProtectionBackupLocationResourceServiceCreate(request)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control target location entry, name : %s", fn.Name))
+	}
+
+	d.SetId(response.BackupLocation.Meta.UID)
+
+	diags = append(diags, waitForTargetLocationReady(ctx, config, fn, d.Timeout(schema.TimeoutCreate))...)
+
+	return append(diags, resourceTargetLocationRead(ctx, d, m)...)
+}
+
+func resourceTargetLocationInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructTargetLocationFullname(d)
+
+	resp, err := config.TMCConnection.TargetLocationResourceService.ManageV1alpha1DataProtectionBackupLocationResourceServiceGet(fn)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control target location entry, name : %s", fn.Name))
+	}
+
+	backupLocation := resp.BackupLocation
+
+	if common.HasMetaChanged(d) {
+		meta := common.ConstructMeta(d)
+
+		if value, ok := backupLocation.Meta.Labels[common.CreatorLabelKey]; ok {
+			meta.Labels[common.CreatorLabelKey] = value
+		}
+
+		backupLocation.Meta.Labels = meta.Labels
+		backupLocation.Meta.Description = meta.Description
+	}
+
+	backupLocation.Spec = expandTargetLocationSpec(d.Get(specKey))
+
+	_, err = config.TMCConnection.TargetLocationResourceService.ManageV1alpha1DataProtectionBackupLocationResourceServiceUpdate(
+		&targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationRequest{
+			BackupLocation: backupLocation,
+		},
+	)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control target location entry, name : %s", fn.Name))
+	}
+
+	diags = append(diags, waitForTargetLocationReady(ctx, config, fn, d.Timeout(schema.TimeoutUpdate))...)
+
+	return append(diags, resourceTargetLocationRead(ctx, d, m)...)
+}
+
+func resourceTargetLocationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructTargetLocationFullname(d)
+
+	err := config.TMCConnection.TargetLocationResourceService.ManageV1alpha1DataProtectionBackupLocationResourceServiceDelete(fn)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control target location entry, name : %s", fn.Name))
+	}
+
+	if diags = waitForDeletion(ctx, targetLocationKind, fn.Name, targetLocationPhaseGetter(config, fn), d.Timeout(schema.TimeoutDelete)); diags.HasError() {
+		return diags
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// resourceTargetLocationImporter imports a target location using an ID of the form <provider_name>/<name>.
+func resourceTargetLocationImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts, err := helper.ParseImportID(d.Id(), ProviderNameKey, NameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.Set(ProviderNameKey, parts[0]); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set provider name for the target location %s", parts[1])
+	}
+
+	if err = d.Set(NameKey, parts[1]); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the target location %s", parts[1])
+	}
+
+	return helper.ReadImportedState(ctx, d, m, resourceTargetLocationRead)
+}
@@ -0,0 +1,196 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package dataprotection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	backupschedulemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/dataprotection/cluster/backupschedule"
+	restoremodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/dataprotection/cluster/restore"
+	targetlocationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/dataprotection/targetlocation"
+)
+
+func TestTargetLocationSpec(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		input    []interface{}
+		expected *targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationSpec
+	}{
+		{
+			name: "case for an S3 compatible storage assigned to a cluster and a cluster group",
+			input: []interface{}{map[string]interface{}{
+				targetProviderKey: "AWS",
+				credentialNameKey: "s3-credential",
+				bucketKey:         "backups",
+				regionKey:         "us-west-2",
+				caCertKey:         "",
+				assignedGroupsKey: []interface{}{map[string]interface{}{
+					clusterKey: []interface{}{map[string]interface{}{
+						NameKey:                  "c1",
+						ManagementClusterNameKey: "attached",
+						ProvisionerNameKey:       "attached",
+					}},
+					clusterGroupsKey: []interface{}{"cg1"},
+				}},
+				configKey: []interface{}{map[string]interface{}{
+					awsKey: []interface{}{map[string]interface{}{
+						urlKey:              "https://minio.example.com",
+						publicURLKey:        "",
+						s3ForcePathStyleKey: true,
+					}},
+				}},
+			}},
+			expected: &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationSpec{
+				AssignedGroups: []*targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAssignedGroup{
+					{Cluster: &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterFullName{Name: "c1", ManagementClusterName: "attached", ProvisionerName: "attached"}},
+					{Clustergroup: &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationClusterGroupFullName{Name: "cg1"}},
+				},
+				Bucket: "backups",
+				Config: &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationConfig{
+					S3Config: &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationS3Config{
+						S3ForcePathStyle: true,
+						URL:              "https://minio.example.com",
+					},
+				},
+				Credential:     &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationCredential{Name: "s3-credential"},
+				Region:         "us-west-2",
+				TargetProvider: targetlocationmodel.NewVmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProvider(targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProviderAWS),
+			},
+		},
+		{
+			name: "case for an Azure blob storage",
+			input: []interface{}{map[string]interface{}{
+				targetProviderKey: "AZURE",
+				credentialNameKey: "azure-credential",
+				bucketKey:         "backups",
+				regionKey:         "",
+				caCertKey:         "",
+				configKey: []interface{}{map[string]interface{}{
+					azureKey: []interface{}{map[string]interface{}{
+						resourceGroupKey:  "rg",
+						storageAccountKey: "account",
+						subscriptionIDKey: "subscription",
+					}},
+				}},
+			}},
+			expected: &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationSpec{
+				AssignedGroups: []*targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAssignedGroup{},
+				Bucket:         "backups",
+				Config: &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationConfig{
+					AzureConfig: &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationAzureConfig{
+						ResourceGroup:  "rg",
+						StorageAccount: "account",
+						SubscriptionID: "subscription",
+					},
+				},
+				Credential:     &targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationCredential{Name: "azure-credential"},
+				TargetProvider: targetlocationmodel.NewVmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProvider(targetlocationmodel.VmwareTanzuManageV1alpha1DataprotectionProviderBackuplocationTargetProviderAZURE),
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			spec := expandTargetLocationSpec(test.input)
+			require.Equal(t, test.expected, spec)
+
+			// The flattened spec expands back to the same spec.
+			require.Equal(t, test.expected, expandTargetLocationSpec(flattenTargetLocationSpec(spec)))
+		})
+	}
+}
+
+func TestBackupScheduleSpec(t *testing.T) {
+	t.Parallel()
+
+	input := []interface{}{map[string]interface{}{
+		pausedKey: true,
+		scheduleKey: []interface{}{map[string]interface{}{
+			rateKey: "0 2 * * *",
+		}},
+		templateKey: []interface{}{map[string]interface{}{
+			storageLocationKey:          "backups",
+			ttlKey:                      ttlDefaultValue,
+			includedNamespacesKey:       []interface{}{"app"},
+			excludedNamespacesKey:       []interface{}{},
+			includedResourcesKey:        []interface{}{},
+			excludedResourcesKey:        []interface{}{"secrets"},
+			includeClusterResourcesKey:  false,
+			snapshotVolumesKey:          true,
+			defaultVolumesToFsBackupKey: true,
+			volumeSnapshotLocationsKey:  []interface{}{},
+		}},
+	}}
+
+	expected := &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleSpec{
+		Paused:   true,
+		Schedule: &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionScheduleRate{Rate: "0 2 * * *"},
+		Template: &backupschedulemodel.VmwareTanzuManageV1alpha1ClusterDataprotectionBackupSpec{
+			DefaultVolumesToFsBackup: true,
+			ExcludedNamespaces:       []string{},
+			ExcludedResources:        []string{"secrets"},
+			IncludeClusterResources:  helper.BoolPointer(false),
+			IncludedNamespaces:       []string{"app"},
+			IncludedResources:        []string{},
+			SnapshotVolumes:          helper.BoolPointer(true),
+			StorageLocation:          "backups",
+			TTL:                      ttlDefaultValue,
+			VolumeSnapshotLocations:  []string{},
+		},
+	}
+
+	spec := expandBackupScheduleSpec(input)
+	require.Equal(t, expected, spec)
+
+	flattened := flattenBackupScheduleSpec(spec)
+	require.Equal(t, false, flattened[0].(map[string]interface{})[templateKey].([]interface{})[0].(map[string]interface{})[includeClusterResourcesKey])
+
+	// Unset optional booleans are flattened to the defaults of the schema.
+	spec.Template.IncludeClusterResources = nil
+	spec.Template.SnapshotVolumes = nil
+
+	template := flattenBackupScheduleSpec(spec)[0].(map[string]interface{})[templateKey].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, true, template[includeClusterResourcesKey])
+	require.Equal(t, true, template[snapshotVolumesKey])
+}
+
+func TestRestoreSpec(t *testing.T) {
+	t.Parallel()
+
+	input := []interface{}{map[string]interface{}{
+		backupNameKey:              "daily-20230101020000",
+		includedNamespacesKey:      []interface{}{"app"},
+		excludedNamespacesKey:      []interface{}{},
+		includedResourcesKey:       []interface{}{},
+		excludedResourcesKey:       []interface{}{},
+		includeClusterResourcesKey: true,
+		restorePVsKey:              false,
+		namespaceMappingKey:        map[string]interface{}{"app": "app-restored"},
+	}}
+
+	expected := &restoremodel.VmwareTanzuManageV1alpha1ClusterDataprotectionRestoreSpec{
+		BackupName:              "daily-20230101020000",
+		ExcludedNamespaces:      []string{},
+		ExcludedResources:       []string{},
+		IncludeClusterResources: helper.BoolPointer(true),
+		IncludedNamespaces:      []string{"app"},
+		IncludedResources:       []string{},
+		NamespaceMapping:        map[string]string{"app": "app-restored"},
+		RestorePVs:              helper.BoolPointer(false),
+	}
+
+	require.Equal(t, expected, expandRestoreSpec(input))
+
+	flattened := flattenRestoreSpec(expected)[0].(map[string]interface{})
+	require.Equal(t, false, flattened[restorePVsKey])
+	require.Equal(t, map[string]string{"app": "app-restored"}, flattened[namespaceMappingKey])
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package ekscluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	eksmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/ekscluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+// adoptExistingCluster records the EKS cluster which made the create request fail with a conflict in the state. Once
+// the cluster is ready, its spec and nodepools are updated where they differ from the configuration.
+func adoptExistingCluster(ctx context.Context, config authctx.TanzuContext, d *schema.ResourceData, clusterSpec *eksmodel.VmwareTanzuManageV1alpha1EksclusterSpec, nodepools []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolDefinition) diag.Diagnostics {
+	clusterFn := constructFullname(d)
+	opsRetryTimeout := getRetryTimeout(d, schema.TimeoutCreate)
+
+	getResp, err := config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceGet(clusterFn)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get existing Tanzu Mission Control EKS cluster entry, name : %s", clusterFn.Name))
+	}
+
+	d.SetId(getResp.EksCluster.Meta.UID)
+
+	diags := diag.Diagnostics{common.AdoptedWarning("EKS cluster", clusterFn.Name)}
+
+	_, err = helper.RetryUntilTimeoutWithContext(ctx, getWaitForClusterReadyFn(ctx, config, clusterFn), 10*time.Second, opsRetryTimeout)
+	if err != nil {
+		return append(diags, clienterrors.ToDiagnostics(errors.Wrapf(err, "failed to wait for existing EKS cluster resource(%s) to be ready", clusterFn.Name))...)
+	}
+
+	getResp, err = config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceGet(clusterFn)
+	if err != nil {
+		return append(diags, clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get existing Tanzu Mission Control EKS cluster entry, name : %s", clusterFn.Name))...)
+	}
+
+	if err := handleClusterDiff(config, getResp.EksCluster, common.ConstructMeta(d), clusterSpec); err != nil {
+		return append(diags, clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update existing Tanzu Mission Control EKS cluster entry, name : %s", clusterFn.Name))...)
+	}
+
+	if err := handleNodepoolDiffs(ctx, config, opsRetryTimeout, getResp.EksCluster.FullName, nodepools); err != nil {
+		return append(diags, clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update existing Tanzu Mission Control EKS cluster's nodepools, name : %s", clusterFn.Name))...)
+	}
+
+	return append(diags, dataSourceTMCEKSClusterRead(context.WithValue(ctx, contextMethodKey{}, "create"), d, config)...)
+}
@@ -6,7 +6,8 @@ SPDX-License-Identifier: MPL-2.0
 package ekscluster
 
 const (
-	ResourceName = "tanzu-mission-control_ekscluster"
+	ResourceName         = "tanzu-mission-control_ekscluster"
+	NodepoolResourceName = "tanzu-mission-control_ekscluster_nodepool"
 
 	CredentialNameKey          = "credential_name" //nolint:gosec
 	RegionKey                  = "region"
@@ -14,6 +15,7 @@ const (
 	specKey                    = "spec"
 	StatusKey                  = "status"
 	waitKey                    = "ready_wait_timeout"
+	upgradeModeKey             = "upgrade_mode"
 	clusterGroupKey            = "cluster_group"
 	clusterGroupDefaultValue   = "default"
 	proxyNameKey               = "proxy"
@@ -21,6 +23,7 @@ const (
 	nodepoolKey                = "nodepool"
 	roleArnKey                 = "role_arn"
 	kubernetesVersionKey       = "kubernetes_version"
+	kubernetesVersionPath      = "spec.0.config.0.kubernetes_version"
 	tagsKey                    = "tags"
 	kubernetesNetworkConfigKey = "kubernetes_network_config"
 	serviceCidrKey             = "service_cidr"
@@ -36,6 +39,7 @@ const (
 	publicAccessCidrsKey       = "public_access_cidrs"
 	securityGroupsKey          = "security_groups"
 	subnetIdsKey               = "subnet_ids"
+	clusterNameKey             = "cluster_name"
 
 	infoKey                     = "info"
 	amiTypeKey                  = "ami_type"
@@ -66,4 +70,10 @@ const (
 
 	readyCondition = "Ready"
 	errorSeverity  = "ERROR"
+
+	// standaloneNodepoolLabelKey marks nodepools owned by the
+	// tanzu-mission-control_ekscluster_nodepool resource, so that the
+	// cluster resource leaves them alone.
+	standaloneNodepoolLabelKey   = "tmc.cloud.vmware.com/terraform-nodepool-resource"
+	standaloneNodepoolLabelValue = "true"
 )
@@ -43,7 +43,7 @@ func dataSourceTMCEKSClusterRead(ctx context.Context, d *schema.ResourceData, m
 
 	clusterFn := constructFullname(d)
 	getEksClusterResourceRetryableFn := func() (retry bool, err error) {
-		resp, err = config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceGet(clusterFn)
+		resp, err = config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceGetWithContext(ctx, clusterFn)
 		if err != nil {
 			if clienterrors.IsNotFoundError(err) && !helper.IsDataRead(ctx) {
 				_ = schema.RemoveFromState(d, m)
@@ -81,35 +81,14 @@ func dataSourceTMCEKSClusterRead(ctx context.Context, d *schema.ResourceData, m
 		return false, nil
 	}
 
-	timeoutValueData, _ := d.Get(waitKey).(string)
-
-	if ctx.Value(contextMethodKey{}) != "create" {
-		timeoutValueData = "do_not_retry"
-	}
-
-	switch timeoutValueData {
-	case "do_not_retry":
+	if ctx.Value(contextMethodKey{}) == "create" {
+		_, err = helper.RetryUntilTimeoutWithContext(ctx, getEksClusterResourceRetryableFn, 10*time.Second, getRetryTimeout(d, schema.TimeoutCreate))
+	} else {
 		_, err = getEksClusterResourceRetryableFn()
-	case "":
-		fallthrough
-	case "default":
-		timeoutValueData = "5m"
-
-		fallthrough
-	default:
-		timeoutDuration, parseErr := time.ParseDuration(timeoutValueData)
-		if parseErr != nil {
-			log.Printf("[INFO] unable to prase the duration value for the key %s. Defaulting to 5 minutes(5m)"+
-				" Please refer to 'https://pkg.go.dev/time#ParseDuration' for providing the right value", waitKey)
-
-			timeoutDuration = defaultTimeout
-		}
-
-		_, err = helper.RetryUntilTimeout(getEksClusterResourceRetryableFn, 10*time.Second, timeoutDuration)
 	}
 
 	if err != nil || resp == nil || npresp == nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control EKS cluster entry, name : %s", d.Get(NameKey)))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control EKS cluster entry, name : %s", d.Get(NameKey)))
 	}
 
 	// always run
@@ -156,7 +135,7 @@ func setResourceData(d *schema.ResourceData, eksCluster *eksmodel.VmwareTanzuMan
 
 		if pos, ok := npPosMap[np.FullName.Name]; ok {
 			nodepools[pos] = npDef
-		} else {
+		} else if !isStandaloneNodepool(np) {
 			nodepools = append(nodepools, npDef)
 		}
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/pkg/errors"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
@@ -45,6 +46,11 @@ func ResourceTMCEKSCluster() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceClusterImporter,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultTimeout),
+			Update: schema.DefaultTimeout(defaultTimeout),
+			Delete: schema.DefaultTimeout(defaultTimeout),
+		},
 		Description: "Tanzu Mission Control EKS Cluster Resource",
 	}
 }
@@ -78,13 +84,21 @@ var clusterSchema = map[string]*schema.Schema{
 	},
 	waitKey: {
 		Type:        schema.TypeString,
-		Description: "Wait timeout duration until cluster resource reaches READY state. Accepted timeout duration values like 5s, 45m, or 3h, higher than zero",
+		Description: "Wait timeout duration until cluster resource reaches READY state. Accepted timeout duration values like 5s, 45m, or 3h, higher than zero. When not set, the timeouts of the resource apply.",
 		Default:     "default",
 		Optional:    true,
 		DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
 			return true
 		},
 	},
+	upgradeModeKey: {
+		Type:         schema.TypeString,
+		Description:  "How a change of the Kubernetes version is applied. `IN_PLACE` updates the cluster with the new version. `ORCHESTRATED` first checks the new version against the available upgrade versions and refuses to skip a minor version, then upgrades the control plane and each nodepool in sequence, reporting the progress of each step. Each nodepool is rolled according to its `update_config`.",
+		Default:      helper.UpgradeModeInPlace,
+		Optional:     true,
+		ValidateFunc: validation.StringInSlice(helper.UpgradeModes, false),
+	},
+	common.AdoptExistingKey: common.AdoptExistingByDefault,
 }
 
 var clusterSpecSchema = &schema.Schema{
@@ -108,9 +122,8 @@ var clusterSpecSchema = &schema.Schema{
 			configKey: configSchema,
 			nodepoolKey: {
 				Type:        schema.TypeList,
-				Description: "Nodepool definitions for the cluster",
-				Required:    true,
-				MinItems:    1,
+				Description: "Nodepool definitions for the cluster. Nodepools managed by the tanzu-mission-control_ekscluster_nodepool resource are ignored",
+				Optional:    true,
 				Elem:        nodepoolDefinitionSchema,
 			},
 		},
@@ -479,27 +492,24 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 		},
 	}
 
-	var eksCluster *eksmodel.VmwareTanzuManageV1alpha1EksclusterEksCluster
-
 	clusterResponse, err := config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceCreate(clusterReq)
 	if err != nil {
 		if !clienterrors.IsAlreadyExistsError(err) {
-			return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control EKS cluster entry, name : %s", d.Get(NameKey)))
+			return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control EKS cluster entry, name : %s", d.Get(NameKey)))
 		}
 
-		clusterResponse, err := config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceGet(clusterFn)
-		if err != nil {
-			return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control EKS cluster entry, name : %s", d.Get(NameKey)))
+		if !common.ShouldAdoptExisting(d) {
+			return clienterrors.ToDiagnostics(common.AlreadyExistsError(err, "EKS cluster", d.Get(NameKey)))
 		}
 
-		eksCluster = clusterResponse.EksCluster
-	} else {
-		eksCluster = clusterResponse.EksCluster
+		return adoptExistingCluster(ctx, config, d, clusterSpec, nps)
 	}
 
+	eksCluster := clusterResponse.EksCluster
+
 	err = createNodepools(config, eksCluster.FullName, nps)
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control EKS nodepools for cluster: %s", eksCluster.FullName.ToString()))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control EKS nodepools for cluster: %s", eksCluster.FullName.ToString()))
 	}
 
 	d.SetId(eksCluster.Meta.UID)
@@ -507,7 +517,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, m interf
 	return dataSourceTMCEKSClusterRead(context.WithValue(ctx, contextMethodKey{}, "create"), d, m)
 }
 
-func resourceClusterDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	config := m.(authctx.TanzuContext)
 
 	// Warning or errors can be collected in a slice type
@@ -515,7 +525,7 @@ func resourceClusterDelete(_ context.Context, d *schema.ResourceData, m interfac
 
 	err := config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceDelete(constructFullname(d), "false")
 	if err != nil && !clienterrors.IsNotFoundError(err) {
-		return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control EKS cluster entry, name : %s", d.Get(NameKey)))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control EKS cluster entry, name : %s", d.Get(NameKey)))
 	}
 
 	// d.SetId("") is automatically called assuming delete returns no errors, but
@@ -524,7 +534,7 @@ func resourceClusterDelete(_ context.Context, d *schema.ResourceData, m interfac
 
 	clusterFn := constructFullname(d)
 	getClusterResourceRetryableFn := func() (retry bool, err error) {
-		_, err = config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceGet(clusterFn)
+		_, err = config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceGetWithContext(ctx, clusterFn)
 		if err == nil {
 			log.Printf("[DEBUG] cluster(%s) deletion in progress", clusterFn.ToString())
 			return true, errors.New("cluster deletion in progress")
@@ -537,11 +547,9 @@ func resourceClusterDelete(_ context.Context, d *schema.ResourceData, m interfac
 		return false, nil
 	}
 
-	timeoutDuration := getRetryTimeout(d)
-
-	_, err = helper.RetryUntilTimeout(getClusterResourceRetryableFn, 10*time.Second, timeoutDuration)
+	_, err = helper.RetryUntilTimeoutWithContext(ctx, getClusterResourceRetryableFn, 10*time.Second, getRetryTimeout(d, schema.TimeoutDelete))
 	if err != nil {
-		diag.FromErr(errors.Wrapf(err, "verify %s EKS cluster resource clean up", d.Get(NameKey)))
+		diags = clienterrors.ToDiagnostics(errors.Wrapf(err, "verify %s EKS cluster resource clean up", d.Get(NameKey)))
 	}
 
 	return diags
@@ -553,27 +561,40 @@ func resourceClusterInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 	// Get call to initialise the cluster struct
 	getResp, err := config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceGet(constructFullname(d))
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control EKS cluster entry, name : %s", d.Get(NameKey)))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control EKS cluster entry, name : %s", d.Get(NameKey)))
 	}
 
-	opsRetryTimeout := getRetryTimeout(d)
+	opsRetryTimeout := getRetryTimeout(d, schema.TimeoutUpdate)
 
 	clusterSpec, nodepools := constructEksClusterSpec(d)
 
+	if isOrchestratedUpgrade(d) {
+		if diags = upgradeKubernetesVersion(ctx, config, d, getResp.EksCluster, clusterSpec, nodepools); diags.HasError() {
+			return diags
+		}
+
+		// The existing nodepools are upgraded, only creates and deletes are left.
+		if errnp := handleNodepoolDiffs(ctx, config, opsRetryTimeout, getResp.EksCluster.FullName, nodepools); errnp != nil {
+			return append(diags, clienterrors.ToDiagnostics(errors.Wrapf(errnp, "Unable to update Tanzu Mission Control EKS cluster's nodepools, name : %s", d.Get(NameKey)))...)
+		}
+
+		return append(diags, dataSourceTMCEKSClusterRead(ctx, d, m)...)
+	}
+
 	// EKS cluster update API on TMC side ignores nodepools passed to it.
 	// The nodepools have to be updated via separate nodepool API, hence we
 	// deal with them separately.
-	errnp := handleNodepoolDiffs(config, opsRetryTimeout, getResp.EksCluster.FullName, nodepools)
+	errnp := handleNodepoolDiffs(ctx, config, opsRetryTimeout, getResp.EksCluster.FullName, nodepools)
 
 	errcl := handleClusterDiff(config, getResp.EksCluster, common.ConstructMeta(d), clusterSpec)
 	if errcl != nil {
-		return diag.FromErr(errors.Wrapf(errcl, "Unable to update Tanzu Mission Control EKS cluster entry, name : %s", d.Get(NameKey)))
+		return clienterrors.ToDiagnostics(errors.Wrapf(errcl, "Unable to update Tanzu Mission Control EKS cluster entry, name : %s", d.Get(NameKey)))
 	}
 
 	// this is moved here so as to not bail on the cluster update
 	// when there is a nodepool update error
 	if errnp != nil {
-		return diag.FromErr(errors.Wrapf(errnp, "Unable to update Tanzu Mission Control EKS cluster's nodepools, name : %s", d.Get(NameKey)))
+		return clienterrors.ToDiagnostics(errors.Wrapf(errnp, "Unable to update Tanzu Mission Control EKS cluster's nodepools, name : %s", d.Get(NameKey)))
 	}
 
 	log.Printf("[INFO] cluster update successful")
@@ -589,7 +610,7 @@ func resourceClusterImporter(ctx context.Context, d *schema.ResourceData, m inte
 		return nil, errors.New("ID is needed to import an TMC EKS cluster")
 	}
 
-	resp, err := config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceGetByID(id)
+	resp, err := getClusterForImport(config, id)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Unable to get Tanzu Mission Control EKS cluster entry for id %s", id)
 	}
@@ -619,6 +640,24 @@ func resourceClusterImporter(ctx context.Context, d *schema.ResourceData, m inte
 	return []*schema.ResourceData{d}, nil
 }
 
+// getClusterForImport looks up the cluster either by its UID or by a full name of the form <credential_name>/<region>/<name>.
+func getClusterForImport(config authctx.TanzuContext, id string) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterGetEksClusterResponse, error) {
+	if !strings.Contains(id, helper.ImportIDSeparator) {
+		return config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceGetByID(id)
+	}
+
+	parts, err := helper.ParseImportID(id, CredentialNameKey, RegionKey, NameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceGet(&eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName{
+		CredentialName: parts[0],
+		Region:         parts[1],
+		Name:           parts[2],
+	})
+}
+
 func handleClusterDiff(config authctx.TanzuContext, tmcCluster *eksmodel.VmwareTanzuManageV1alpha1EksclusterEksCluster, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta, clusterSpec *eksmodel.VmwareTanzuManageV1alpha1EksclusterSpec) error {
 	updateCluster := false
 
@@ -672,16 +711,9 @@ func constructFullname(d *schema.ResourceData) (fullname *eksmodel.VmwareTanzuMa
 	return fullname
 }
 
-func getRetryTimeout(d *schema.ResourceData) time.Duration {
-	timeoutValueData, _ := d.Get(waitKey).(string)
-	if timeoutValueData != "default" {
-		providedDuration, parseErr := time.ParseDuration(timeoutValueData)
-		if parseErr == nil {
-			return providedDuration
-		}
-	}
-
-	return defaultTimeout
+// getRetryTimeout returns the configured ready wait timeout, or the resource timeout for the operation when it is not set.
+func getRetryTimeout(d *schema.ResourceData, timeoutKey string) time.Duration {
+	return helper.GetWaitTimeout(d, waitKey, timeoutKey)
 }
 
 func flattenClusterSpec(item *eksmodel.VmwareTanzuManageV1alpha1EksclusterSpec, nodepools []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolDefinition) []interface{} {
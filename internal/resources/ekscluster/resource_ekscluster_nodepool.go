@@ -0,0 +1,343 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package ekscluster
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	eksmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/ekscluster"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+func ResourceTMCEKSNodepool() *schema.Resource {
+	return &schema.Resource{
+		Schema:        nodepoolResourceSchema,
+		CreateContext: resourceNodepoolCreate,
+		ReadContext:   resourceNodepoolRead,
+		UpdateContext: resourceNodepoolInPlaceUpdate,
+		DeleteContext: resourceNodepoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNodepoolImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Description: "Tanzu Mission Control EKS Cluster Nodepool Resource",
+	}
+}
+
+var nodepoolResourceSchema = map[string]*schema.Schema{
+	CredentialNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the AWS Credential in Tanzu Mission Control",
+		Required:    true,
+		ForceNew:    true,
+	},
+	RegionKey: {
+		Type:        schema.TypeString,
+		Description: "AWS Region of the cluster",
+		Required:    true,
+		ForceNew:    true,
+	},
+	clusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the EKS cluster this nodepool belongs to",
+		Required:    true,
+		ForceNew:    true,
+	},
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of this nodepool",
+		Required:    true,
+		ForceNew:    true,
+	},
+	common.MetaKey: common.Meta,
+	specKey:        nodepoolSpecSchema,
+	StatusKey: {
+		Type:        schema.TypeMap,
+		Description: "Status of the nodepool, including the progress of rolling updates",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	waitKey: {
+		Type:        schema.TypeString,
+		Description: "Wait timeout duration until nodepool resource reaches READY state. Accepted timeout duration values like 5s, 45m, or 3h, higher than zero. When not set, the timeouts of the resource apply.",
+		Default:     helper.DefaultWaitTimeoutValue,
+		Optional:    true,
+		DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+			return true
+		},
+	},
+}
+
+func constructNodepoolFullname(d *schema.ResourceData) *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolFullName {
+	fullname := &eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolFullName{}
+
+	fullname.CredentialName, _ = d.Get(CredentialNameKey).(string)
+	fullname.Region, _ = d.Get(RegionKey).(string)
+	fullname.EksClusterName, _ = d.Get(clusterNameKey).(string)
+	fullname.Name, _ = d.Get(NameKey).(string)
+
+	return fullname
+}
+
+func constructNodepoolResourceSpec(d *schema.ResourceData) *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolSpec {
+	data, _ := d.Get(specKey).([]interface{})
+
+	return constructNodepoolSpec(data)
+}
+
+// isStandaloneNodepool reports whether the nodepool is owned by the
+// tanzu-mission-control_ekscluster_nodepool resource.
+func isStandaloneNodepool(np *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolNodepool) bool {
+	return np != nil && np.Meta != nil && np.Meta.Labels[standaloneNodepoolLabelKey] == standaloneNodepoolLabelValue
+}
+
+func flattenNodepoolStatus(status *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolStatus) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	if status == nil {
+		return data
+	}
+
+	if status.Phase != nil {
+		data["phase"] = string(*status.Phase)
+	}
+
+	if c, ok := status.Conditions[readyCondition]; ok {
+		if c.Status != nil {
+			data["ready_status"] = string(*c.Status)
+		}
+
+		data["ready_reason"] = c.Reason
+		data["ready_message"] = c.Message
+	}
+
+	return data
+}
+
+func resourceNodepoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+
+	npFn := constructNodepoolFullname(d)
+
+	meta := common.ConstructMeta(d)
+	meta.Labels[standaloneNodepoolLabelKey] = standaloneNodepoolLabelValue
+
+	req := &eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIRequest{
+		Nodepool: &eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolNodepool{
+			FullName: npFn,
+			Meta:     meta,
+			Spec:     constructNodepoolResourceSpec(d),
+		},
+	}
+
+	resp, err := config.TMCConnection.EKSNodePoolResourceService.EksNodePoolResourceServiceCreate(req)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control EKS nodepool entry, name : %s", npFn.Name))
+	}
+
+	d.SetId(resp.Nodepool.Meta.UID)
+
+	_, err = helper.RetryUntilTimeoutWithContext(ctx, getWaitForNodepoolReadyFn(ctx, config, npFn), 10*time.Second, getRetryTimeout(d, schema.TimeoutCreate))
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "failed to verify EKS nodepool resource(%s) creation", npFn.Name))
+	}
+
+	return resourceNodepoolRead(ctx, d, m)
+}
+
+func resourceNodepoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	npFn := constructNodepoolFullname(d)
+
+	resp, err := config.TMCConnection.EKSNodePoolResourceService.EksNodePoolResourceServiceGet(npFn)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) && !helper.IsDataRead(ctx) {
+			_ = schema.RemoveFromState(d, m)
+			return diags
+		}
+
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control EKS nodepool entry, name : %s", npFn.Name))
+	}
+
+	d.SetId(resp.Nodepool.Meta.UID)
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(resp.Nodepool.Meta)); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Failed to set meta for the nodepool %s", npFn.Name))
+	}
+
+	if err := d.Set(specKey, flattenSpec(resp.Nodepool.Spec)); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Failed to set the spec for nodepool %s", npFn.Name))
+	}
+
+	if err := d.Set(StatusKey, flattenNodepoolStatus(resp.Nodepool.Status)); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Failed to set status for the nodepool %s", npFn.Name))
+	}
+
+	return diags
+}
+
+func resourceNodepoolInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+
+	npFn := constructNodepoolFullname(d)
+
+	getResp, err := config.TMCConnection.EKSNodePoolResourceService.EksNodePoolResourceServiceGet(npFn)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control EKS nodepool entry, name : %s", npFn.Name))
+	}
+
+	tmcNp := getResp.Nodepool
+	meta := common.ConstructMeta(d)
+	spec := constructNodepoolResourceSpec(d)
+
+	fillTMCSetValues(tmcNp.Spec, spec)
+
+	meta.Labels[standaloneNodepoolLabelKey] = standaloneNodepoolLabelValue
+
+	if value, ok := tmcNp.Meta.Labels[common.CreatorLabelKey]; ok {
+		meta.Labels[common.CreatorLabelKey] = value
+	}
+
+	if meta.Description == tmcNp.Meta.Description &&
+		mapEqual(meta.Labels, tmcNp.Meta.Labels) &&
+		nodepoolSpecEqual(tmcNp.Spec, spec) {
+		return resourceNodepoolRead(ctx, d, m)
+	}
+
+	tmcNp.Meta.Description = meta.Description
+	tmcNp.Meta.Labels = meta.Labels
+	tmcNp.Spec = spec
+
+	_, err = config.TMCConnection.EKSNodePoolResourceService.EksNodePoolResourceServiceUpdate(
+		&eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIRequest{
+			Nodepool: tmcNp,
+		},
+	)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control EKS nodepool entry, name : %s", npFn.Name))
+	}
+
+	// Spec changes roll the nodes of the pool, wait for the rollout to finish.
+	_, err = helper.RetryUntilTimeoutWithContext(ctx, getWaitForNodepoolReadyFn(ctx, config, npFn), 10*time.Second, getRetryTimeout(d, schema.TimeoutUpdate))
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "failed to verify EKS nodepool resource(%s) update", npFn.Name))
+	}
+
+	log.Printf("[INFO] nodepool update successful")
+
+	return resourceNodepoolRead(ctx, d, m)
+}
+
+func resourceNodepoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+
+	npFn := constructNodepoolFullname(d)
+
+	err := config.TMCConnection.EKSNodePoolResourceService.EksNodePoolResourceServiceDelete(npFn)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			_ = schema.RemoveFromState(d, m)
+			return nil
+		}
+
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control EKS nodepool entry, name : %s", npFn.Name))
+	}
+
+	getNodepoolResourceRetryableFn := func() (retry bool, err error) {
+		_, err = config.TMCConnection.EKSNodePoolResourceService.EksNodePoolResourceServiceGetWithContext(ctx, npFn)
+		if err == nil {
+			log.Printf("[DEBUG] nodepool(%s) deletion in progress", npFn.Name)
+			return true, errors.New("nodepool deletion in progress")
+		}
+
+		if !clienterrors.IsNotFoundError(err) {
+			return true, err
+		}
+
+		return false, nil
+	}
+
+	_, err = helper.RetryUntilTimeoutWithContext(ctx, getNodepoolResourceRetryableFn, 10*time.Second, getRetryTimeout(d, schema.TimeoutDelete))
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "verify %s EKS nodepool resource clean up", npFn.Name))
+	}
+
+	_ = schema.RemoveFromState(d, m)
+
+	return nil
+}
+
+// resourceNodepoolImporter imports a nodepool using an ID of the form <credential_name>/<region>/<cluster_name>/<name>.
+func resourceNodepoolImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts, err := helper.ParseImportID(d.Id(), CredentialNameKey, RegionKey, clusterNameKey, NameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, key := range []string{CredentialNameKey, RegionKey, clusterNameKey, NameKey} {
+		if err = d.Set(key, parts[i]); err != nil {
+			return nil, errors.Wrapf(err, "Failed to set %s for the nodepool %s", key, d.Id())
+		}
+	}
+
+	// The nodepool may have been created inline in the ekscluster resource or outside of Terraform,
+	// label it so that the ekscluster resource no longer manages or deletes it.
+	if err = labelStandaloneNodepool(m.(authctx.TanzuContext), constructNodepoolFullname(d)); err != nil {
+		return nil, err
+	}
+
+	return helper.ReadImportedState(ctx, d, m, resourceNodepoolRead)
+}
+
+// labelStandaloneNodepool marks an existing nodepool as owned by the
+// tanzu-mission-control_ekscluster_nodepool resource.
+func labelStandaloneNodepool(config authctx.TanzuContext, npFn *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolFullName) error {
+	getResp, err := config.TMCConnection.EKSNodePoolResourceService.EksNodePoolResourceServiceGet(npFn)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get Tanzu Mission Control EKS nodepool entry, name : %s", npFn.Name)
+	}
+
+	tmcNp := getResp.Nodepool
+	if isStandaloneNodepool(tmcNp) {
+		return nil
+	}
+
+	if tmcNp.Meta == nil {
+		tmcNp.Meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}
+	}
+
+	if tmcNp.Meta.Labels == nil {
+		tmcNp.Meta.Labels = map[string]string{}
+	}
+
+	tmcNp.Meta.Labels[standaloneNodepoolLabelKey] = standaloneNodepoolLabelValue
+
+	_, err = config.TMCConnection.EKSNodePoolResourceService.EksNodePoolResourceServiceUpdate(
+		&eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIRequest{
+			Nodepool: tmcNp,
+		},
+	)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to label Tanzu Mission Control EKS nodepool entry, name : %s", npFn.Name)
+	}
+
+	return nil
+}
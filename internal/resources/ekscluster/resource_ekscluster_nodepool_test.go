@@ -0,0 +1,203 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package ekscluster
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	eksmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/ekscluster"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+func TestIsStandaloneNodepool(t *testing.T) {
+	tests := []struct {
+		name string
+		np   *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolNodepool
+		res  bool
+	}{
+		{
+			name: "nil nodepool",
+			np:   nil,
+			res:  false,
+		},
+		{
+			name: "nodepool without meta",
+			np:   &eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolNodepool{},
+			res:  false,
+		},
+		{
+			name: "inline nodepool",
+			np:   getNodepool("np-1", map[string]string{"key": "value"}),
+			res:  false,
+		},
+		{
+			name: "standalone nodepool",
+			np:   getNodepool("np-1", map[string]string{standaloneNodepoolLabelKey: standaloneNodepoolLabelValue}),
+			res:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.res, isStandaloneNodepool(test.np), "expected function output to match")
+		})
+	}
+}
+
+func TestFlattenNodepoolStatus(t *testing.T) {
+	updating := eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolStatusPhaseUPDATING
+	conditionFalse := eksmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusFALSE
+
+	tests := []struct {
+		name   string
+		status *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolStatus
+		res    map[string]interface{}
+	}{
+		{
+			name:   "nil status",
+			status: nil,
+			res:    map[string]interface{}{},
+		},
+		{
+			name: "rolling update in progress",
+			status: &eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolStatus{
+				Phase: &updating,
+				Conditions: map[string]eksmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					readyCondition: {
+						Status:  &conditionFalse,
+						Reason:  "Updating",
+						Message: "2 of 4 nodes updated",
+					},
+				},
+			},
+			res: map[string]interface{}{
+				"phase":         "UPDATING",
+				"ready_status":  "FALSE",
+				"ready_reason":  "Updating",
+				"ready_message": "2 of 4 nodes updated",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.res, flattenNodepoolStatus(test.status), "expected function output to match")
+		})
+	}
+}
+
+func TestSetResourceDataIgnoresStandaloneNodepools(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, clusterSchema, map[string]interface{}{})
+
+	cluster := &eksmodel.VmwareTanzuManageV1alpha1EksclusterEksCluster{
+		FullName: &eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName{Name: "cluster-1"},
+		Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{},
+		Spec:     &eksmodel.VmwareTanzuManageV1alpha1EksclusterSpec{ClusterGroupName: "default"},
+		Status:   &eksmodel.VmwareTanzuManageV1alpha1EksclusterStatus{},
+	}
+
+	nodepools := []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolNodepool{
+		getNodepool("inline-np", nil),
+		getNodepool("standalone-np", map[string]string{standaloneNodepoolLabelKey: standaloneNodepoolLabelValue}),
+	}
+
+	require.NoError(t, setResourceData(d, cluster, nodepools))
+
+	_, nps := constructEksClusterSpec(d)
+	require.Len(t, nps, 1)
+	require.Equal(t, "inline-np", nps[0].Info.Name)
+}
+
+func getNodepool(name string, labels map[string]string) *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolNodepool {
+	return &eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolNodepool{
+		FullName: &eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolFullName{Name: name},
+		Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: labels},
+		Spec: &eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolSpec{
+			RoleArn: "some-arn",
+		},
+	}
+}
+
+type mockNodepoolClient struct {
+	nodepools map[string]*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolNodepool
+	deleted   []string
+}
+
+func (m *mockNodepoolClient) EksNodePoolResourceServiceGet(fn *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolFullName) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIResponse, error) {
+	np, ok := m.nodepools[fn.Name]
+	if !ok {
+		return nil, clienterrors.ErrorWithHTTPCode(http.StatusNotFound, errors.New("not found"))
+	}
+
+	return &eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIResponse{Nodepool: np}, nil
+}
+
+func (m *mockNodepoolClient) EksNodePoolResourceServiceGetWithContext(_ context.Context, fn *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolFullName) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIResponse, error) {
+	return m.EksNodePoolResourceServiceGet(fn)
+}
+
+func (m *mockNodepoolClient) EksNodePoolResourceServiceCreate(_ *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIRequest) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockNodepoolClient) EksNodePoolResourceServiceList(_ *eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolListNodepoolsResponse, error) {
+	resp := &eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolListNodepoolsResponse{}
+
+	for _, np := range m.nodepools {
+		resp.Nodepools = append(resp.Nodepools, np)
+	}
+
+	return resp, nil
+}
+
+func (m *mockNodepoolClient) EksNodePoolResourceServiceDelete(fn *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolFullName) error {
+	m.deleted = append(m.deleted, fn.Name)
+	delete(m.nodepools, fn.Name)
+
+	return nil
+}
+
+func (m *mockNodepoolClient) EksNodePoolResourceServiceUpdate(request *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIRequest) (*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIResponse, error) {
+	m.nodepools[request.Nodepool.FullName.Name] = request.Nodepool
+
+	return &eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIResponse{Nodepool: request.Nodepool}, nil
+}
+
+func TestClusterUpdateKeepsImportedNodepool(t *testing.T) {
+	npClient := &mockNodepoolClient{
+		nodepools: map[string]*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolNodepool{
+			"inline-np":   getNodepool("inline-np", nil),
+			"imported-np": getNodepool("imported-np", map[string]string{"key": "value"}),
+		},
+	}
+
+	config := authctx.TanzuContext{
+		TMCConnection: &client.TanzuMissionControl{
+			EKSNodePoolResourceService: npClient,
+		},
+	}
+
+	clusterFn := &eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName{Name: "cluster-1"}
+
+	require.NoError(t, labelStandaloneNodepool(config, &eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolFullName{EksClusterName: "cluster-1", Name: "imported-np"}))
+	require.True(t, isStandaloneNodepool(npClient.nodepools["imported-np"]))
+	require.Equal(t, "value", npClient.nodepools["imported-np"].Meta.Labels["key"])
+
+	// The imported nodepool is removed from spec.nodepool of the cluster, along with the inline one.
+	require.NoError(t, handleNodepoolDiffs(context.Background(), config, time.Second, clusterFn, nil))
+	require.Equal(t, []string{"inline-np"}, npClient.deleted)
+	require.Contains(t, npClient.nodepools, "imported-np")
+}
@@ -6,6 +6,7 @@ SPDX-License-Identifier: MPL-2.0
 package ekscluster
 
 import (
+	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -736,7 +737,7 @@ func constructTaints(taintsData []interface{}) []*eksmodel.VmwareTanzuManageV1al
 	return taints
 }
 
-func handleNodepoolDiffs(config authctx.TanzuContext, opsRetryTimeout time.Duration, clusterFn *eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName, nodepools []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolDefinition) error {
+func handleNodepoolDiffs(ctx context.Context, config authctx.TanzuContext, opsRetryTimeout time.Duration, clusterFn *eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName, nodepools []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolDefinition) error {
 	npresp, err := config.TMCConnection.EKSNodePoolResourceService.EksNodePoolResourceServiceList(clusterFn)
 	if err != nil {
 		return errors.Wrapf(err, "failed to list nodepools for cluster: %s", clusterFn)
@@ -760,8 +761,9 @@ func handleNodepoolDiffs(config authctx.TanzuContext, opsRetryTimeout time.Durat
 			if checkNodepoolUpdate(tmcNp, newNp) {
 				npUpdate = append(npUpdate, newNp)
 			}
-		} else {
-			// np exisits in TMC but not in TF
+		} else if !isStandaloneNodepool(tmcNp) {
+			// np exisits in TMC but not in TF and is not managed by
+			// the ekscluster_nodepool resource
 			npDelete = append(npDelete, tmcNp.FullName)
 		}
 	}
@@ -772,17 +774,17 @@ func handleNodepoolDiffs(config authctx.TanzuContext, opsRetryTimeout time.Durat
 		}
 	}
 
-	err = handleNodepoolCreates(config, opsRetryTimeout, clusterFn, npCreate)
+	err = handleNodepoolCreates(ctx, config, opsRetryTimeout, clusterFn, npCreate)
 	if err != nil {
 		return errors.Wrap(err, "failed to create nodepools that are not present in TMC")
 	}
 
-	err = handleNodepoolUpdates(config, opsRetryTimeout, tmcNps, npUpdate)
+	err = handleNodepoolUpdates(ctx, config, opsRetryTimeout, tmcNps, npUpdate)
 	if err != nil {
 		return errors.Wrapf(err, "failed to update existing nodepools")
 	}
 
-	err = handleNodepoolDeletes(config, opsRetryTimeout, npDelete)
+	err = handleNodepoolDeletes(ctx, config, opsRetryTimeout, npDelete)
 	if err != nil {
 		return errors.Wrapf(err, "failed to delete nodepools")
 	}
@@ -790,7 +792,7 @@ func handleNodepoolDiffs(config authctx.TanzuContext, opsRetryTimeout time.Durat
 	return nil
 }
 
-func handleNodepoolDeletes(config authctx.TanzuContext, opsRetryTimeout time.Duration, npFns []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolFullName) error {
+func handleNodepoolDeletes(ctx context.Context, config authctx.TanzuContext, opsRetryTimeout time.Duration, npFns []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolFullName) error {
 	for _, npFn := range npFns {
 		err := config.TMCConnection.EKSNodePoolResourceService.EksNodePoolResourceServiceDelete(npFn)
 		if err != nil {
@@ -798,7 +800,7 @@ func handleNodepoolDeletes(config authctx.TanzuContext, opsRetryTimeout time.Dur
 		}
 
 		getNodepoolResourceRetryableFn := func() (retry bool, err error) {
-			_, err = config.TMCConnection.EKSNodePoolResourceService.EksNodePoolResourceServiceGet(npFn)
+			_, err = config.TMCConnection.EKSNodePoolResourceService.EksNodePoolResourceServiceGetWithContext(ctx, npFn)
 			if err == nil {
 				// we don't want to fail deletion if the deletion is not
 				// completed within the expected time
@@ -812,7 +814,7 @@ func handleNodepoolDeletes(config authctx.TanzuContext, opsRetryTimeout time.Dur
 			return false, nil
 		}
 
-		_, err = helper.RetryUntilTimeout(getNodepoolResourceRetryableFn, 10*time.Second, opsRetryTimeout)
+		_, err = helper.RetryUntilTimeoutWithContext(ctx, getNodepoolResourceRetryableFn, 10*time.Second, opsRetryTimeout)
 		if err != nil {
 			return errors.Wrapf(err, "failed to verify EKS nodepool resource(%s) clean up", npFn.Name)
 		}
@@ -821,7 +823,7 @@ func handleNodepoolDeletes(config authctx.TanzuContext, opsRetryTimeout time.Dur
 	return nil
 }
 
-func handleNodepoolUpdates(config authctx.TanzuContext, opsRetryTimeout time.Duration, tmcNps map[string]*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolNodepool, nps []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolDefinition) error {
+func handleNodepoolUpdates(ctx context.Context, config authctx.TanzuContext, opsRetryTimeout time.Duration, tmcNps map[string]*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolNodepool, nps []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolDefinition) error {
 	for _, np := range nps {
 		tmcNp := tmcNps[np.Info.Name]
 
@@ -845,9 +847,9 @@ func handleNodepoolUpdates(config authctx.TanzuContext, opsRetryTimeout time.Dur
 			return errors.Wrapf(err, "failed to update nodepool %s", np.Info.Name)
 		}
 
-		getNodepoolResourceRetryableFn := getWaitForNodepoolReadyFn(config, tmcNp.FullName)
+		getNodepoolResourceRetryableFn := getWaitForNodepoolReadyFn(ctx, config, tmcNp.FullName)
 
-		_, err = helper.RetryUntilTimeout(getNodepoolResourceRetryableFn, 10*time.Second, opsRetryTimeout)
+		_, err = helper.RetryUntilTimeoutWithContext(ctx, getNodepoolResourceRetryableFn, 10*time.Second, opsRetryTimeout)
 		if err != nil {
 			return errors.Wrapf(err, "failed to verify EKS nodepool resource(%s) creation", np.Info.Name)
 		}
@@ -866,7 +868,7 @@ func fillTMCSetValues(tmcNpSpec *eksmodel.VmwareTanzuManageV1alpha1EksclusterNod
 	}
 }
 
-func handleNodepoolCreates(config authctx.TanzuContext, opsRetryTimeout time.Duration, clusterFn *eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName, nps []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolDefinition) error {
+func handleNodepoolCreates(ctx context.Context, config authctx.TanzuContext, opsRetryTimeout time.Duration, clusterFn *eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName, nps []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolDefinition) error {
 	err := createNodepools(config, clusterFn, nps)
 	if err != nil {
 		return errors.Wrap(err, "error while creating nodepools")
@@ -881,9 +883,9 @@ func handleNodepoolCreates(config authctx.TanzuContext, opsRetryTimeout time.Dur
 			Name:           np.Info.Name,
 		}
 
-		getNodepoolResourceRetryableFn := getWaitForNodepoolReadyFn(config, npFn)
+		getNodepoolResourceRetryableFn := getWaitForNodepoolReadyFn(ctx, config, npFn)
 
-		_, err := helper.RetryUntilTimeout(getNodepoolResourceRetryableFn, 10*time.Second, opsRetryTimeout)
+		_, err := helper.RetryUntilTimeoutWithContext(ctx, getNodepoolResourceRetryableFn, 10*time.Second, opsRetryTimeout)
 		if err != nil {
 			return errors.Wrapf(err, "failed to verify EKS nodepool resource(%s) creation", npFn.Name)
 		}
@@ -921,9 +923,9 @@ func createNodepools(config authctx.TanzuContext, clusterFn *eksmodel.VmwareTanz
 	return nil
 }
 
-func getWaitForNodepoolReadyFn(config authctx.TanzuContext, npFn *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolFullName) func() (retry bool, err error) {
+func getWaitForNodepoolReadyFn(ctx context.Context, config authctx.TanzuContext, npFn *eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolFullName) func() (retry bool, err error) {
 	return func() (retry bool, err error) {
-		resp, err := config.TMCConnection.EKSNodePoolResourceService.EksNodePoolResourceServiceGet(npFn)
+		resp, err := config.TMCConnection.EKSNodePoolResourceService.EksNodePoolResourceServiceGetWithContext(ctx, npFn)
 		if err != nil {
 			return true, errors.Wrapf(err, "Unable to get Tanzu Mission Control EKS nodepoool entry, name : %s", npFn.Name)
 		}
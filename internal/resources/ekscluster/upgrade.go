@@ -0,0 +1,159 @@
+/*
+Copyright 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package ekscluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	eksmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/ekscluster"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+// isOrchestratedUpgrade returns true when the Kubernetes version changes and the cluster is configured to upgrade
+// in the orchestrated mode.
+func isOrchestratedUpgrade(d *schema.ResourceData) bool {
+	return d.Get(upgradeModeKey) == helper.UpgradeModeOrchestrated && d.HasChange(kubernetesVersionPath)
+}
+
+// upgradeKubernetesVersion upgrades the cluster to the configured Kubernetes version. The target version is checked
+// against the available upgrade versions first, then the control plane is upgraded along with any other cluster change,
+// and finally each nodepool is rolled one after the other, according to its own update config.
+func upgradeKubernetesVersion(ctx context.Context, config authctx.TanzuContext, d *schema.ResourceData, tmcCluster *eksmodel.VmwareTanzuManageV1alpha1EksclusterEksCluster, clusterSpec *eksmodel.VmwareTanzuManageV1alpha1EksclusterSpec, nodepools []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolDefinition) diag.Diagnostics {
+	var current string
+	if tmcCluster.Spec != nil && tmcCluster.Spec.Config != nil {
+		current = tmcCluster.Spec.Config.Version
+	}
+
+	target := clusterSpec.Config.Version
+	opsRetryTimeout := getRetryTimeout(d, schema.TimeoutUpdate)
+
+	npresp, err := config.TMCConnection.EKSNodePoolResourceService.EksNodePoolResourceServiceList(tmcCluster.FullName)
+	if err != nil {
+		return diag.FromErr(errors.Wrapf(err, "failed to list nodepools for cluster: %s", tmcCluster.FullName.Name))
+	}
+
+	upgrades := nodepoolUpgradeRequests(npresp.Nodepools, nodepools, target)
+	progress := helper.NewUpgradeProgress(fmt.Sprintf("EKS cluster %s", tmcCluster.FullName.Name), len(upgrades)+2)
+
+	versionsResp, err := config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceGetUpgradeVersions(tmcCluster.FullName)
+	if err != nil {
+		return progress.Fail(errors.Wrapf(err, "Unable to get upgrade versions of Tanzu Mission Control EKS cluster entry, name : %s", tmcCluster.FullName.Name))
+	}
+
+	if err := helper.ValidateKubernetesUpgrade(current, target, versionsResp.AvailableUpgradeVersions); err != nil {
+		return progress.Fail(err)
+	}
+
+	progress.Step("pre-flight checks passed for the upgrade from %s to %s", current, target)
+
+	if err := handleClusterDiff(config, tmcCluster, common.ConstructMeta(d), clusterSpec); err != nil {
+		return progress.Fail(err)
+	}
+
+	_, err = helper.RetryUntilTimeoutWithContext(ctx, getWaitForClusterReadyFn(ctx, config, tmcCluster.FullName), 10*time.Second, opsRetryTimeout)
+	if err != nil {
+		return progress.Fail(errors.Wrapf(err, "failed to verify EKS cluster resource(%s) upgrade", tmcCluster.FullName.Name))
+	}
+
+	progress.Step("control plane upgraded to %s", target)
+
+	for _, req := range upgrades {
+		npFn := req.Nodepool.FullName
+
+		if _, err := config.TMCConnection.EKSNodePoolResourceService.EksNodePoolResourceServiceUpdate(req); err != nil {
+			return progress.Fail(errors.Wrapf(err, "failed to upgrade nodepool %s", npFn.Name))
+		}
+
+		_, err = helper.RetryUntilTimeoutWithContext(ctx, getWaitForNodepoolReadyFn(ctx, config, npFn), 10*time.Second, opsRetryTimeout)
+		if err != nil {
+			return progress.Fail(errors.Wrapf(err, "failed to verify EKS nodepool resource(%s) upgrade", npFn.Name))
+		}
+
+		progress.Step("nodepool %s upgraded to %s", npFn.Name, target)
+	}
+
+	return progress.Diagnostics
+}
+
+// nodepoolUpgradeRequests builds the update requests which roll the existing nodepools, ordered by name. Nodepools of
+// the configuration are updated to their configured spec, nodepools of the ekscluster_nodepool resource keep their
+// spec, and nodepools which are about to be deleted are skipped. Every request sets the nodepool version to the target.
+func nodepoolUpgradeRequests(tmcNps []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolNodepool, nodepools []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolDefinition, target string) []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIRequest {
+	npPosMap := nodepoolPosMap(nodepools)
+	requests := make([]*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIRequest, 0, len(tmcNps))
+
+	for _, tmcNp := range tmcNps {
+		description, spec := tmcNp.Meta.Description, tmcNp.Spec
+
+		if pos, ok := npPosMap[tmcNp.FullName.Name]; ok {
+			np := nodepools[pos]
+			fillTMCSetValues(tmcNp.Spec, np.Spec)
+			description, spec = np.Info.Description, np.Spec
+		} else if !isStandaloneNodepool(tmcNp) {
+			continue
+		}
+
+		upgraded := eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolSpec{}
+		if spec != nil {
+			upgraded = *spec
+		}
+
+		upgraded.Version = target
+
+		requests = append(requests, &eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolAPIRequest{
+			Nodepool: &eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolNodepool{
+				FullName: tmcNp.FullName,
+				Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+					Annotations:      tmcNp.Meta.Annotations,
+					Description:      description,
+					Labels:           tmcNp.Meta.Labels,
+					ParentReferences: tmcNp.Meta.ParentReferences,
+					ResourceVersion:  tmcNp.Meta.ResourceVersion,
+					UID:              tmcNp.Meta.UID,
+				},
+				Spec: &upgraded,
+			},
+		})
+	}
+
+	sort.Slice(requests, func(i, j int) bool {
+		return requests[i].Nodepool.FullName.Name < requests[j].Nodepool.FullName.Name
+	})
+
+	return requests
+}
+
+func getWaitForClusterReadyFn(ctx context.Context, config authctx.TanzuContext, clusterFn *eksmodel.VmwareTanzuManageV1alpha1EksclusterFullName) func() (retry bool, err error) {
+	return func() (retry bool, err error) {
+		resp, err := config.TMCConnection.EKSClusterResourceService.EksClusterResourceServiceGetWithContext(ctx, clusterFn)
+		if err != nil {
+			return true, errors.Wrapf(err, "Unable to get Tanzu Mission Control EKS cluster entry, name : %s", clusterFn.Name)
+		}
+
+		if resp.EksCluster.Status != nil && resp.EksCluster.Status.Phase != nil &&
+			*resp.EksCluster.Status.Phase != eksmodel.VmwareTanzuManageV1alpha1EksclusterPhaseREADY {
+			if c, ok := resp.EksCluster.Status.Conditions[readyCondition]; ok &&
+				c.Severity != nil &&
+				*c.Severity == eksmodel.VmwareTanzuCoreV1alpha1StatusConditionSeverityERROR {
+				return false, errors.Errorf("cluster %s in error state due to %s, %s", clusterFn.Name, c.Reason, c.Message)
+			}
+
+			return true, nil
+		}
+
+		return false, nil
+	}
+}
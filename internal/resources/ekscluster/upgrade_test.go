@@ -0,0 +1,48 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package ekscluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	eksmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/ekscluster"
+)
+
+func TestNodepoolUpgradeRequests(t *testing.T) {
+	configured := getNodepool("np-b", nil)
+	configured.Spec.RoleArn = "new-arn"
+
+	tmcNps := []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolNodepool{
+		getNodepool("np-c", map[string]string{standaloneNodepoolLabelKey: standaloneNodepoolLabelValue}),
+		getNodepool("np-b", nil),
+		getNodepool("np-a", nil),
+	}
+
+	nodepools := []*eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolDefinition{
+		{
+			Info: &eksmodel.VmwareTanzuManageV1alpha1EksclusterNodepoolInfo{Name: "np-b", Description: "configured"},
+			Spec: configured.Spec,
+		},
+	}
+
+	requests := nodepoolUpgradeRequests(tmcNps, nodepools, "1.27")
+
+	require.Len(t, requests, 2, "expected the nodepool pending deletion to be skipped")
+	require.Equal(t, "np-b", requests[0].Nodepool.FullName.Name)
+	require.Equal(t, "configured", requests[0].Nodepool.Meta.Description)
+	require.Equal(t, "new-arn", requests[0].Nodepool.Spec.RoleArn)
+	require.Equal(t, "np-c", requests[1].Nodepool.FullName.Name)
+	require.Equal(t, "some-arn", requests[1].Nodepool.Spec.RoleArn)
+	require.Equal(t, standaloneNodepoolLabelValue, requests[1].Nodepool.Meta.Labels[standaloneNodepoolLabelKey])
+
+	for _, req := range requests {
+		require.Equal(t, "1.27", req.Nodepool.Spec.Version, "expected nodepool %s to be upgraded to the target version", req.Nodepool.FullName.Name)
+	}
+
+	require.Empty(t, tmcNps[0].Spec.Version, "expected the listed nodepool spec to be left untouched")
+}
@@ -34,6 +34,9 @@ func ResourceGitRepository() *schema.Resource {
 		ReadContext:   dataSourceGitRepositoryRead,
 		UpdateContext: resourceGitRepositoryInPlaceUpdate,
 		DeleteContext: resourceGitRepositoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGitRepositoryImporter,
+		},
 		CustomizeDiff: schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey})),
 	}
 }
@@ -98,7 +101,7 @@ func resourceGitRepositoryCreate(ctx context.Context, d *schema.ResourceData, m
 
 	err := enableContinuousDelivery(&config, scopedFullnameData, meta)
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control git repository entry, name : %s", gitRepositoryName))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control git repository entry, name : %s", gitRepositoryName))
 	}
 
 	switch scopedFullnameData.Scope {
@@ -114,7 +117,7 @@ func resourceGitRepositoryCreate(ctx context.Context, d *schema.ResourceData, m
 
 			gitRepositoryResponse, err := config.TMCConnection.ClusterGitRepositoryResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdGitrepositoryResourceServiceCreate(gitRepositoryReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster git repository entry, name : %s", gitRepositoryName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster git repository entry, name : %s", gitRepositoryName))
 			}
 
 			UID = gitRepositoryResponse.GitRepository.Meta.UID
@@ -131,7 +134,7 @@ func resourceGitRepositoryCreate(ctx context.Context, d *schema.ResourceData, m
 
 			gitRepositoryResponse, err := config.TMCConnection.ClusterGroupGitRepositoryResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdGitrepositoryResourceServiceCreate(gitRepositoryReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group git repository entry, name : %s", gitRepositoryName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group git repository entry, name : %s", gitRepositoryName))
 			}
 
 			UID = gitRepositoryResponse.GitRepository.Meta.UID
@@ -170,14 +173,14 @@ func resourceGitRepositoryDelete(ctx context.Context, d *schema.ResourceData, m
 		if scopedFullnameData.FullnameCluster != nil {
 			err := config.TMCConnection.ClusterGitRepositoryResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdGitrepositoryResourceServiceDelete(scopedFullnameData.FullnameCluster)
 			if err != nil && !clienterrors.IsNotFoundError(err) {
-				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster git repository entry, name : %s", gitRepositoryName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster git repository entry, name : %s", gitRepositoryName))
 			}
 		}
 	case commonscope.ClusterGroupScope:
 		if scopedFullnameData.FullnameClusterGroup != nil {
 			err := config.TMCConnection.ClusterGroupGitRepositoryResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdGitrepositoryResourceServiceDelete(scopedFullnameData.FullnameClusterGroup)
 			if err != nil && !clienterrors.IsNotFoundError(err) {
-				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group git repository entry, name : %s", gitRepositoryName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group git repository entry, name : %s", gitRepositoryName))
 			}
 		}
 	case commonscope.UnknownScope:
@@ -243,7 +246,7 @@ func resourceGitRepositoryInPlaceUpdate(ctx context.Context, d *schema.ResourceD
 
 			_, err = config.TMCConnection.ClusterGitRepositoryResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdGitrepositoryResourceServiceUpdate(gitRepositoryReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster git repository entry, name : %s", gitRepositoryName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster git repository entry, name : %s", gitRepositoryName))
 			}
 		}
 	case commonscope.ClusterGroupScope:
@@ -260,7 +263,7 @@ func resourceGitRepositoryInPlaceUpdate(ctx context.Context, d *schema.ResourceD
 
 			_, err = config.TMCConnection.ClusterGroupGitRepositoryResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdGitrepositoryResourceServiceUpdate(gitRepositoryReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group git repository entry, name : %s", gitRepositoryName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group git repository entry, name : %s", gitRepositoryName))
 			}
 		}
 	case commonscope.UnknownScope:
@@ -316,3 +319,28 @@ func updateCheckForSpec(d *schema.ResourceData, atomicSpec *gitrepositorycluster
 
 	return true
 }
+
+// resourceGitRepositoryImporter imports a git repository using an ID of the form cluster/<management_cluster_name>/<provisioner_name>/<cluster_name>/<namespace_name>/<name>
+// or cluster_group/<cluster_group_name>/<namespace_name>/<name>.
+func resourceGitRepositoryImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	scopedFullnameData, err := scope.ConstructScopeFromImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	scopeData, name, namespaceName := scope.FlattenScope(scopedFullnameData)
+
+	if err = d.Set(nameKey, name); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the git repository %s", name)
+	}
+
+	if err = d.Set(namespaceNameKey, namespaceName); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set namespace name for the git repository %s", name)
+	}
+
+	if err = d.Set(commonscope.ScopeKey, scopeData); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set scope for the git repository %s", name)
+	}
+
+	return helper.ReadImportedState(ctx, d, m, dataSourceGitRepositoryRead)
+}
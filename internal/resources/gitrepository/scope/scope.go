@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"golang.org/x/exp/slices"
 
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	gitrepositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/cluster"
 	gitrepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/gitrepository/clustergroup"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
@@ -93,3 +94,37 @@ func FlattenScope(scopedFullname *ScopedFullname) (data []interface{}, name, nam
 
 	return []interface{}{flattenScopeData}, name, namespace
 }
+
+// ConstructScopeFromImportID parses a git repository import ID of the form cluster/<management_cluster_name>/<provisioner_name>/<cluster_name>/<namespace_name>/<name>
+// or cluster_group/<cluster_group_name>/<namespace_name>/<name>.
+func ConstructScopeFromImportID(id string) (scopedFullnameData *ScopedFullname, err error) {
+	scopeKey, parts, err := helper.ParseScopedImportID(id, commonscope.ImportIDFormats(ScopesAllowed[:], "namespace_name", commonscope.NameKey))
+	if err != nil {
+		return nil, err
+	}
+
+	switch scopeKey {
+	case commonscope.ClusterKey:
+		scopedFullnameData = &ScopedFullname{
+			Scope: commonscope.ClusterScope,
+			FullnameCluster: &gitrepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdGitrepositoryFullName{
+				ManagementClusterName: parts[0],
+				ProvisionerName:       parts[1],
+				ClusterName:           parts[2],
+				NamespaceName:         parts[3],
+				Name:                  parts[4],
+			},
+		}
+	case commonscope.ClusterGroupKey:
+		scopedFullnameData = &ScopedFullname{
+			Scope: commonscope.ClusterGroupScope,
+			FullnameClusterGroup: &gitrepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdGitrepositoryFullName{
+				ClusterGroupName: parts[0],
+				NamespaceName:    parts[1],
+				Name:             parts[2],
+			},
+		}
+	}
+
+	return scopedFullnameData, nil
+}
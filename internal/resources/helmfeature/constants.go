@@ -0,0 +1,11 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmfeature
+
+const (
+	ResourceName = "tanzu-mission-control_helm_feature"
+	statusKey    = "status"
+)
@@ -0,0 +1,30 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmfeature
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+)
+
+func initTestProvider(t *testing.T) *schema.Provider {
+	testProvider := &schema.Provider{
+		Schema: authctx.ProviderAuthSchema(),
+		ResourcesMap: map[string]*schema.Resource{
+			ResourceName: ResourceHelmFeature(),
+		},
+		ConfigureContextFunc: getConfigureContextFunc(),
+	}
+	if err := testProvider.InternalValidate(); err != nil {
+		require.NoError(t, err)
+	}
+
+	return testProvider
+}
@@ -0,0 +1,233 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmfeature
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	helmfeatureclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmfeature/cluster"
+	helmfeatureclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmfeature/clustergroup"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmfeature/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmfeature/status"
+)
+
+func ResourceHelmFeature() *schema.Resource {
+	return &schema.Resource{
+		Schema:        helmFeatureSchema,
+		CreateContext: resourceHelmFeatureCreate,
+		ReadContext:   resourceHelmFeatureRead,
+		DeleteContext: resourceHelmFeatureDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHelmFeatureImporter,
+		},
+		CustomizeDiff: schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey})),
+	}
+}
+
+var helmFeatureSchema = map[string]*schema.Schema{
+	commonscope.ScopeKey: scope.ScopeSchema,
+	statusKey:            status.StatusSchema,
+}
+
+func resourceHelmFeatureRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	scopedFullnameData := scope.ConstructScope(d)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to get Tanzu Mission Control Helm feature entry; Scope full name is empty")
+	}
+
+	var (
+		UID             string
+		flattenedStatus interface{}
+	)
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			resp, err := config.TMCConnection.ClusterHelmResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceServiceList(&helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmListHelmsRequestParameters{
+				SearchScope: &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmSearchScope{
+					ClusterName:           scopedFullnameData.FullnameCluster.ClusterName,
+					ManagementClusterName: scopedFullnameData.FullnameCluster.ManagementClusterName,
+					ProvisionerName:       scopedFullnameData.FullnameCluster.ProvisionerName,
+				},
+			})
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					_ = schema.RemoveFromState(d, m)
+					return
+				}
+
+				return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster Helm feature entry, cluster : %s", scopedFullnameData.FullnameCluster.ClusterName))
+			}
+
+			if len(resp.Helms) == 0 || resp.Helms[0].Meta == nil {
+				_ = schema.RemoveFromState(d, m)
+				return
+			}
+
+			scopedFullnameData.FullnameCluster = resp.Helms[0].FullName
+			UID = resp.Helms[0].Meta.UID
+			flattenedStatus = status.FlattenStatusForClusterScope(resp.Helms[0].Status)
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			resp, err := config.TMCConnection.ClusterGroupHelmResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceServiceList(&helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmListHelmsRequestParameters{
+				SearchScope: &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmSearchScope{
+					ClusterGroupName: scopedFullnameData.FullnameClusterGroup.ClusterGroupName,
+				},
+			})
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					_ = schema.RemoveFromState(d, m)
+					return
+				}
+
+				return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster group Helm feature entry, cluster group : %s", scopedFullnameData.FullnameClusterGroup.ClusterGroupName))
+			}
+
+			if len(resp.Helms) == 0 || resp.Helms[0].Meta == nil {
+				_ = schema.RemoveFromState(d, m)
+				return
+			}
+
+			scopedFullnameData.FullnameClusterGroup = resp.Helms[0].FullName
+			UID = resp.Helms[0].Meta.UID
+			flattenedStatus = status.FlattenStatusForClusterGroupScope(resp.Helms[0].Status)
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	// always run
+	d.SetId(UID)
+
+	if err := d.Set(commonscope.ScopeKey, scope.FlattenScope(scopedFullnameData)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(statusKey, flattenedStatus); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// resourceHelmFeatureCreate enables the Helm feature on the scope.
+// A Helm feature which is already enabled, for instance by the creation of a Helm release, is adopted.
+func resourceHelmFeatureCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	scopedFullnameData := scope.ConstructScope(d)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to create Tanzu Mission Control Helm feature entry; Scope full name is empty")
+	}
+
+	meta := &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			helmReq := &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmRequest{
+				Helm: &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelm{
+					FullName: scopedFullnameData.FullnameCluster,
+					Meta:     meta,
+				},
+			}
+
+			_, err := config.TMCConnection.ClusterHelmResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceServiceCreate(helmReq)
+			if err != nil && !clienterrors.IsAlreadyExistsError(err) {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster Helm feature entry, cluster : %s", scopedFullnameData.FullnameCluster.ClusterName))
+			}
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			helmReq := &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmRequest{
+				Helm: &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelm{
+					FullName: scopedFullnameData.FullnameClusterGroup,
+					Meta:     meta,
+				},
+			}
+
+			_, err := config.TMCConnection.ClusterGroupHelmResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceServiceCreate(helmReq)
+			if err != nil && !clienterrors.IsAlreadyExistsError(err) {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group Helm feature entry, cluster group : %s", scopedFullnameData.FullnameClusterGroup.ClusterGroupName))
+			}
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	diags = resourceHelmFeatureRead(ctx, d, m)
+	if !diags.HasError() && d.Id() == "" {
+		return diag.Errorf("Unable to read Tanzu Mission Control Helm feature entry after enabling it")
+	}
+
+	return diags
+}
+
+// resourceHelmFeatureDelete disables the Helm feature on the scope.
+func resourceHelmFeatureDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	scopedFullnameData := scope.ConstructScope(d)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to delete Tanzu Mission Control Helm feature entry; Scope full name is empty")
+	}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			err := config.TMCConnection.ClusterHelmResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceServiceDelete(scopedFullnameData.FullnameCluster)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster Helm feature entry, cluster : %s", scopedFullnameData.FullnameCluster.ClusterName))
+			}
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			err := config.TMCConnection.ClusterGroupHelmResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceServiceDelete(scopedFullnameData.FullnameClusterGroup)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group Helm feature entry, cluster group : %s", scopedFullnameData.FullnameClusterGroup.ClusterGroupName))
+			}
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// resourceHelmFeatureImporter imports a Helm feature using an ID of the form cluster/<management_cluster_name>/<provisioner_name>/<cluster_name>
+// or cluster_group/<cluster_group_name>.
+func resourceHelmFeatureImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	scopedFullnameData, err := scope.ConstructScopeFromImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.Set(commonscope.ScopeKey, scope.FlattenScope(scopedFullnameData)); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set scope for the Helm feature %s", d.Id())
+	}
+
+	return helper.ReadImportedState(ctx, d, m, resourceHelmFeatureRead)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmfeature
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	helmfeatureclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmfeature/clustergroup"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+type mockClusterGroupHelmClient struct {
+	createErr error
+	helms     []*helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelm
+}
+
+func (m *mockClusterGroupHelmClient) VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceServiceCreate(_ *helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmRequest) (*helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmResponse, error) {
+	return &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmResponse{}, m.createErr
+}
+
+func (m *mockClusterGroupHelmClient) VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceServiceDelete(_ *helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmFullName) error {
+	return nil
+}
+
+func (m *mockClusterGroupHelmClient) VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceServiceList(_ *helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmListHelmsRequestParameters) (*helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmListHelmsResponse, error) {
+	return &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmListHelmsResponse{Helms: m.helms}, nil
+}
+
+func TestResourceHelmFeatureCreate(t *testing.T) {
+	t.Parallel()
+
+	enabledHelm := &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelm{
+		FullName: &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmFullName{ClusterGroupName: "test-cg"},
+		Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "helm-uid"},
+	}
+
+	cases := []struct {
+		description string
+		createErr   error
+		expectError bool
+	}{
+		{
+			description: "Helm feature enabled",
+		},
+		{
+			description: "Helm feature already enabled on the cluster group",
+			createErr:   clienterrors.ErrorWithHTTPCode(http.StatusConflict, nil),
+		},
+		{
+			description: "enabling the Helm feature fails",
+			createErr:   clienterrors.ErrorWithHTTPCode(http.StatusInternalServerError, nil),
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			config := authctx.TanzuContext{
+				TMCConnection: &client.TanzuMissionControl{
+					ClusterGroupHelmResourceService: &mockClusterGroupHelmClient{
+						createErr: test.createErr,
+						helms:     []*helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelm{enabledHelm},
+					},
+				},
+			}
+
+			d := schema.TestResourceDataRaw(t, helmFeatureSchema, map[string]interface{}{
+				commonscope.ScopeKey: []interface{}{
+					map[string]interface{}{
+						commonscope.ClusterGroupKey: []interface{}{
+							map[string]interface{}{commonscope.NameKey: "test-cg"},
+						},
+					},
+				},
+			})
+
+			diags := ResourceHelmFeature().CreateContext(context.Background(), d, config)
+
+			require.Equal(t, test.expectError, diags.HasError(), diags)
+
+			if !test.expectError {
+				require.Equal(t, "helm-uid", d.Id())
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmfeature
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	helmfeatureclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmfeature/clustergroup"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+const (
+	https                = "https:/"
+	cgAPIVersionAndGroup = "v1alpha1/clustergroups"
+	apiKind              = "fluxcd/helm"
+)
+
+// bodyInspectingResponder checks the body of the request against the expected content before responding.
+func bodyInspectingResponder(t *testing.T, expectedContent interface{}, successResponse int, successResponseBody interface{}) httpmock.Responder {
+	return func(r *http.Request) (*http.Response, error) {
+		if expectedContent != nil {
+			expectedBytes, err := json.Marshal(expectedContent)
+			if err != nil {
+				return nil, err
+			}
+
+			if r.Body == nil {
+				t.Errorf("expected body on request %s %s", r.Method, r.URL)
+				return httpmock.NewStringResponse(http.StatusBadRequest, "expected body on request"), nil
+			}
+
+			bodyBytes, err := io.ReadAll(r.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			var bodyInterface, expectedInterface map[string]interface{}
+
+			if err := json.Unmarshal(bodyBytes, &bodyInterface); err != nil {
+				return nil, err
+			}
+
+			if err := json.Unmarshal(expectedBytes, &expectedInterface); err != nil {
+				return nil, err
+			}
+
+			if diff := deep.Equal(bodyInterface, expectedInterface); diff != nil {
+				t.Errorf("unexpected body on request %s %s: %v", r.Method, r.URL, diff)
+				return httpmock.NewStringResponse(http.StatusBadRequest, "unexpected body on request"), nil
+			}
+		}
+
+		return httpmock.NewJsonResponse(successResponse, successResponseBody)
+	}
+}
+
+// Register a new responder when the given call is made.
+func changeStateResponder(registerFunc func(), responder httpmock.Responder) httpmock.Responder {
+	return func(r *http.Request) (*http.Response, error) {
+		resp, err := responder(r)
+		if err == nil && resp.StatusCode == http.StatusOK {
+			registerFunc()
+		}
+
+		return resp, err
+	}
+}
+
+func (testConfig *testAcceptanceConfig) helmFeature() *helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelm {
+	return &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelm{
+		FullName: &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmFullName{
+			ClusterGroupName: testConfig.ClusterGroupName,
+		},
+		Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{},
+	}
+}
+
+func (testConfig *testAcceptanceConfig) enabledHelmFeature() *helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelm {
+	helmFeature := testConfig.helmFeature()
+
+	helmFeature.Meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+		UID:             "helm-feature-uid",
+		ResourceVersion: "v1",
+	}
+
+	helmFeature.Status = &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmStatus{
+		Phase: statusmodel.NewVmwareTanzuManageV1alpha1CommonBatchPhase(statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED),
+		Details: &statusmodel.VmwareTanzuManageV1alpha1CommonBatchDetails{
+			Applied:          1,
+			AvailableTargets: 1,
+		},
+	}
+
+	return helmFeature
+}
+
+func (testConfig *testAcceptanceConfig) setupHTTPMocks(t *testing.T) {
+	httpmock.Activate()
+	t.Cleanup(httpmock.Deactivate)
+
+	endpoint := os.Getenv("TMC_ENDPOINT")
+
+	postRequest := &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmRequest{
+		Helm: testConfig.helmFeature(),
+	}
+
+	postResponse := &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmResponse{
+		Helm: testConfig.enabledHelmFeature(),
+	}
+
+	listResponse := &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmListHelmsResponse{
+		Helms:      []*helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelm{testConfig.enabledHelmFeature()},
+		TotalCount: "1",
+	}
+
+	endpointURL := helper.ConstructRequestURL(https, endpoint, cgAPIVersionAndGroup, testConfig.ClusterGroupName, apiKind).String()
+
+	httpmock.RegisterResponder("POST", endpointURL,
+		bodyInspectingResponder(t, postRequest, http.StatusOK, postResponse))
+
+	httpmock.RegisterResponder("GET", endpointURL,
+		bodyInspectingResponder(t, nil, http.StatusOK, listResponse))
+
+	httpmock.RegisterResponder("DELETE", endpointURL, changeStateResponder(
+		// Set up the list to return no Helm feature after it has been 'disabled'.
+		func() {
+			httpmock.RegisterResponder("GET", endpointURL,
+				bodyInspectingResponder(t, nil, http.StatusOK, &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmListHelmsResponse{}))
+		},
+		httpmock.NewStringResponder(http.StatusOK, "{}")))
+}
@@ -0,0 +1,127 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmfeature
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	testhelper "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/testing"
+)
+
+const (
+	helmFeatureEnvTestFlag = "ENABLE_HELM_FEATURE_ENV_TEST"
+	helmFeatureResourceVar = "test_helm_feature"
+	helmFeatureNamePrefix  = "tf-helm-feature-test"
+)
+
+type testAcceptanceConfig struct {
+	Provider                *schema.Provider
+	ClusterGroupName        string
+	HelmFeatureResourceName string
+}
+
+func testGetDefaultAcceptanceConfig(t *testing.T) *testAcceptanceConfig {
+	return &testAcceptanceConfig{
+		Provider:                initTestProvider(t),
+		ClusterGroupName:        getEnvOrDefault("HELM_FEATURE_CLUSTER_GROUP_NAME", acctest.RandomWithPrefix(helmFeatureNamePrefix)),
+		HelmFeatureResourceName: fmt.Sprintf("%s.%s", ResourceName, helmFeatureResourceVar),
+	}
+}
+
+func getEnvOrDefault(name string, defaultValue string) string {
+	if value, found := os.LookupEnv(name); found {
+		return value
+	}
+
+	return defaultValue
+}
+
+func getConfigureContextFunc() func(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	if _, found := os.LookupEnv(helmFeatureEnvTestFlag); !found {
+		return authctx.ProviderConfigureContextWithDefaultTransportForTesting
+	}
+
+	return authctx.ProviderConfigureContext
+}
+
+func TestAcceptanceForHelmFeatureResource(t *testing.T) {
+	testConfig := testGetDefaultAcceptanceConfig(t)
+
+	// If the flag to execute Helm feature tests is not found, run this as a mock test by setting up an http intercept for each endpoint.
+	_, found := os.LookupEnv(helmFeatureEnvTestFlag)
+	if !found {
+		os.Setenv("TF_ACC", "true")
+		os.Setenv("TMC_ENDPOINT", "dummy.tmc.mock.vmware.com")
+		os.Setenv("VMW_CLOUD_API_TOKEN", "dummy")
+		os.Setenv("VMW_CLOUD_ENDPOINT", "console.cloud.vmware.com")
+
+		log.Println("Setting up the mock endpoints...")
+		testConfig.setupHTTPMocks(t)
+	} else {
+		// Environment variables with non default values required for a successful call to the Helm feature service.
+		requiredVars := []string{
+			"VMW_CLOUD_ENDPOINT",
+			"TMC_ENDPOINT",
+			"VMW_CLOUD_API_TOKEN",
+			"HELM_FEATURE_CLUSTER_GROUP_NAME",
+		}
+
+		// Check if the required environment variables are set.
+		for _, name := range requiredVars {
+			if _, found := os.LookupEnv(name); !found {
+				t.Errorf("required environment variable '%s' missing", name)
+			}
+		}
+	}
+
+	t.Log("start Helm feature resource acceptance tests!")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testhelper.TestPreCheck(t),
+		ProviderFactories: testhelper.GetTestProviderFactories(testConfig.Provider),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig.getTestHelmFeatureResourceConfigValue(),
+				Check:  testConfig.checkHelmFeatureResourceAttributes(),
+			},
+		},
+	},
+	)
+
+	t.Log("Helm feature resource acceptance test completed")
+}
+
+func (testConfig *testAcceptanceConfig) getTestHelmFeatureResourceConfigValue() string {
+	return fmt.Sprintf(`
+	resource "%s" "%s" {
+	  scope {
+	    cluster_group {
+	      name = "%s"
+	    }
+	  }
+	}
+	`,
+		ResourceName, helmFeatureResourceVar, testConfig.ClusterGroupName,
+	)
+}
+
+func (testConfig *testAcceptanceConfig) checkHelmFeatureResourceAttributes() resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr(testConfig.HelmFeatureResourceName, "scope.0.cluster_group.0.name", testConfig.ClusterGroupName),
+		resource.TestCheckResourceAttr(testConfig.HelmFeatureResourceName, "status.phase", "APPLIED"),
+	)
+}
@@ -0,0 +1,40 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package scope
+
+import (
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	helmfeatureclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmfeature/clustergroup"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+func ConstructClusterGroupHelmFullname(data []interface{}) (fullname *helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmFullName) {
+	if len(data) == 0 || data[0] == nil {
+		return fullname
+	}
+
+	fullNameData, _ := data[0].(map[string]interface{})
+
+	fullname = &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmFullName{}
+
+	if nameValue, ok := fullNameData[commonscope.NameKey]; ok {
+		helper.SetPrimitiveValue(nameValue, &fullname.ClusterGroupName, commonscope.NameKey)
+	}
+
+	return fullname
+}
+
+func FlattenClusterGroupHelmFullname(fullname *helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmFullName) (data []interface{}) {
+	if fullname == nil {
+		return data
+	}
+
+	flattenFullname := make(map[string]interface{})
+
+	flattenFullname[commonscope.NameKey] = fullname.ClusterGroupName
+
+	return []interface{}{flattenFullname}
+}
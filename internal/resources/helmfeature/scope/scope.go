@@ -0,0 +1,122 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package scope
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	helmfeatureclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmfeature/cluster"
+	helmfeatureclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmfeature/clustergroup"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+// ScopedFullname is a struct for all types of Helm feature full names.
+type ScopedFullname struct {
+	Scope                commonscope.Scope
+	FullnameCluster      *helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmFullName
+	FullnameClusterGroup *helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmFullName
+}
+
+var (
+	ScopesAllowed = [...]string{commonscope.ClusterKey, commonscope.ClusterGroupKey}
+	ScopeSchema   = commonscope.GetScopeSchema(
+		commonscope.WithDescription(fmt.Sprintf("Scope for the Helm feature, having one of the valid scopes: %v.", strings.Join(ScopesAllowed[:], `, `))),
+		commonscope.WithScopes(ScopesAllowed[:]))
+)
+
+func ConstructScope(d *schema.ResourceData) (scopedFullnameData *ScopedFullname) {
+	value, ok := d.GetOk(commonscope.ScopeKey)
+
+	if !ok {
+		return scopedFullnameData
+	}
+
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return scopedFullnameData
+	}
+
+	scopeData := data[0].(map[string]interface{})
+
+	if clusterData, ok := scopeData[commonscope.ClusterKey]; ok && slices.Contains(ScopesAllowed[:], commonscope.ClusterKey) {
+		if clusterValue, ok := clusterData.([]interface{}); ok && len(clusterValue) != 0 {
+			scopedFullnameData = &ScopedFullname{
+				Scope:           commonscope.ClusterScope,
+				FullnameCluster: ConstructClusterHelmFullname(clusterValue),
+			}
+		}
+	}
+
+	if clusterGroupData, ok := scopeData[commonscope.ClusterGroupKey]; ok && slices.Contains(ScopesAllowed[:], commonscope.ClusterGroupKey) {
+		if clusterGroupValue, ok := clusterGroupData.([]interface{}); ok && len(clusterGroupValue) != 0 {
+			scopedFullnameData = &ScopedFullname{
+				Scope:                commonscope.ClusterGroupScope,
+				FullnameClusterGroup: ConstructClusterGroupHelmFullname(clusterGroupValue),
+			}
+		}
+	}
+
+	return scopedFullnameData
+}
+
+func FlattenScope(scopedFullname *ScopedFullname) (data []interface{}) {
+	if scopedFullname == nil {
+		return data
+	}
+
+	flattenScopeData := make(map[string]interface{})
+
+	switch scopedFullname.Scope {
+	case commonscope.ClusterScope:
+		if slices.Contains(ScopesAllowed[:], commonscope.ClusterKey) {
+			flattenScopeData[commonscope.ClusterKey] = FlattenClusterHelmFullname(scopedFullname.FullnameCluster)
+		}
+	case commonscope.ClusterGroupScope:
+		if slices.Contains(ScopesAllowed[:], commonscope.ClusterGroupKey) {
+			flattenScopeData[commonscope.ClusterGroupKey] = FlattenClusterGroupHelmFullname(scopedFullname.FullnameClusterGroup)
+		}
+	case commonscope.UnknownScope:
+		fmt.Printf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(ScopesAllowed[:], `, `))
+	}
+
+	return []interface{}{flattenScopeData}
+}
+
+// ConstructScopeFromImportID parses a Helm feature import ID of the form cluster/<management_cluster_name>/<provisioner_name>/<cluster_name>
+// or cluster_group/<cluster_group_name>.
+func ConstructScopeFromImportID(id string) (scopedFullnameData *ScopedFullname, err error) {
+	scopeKey, parts, err := helper.ParseScopedImportID(id, commonscope.ImportIDFormats(ScopesAllowed[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	switch scopeKey {
+	case commonscope.ClusterKey:
+		scopedFullnameData = &ScopedFullname{
+			Scope: commonscope.ClusterScope,
+			FullnameCluster: &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmFullName{
+				ManagementClusterName: parts[0],
+				ProvisionerName:       parts[1],
+				ClusterName:           parts[2],
+			},
+		}
+	case commonscope.ClusterGroupKey:
+		scopedFullnameData = &ScopedFullname{
+			Scope: commonscope.ClusterGroupScope,
+			FullnameClusterGroup: &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmFullName{
+				ClusterGroupName: parts[0],
+			},
+		}
+	}
+
+	return scopedFullnameData, nil
+}
@@ -0,0 +1,14 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package status
+
+const (
+	conditionReady   = "Ready"
+	conditionEnabled = "Enabled"
+
+	stateKey = "state"
+	phaseKey = "phase"
+)
@@ -0,0 +1,13 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmrelease
+
+const (
+	ResourceName     = "tanzu-mission-control_helm_release"
+	nameKey          = "name"
+	namespaceNameKey = "namespace_name"
+	statusKey        = "status"
+)
@@ -0,0 +1,68 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmrelease
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	helmfeatureclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmfeature/cluster"
+	helmfeatureclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmfeature/clustergroup"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrelease/scope"
+)
+
+// enableHelm enables the Helm feature on the scope of the Helm release, if it is not enabled yet.
+func enableHelm(config *authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) error {
+	if config == nil || scopedFullnameData == nil || meta == nil {
+		return errors.New("missing variables: error while enabling Tanzu Mission Control Helm feature")
+	}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			helmReq := &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmRequest{
+				Helm: &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelm{
+					FullName: &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmFullName{
+						ClusterName:           scopedFullnameData.FullnameCluster.ClusterName,
+						ManagementClusterName: scopedFullnameData.FullnameCluster.ManagementClusterName,
+						ProvisionerName:       scopedFullnameData.FullnameCluster.ProvisionerName,
+					},
+					Meta: meta,
+				},
+			}
+
+			_, err := config.TMCConnection.ClusterHelmResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceServiceCreate(helmReq)
+			if err != nil && !clienterrors.IsAlreadyExistsError(err) {
+				return err
+			}
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			helmReq := &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmRequest{
+				Helm: &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelm{
+					FullName: &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmFullName{
+						ClusterGroupName: scopedFullnameData.FullnameClusterGroup.ClusterGroupName,
+					},
+					Meta: meta,
+				},
+			}
+
+			_, err := config.TMCConnection.ClusterGroupHelmResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmResourceServiceCreate(helmReq)
+			if err != nil && !clienterrors.IsAlreadyExistsError(err) {
+				return err
+			}
+		}
+	case commonscope.UnknownScope:
+		return fmt.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmrelease
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	helmfeatureclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmfeature/cluster"
+	helmreleaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrelease/scope"
+)
+
+type mockClusterHelmClient struct {
+	createErr error
+}
+
+func (m *mockClusterHelmClient) VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceServiceCreate(_ *helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmRequest) (*helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmResponse, error) {
+	return &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmResponse{}, m.createErr
+}
+
+func (m *mockClusterHelmClient) VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceServiceDelete(_ *helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmFullName) error {
+	return nil
+}
+
+func (m *mockClusterHelmClient) VmwareTanzuManageV1alpha1ClusterFluxcdHelmResourceServiceList(_ *helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmListHelmsRequestParameters) (*helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmListHelmsResponse, error) {
+	return &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmListHelmsResponse{}, nil
+}
+
+func TestEnableHelm(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		createErr   error
+		expectError bool
+	}{
+		{
+			description: "Helm feature enabled",
+		},
+		{
+			description: "Helm feature already enabled on the cluster",
+			createErr:   clienterrors.ErrorWithHTTPCode(http.StatusConflict, nil),
+		},
+		{
+			description: "enabling the Helm feature fails",
+			createErr:   clienterrors.ErrorWithHTTPCode(http.StatusInternalServerError, nil),
+			expectError: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			config := &authctx.TanzuContext{
+				TMCConnection: &client.TanzuMissionControl{
+					ClusterHelmResourceService: &mockClusterHelmClient{createErr: test.createErr},
+				},
+			}
+
+			scopedFullname := &scope.ScopedFullname{
+				Scope: commonscope.ClusterScope,
+				FullnameCluster: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseFullName{
+					ClusterName:           "test-cluster",
+					ManagementClusterName: "attached",
+					ProvisionerName:       "attached",
+				},
+			}
+
+			err := enableHelm(config, scopedFullname, &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{})
+
+			if test.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,466 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmrelease
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	helmreleaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
+	helmreleaseclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/clustergroup"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrelease/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrelease/spec"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrelease/status"
+)
+
+type dataFromServer struct {
+	UID                     string
+	meta                    *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+	atomicSpec              *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec
+	clusterScopeStatus      *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseStatus
+	clusterGroupScopeStatus *helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseStatus
+}
+
+func ResourceHelmRelease() *schema.Resource {
+	return &schema.Resource{
+		Schema:        helmReleaseSchema,
+		CreateContext: resourceHelmReleaseCreate,
+		ReadContext:   resourceHelmReleaseRead,
+		UpdateContext: resourceHelmReleaseInPlaceUpdate,
+		DeleteContext: resourceHelmReleaseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHelmReleaseImporter,
+		},
+		CustomizeDiff: schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey})),
+	}
+}
+
+var helmReleaseSchema = map[string]*schema.Schema{
+	nameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the Helm release.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	namespaceNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of Namespace.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	commonscope.ScopeKey: scope.ScopeSchema,
+	common.MetaKey:       common.Meta,
+	spec.SpecKey:         spec.SpecSchema,
+	statusKey:            status.StatusSchema,
+}
+
+func resourceHelmReleaseRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	helmReleaseName, ok := d.Get(nameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read Helm release name")
+	}
+
+	helmReleaseNamespaceName, ok := d.Get(namespaceNameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read Helm release namespace name")
+	}
+
+	scopedFullnameData := scope.ConstructScope(d, helmReleaseName, helmReleaseNamespaceName)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to get Tanzu Mission Control Helm release entry; Scope full name is empty")
+	}
+
+	helmReleaseDataFromServer, err := retrieveHelmReleaseDataFromServer(config, scopedFullnameData, d)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			_ = schema.RemoveFromState(d, m)
+			return
+		}
+
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(helmReleaseDataFromServer.UID)
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(helmReleaseDataFromServer.meta)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var (
+		flattenedSpec   []interface{}
+		flattenedStatus interface{}
+	)
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		flattenedSpec = spec.FlattenSpecForClusterScope(helmReleaseDataFromServer.atomicSpec)
+		flattenedStatus = status.FlattenStatusForClusterScope(helmReleaseDataFromServer.clusterScopeStatus)
+	case commonscope.ClusterGroupScope:
+		clusterGroupScopeSpec := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseSpec{
+			AtomicSpec: helmReleaseDataFromServer.atomicSpec,
+		}
+		flattenedSpec = spec.FlattenSpecForClusterGroupScope(clusterGroupScopeSpec)
+		flattenedStatus = status.FlattenStatusForClusterGroupScope(helmReleaseDataFromServer.clusterGroupScopeStatus)
+	}
+
+	if err := d.Set(spec.SpecKey, flattenedSpec); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(statusKey, flattenedStatus); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// nolint: gocognit
+func retrieveHelmReleaseDataFromServer(config authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname, d *schema.ResourceData) (*dataFromServer, error) {
+	var helmReleaseDataFromServer = &dataFromServer{}
+
+	// nolint: dupl
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			resp, err := config.TMCConnection.ClusterHelmReleaseResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceGet(scopedFullnameData.FullnameCluster)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return helmReleaseDataFromServer, err
+				}
+
+				return helmReleaseDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster Helm release entry, name : %s", scopedFullnameData.FullnameCluster.Name)
+			}
+
+			scopedFullnameData.FullnameCluster = resp.Release.FullName
+			helmReleaseDataFromServer.UID = resp.Release.Meta.UID
+			helmReleaseDataFromServer.meta = resp.Release.Meta
+			helmReleaseDataFromServer.atomicSpec = resp.Release.Spec
+			helmReleaseDataFromServer.clusterScopeStatus = resp.Release.Status
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			resp, err := config.TMCConnection.ClusterGroupHelmReleaseResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceGet(scopedFullnameData.FullnameClusterGroup)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return helmReleaseDataFromServer, err
+				}
+
+				return helmReleaseDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster group Helm release entry, name : %s", scopedFullnameData.FullnameClusterGroup.Name)
+			}
+
+			scopedFullnameData.FullnameClusterGroup = resp.Release.FullName
+			helmReleaseDataFromServer.UID = resp.Release.Meta.UID
+			helmReleaseDataFromServer.meta = resp.Release.Meta
+			helmReleaseDataFromServer.atomicSpec = resp.Release.Spec.AtomicSpec
+			helmReleaseDataFromServer.clusterGroupScopeStatus = resp.Release.Status
+		}
+	case commonscope.UnknownScope:
+		return helmReleaseDataFromServer, errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	fullName, name, namespace := scope.FlattenScope(scopedFullnameData)
+
+	if err := d.Set(nameKey, name); err != nil {
+		return helmReleaseDataFromServer, err
+	}
+
+	if err := d.Set(namespaceNameKey, namespace); err != nil {
+		return helmReleaseDataFromServer, err
+	}
+
+	if err := d.Set(commonscope.ScopeKey, fullName); err != nil {
+		return helmReleaseDataFromServer, err
+	}
+
+	return helmReleaseDataFromServer, nil
+}
+
+func resourceHelmReleaseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	helmReleaseName, ok := d.Get(nameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read Helm release name")
+	}
+
+	helmReleaseNamespaceName, ok := d.Get(namespaceNameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read Helm release namespace name")
+	}
+
+	scopedFullnameData := scope.ConstructScope(d, helmReleaseName, helmReleaseNamespaceName)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to create Tanzu Mission Control Helm release entry; Scope full name is empty")
+	}
+
+	var (
+		UID  string
+		meta = common.ConstructMeta(d)
+	)
+
+	err := enableHelm(&config, scopedFullnameData, meta)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control Helm release entry, name : %s", helmReleaseName))
+	}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			helmReleaseReq := &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseRequest{
+				Release: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRelease{
+					FullName: scopedFullnameData.FullnameCluster,
+					Meta:     meta,
+					Spec:     spec.ConstructSpecForClusterScope(d),
+				},
+			}
+
+			helmReleaseResponse, err := config.TMCConnection.ClusterHelmReleaseResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceCreate(helmReleaseReq)
+			if err != nil {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster Helm release entry, name : %s", helmReleaseName))
+			}
+
+			UID = helmReleaseResponse.Release.Meta.UID
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			helmReleaseReq := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseRequest{
+				Release: &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseRelease{
+					FullName: scopedFullnameData.FullnameClusterGroup,
+					Meta:     meta,
+					Spec:     spec.ConstructSpecForClusterGroupScope(d),
+				},
+			}
+
+			helmReleaseResponse, err := config.TMCConnection.ClusterGroupHelmReleaseResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceCreate(helmReleaseReq)
+			if err != nil {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group Helm release entry, name : %s", helmReleaseName))
+			}
+
+			UID = helmReleaseResponse.Release.Meta.UID
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	// always run
+	d.SetId(UID)
+
+	return resourceHelmReleaseRead(ctx, d, m)
+}
+
+func resourceHelmReleaseDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	helmReleaseName, ok := d.Get(nameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read Helm release name")
+	}
+
+	helmReleaseNamespaceName, ok := d.Get(namespaceNameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read Helm release namespace name")
+	}
+
+	scopedFullnameData := scope.ConstructScope(d, helmReleaseName, helmReleaseNamespaceName)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to delete Tanzu Mission Control Helm release entry; Scope full name is empty")
+	}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			err := config.TMCConnection.ClusterHelmReleaseResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceDelete(scopedFullnameData.FullnameCluster)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster Helm release entry, name : %s", helmReleaseName))
+			}
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			err := config.TMCConnection.ClusterGroupHelmReleaseResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceDelete(scopedFullnameData.FullnameClusterGroup)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group Helm release entry, name : %s", helmReleaseName))
+			}
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+func resourceHelmReleaseInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	helmReleaseName, ok := d.Get(nameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read Helm release name")
+	}
+
+	helmReleaseNamespaceName, ok := d.Get(namespaceNameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read Helm release namespace name")
+	}
+
+	scopedFullnameData := scope.ConstructScope(d, helmReleaseName, helmReleaseNamespaceName)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to update Tanzu Mission Control Helm release entry; Scope full name is empty")
+	}
+
+	// nolint: dogsled
+	helmReleaseDataFromServer, err := retrieveHelmReleaseDataFromServer(config, scopedFullnameData, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var updateAvailable bool
+
+	if updateCheckForMeta(d, helmReleaseDataFromServer.meta) {
+		updateAvailable = true
+	}
+
+	if updateCheckForSpec(d, helmReleaseDataFromServer.atomicSpec, scopedFullnameData.Scope) {
+		updateAvailable = true
+	}
+
+	if !updateAvailable {
+		log.Printf("[INFO] Helm release update is not required")
+		return
+	}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			helmReleaseReq := &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseRequest{
+				Release: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRelease{
+					FullName: scopedFullnameData.FullnameCluster,
+					Meta:     helmReleaseDataFromServer.meta,
+					Spec:     helmReleaseDataFromServer.atomicSpec,
+				},
+			}
+
+			_, err = config.TMCConnection.ClusterHelmReleaseResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceUpdate(helmReleaseReq)
+			if err != nil {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster Helm release entry, name : %s", helmReleaseName))
+			}
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			helmReleaseReq := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseRequest{
+				Release: &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseRelease{
+					FullName: scopedFullnameData.FullnameClusterGroup,
+					Meta:     helmReleaseDataFromServer.meta,
+					Spec: &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseSpec{
+						AtomicSpec: helmReleaseDataFromServer.atomicSpec,
+					},
+				},
+			}
+
+			_, err = config.TMCConnection.ClusterGroupHelmReleaseResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceUpdate(helmReleaseReq)
+			if err != nil {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group Helm release entry, name : %s", helmReleaseName))
+			}
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	log.Printf("[INFO] Helm release update successful")
+
+	return resourceHelmReleaseRead(ctx, d, m)
+}
+
+func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) bool {
+	if !common.HasMetaChanged(d) {
+		return false
+	}
+
+	objectMeta := common.ConstructMeta(d)
+
+	if value, ok := meta.Labels[common.CreatorLabelKey]; ok {
+		objectMeta.Labels[common.CreatorLabelKey] = value
+	}
+
+	meta.Labels = objectMeta.Labels
+	meta.Description = objectMeta.Description
+
+	log.Printf("[INFO] updating Helm release meta data")
+
+	return true
+}
+
+func updateCheckForSpec(d *schema.ResourceData, atomicSpec *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec, scope commonscope.Scope) bool {
+	if !spec.HasSpecChanged(d) {
+		return false
+	}
+
+	var helmReleaseSpec *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec
+
+	switch scope {
+	case commonscope.ClusterScope:
+		helmReleaseSpec = spec.ConstructSpecForClusterScope(d)
+	case commonscope.ClusterGroupScope:
+		clusterGroupScopeSpec := spec.ConstructSpecForClusterGroupScope(d)
+		helmReleaseSpec = clusterGroupScopeSpec.AtomicSpec
+	}
+
+	atomicSpec.ChartRef = helmReleaseSpec.ChartRef
+	atomicSpec.InlineConfiguration = helmReleaseSpec.InlineConfiguration
+	atomicSpec.Interval = helmReleaseSpec.Interval
+	atomicSpec.TargetNamespace = helmReleaseSpec.TargetNamespace
+
+	log.Printf("[INFO] updating Helm release spec")
+
+	return true
+}
+
+// resourceHelmReleaseImporter imports a Helm release using an ID of the form cluster/<management_cluster_name>/<provisioner_name>/<cluster_name>/<namespace_name>/<name>
+// or cluster_group/<cluster_group_name>/<namespace_name>/<name>.
+func resourceHelmReleaseImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	scopedFullnameData, err := scope.ConstructScopeFromImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	scopeData, name, namespaceName := scope.FlattenScope(scopedFullnameData)
+
+	if err = d.Set(nameKey, name); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the Helm release %s", name)
+	}
+
+	if err = d.Set(namespaceNameKey, namespaceName); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set namespace name for the Helm release %s", name)
+	}
+
+	if err = d.Set(commonscope.ScopeKey, scopeData); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set scope for the Helm release %s", name)
+	}
+
+	return helper.ReadImportedState(ctx, d, m, resourceHelmReleaseRead)
+}
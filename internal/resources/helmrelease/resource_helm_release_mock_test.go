@@ -0,0 +1,656 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmrelease
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	helmfeatureclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmfeature/cluster"
+	helmfeatureclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmfeature/clustergroup"
+	helmreleaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
+	helmreleaseclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/clustergroup"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+const (
+	https                = "https:/"
+	clAPIVersionAndGroup = "v1alpha1/clusters"
+	apiSubGroup          = "namespaces"
+	apiKind              = "fluxcd/helmreleases"
+	helmAPIKind          = "fluxcd/helm"
+	cgAPIVersionAndGroup = "v1alpha1/clustergroups"
+)
+
+func getMockSpec() helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec {
+	return helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec{
+		ChartRef: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef{
+			Chart:               "charts/podinfo",
+			RepositoryName:      "someGitRepository",
+			RepositoryNamespace: "tanzu-continuousdelivery-resources",
+			RepositoryType:      helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeGIT.Pointer(),
+		},
+		InlineConfiguration: "replicaCount: 1\n",
+		Interval:            "5m",
+	}
+}
+
+// nolint: unparam
+func bodyInspectingResponder(t *testing.T, expectedContent interface{}, successResponse int, successResponseBody interface{}) httpmock.Responder {
+	return func(r *http.Request) (*http.Response, error) {
+		successFunc := func() (*http.Response, error) {
+			return httpmock.NewJsonResponse(successResponse, successResponseBody)
+		}
+
+		if expectedContent == nil {
+			return successFunc()
+		}
+
+		// Compare to expected content.
+		expectedBytes, err := json.Marshal(expectedContent)
+		if err != nil {
+			t.Fail()
+			return nil, err
+		}
+
+		if r.Body == nil {
+			t.Fail()
+			return nil, fmt.Errorf("expected body on request")
+		}
+
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fail()
+			return nil, err
+		}
+
+		var bodyInterface map[string]interface{}
+		if err = json.Unmarshal(bodyBytes, &bodyInterface); err == nil {
+			var expectedInterface map[string]interface{}
+
+			err = json.Unmarshal(expectedBytes, &expectedInterface)
+			if err != nil {
+				return nil, err
+			}
+
+			diff := deep.Equal(bodyInterface, expectedInterface)
+			if diff == nil {
+				return successFunc()
+			}
+		} else {
+			return nil, err
+		}
+
+		return successFunc()
+	}
+}
+
+// Register a new responder when the given call is made.
+func changeStateResponder(registerFunc func(), successResponse int, successResponseBody interface{}) httpmock.Responder {
+	return func(r *http.Request) (*http.Response, error) {
+		registerFunc()
+		return httpmock.NewJsonResponse(successResponse, successResponseBody)
+	}
+}
+
+func (testConfig *testAcceptanceConfig) setupHTTPMocksUpdate(t *testing.T, scope commonscope.Scope) {
+	httpmock.Activate()
+	t.Cleanup(httpmock.Deactivate)
+
+	endpoint := os.Getenv("TMC_ENDPOINT")
+
+	OrgID := os.Getenv("ORG_ID")
+
+	reference := objectmetamodel.VmwareTanzuCoreV1alpha1ObjectReference{
+		Rid: "test_rid",
+		UID: "test_uid",
+	}
+	referenceArray := make([]*objectmetamodel.VmwareTanzuCoreV1alpha1ObjectReference, 0)
+	referenceArray = append(referenceArray, &reference)
+
+	switch scope {
+	case commonscope.ClusterScope:
+		getModel := &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRelease{
+			FullName: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseFullName{
+				Name:                  testConfig.HelmReleaseName,
+				OrgID:                 OrgID,
+				ClusterName:           testConfig.ScopeHelperResources.Cluster.Name,
+				NamespaceName:         testConfig.Namespace,
+				ProvisionerName:       "attached",
+				ManagementClusterName: "attached",
+			},
+			Spec: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec{
+				ChartRef: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef{
+					Chart:               "charts/podinfo",
+					RepositoryName:      "someGitRepository",
+					RepositoryNamespace: "tanzu-continuousdelivery-resources",
+					RepositoryType:      helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeGIT.Pointer(),
+				},
+				InlineConfiguration: "replicaCount: 2\n",
+				Interval:            "10m",
+			},
+			Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+				ParentReferences: referenceArray,
+				Description:      "resource with description",
+				Labels: map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				},
+				UID:             "Helm release1",
+				ResourceVersion: "v1",
+			},
+			Status: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseStatus{
+				Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					"Ready": {
+						Reason: "made successfully",
+					},
+				},
+			},
+		}
+
+		getResponse := &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseGetReleaseResponse{
+			Release: getModel,
+		}
+		getHelmReleaseEndpoint := (helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ScopeHelperResources.Cluster.Name, apiSubGroup, testConfig.Namespace, apiKind, testConfig.HelmReleaseName)).String()
+
+		httpmock.RegisterResponder("GET", getHelmReleaseEndpoint,
+			bodyInspectingResponder(t, nil, 200, getResponse))
+	case commonscope.ClusterGroupScope:
+		getCGModel := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseRelease{
+			FullName: &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseFullName{
+				Name:             testConfig.HelmReleaseName,
+				OrgID:            OrgID,
+				ClusterGroupName: testConfig.ScopeHelperResources.ClusterGroup.Name,
+				NamespaceName:    testConfig.Namespace,
+			},
+			Spec: &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseSpec{
+				AtomicSpec: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec{
+					ChartRef: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef{
+						Chart:               "charts/podinfo",
+						RepositoryName:      "someGitRepository",
+						RepositoryNamespace: "tanzu-continuousdelivery-resources",
+						RepositoryType:      helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeGIT.Pointer(),
+					},
+					InlineConfiguration: "replicaCount: 2\n",
+					Interval:            "10m",
+				},
+			},
+			Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+				ParentReferences: referenceArray,
+				Description:      "resource with description",
+				Labels: map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				},
+				UID:             "cdhelmrelease1",
+				ResourceVersion: "v1",
+			},
+			Status: &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseStatus{
+				Phase: statusmodel.NewVmwareTanzuManageV1alpha1CommonBatchPhase(statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED),
+			},
+		}
+
+		getCGResponse := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseGetReleaseResponse{
+			Release: getCGModel,
+		}
+
+		getCGHelmReleaseEndpoint := (helper.ConstructRequestURL(https, endpoint, cgAPIVersionAndGroup, testConfig.ScopeHelperResources.ClusterGroup.Name, "namespace", apiKind, testConfig.HelmReleaseName)).String()
+
+		httpmock.RegisterResponder("GET", getCGHelmReleaseEndpoint,
+			bodyInspectingResponder(t, nil, 200, getCGResponse))
+	}
+}
+
+func (testConfig *testAcceptanceConfig) setupHTTPMocks(t *testing.T) {
+	httpmock.Activate()
+	t.Cleanup(httpmock.Deactivate)
+
+	endpoint := os.Getenv("TMC_ENDPOINT")
+
+	OrgID := os.Getenv("ORG_ID")
+
+	reference := objectmetamodel.VmwareTanzuCoreV1alpha1ObjectReference{
+		Rid: "test_rid",
+		UID: "test_uid",
+	}
+	referenceArray := make([]*objectmetamodel.VmwareTanzuCoreV1alpha1ObjectReference, 0)
+	referenceArray = append(referenceArray, &reference)
+
+	// cluster level HelmRelease resorce.
+	postRequest, postResponse, getResponse, postHelmRequest, postHelmResponse := testConfig.getClRequestResponse(OrgID, referenceArray)
+
+	putRequest := &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseRequest{
+		Release: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRelease{
+			FullName: postRequest.Release.FullName,
+			Meta:     postRequest.Release.Meta,
+			Spec: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec{
+				ChartRef: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef{
+					Chart:               "charts/podinfo",
+					RepositoryName:      "someGitRepository",
+					RepositoryNamespace: "tanzu-continuousdelivery-resources",
+					RepositoryType:      helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeGIT.Pointer(),
+				},
+				InlineConfiguration: "replicaCount: 2\n",
+				Interval:            "10m",
+			},
+		},
+	}
+
+	putResponse := &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseResponse{
+		Release: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRelease{
+			FullName: postRequest.Release.FullName,
+			Meta:     postRequest.Release.Meta,
+			Spec: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec{
+				ChartRef: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef{
+					Chart:               "charts/podinfo",
+					RepositoryName:      "someGitRepository",
+					RepositoryNamespace: "tanzu-continuousdelivery-resources",
+					RepositoryType:      helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeGIT.Pointer(),
+				},
+				InlineConfiguration: "replicaCount: 2\n",
+				Interval:            "10m",
+			},
+			Status: postResponse.Release.Status,
+		},
+	}
+
+	postEndpoint := (helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ScopeHelperResources.Cluster.Name, apiSubGroup, testConfig.Namespace, apiKind)).String()
+	getHelmReleaseEndpoint := (helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ScopeHelperResources.Cluster.Name, apiSubGroup, testConfig.Namespace, apiKind, testConfig.HelmReleaseName)).String()
+	deleteEndpoint := getHelmReleaseEndpoint
+
+	postHelmEndpoint := (helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ScopeHelperResources.Cluster.Name, helmAPIKind)).String()
+
+	httpmock.RegisterResponder("POST", postHelmEndpoint,
+		bodyInspectingResponder(t, postHelmRequest, 200, postHelmResponse))
+
+	httpmock.RegisterResponder("POST", postEndpoint,
+		bodyInspectingResponder(t, postRequest, 200, postResponse))
+
+	httpmock.RegisterResponder("PUT", getHelmReleaseEndpoint,
+		bodyInspectingResponder(t, putRequest, 200, putResponse))
+
+	httpmock.RegisterResponder("GET", getHelmReleaseEndpoint,
+		bodyInspectingResponder(t, nil, 200, getResponse))
+
+	httpmock.RegisterResponder("DELETE", deleteEndpoint, changeStateResponder(
+		// Set up the get to return 404 after the Secret has been 'deleted'.
+		func() {
+			httpmock.RegisterResponder("GET", getHelmReleaseEndpoint,
+				httpmock.NewStringResponder(404, "Not found"))
+		},
+		http.StatusOK,
+		nil))
+
+	// cluster group level Helm release resource.
+	postCGRequest, postCGResponse, getCGResponse, postCGHelmRequest, postCGHelmResponse := testConfig.getCGRequestResponse(OrgID, referenceArray)
+
+	putCGRequest := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseRequest{
+		Release: &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseRelease{
+			FullName: postCGRequest.Release.FullName,
+			Meta:     postResponse.Release.Meta,
+			Spec: &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseSpec{
+				AtomicSpec: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec{
+					ChartRef: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef{
+						Chart:               "charts/podinfo",
+						RepositoryName:      "someGitRepository",
+						RepositoryNamespace: "tanzu-continuousdelivery-resources",
+						RepositoryType:      helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeGIT.Pointer(),
+					},
+					InlineConfiguration: "replicaCount: 2\n",
+					Interval:            "10m",
+				},
+			},
+		},
+	}
+
+	putCGResponse := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseResponse{
+		Release: &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseRelease{
+			FullName: postCGRequest.Release.FullName,
+			Meta:     postResponse.Release.Meta,
+			Spec: &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseSpec{
+				AtomicSpec: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec{
+					ChartRef: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef{
+						Chart:               "charts/podinfo",
+						RepositoryName:      "someGitRepository",
+						RepositoryNamespace: "tanzu-continuousdelivery-resources",
+						RepositoryType:      helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeGIT.Pointer(),
+					},
+					InlineConfiguration: "replicaCount: 2\n",
+					Interval:            "10m",
+				},
+			},
+			Status: postCGResponse.Release.Status,
+		},
+	}
+
+	postCGEndpoint := (helper.ConstructRequestURL(https, endpoint, cgAPIVersionAndGroup, testConfig.ScopeHelperResources.ClusterGroup.Name, "namespace", apiKind)).String()
+	getCGHelmReleaseEndpoint := (helper.ConstructRequestURL(https, endpoint, cgAPIVersionAndGroup, testConfig.ScopeHelperResources.ClusterGroup.Name, "namespace", apiKind, testConfig.HelmReleaseName)).String()
+	deleteCGEndpoint := getCGHelmReleaseEndpoint
+
+	postCGHelmEndpoint := (helper.ConstructRequestURL(https, endpoint, cgAPIVersionAndGroup, testConfig.ScopeHelperResources.ClusterGroup.Name, helmAPIKind)).String()
+
+	httpmock.RegisterResponder("POST", postCGHelmEndpoint,
+		bodyInspectingResponder(t, postCGHelmRequest, 200, postCGHelmResponse))
+
+	httpmock.RegisterResponder("POST", postCGEndpoint,
+		bodyInspectingResponder(t, postCGRequest, 200, postCGResponse))
+
+	httpmock.RegisterResponder("GET", getCGHelmReleaseEndpoint,
+		bodyInspectingResponder(t, nil, 200, getCGResponse))
+
+	httpmock.RegisterResponder("PUT", getCGHelmReleaseEndpoint,
+		bodyInspectingResponder(t, putCGRequest, 200, putCGResponse))
+
+	httpmock.RegisterResponder("DELETE", deleteCGEndpoint, changeStateResponder(
+		// Set up the get to return 404 after the Secret has been 'deleted'.
+		func() {
+			httpmock.RegisterResponder("GET", getCGHelmReleaseEndpoint,
+				httpmock.NewStringResponder(404, "Not found"))
+		},
+		http.StatusOK,
+		nil))
+}
+
+func (testConfig *testAcceptanceConfig) getCGRequestResponse(orgID string, referenceArray []*objectmetamodel.VmwareTanzuCoreV1alpha1ObjectReference) (
+	*helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseRequest,
+	*helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseResponse,
+	*helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseGetReleaseResponse,
+	*helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmRequest,
+	*helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmResponse,
+) {
+	helmReleaseSpec := getMockSpec()
+
+	cdSpec := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseSpec{
+		AtomicSpec: &helmReleaseSpec,
+	}
+
+	postCGRequestModel := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseRelease{
+		FullName: &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseFullName{
+			Name:             testConfig.HelmReleaseName,
+			OrgID:            orgID,
+			ClusterGroupName: testConfig.ScopeHelperResources.ClusterGroup.Name,
+			NamespaceName:    testConfig.Namespace,
+		},
+		Spec: cdSpec,
+		Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+			ParentReferences: nil,
+			Description:      "resource with description",
+			Labels: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			UID:             "cdhelmrelease1",
+			ResourceVersion: "v1",
+		},
+	}
+
+	postCGResponseModel := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseRelease{
+		FullName: &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseFullName{
+			Name:             testConfig.HelmReleaseName,
+			OrgID:            orgID,
+			ClusterGroupName: testConfig.ScopeHelperResources.ClusterGroup.Name,
+			NamespaceName:    testConfig.Namespace,
+		},
+		Spec: cdSpec,
+		Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+			ParentReferences: nil,
+			Description:      "resource with description",
+			Labels: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			UID:             "cdhelmrelease1",
+			ResourceVersion: "v1",
+		},
+		Status: &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseStatus{
+			Phase: statusmodel.NewVmwareTanzuManageV1alpha1CommonBatchPhase(statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED),
+		},
+	}
+
+	postCGRequest := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseRequest{
+		Release: postCGRequestModel,
+	}
+
+	postCGResponse := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseReleaseResponse{
+		Release: postCGResponseModel,
+	}
+
+	getCGModel := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseRelease{
+		FullName: &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseFullName{
+			Name:             testConfig.HelmReleaseName,
+			OrgID:            orgID,
+			ClusterGroupName: testConfig.ScopeHelperResources.ClusterGroup.Name,
+			NamespaceName:    testConfig.Namespace,
+		},
+		Spec: cdSpec,
+		Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+			ParentReferences: referenceArray,
+			Description:      "resource with description",
+			Labels: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			UID:             "cdhelmrelease1",
+			ResourceVersion: "v1",
+		},
+		Status: &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseStatus{
+			Phase: statusmodel.NewVmwareTanzuManageV1alpha1CommonBatchPhase(statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED),
+		},
+	}
+
+	getCGResponse := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseGetReleaseResponse{
+		Release: getCGModel,
+	}
+
+	postRequestCGHelmModel := &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelm{
+		FullName: &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmFullName{
+			ClusterGroupName: testConfig.ScopeHelperResources.ClusterGroup.Name,
+			OrgID:            orgID,
+		},
+		Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+			ParentReferences: nil,
+			Description:      "resource with description",
+			Labels: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			UID:             "cdhelm1",
+			ResourceVersion: "v1",
+		},
+	}
+
+	postResponseCGHelm := &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelm{
+		FullName: &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmFullName{
+			ClusterGroupName: testConfig.ScopeHelperResources.ClusterGroup.Name,
+			OrgID:            orgID,
+		},
+		Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+			ParentReferences: nil,
+			Description:      "resource with description",
+			Labels: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			UID:             "cdhelm1",
+			ResourceVersion: "v1",
+		},
+		Status: &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmStatus{
+			Phase: statusmodel.NewVmwareTanzuManageV1alpha1CommonBatchPhase(statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED),
+		},
+	}
+
+	postCGHelmRequest := &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmRequest{
+		Helm: postRequestCGHelmModel,
+	}
+
+	postCGHelmResponse := &helmfeatureclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmHelmResponse{
+		Helm: postResponseCGHelm,
+	}
+
+	return postCGRequest, postCGResponse, getCGResponse, postCGHelmRequest, postCGHelmResponse
+}
+
+func (testConfig *testAcceptanceConfig) getClRequestResponse(orgID string, referenceArray []*objectmetamodel.VmwareTanzuCoreV1alpha1ObjectReference) (
+	*helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseRequest,
+	*helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseResponse,
+	*helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseGetReleaseResponse,
+	*helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmRequest,
+	*helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmResponse,
+) {
+	helmReleaseSpec := getMockSpec()
+	postRequestModel := &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRelease{
+		FullName: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseFullName{
+			Name:                  testConfig.HelmReleaseName,
+			OrgID:                 orgID,
+			ClusterName:           testConfig.ScopeHelperResources.Cluster.Name,
+			NamespaceName:         testConfig.Namespace,
+			ProvisionerName:       "attached",
+			ManagementClusterName: "attached",
+		},
+		Spec: &helmReleaseSpec,
+		Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+			ParentReferences: nil,
+			Description:      "resource with description",
+			Labels: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			UID:             "Helm release1",
+			ResourceVersion: "v1",
+		},
+	}
+
+	postResponseModel := &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRelease{
+		FullName: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseFullName{
+			Name:                  testConfig.HelmReleaseName,
+			OrgID:                 orgID,
+			ClusterName:           testConfig.ScopeHelperResources.Cluster.Name,
+			NamespaceName:         testConfig.Namespace,
+			ProvisionerName:       "attached",
+			ManagementClusterName: "attached",
+		},
+		Spec: &helmReleaseSpec,
+		Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+			ParentReferences: nil,
+			Description:      "resource with description",
+			Labels: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			UID:             "Helm release1",
+			ResourceVersion: "v1",
+		},
+		Status: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseStatus{
+			Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+				"Ready": {
+					Reason: "made successfully",
+				},
+			},
+		},
+	}
+
+	postRequest := &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseRequest{
+		Release: postRequestModel,
+	}
+
+	postResponse := &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseReleaseResponse{
+		Release: postResponseModel,
+	}
+
+	getModel := &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRelease{
+		FullName: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseFullName{
+			Name:                  testConfig.HelmReleaseName,
+			OrgID:                 orgID,
+			ClusterName:           testConfig.ScopeHelperResources.Cluster.Name,
+			NamespaceName:         testConfig.Namespace,
+			ProvisionerName:       "attached",
+			ManagementClusterName: "attached",
+		},
+		Spec: &helmReleaseSpec,
+		Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+			ParentReferences: referenceArray,
+			Description:      "resource with description",
+			Labels: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			UID:             "Helm release1",
+			ResourceVersion: "v1",
+		},
+		Status: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseStatus{
+			Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+				"Ready": {
+					Reason: "made successfully",
+				},
+			},
+		},
+	}
+
+	getResponse := &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseGetReleaseResponse{
+		Release: getModel,
+	}
+
+	postRequestHelmModel := &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelm{
+		FullName: &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmFullName{
+			ClusterName: testConfig.ScopeHelperResources.Cluster.Name,
+			OrgID:       orgID,
+		},
+		Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+			ParentReferences: nil,
+			Description:      "resource with description",
+			Labels: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			UID:             "helm1",
+			ResourceVersion: "v1",
+		},
+	}
+
+	postResponseHelm := &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelm{
+		FullName: &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmFullName{
+			ClusterName: testConfig.ScopeHelperResources.Cluster.Name,
+			OrgID:       orgID,
+		},
+		Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+			ParentReferences: nil,
+			Description:      "resource with description",
+			Labels: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			UID:             "helm1",
+			ResourceVersion: "v1",
+		},
+		Status: &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmStatus{
+			Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+				"Ready": {
+					Reason: "made successfully",
+				},
+			},
+		},
+	}
+
+	postHelmRequest := &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmRequest{
+		Helm: postRequestHelmModel,
+	}
+
+	postHelmResponse := &helmfeatureclustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdHelmHelmResponse{
+		Helm: postResponseHelm,
+	}
+
+	return postRequest, postResponse, getResponse, postHelmRequest, postHelmResponse
+}
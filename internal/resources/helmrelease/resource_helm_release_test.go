@@ -0,0 +1,398 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package helmrelease
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/proxy"
+	helmreleaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
+	helmreleaseclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/clustergroup"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	gitrepositoryhelper "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/gitrepository"
+	helmreleasescope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/helmrelease/scope"
+	testhelper "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/testing"
+)
+
+// nolint: gosec
+const (
+	helmReleaseResource      = ResourceName
+	helmReleaseResourceVar   = "test_Helm release"
+	helmReleaseDataSourceVar = "test_data_source_Helm release"
+	helmReleaseNamePrefix    = "tf-helm-release-test"
+
+	gitRepositoryResource    = gitrepositoryhelper.ResourceName
+	gitRepositoryResourceVar = "test_git_repository"
+	gitRepositoryNamePrefix  = "tf-gr-test"
+)
+
+type testAcceptanceConfig struct {
+	Provider                 *schema.Provider
+	HelmReleaseResource      string
+	HelmReleaseResourceVar   string
+	HelmReleaseResourceName  string
+	HelmReleaseName          string
+	ScopeHelperResources     *commonscope.ScopeHelperResources
+	GitRepositoryResource    string
+	GitRepositoryResourceVar string
+	GitRepositoryName        string
+	Namespace                string
+}
+
+func testGetDefaultAcceptanceConfig(t *testing.T) *testAcceptanceConfig {
+	return &testAcceptanceConfig{
+		Provider:                 initTestProvider(t),
+		HelmReleaseResource:      helmReleaseResource,
+		HelmReleaseResourceVar:   helmReleaseResourceVar,
+		HelmReleaseResourceName:  fmt.Sprintf("%s.%s", helmReleaseResource, helmReleaseResourceVar),
+		HelmReleaseName:          acctest.RandomWithPrefix(helmReleaseNamePrefix),
+		ScopeHelperResources:     commonscope.NewScopeHelperResources(),
+		GitRepositoryResource:    gitRepositoryResource,
+		GitRepositoryResourceVar: gitRepositoryResourceVar,
+		GitRepositoryName:        acctest.RandomWithPrefix(gitRepositoryNamePrefix),
+		Namespace:                "tanzu-continuousdelivery-resources",
+	}
+}
+
+func getConfigureContextFunc() func(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	if _, found := os.LookupEnv("ENABLE_HELM_RELEASE_ENV_TEST"); !found {
+		return authctx.ProviderConfigureContextWithDefaultTransportForTesting
+	}
+
+	return authctx.ProviderConfigureContext
+}
+
+func getSetupConfig(config *authctx.TanzuContext) error {
+	if _, found := os.LookupEnv("ENABLE_HELM_RELEASE_ENV_TEST"); !found {
+		return config.SetupWithDefaultTransportForTesting()
+	}
+
+	return config.Setup()
+}
+
+func TestAcceptanceForHelmReleaseResource(t *testing.T) {
+	testConfig := testGetDefaultAcceptanceConfig(t)
+
+	// If the flag to execute Helm release tests is not found, run this as a mock test by setting up an http intercept for each endpoint.
+	_, found := os.LookupEnv("ENABLE_HELM_RELEASE_ENV_TEST")
+	if !found {
+		os.Setenv("TF_ACC", "true")
+		os.Setenv("TMC_ENDPOINT", "dummy.tmc.mock.vmware.com")
+		os.Setenv("VMW_CLOUD_API_TOKEN", "dummy")
+		os.Setenv("VMW_CLOUD_ENDPOINT", "console.cloud.vmware.com")
+
+		log.Println("Setting up the mock endpoints...")
+
+		testConfig.setupHTTPMocks(t)
+	} else {
+		// Environment variables with non default values required for a successful call to Cluster Config Service.
+		requiredVars := []string{
+			"VMW_CLOUD_ENDPOINT",
+			"TMC_ENDPOINT",
+			"VMW_CLOUD_API_TOKEN",
+			"ORG_ID",
+		}
+
+		// Check if the required environment variables are set.
+		for _, name := range requiredVars {
+			if _, found := os.LookupEnv(name); !found {
+				t.Errorf("required environment variable '%s' missing", name)
+			}
+		}
+	}
+
+	t.Log("start Helm release resource acceptance tests!")
+
+	// Test case for Helm release resource.
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testhelper.TestPreCheck(t),
+		ProviderFactories: testhelper.GetTestProviderFactories(testConfig.Provider),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if testConfig.ScopeHelperResources.Cluster.KubeConfigPath == "" && found {
+						t.Skip("KUBECONFIG env var is not set for cluster scoped Helm release acceptance test")
+					}
+				},
+				Config: testConfig.getTestHelmReleaseResourceBasicConfigValue(commonscope.ClusterScope),
+				Check:  testConfig.checkHelmReleaseResourceAttributes(commonscope.ClusterScope),
+			},
+			{
+				PreConfig: func() {
+					if !found {
+						t.Log("Setting up the updated GET mock responder for cluster scope...")
+						testConfig.setupHTTPMocksUpdate(t, commonscope.ClusterScope)
+					}
+				},
+				Config: testConfig.getTestHelmReleaseResourceBasicConfigValue(commonscope.ClusterScope, WithReplicaCount(2), WithInterval("10m")),
+				Check:  testConfig.checkHelmReleaseResourceAttributes(commonscope.ClusterScope),
+			},
+			{
+				Config: testConfig.getTestHelmReleaseResourceBasicConfigValue(commonscope.ClusterGroupScope),
+				Check:  testConfig.checkHelmReleaseResourceAttributes(commonscope.ClusterGroupScope),
+			},
+			{
+				PreConfig: func() {
+					if !found {
+						t.Log("Setting up the updated GET mock responder for cluster group scope...")
+						testConfig.setupHTTPMocksUpdate(t, commonscope.ClusterGroupScope)
+					}
+				},
+				Config: testConfig.getTestHelmReleaseResourceBasicConfigValue(commonscope.ClusterGroupScope, WithReplicaCount(2), WithInterval("10m")),
+				Check:  testConfig.checkHelmReleaseResourceAttributes(commonscope.ClusterGroupScope),
+			},
+		},
+	},
+	)
+
+	t.Log("Helm release resource acceptance test complete")
+}
+
+func (testConfig *testAcceptanceConfig) getTestHelmReleaseResourceBasicConfigValue(scope commonscope.Scope, opts ...OperationOption) string {
+	helperBlock, scopeBlock := testConfig.ScopeHelperResources.GetTestResourceHelperAndScope(scope, helmreleasescope.ScopesAllowed[:])
+	helmReleaseSpec := getHelmReleasespec(opts...)
+
+	if _, found := os.LookupEnv("ENABLE_HELM_RELEASE_ENV_TEST"); !found {
+		clStr := fmt.Sprintf(`
+	resource "%s" "%s" {
+		name = "%s"
+
+		namespace_name = "%s"
+
+		scope {
+		cluster {
+			name = "%s"
+			management_cluster_name = "attached"
+			provisioner_name = "attached"
+		}
+	 }
+
+		%s
+	}
+	`, testConfig.HelmReleaseResource, testConfig.HelmReleaseResourceVar, testConfig.HelmReleaseName, testConfig.Namespace, testConfig.ScopeHelperResources.Cluster.Name, helmReleaseSpec)
+
+		cgStr := fmt.Sprintf(`
+	resource "%s" "%s" {
+		name = "%s"
+
+		namespace_name = "%s"
+
+		 scope {
+		cluster_group {
+			name = "%s"
+		}
+	 }
+
+		%s
+	}
+	`, testConfig.HelmReleaseResource, testConfig.HelmReleaseResourceVar, testConfig.HelmReleaseName, testConfig.Namespace, testConfig.ScopeHelperResources.ClusterGroup.Name, helmReleaseSpec)
+
+		switch scope {
+		case commonscope.ClusterScope:
+			return clStr
+		case commonscope.ClusterGroupScope:
+			return cgStr
+		}
+	}
+
+	return fmt.Sprintf(`
+	%s
+	
+	resource "%s" "%s" {
+	 name = "%s"
+
+	 namespace_name = "%s"
+	
+	 %s
+	
+	 spec {
+		url = "https://github.com/stefanprodan/podinfo"
+		ref {
+			branch = "master"
+		}
+	 }
+	}
+
+	resource "%s" "%s" {
+		name = "%s"
+
+		namespace_name = "%s"
+
+		%s
+
+		%s
+	}
+	`, helperBlock, testConfig.GitRepositoryResource, testConfig.GitRepositoryResourceVar, testConfig.GitRepositoryName, testConfig.Namespace, scopeBlock, testConfig.HelmReleaseResource, testConfig.HelmReleaseResourceVar, testConfig.HelmReleaseName, testConfig.Namespace, scopeBlock, helmReleaseSpec)
+}
+
+type (
+	OperationConfig struct {
+		replicaCount int
+		interval     string
+	}
+
+	OperationOption func(*OperationConfig)
+)
+
+func WithReplicaCount(val int) OperationOption {
+	return func(config *OperationConfig) {
+		config.replicaCount = val
+	}
+}
+
+func WithInterval(val string) OperationOption {
+	return func(config *OperationConfig) {
+		config.interval = val
+	}
+}
+
+func getHelmReleasespec(opts ...OperationOption) string {
+	cfg := &OperationConfig{
+		replicaCount: 1,
+		interval:     "5m",
+	}
+
+	for _, o := range opts {
+		o(cfg)
+	}
+
+	if _, found := os.LookupEnv("ENABLE_HELM_RELEASE_ENV_TEST"); !found {
+		inputBlock := `
+    spec {
+			chart_ref {
+				chart = "charts/podinfo"
+				repository_name = "someGitRepository"
+				repository_namespace = "tanzu-continuousdelivery-resources"
+				repository_type = "GIT"
+			}
+			values = yamlencode({ replicaCount = %d })
+			interval = "%s"
+		}
+`
+		inputBlock = fmt.Sprintf(inputBlock, cfg.replicaCount, cfg.interval)
+
+		return inputBlock
+	}
+
+	inputBlock := `
+    spec {
+			chart_ref {
+				chart = "charts/podinfo"
+				repository_name = tanzu-mission-control_git_repository.test_git_repository.name
+				repository_namespace = tanzu-mission-control_git_repository.test_git_repository.namespace_name
+				repository_type = "GIT"
+			}
+			values = yamlencode({ replicaCount = %d })
+			interval = "%s"
+		}
+`
+	inputBlock = fmt.Sprintf(inputBlock, cfg.replicaCount, cfg.interval)
+
+	return inputBlock
+}
+
+// checkHelmReleaseResourceAttributes checks for Helm release creation along with meta attributes.
+func (testConfig *testAcceptanceConfig) checkHelmReleaseResourceAttributes(scopeType commonscope.Scope) resource.TestCheckFunc {
+	var check = []resource.TestCheckFunc{
+		testConfig.verifyHelmReleaseResourceCreation(scopeType),
+		resource.TestCheckResourceAttr(testConfig.HelmReleaseResourceName, "name", testConfig.HelmReleaseName),
+	}
+
+	switch scopeType {
+	case commonscope.ClusterScope:
+		check = append(check, resource.TestCheckResourceAttr(testConfig.HelmReleaseResourceName, "scope.0.cluster.0.name", testConfig.ScopeHelperResources.Cluster.Name))
+	case commonscope.ClusterGroupScope:
+		check = append(check, resource.TestCheckResourceAttr(testConfig.HelmReleaseResourceName, "scope.0.cluster_group.0.name", testConfig.ScopeHelperResources.ClusterGroup.Name))
+	case commonscope.UnknownScope:
+		log.Printf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(helmreleasescope.ScopesAllowed[:], `, `))
+	}
+
+	check = append(check, commonscope.MetaResourceAttributeCheck(testConfig.HelmReleaseResourceName)...)
+
+	return resource.ComposeTestCheckFunc(check...)
+}
+
+func (testConfig *testAcceptanceConfig) verifyHelmReleaseResourceCreation(scopeType commonscope.Scope) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testConfig.Provider == nil {
+			return fmt.Errorf("provider not initialised")
+		}
+
+		rs, ok := s.RootModule().Resources[testConfig.HelmReleaseResourceName]
+		if !ok {
+			return fmt.Errorf("not found resource: %s", testConfig.HelmReleaseResourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID not set, resource: %s", testConfig.HelmReleaseResourceName)
+		}
+
+		config := authctx.TanzuContext{
+			ServerEndpoint:   os.Getenv(authctx.ServerEndpointEnvVar),
+			Token:            os.Getenv(authctx.VMWCloudAPITokenEnvVar),
+			VMWCloudEndPoint: os.Getenv(authctx.VMWCloudEndpointEnvVar),
+			TLSConfig:        &proxy.TLSConfig{},
+		}
+
+		err := getSetupConfig(&config)
+		if err != nil {
+			return errors.Wrap(err, "unable to set the context")
+		}
+
+		switch scopeType {
+		case commonscope.ClusterScope:
+			fn := &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseFullName{
+				ClusterName:           testConfig.ScopeHelperResources.Cluster.Name,
+				ManagementClusterName: commonscope.AttachedValue,
+				Name:                  testConfig.HelmReleaseName,
+				NamespaceName:         "tanzu-continuousdelivery-resources",
+				ProvisionerName:       commonscope.AttachedValue,
+			}
+
+			resp, err := config.TMCConnection.ClusterHelmReleaseResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdHelmReleaseResourceServiceGet(fn)
+			if err != nil {
+				return errors.Wrap(err, "cluster scoped Helm release resource not found")
+			}
+
+			if resp == nil {
+				return errors.Wrapf(err, "cluster scoped Helm release resource is empty, resource: %s", testConfig.HelmReleaseResourceName)
+			}
+		case commonscope.ClusterGroupScope:
+			fn := &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseFullName{
+				ClusterGroupName: testConfig.ScopeHelperResources.ClusterGroup.Name,
+				Name:             testConfig.HelmReleaseName,
+				NamespaceName:    "tanzu-continuousdelivery-resources",
+			}
+
+			resp, err := config.TMCConnection.ClusterGroupHelmReleaseResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdHelmReleaseResourceServiceGet(fn)
+			if err != nil {
+				return errors.Wrap(err, "cluster group scoped Helm release resource not found")
+			}
+
+			if resp == nil {
+				return errors.Wrapf(err, "cluster group scoped Helm release resource is empty, resource: %s", testConfig.HelmReleaseResourceName)
+			}
+		case commonscope.UnknownScope:
+			return errors.Errorf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(helmreleasescope.ScopesAllowed[:], `, `))
+		}
+
+		return nil
+	}
+}
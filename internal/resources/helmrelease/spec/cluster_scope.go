@@ -0,0 +1,68 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package spec
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	helmreleaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
+)
+
+func ConstructSpecForClusterScope(d *schema.ResourceData) (spec *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec) {
+	value, ok := d.GetOk(SpecKey)
+	if !ok {
+		return spec
+	}
+
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return spec
+	}
+
+	specData := data[0].(map[string]interface{})
+
+	spec = &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec{}
+
+	if chartRef, ok := specData[chartRefKey]; ok {
+		if chartRefData, ok := chartRef.([]interface{}); ok {
+			spec.ChartRef = expandChartRef(chartRefData)
+		}
+	}
+
+	if valuesValue, ok := specData[valuesKey]; ok {
+		helper.SetPrimitiveValue(valuesValue, &spec.InlineConfiguration, valuesKey)
+	}
+
+	if intervalValue, ok := specData[intervalKey]; ok {
+		helper.SetPrimitiveValue(intervalValue, &spec.Interval, intervalKey)
+	}
+
+	if targetNamespaceValue, ok := specData[targetNamespaceKey]; ok {
+		helper.SetPrimitiveValue(targetNamespaceValue, &spec.TargetNamespace, targetNamespaceKey)
+	}
+
+	return spec
+}
+
+func FlattenSpecForClusterScope(spec *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec) (data []interface{}) {
+	if spec == nil {
+		return data
+	}
+
+	flattenSpecData := make(map[string]interface{})
+
+	if spec.ChartRef != nil {
+		flattenSpecData[chartRefKey] = flattenChartRef(spec.ChartRef)
+	}
+
+	flattenSpecData[valuesKey] = spec.InlineConfiguration
+	flattenSpecData[intervalKey] = spec.Interval
+	flattenSpecData[targetNamespaceKey] = spec.TargetNamespace
+
+	return []interface{}{flattenSpecData}
+}
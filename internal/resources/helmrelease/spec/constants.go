@@ -0,0 +1,19 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package spec
+
+const (
+	SpecKey                = "spec"
+	chartRefKey            = "chart_ref"
+	chartKey               = "chart"
+	repositoryNameKey      = "repository_name"
+	repositoryNamespaceKey = "repository_namespace"
+	repositoryTypeKey      = "repository_type"
+	versionKey             = "version"
+	valuesKey              = "values"
+	intervalKey            = "interval"
+	targetNamespaceKey     = "target_namespace"
+)
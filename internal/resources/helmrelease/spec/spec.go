@@ -0,0 +1,213 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package spec
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	helmreleaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
+)
+
+var SpecSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Spec for the Helm release.",
+	Required:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			chartRefKey: chartRefSchema,
+			valuesKey: {
+				Type:             schema.TypeString,
+				Description:      "Values of the chart, as a YAML or JSON document. Use `yamlencode()` to provide the values as structured HCL, or a heredoc string to provide raw YAML.",
+				Optional:         true,
+				ValidateFunc:     validateValues,
+				DiffSuppressFunc: suppressEquivalentValues,
+			},
+			intervalKey: {
+				Type:        schema.TypeString,
+				Description: "Interval at which to reconcile the Helm release.",
+				Optional:    true,
+				Default:     "10m",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					durationInScript, err := time.ParseDuration(old)
+					if err != nil {
+						return false
+					}
+
+					durationInState, err := time.ParseDuration(new)
+					if err != nil {
+						return false
+					}
+
+					return durationInScript.Seconds() == durationInState.Seconds()
+				},
+			},
+			targetNamespaceKey: {
+				Type:        schema.TypeString,
+				Description: "Namespace to install the chart in. Defaults to the namespace of the Helm release. The namespace must exist on the cluster.",
+				Optional:    true,
+				Default:     "",
+			},
+		},
+	},
+}
+
+var chartRefSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Reference to the chart which will be installed.",
+	Required:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			chartKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the chart in a Helm repository, or path of the chart in a git repository.",
+				Required:    true,
+			},
+			repositoryNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the git repository or Helm repository holding the chart.",
+				Required:    true,
+			},
+			repositoryNamespaceKey: {
+				Type:        schema.TypeString,
+				Description: "Namespace of the git repository or Helm repository holding the chart.",
+				Required:    true,
+			},
+			repositoryTypeKey: {
+				Type:        schema.TypeString,
+				Description: "Type of the repository holding the chart, one of GIT or HELM.",
+				Required:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeGIT),
+					string(helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeHELM),
+				}, false),
+			},
+			versionKey: {
+				Type:        schema.TypeString,
+				Description: "Version of the chart, only used for charts of a Helm repository. Defaults to the latest version of the chart.",
+				Optional:    true,
+			},
+		},
+	},
+}
+
+func expandChartRef(data []interface{}) (chartRef *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef) {
+	if len(data) == 0 || data[0] == nil {
+		return chartRef
+	}
+
+	chartRefData, _ := data[0].(map[string]interface{})
+	chartRef = &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef{}
+
+	if chartValue, ok := chartRefData[chartKey]; ok {
+		helper.SetPrimitiveValue(chartValue, &chartRef.Chart, chartKey)
+	}
+
+	if repositoryNameValue, ok := chartRefData[repositoryNameKey]; ok {
+		helper.SetPrimitiveValue(repositoryNameValue, &chartRef.RepositoryName, repositoryNameKey)
+	}
+
+	if repositoryNamespaceValue, ok := chartRefData[repositoryNamespaceKey]; ok {
+		helper.SetPrimitiveValue(repositoryNamespaceValue, &chartRef.RepositoryNamespace, repositoryNamespaceKey)
+	}
+
+	if repositoryTypeValue, ok := chartRefData[repositoryTypeKey]; ok && repositoryTypeValue.(string) != "" {
+		chartRef.RepositoryType = helmreleaseclustermodel.NewVmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryType(
+			helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryType(repositoryTypeValue.(string)))
+	}
+
+	if versionValue, ok := chartRefData[versionKey]; ok {
+		helper.SetPrimitiveValue(versionValue, &chartRef.Version, versionKey)
+	}
+
+	return chartRef
+}
+
+func flattenChartRef(chartRef *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef) (data []interface{}) {
+	if chartRef == nil {
+		return data
+	}
+
+	flattenChartRefData := make(map[string]interface{})
+
+	flattenChartRefData[chartKey] = chartRef.Chart
+	flattenChartRefData[repositoryNameKey] = chartRef.RepositoryName
+	flattenChartRefData[repositoryNamespaceKey] = chartRef.RepositoryNamespace
+	flattenChartRefData[versionKey] = chartRef.Version
+
+	if chartRef.RepositoryType != nil {
+		flattenChartRefData[repositoryTypeKey] = string(*chartRef.RepositoryType)
+	}
+
+	return []interface{}{flattenChartRefData}
+}
+
+func parseValues(document string) (values map[string]interface{}, err error) {
+	if strings.TrimSpace(document) == "" {
+		return nil, nil
+	}
+
+	if err := yaml.Unmarshal([]byte(document), &values); err != nil {
+		return nil, errors.Wrap(err, "values must be a YAML or JSON object")
+	}
+
+	return values, nil
+}
+
+func validateValues(value interface{}, key string) (warnings []string, errs []error) {
+	document, ok := value.(string)
+	if !ok {
+		return nil, []error{errors.Errorf("expected %s to be a string", key)}
+	}
+
+	if _, err := parseValues(document); err != nil {
+		return nil, []error{errors.Wrapf(err, "invalid %s", key)}
+	}
+
+	return nil, nil
+}
+
+// suppressEquivalentValues ignores formatting differences between values documents holding the same values,
+// such as the output of yamlencode() and the YAML document returned by Tanzu Mission Control.
+func suppressEquivalentValues(_, old, new string, _ *schema.ResourceData) bool {
+	oldValues, err := parseValues(old)
+	if err != nil {
+		return false
+	}
+
+	newValues, err := parseValues(new)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldValues, newValues)
+}
+
+func HasSpecChanged(d *schema.ResourceData) bool {
+	updateRequired := false
+
+	switch {
+	case d.HasChange(helper.GetFirstElementOf(SpecKey, chartRefKey)):
+		fallthrough
+	case d.HasChange(helper.GetFirstElementOf(SpecKey, valuesKey)):
+		fallthrough
+	case d.HasChange(helper.GetFirstElementOf(SpecKey, intervalKey)):
+		fallthrough
+	case d.HasChange(helper.GetFirstElementOf(SpecKey, targetNamespaceKey)):
+		updateRequired = true
+	}
+
+	return updateRequired
+}
@@ -0,0 +1,127 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	helmreleaseclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/cluster"
+	helmreleaseclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/helmrelease/clustergroup"
+)
+
+func TestFlattenSpecForClusterScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil cluster Helm release spec",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete cluster Helm release spec",
+			input: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec{
+				ChartRef: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef{
+					Chart:               "podinfo",
+					RepositoryName:      "podinfo",
+					RepositoryNamespace: "default",
+					RepositoryType:      helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeHELM.Pointer(),
+					Version:             "6.3.5",
+				},
+				InlineConfiguration: "replicaCount: 2\n",
+				Interval:            "10m",
+				TargetNamespace:     "default",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					chartRefKey: []interface{}{
+						map[string]interface{}{
+							chartKey:               "podinfo",
+							repositoryNameKey:      "podinfo",
+							repositoryNamespaceKey: "default",
+							repositoryTypeKey:      "HELM",
+							versionKey:             "6.3.5",
+						},
+					},
+					valuesKey:          "replicaCount: 2\n",
+					intervalKey:        "10m",
+					targetNamespaceKey: "default",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenSpecForClusterScope(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFlattenSpecForClusterGroupScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseSpec
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil cluster group Helm release spec",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete cluster group Helm release spec",
+			input: &helmreleaseclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceFluxcdHelmReleaseSpec{
+				AtomicSpec: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseSpec{
+					ChartRef: &helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseChartRef{
+						Chart:               "podinfo",
+						RepositoryName:      "podinfo",
+						RepositoryNamespace: "default",
+						RepositoryType:      helmreleaseclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceFluxcdHelmReleaseRepositoryTypeHELM.Pointer(),
+						Version:             "6.3.5",
+					},
+					InlineConfiguration: "replicaCount: 2\n",
+					Interval:            "10m",
+					TargetNamespace:     "default",
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					chartRefKey: []interface{}{
+						map[string]interface{}{
+							chartKey:               "podinfo",
+							repositoryNameKey:      "podinfo",
+							repositoryNamespaceKey: "default",
+							repositoryTypeKey:      "HELM",
+							versionKey:             "6.3.5",
+						},
+					},
+					valuesKey:          "replicaCount: 2\n",
+					intervalKey:        "10m",
+					targetNamespaceKey: "default",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenSpecForClusterGroupScope(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
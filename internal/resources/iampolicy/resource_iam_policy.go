@@ -37,7 +37,10 @@ func ResourceIAMPolicy() *schema.Resource {
 		CreateContext: resourceIAMPolicyCreate,
 		UpdateContext: resourceIAMPolicyInPlaceUpdate,
 		DeleteContext: resourceIAMPolicyDelete,
-		Schema:        iamPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIAMPolicyImporter,
+		},
+		Schema: iamPolicySchema,
 		CustomizeDiff: customdiff.All(
 			validateScope,
 			validateRoleBindingSubjectDuplicate,
@@ -73,7 +76,7 @@ func resourceIAMPolicyCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 			iamResponse, err := config.TMCConnection.OrganizationIAMResourceService.ManageV1alpha1OrganizationIAMPolicyPatch(iamRequest)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "unable to create Role Binding for organization"))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to create Role Binding for organization"))
 			}
 
 			UID = iamResponse.Policy.Meta.UID
@@ -87,7 +90,7 @@ func resourceIAMPolicyCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 			iamResponse, err := config.TMCConnection.ClusterGroupIAMResourceService.ManageV1alpha1ClusterGroupIAMPolicyPatch(iamRequest)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "unable to create Role Binding for cluster group"))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to create Role Binding for cluster group"))
 			}
 
 			UID = iamResponse.Policy.Meta.UID
@@ -101,7 +104,7 @@ func resourceIAMPolicyCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 			iamResponse, err := config.TMCConnection.ClusterIAMResourceService.ManageV1alpha1ClusterIAMPolicyPatch(iamRequest)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "unable to create Role Binding for cluster"))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to create Role Binding for cluster"))
 			}
 
 			UID = iamResponse.Policy.Meta.UID
@@ -115,7 +118,7 @@ func resourceIAMPolicyCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 			iamResponse, err := config.TMCConnection.WorkspaceIAMResourceService.ManageV1alpha1WorkspaceIAMPolicyPatch(iamRequest)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "unable to create Role Binding for workspace"))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to create Role Binding for workspace"))
 			}
 
 			UID = iamResponse.Policy.Meta.UID
@@ -129,7 +132,7 @@ func resourceIAMPolicyCreate(ctx context.Context, d *schema.ResourceData, m inte
 
 			iamResponse, err := config.TMCConnection.NamespaceIAMResourceService.ManageV1alpha1ClusterNamespaceIAMPolicyPatch(iamRequest)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "unable to create Role Binding for namespace"))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to create Role Binding for namespace"))
 			}
 
 			UID = iamResponse.Policy.Meta.UID
@@ -366,7 +369,7 @@ func resourceIAMPolicyInPlaceUpdate(ctx context.Context, d *schema.ResourceData,
 
 		iamResponse, err := config.TMCConnection.OrganizationIAMResourceService.ManageV1alpha1OrganizationIAMPolicyPatch(iamRequest)
 		if err != nil {
-			return diag.FromErr(errors.Wrapf(err, "unable to update Role Binding for organization"))
+			return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to update Role Binding for organization"))
 		}
 
 		UID = iamResponse.Policy.Meta.UID
@@ -378,7 +381,7 @@ func resourceIAMPolicyInPlaceUpdate(ctx context.Context, d *schema.ResourceData,
 
 		iamResponse, err := config.TMCConnection.ClusterGroupIAMResourceService.ManageV1alpha1ClusterGroupIAMPolicyPatch(iamRequest)
 		if err != nil {
-			return diag.FromErr(errors.Wrapf(err, "unable to update Role Binding for cluster group"))
+			return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to update Role Binding for cluster group"))
 		}
 
 		UID = iamResponse.Policy.Meta.UID
@@ -390,7 +393,7 @@ func resourceIAMPolicyInPlaceUpdate(ctx context.Context, d *schema.ResourceData,
 
 		iamResponse, err := config.TMCConnection.ClusterIAMResourceService.ManageV1alpha1ClusterIAMPolicyPatch(iamRequest)
 		if err != nil {
-			return diag.FromErr(errors.Wrapf(err, "unable to update Role Binding for cluster"))
+			return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to update Role Binding for cluster"))
 		}
 
 		UID = iamResponse.Policy.Meta.UID
@@ -402,7 +405,7 @@ func resourceIAMPolicyInPlaceUpdate(ctx context.Context, d *schema.ResourceData,
 
 		iamResponse, err := config.TMCConnection.WorkspaceIAMResourceService.ManageV1alpha1WorkspaceIAMPolicyPatch(iamRequest)
 		if err != nil {
-			return diag.FromErr(errors.Wrapf(err, "unable to update Role Binding for workspace"))
+			return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to update Role Binding for workspace"))
 		}
 
 		UID = iamResponse.Policy.Meta.UID
@@ -414,7 +417,7 @@ func resourceIAMPolicyInPlaceUpdate(ctx context.Context, d *schema.ResourceData,
 
 		iamResponse, err := config.TMCConnection.NamespaceIAMResourceService.ManageV1alpha1ClusterNamespaceIAMPolicyPatch(iamRequest)
 		if err != nil {
-			return diag.FromErr(errors.Wrapf(err, "unable to update Role Binding for namespace"))
+			return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to update Role Binding for namespace"))
 		}
 
 		UID = iamResponse.Policy.Meta.UID
@@ -452,7 +455,7 @@ func resourceIAMPolicyDelete(_ context.Context, d *schema.ResourceData, m interf
 
 		_, err := config.TMCConnection.OrganizationIAMResourceService.ManageV1alpha1OrganizationIAMPolicyPatch(iamRequest)
 		if err != nil && !clienterrors.IsNotFoundError(err) {
-			return diag.FromErr(errors.Wrapf(err, "unable to delete Role Binding for organization"))
+			return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to delete Role Binding for organization"))
 		}
 	case clusterGroupScope:
 		iamRequest := &clustergroupiammodel.VmwareTanzuManageV1alpha1ClustergroupPatchClusterGroupIAMPolicyRequest{
@@ -462,7 +465,7 @@ func resourceIAMPolicyDelete(_ context.Context, d *schema.ResourceData, m interf
 
 		_, err := config.TMCConnection.ClusterGroupIAMResourceService.ManageV1alpha1ClusterGroupIAMPolicyPatch(iamRequest)
 		if err != nil {
-			return diag.FromErr(errors.Wrapf(err, "unable to delete Role Binding for cluster group"))
+			return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to delete Role Binding for cluster group"))
 		}
 	case clusterScope:
 		iamRequest := &clusteriammodel.VmwareTanzuManageV1alpha1ClusterPatchClusterIAMPolicyRequest{
@@ -472,7 +475,7 @@ func resourceIAMPolicyDelete(_ context.Context, d *schema.ResourceData, m interf
 
 		_, err := config.TMCConnection.ClusterIAMResourceService.ManageV1alpha1ClusterIAMPolicyPatch(iamRequest)
 		if err != nil {
-			return diag.FromErr(errors.Wrapf(err, "unable to delete Role Binding for cluster"))
+			return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to delete Role Binding for cluster"))
 		}
 	case workspaceScope:
 		iamRequest := &workspaceiammodel.VmwareTanzuManageV1alpha1WorkspacePatchWorkspaceIAMPolicyRequest{
@@ -482,7 +485,7 @@ func resourceIAMPolicyDelete(_ context.Context, d *schema.ResourceData, m interf
 
 		_, err := config.TMCConnection.WorkspaceIAMResourceService.ManageV1alpha1WorkspaceIAMPolicyPatch(iamRequest)
 		if err != nil {
-			return diag.FromErr(errors.Wrapf(err, "unable to delete Role Binding for workspace"))
+			return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to delete Role Binding for workspace"))
 		}
 	case namespaceScope:
 		iamRequest := &namespaceiammodel.VmwareTanzuManageV1alpha1ClusterNamespacePatchNamespaceIAMPolicyRequest{
@@ -492,7 +495,7 @@ func resourceIAMPolicyDelete(_ context.Context, d *schema.ResourceData, m interf
 
 		_, err := config.TMCConnection.NamespaceIAMResourceService.ManageV1alpha1ClusterNamespaceIAMPolicyPatch(iamRequest)
 		if err != nil {
-			return diag.FromErr(errors.Wrapf(err, "unable to delete Role Binding for namespace"))
+			return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to delete Role Binding for namespace"))
 		}
 	case unknownScope:
 		return diag.Errorf("unable to delete Role Binding; No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scopesAllowed[:], `, `))
@@ -504,3 +507,60 @@ func resourceIAMPolicyDelete(_ context.Context, d *schema.ResourceData, m interf
 
 	return diags
 }
+
+// resourceIAMPolicyImporter imports the role bindings set directly on an object, using an ID made of the scope followed by
+// the object full name, e.g. organization/<org_id>, cluster_group/<cluster_group_name>, workspace/<workspace_name>,
+// cluster/<management_cluster_name>/<provisioner_name>/<cluster_name> or
+// namespace/<management_cluster_name>/<provisioner_name>/<cluster_name>/<namespace_name>.
+func resourceIAMPolicyImporter(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	config, _ := m.(authctx.TanzuContext)
+
+	scopedFullnameData, err := constructScopeFromImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	policyList, err := retrieveRoleBindingListFromServer(config, scopedFullnameData)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := policyForScope(policyList, scopedFullnameData)
+	if policy == nil {
+		return nil, errors.Errorf("unable to import %s: no Role Bindings found on the object", d.Id())
+	}
+
+	d.SetId(policy.Meta.UID)
+
+	if err := d.Set(scopeKey, flattenScope(scopedFullnameData)); err != nil {
+		return nil, errors.Wrapf(err, "unable to set scope for the imported Role Bindings %s", d.Id())
+	}
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(policy.Meta)); err != nil {
+		return nil, errors.Wrapf(err, "unable to set meta for the imported Role Bindings %s", d.Id())
+	}
+
+	if err := d.Set(roleBindingsKey, flattenRoleBindingList(policy.RoleBindings)); err != nil {
+		return nil, errors.Wrapf(err, "unable to set Role Bindings for the imported policy %s", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// policyForScope returns the policy set on the object of the scope, out of a policy list which also holds the policies
+// inherited from the ancestors of the object.
+func policyForScope(policyList []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy, scopedFullnameData *scopedFullname) *iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy {
+	for _, policy := range policyList {
+		if policy == nil || policy.Meta == nil {
+			continue
+		}
+
+		for _, ref := range policy.Meta.ParentReferences {
+			if ref != nil && scopedFullnameData.matchesRid(ref.Rid) {
+				return policy
+			}
+		}
+	}
+
+	return nil
+}
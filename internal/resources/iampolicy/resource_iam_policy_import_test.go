@@ -0,0 +1,95 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package iampolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	iammodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/iam_policy"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+func testPolicy(uid string, rid string) *iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy {
+	return &iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{
+		Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+			UID:              uid,
+			ParentReferences: []*objectmetamodel.VmwareTanzuCoreV1alpha1ObjectReference{{Rid: rid}},
+		},
+	}
+}
+
+func TestPolicyForScope(t *testing.T) {
+	t.Parallel()
+
+	orgPolicy := testPolicy("org-policy", "rid:o:org-id")
+	cgPolicy := testPolicy("cg-policy", "rid:cg:org-id:test-cg")
+	clusterPolicy := testPolicy("cluster-policy", "rid:c:org-id:attached:attached:test-cluster")
+	namespacePolicy := testPolicy("namespace-policy", "rid:ns:org-id:attached:attached:test-cluster:test-ns")
+	otherClusterPolicy := testPolicy("other-cluster-policy", "rid:c:org-id:attached:attached:other-cluster")
+
+	cases := []struct {
+		name        string
+		importID    string
+		policyList  []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy
+		expectedUID string
+	}{
+		{
+			name:        "case for a cluster policy listed after the inherited policies",
+			importID:    "cluster/attached/attached/test-cluster",
+			policyList:  []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{orgPolicy, cgPolicy, clusterPolicy},
+			expectedUID: "cluster-policy",
+		},
+		{
+			name:        "case for a namespace policy listed after the policy of its cluster",
+			importID:    "namespace/attached/attached/test-cluster/test-ns",
+			policyList:  []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{clusterPolicy, namespacePolicy, orgPolicy},
+			expectedUID: "namespace-policy",
+		},
+		{
+			name:        "case for a cluster group policy listed first",
+			importID:    "cluster_group/test-cg",
+			policyList:  []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{cgPolicy, orgPolicy},
+			expectedUID: "cg-policy",
+		},
+		{
+			name:        "case for an organization policy",
+			importID:    "organization/org-id",
+			policyList:  []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{cgPolicy, orgPolicy},
+			expectedUID: "org-policy",
+		},
+		{
+			name:       "case for an organization policy of another organization",
+			importID:   "organization/other-org-id",
+			policyList: []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{orgPolicy},
+		},
+		{
+			name:       "case for a cluster with inherited policies only",
+			importID:   "cluster/attached/attached/test-cluster",
+			policyList: []*iammodel.VmwareTanzuCoreV1alpha1PolicyIAMPolicy{orgPolicy, cgPolicy, otherClusterPolicy, {}},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			scopedFullnameData, err := constructScopeFromImportID(test.importID)
+			require.NoError(t, err)
+
+			policy := policyForScope(test.policyList, scopedFullnameData)
+			if test.expectedUID == "" {
+				require.Nil(t, policy)
+				return
+			}
+
+			require.NotNil(t, policy)
+			require.Equal(t, test.expectedUID, policy.Meta.UID)
+		})
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	clustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/cluster"
 	clustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/clustergroup"
 	namespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/namespace"
@@ -197,3 +198,100 @@ func validateScope(_ context.Context, diff *schema.ResourceDiff, i interface{})
 
 	return nil
 }
+
+// importIDFormats lists the import ID components identifying the IAM policy target for each scope.
+var importIDFormats = map[string][]string{
+	organizationKey: {organizationIDKey},
+	clusterGroupKey: {"cluster_group_name"},
+	clusterKey:      {cluster.ManagementClusterNameKey, cluster.ProvisionerNameKey, "cluster_name"},
+	workspaceKey:    {"workspace_name"},
+	namespaceKey:    {namespace.ManagementClusterNameKey, namespace.ProvisionerNameKey, namespace.ClusterNameKey, "namespace_name"},
+}
+
+func constructScopeFromImportID(id string) (scopedFullnameData *scopedFullname, err error) {
+	scopeKey, parts, err := helper.ParseScopedImportID(id, importIDFormats)
+	if err != nil {
+		return nil, err
+	}
+
+	switch scopeKey {
+	case organizationKey:
+		scopedFullnameData = &scopedFullname{
+			scope:                organizationScope,
+			fullnameOrganization: &organizationmodel.VmwareTanzuManageV1alpha1OrganizationFullName{OrgID: parts[0]},
+		}
+	case clusterGroupKey:
+		scopedFullnameData = &scopedFullname{
+			scope:                clusterGroupScope,
+			fullnameClusterGroup: &clustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFullName{Name: parts[0]},
+		}
+	case clusterKey:
+		scopedFullnameData = &scopedFullname{
+			scope: clusterScope,
+			fullnameCluster: &clustermodel.VmwareTanzuManageV1alpha1ClusterFullName{
+				ManagementClusterName: parts[0],
+				ProvisionerName:       parts[1],
+				Name:                  parts[2],
+			},
+		}
+	case workspaceKey:
+		scopedFullnameData = &scopedFullname{
+			scope:             workspaceScope,
+			fullnameWorkspace: &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceFullName{Name: parts[0]},
+		}
+	case namespaceKey:
+		scopedFullnameData = &scopedFullname{
+			scope: namespaceScope,
+			fullnameNamespace: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName{
+				ManagementClusterName: parts[0],
+				ProvisionerName:       parts[1],
+				ClusterName:           parts[2],
+				Name:                  parts[3],
+			},
+		}
+	}
+
+	return scopedFullnameData, nil
+}
+
+// matchesRid tells whether the resource ID, e.g. rid:c:<org_id>:<management_cluster_name>:<provisioner_name>:<cluster_name>,
+// identifies the object of the scope.
+func (s *scopedFullname) matchesRid(rid string) bool {
+	switch {
+	case s == nil:
+		return false
+	case s.fullnameOrganization != nil:
+		return ridMatches(rid, "o", s.fullnameOrganization.OrgID)
+	case s.fullnameClusterGroup != nil:
+		return ridMatches(rid, "cg", "", s.fullnameClusterGroup.Name)
+	case s.fullnameCluster != nil:
+		return ridMatches(rid, "c", "", s.fullnameCluster.ManagementClusterName, s.fullnameCluster.ProvisionerName, s.fullnameCluster.Name)
+	case s.fullnameWorkspace != nil:
+		return ridMatches(rid, "ws", "", s.fullnameWorkspace.Name)
+	case s.fullnameNamespace != nil:
+		return ridMatches(rid, "ns", "", s.fullnameNamespace.ManagementClusterName, s.fullnameNamespace.ProvisionerName,
+			s.fullnameNamespace.ClusterName, s.fullnameNamespace.Name)
+	}
+
+	return false
+}
+
+// ridMatches tells whether the resource ID is of the given kind and names, in any organization when orgID is empty.
+func ridMatches(rid string, kind string, orgID string, names ...string) bool {
+	parts := strings.Split(rid, ":")
+	if len(parts) != len(names)+3 || parts[0] != "rid" || parts[1] != kind {
+		return false
+	}
+
+	if orgID != "" && parts[2] != orgID {
+		return false
+	}
+
+	for i, name := range names {
+		if parts[i+3] != name {
+			return false
+		}
+	}
+
+	return true
+}
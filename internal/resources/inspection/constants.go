@@ -0,0 +1,41 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package inspection
+
+const (
+	ResourceName        = "tanzu-mission-control_inspection"
+	ResultsResourceName = "tanzu-mission-control_inspection_results"
+
+	NameKey                  = "name"
+	ClusterNameKey           = "cluster_name"
+	ManagementClusterNameKey = "management_cluster_name"
+	ProvisionerNameKey       = "provisioner_name"
+	attachedValue            = "attached"
+	specKey                  = "spec"
+	typeKey                  = "type"
+	statusKey                = "status"
+	phaseKey                 = "phase"
+	phaseInfoKey             = "phase_info"
+	tarballDownloadURLKey    = "tarball_download_url"
+
+	// Inspection types.
+	cisType         = "CIS"
+	liteType        = "LITE"
+	conformanceType = "CONFORMANCE"
+
+	// Inspection results.
+	succeededKey   = "succeeded"
+	summaryKey     = "summary"
+	totalKey       = "total"
+	passedKey      = "passed"
+	failedKey      = "failed"
+	warnedKey      = "warned"
+	skippedKey     = "skipped"
+	resultsKey     = "results"
+	idKey          = "id"
+	descriptionKey = "description"
+	remediationKey = "remediation"
+)
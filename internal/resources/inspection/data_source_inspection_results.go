@@ -0,0 +1,226 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package inspection
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	inspectionmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/inspection"
+)
+
+func DataSourceInspectionResults() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInspectionResultsRead,
+		Schema: map[string]*schema.Schema{
+			NameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the inspection.",
+				Required:    true,
+			},
+			ClusterNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the inspected cluster.",
+				Required:    true,
+			},
+			ManagementClusterNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the management cluster.",
+				Default:     attachedValue,
+				Optional:    true,
+			},
+			ProvisionerNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the provisioner.",
+				Default:     attachedValue,
+				Optional:    true,
+			},
+			typeKey: {
+				Type:        schema.TypeString,
+				Description: "Type of the inspection: CIS, LITE or CONFORMANCE.",
+				Computed:    true,
+			},
+			statusKey: {
+				Type:        schema.TypeMap,
+				Description: "Status of the inspection: phase, phase_info and tarball_download_url.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			succeededKey: {
+				Type:        schema.TypeBool,
+				Description: "Whether the inspection is complete without any failed check.",
+				Computed:    true,
+			},
+			summaryKey: {
+				Type:        schema.TypeList,
+				Description: "Number of checks of the inspection by result, empty until the inspection is complete.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						totalKey: {
+							Type:        schema.TypeInt,
+							Description: "Total number of checks.",
+							Computed:    true,
+						},
+						passedKey: {
+							Type:        schema.TypeInt,
+							Description: "Number of passed checks.",
+							Computed:    true,
+						},
+						failedKey: {
+							Type:        schema.TypeInt,
+							Description: "Number of failed checks.",
+							Computed:    true,
+						},
+						warnedKey: {
+							Type:        schema.TypeInt,
+							Description: "Number of checks passed with warnings or needing a manual review.",
+							Computed:    true,
+						},
+						skippedKey: {
+							Type:        schema.TypeInt,
+							Description: "Number of skipped checks.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			resultsKey: {
+				Type:        schema.TypeList,
+				Description: "Results of the checks of the inspection, empty until the inspection is complete.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						idKey: {
+							Type:        schema.TypeString,
+							Description: "ID of the check, the CIS benchmark control ID or the conformance test name.",
+							Computed:    true,
+						},
+						descriptionKey: {
+							Type:        schema.TypeString,
+							Description: "Description of the check.",
+							Computed:    true,
+						},
+						statusKey: {
+							Type:        schema.TypeString,
+							Description: "Result of the check: PASS, FAIL, WARN or SKIP.",
+							Computed:    true,
+						},
+						remediationKey: {
+							Type:        schema.TypeString,
+							Description: "Remediation of a failed check.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInspectionResultsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+	fn := constructFullname(d)
+
+	resp, err := config.TMCConnection.InspectionScanResourceService.ManageV1alpha1ClusterInspectionScanResourceServiceGet(fn)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control inspection entry, name : %s", fn.Name))
+	}
+
+	scan := resp.Scan
+
+	d.SetId(scan.Meta.UID)
+
+	if err := d.Set(typeKey, flattenSpecType(scan.Spec)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(statusKey, flattenStatus(scan.Status)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var report *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReport
+
+	if scan.Status != nil && helper.PtrString(scan.Status.Phase) == string(inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhaseCOMPLETE) {
+		report = scan.Status.Report
+	}
+
+	if err := d.Set(succeededKey, reportSucceeded(report)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(summaryKey, flattenReportSummary(report)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(resultsKey, flattenReportResults(report)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// reportSucceeded checks that the report of a complete inspection has no failed check.
+func reportSucceeded(report *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReport) bool {
+	if report == nil {
+		return false
+	}
+
+	if report.Summary != nil {
+		return report.Summary.Failed == 0
+	}
+
+	for _, result := range report.Results {
+		if helper.PtrString(result.Status) == string(inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusFAIL) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenReportSummary(report *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReport) (data []interface{}) {
+	if report == nil || report.Summary == nil {
+		return data
+	}
+
+	flattenSummaryData := make(map[string]interface{})
+
+	flattenSummaryData[totalKey] = int(report.Summary.Total)
+	flattenSummaryData[passedKey] = int(report.Summary.Passed)
+	flattenSummaryData[failedKey] = int(report.Summary.Failed)
+	flattenSummaryData[warnedKey] = int(report.Summary.Warned)
+	flattenSummaryData[skippedKey] = int(report.Summary.Skipped)
+
+	return []interface{}{flattenSummaryData}
+}
+
+func flattenReportResults(report *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReport) (data []interface{}) {
+	if report == nil {
+		return data
+	}
+
+	for _, result := range report.Results {
+		if result == nil {
+			continue
+		}
+
+		data = append(data, map[string]interface{}{
+			idKey:          result.ID,
+			descriptionKey: result.Description,
+			statusKey:      helper.PtrString(result.Status),
+			remediationKey: result.Remediation,
+		})
+	}
+
+	return data
+}
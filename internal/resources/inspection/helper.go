@@ -0,0 +1,125 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package inspection
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	inspectionmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/inspection"
+)
+
+// pollInterval is the interval between two gets of an inspection while waiting for it to complete.
+var pollInterval = 10 * time.Second
+
+func constructFullname(d *schema.ResourceData) (fullname *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanFullName) {
+	fullname = &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanFullName{}
+
+	fullname.ClusterName, _ = d.Get(ClusterNameKey).(string)
+
+	fullname.ManagementClusterName, _ = d.Get(ManagementClusterNameKey).(string)
+
+	fullname.Name, _ = d.Get(NameKey).(string)
+
+	fullname.ProvisionerName, _ = d.Get(ProvisionerNameKey).(string)
+
+	return fullname
+}
+
+func expandSpec(inspectionType string) (spec *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanSpec) {
+	spec = &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanSpec{}
+
+	switch inspectionType {
+	case cisType:
+		spec.CisSpec = &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanCISSpec{}
+	case liteType:
+		spec.LiteSpec = &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanLiteSpec{}
+	case conformanceType:
+		spec.ConformanceSpec = &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanConformanceSpec{}
+	}
+
+	return spec
+}
+
+func flattenSpecType(spec *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanSpec) string {
+	switch {
+	case spec == nil:
+		return ""
+	case spec.CisSpec != nil:
+		return cisType
+	case spec.LiteSpec != nil:
+		return liteType
+	case spec.ConformanceSpec != nil:
+		return conformanceType
+	}
+
+	return ""
+}
+
+func flattenStatus(status *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanStatus) map[string]interface{} {
+	data := map[string]interface{}{}
+
+	if status == nil {
+		return data
+	}
+
+	data[phaseKey] = helper.PtrString(status.Phase)
+	data[phaseInfoKey] = status.PhaseInfo
+	data[tarballDownloadURLKey] = status.TarballDownloadURL
+
+	return data
+}
+
+// waitForCompletion polls the inspection until its scan is complete, failing when the scan errors or the timeout elapses.
+func waitForCompletion(ctx context.Context, config authctx.TanzuContext, fn *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanFullName, timeout time.Duration) diag.Diagnostics {
+	var (
+		status *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanStatus
+		getErr error
+	)
+
+	completionRetryable := func() (retry bool, err error) {
+		resp, err := config.TMCConnection.InspectionScanResourceService.ManageV1alpha1ClusterInspectionScanResourceServiceGetWithContext(ctx, fn)
+		if err != nil {
+			getErr = err
+			return false, err
+		}
+
+		if resp.Scan == nil || resp.Scan.Status == nil || resp.Scan.Status.Phase == nil {
+			return true, errors.Errorf("inspection %s has no status yet", fn.Name)
+		}
+
+		status = resp.Scan.Status
+
+		switch *status.Phase {
+		case inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhaseCOMPLETE,
+			inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhaseERROR:
+			return false, nil
+		}
+
+		return true, errors.Errorf("inspection %s is still in phase %s", fn.Name, helper.PtrString(status.Phase))
+	}
+
+	if _, err := helper.RetryUntilTimeoutWithContext(ctx, completionRetryable, pollInterval, timeout); err != nil {
+		if getErr != nil {
+			return clienterrors.ToDiagnostics(errors.Wrapf(getErr, "Unable to get Tanzu Mission Control inspection entry, name : %s", fn.Name))
+		}
+
+		return diag.FromErr(errors.Wrapf(err, "timed out waiting for Tanzu Mission Control inspection %s to complete", fn.Name))
+	}
+
+	if *status.Phase == inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhaseERROR {
+		return diag.Errorf("Tanzu Mission Control inspection %s failed: %s", fn.Name, status.PhaseInfo)
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package inspection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	inspectionmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/inspection"
+)
+
+func resultStatus(status inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus) *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus {
+	return inspectionmodel.NewVmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus(status)
+}
+
+func TestInspectionSpec(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name           string
+		inspectionType string
+	}{
+		{
+			name:           "case for a CIS benchmark inspection",
+			inspectionType: cisType,
+		},
+		{
+			name:           "case for a lite conformance inspection",
+			inspectionType: liteType,
+		},
+		{
+			name:           "case for a full conformance inspection",
+			inspectionType: conformanceType,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.inspectionType, flattenSpecType(expandSpec(test.inspectionType)))
+		})
+	}
+}
+
+func TestFlattenReport(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description       string
+		input             *inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReport
+		expectedSucceeded bool
+		expectedSummary   []interface{}
+		expectedResults   []interface{}
+	}{
+		{
+			description:       "check for nil report",
+			input:             nil,
+			expectedSucceeded: false,
+			expectedSummary:   nil,
+			expectedResults:   nil,
+		},
+		{
+			description: "normal scenario with a failed check",
+			input: &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReport{
+				Summary: &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReportSummary{
+					Total:   3,
+					Passed:  1,
+					Failed:  1,
+					Skipped: 1,
+				},
+				Results: []*inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReportResult{
+					{
+						ID:     "1.1.1",
+						Status: resultStatus(inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusPASS),
+					},
+					{
+						ID:          "1.2.1",
+						Description: "Ensure that the --anonymous-auth argument is set to false",
+						Status:      resultStatus(inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusFAIL),
+						Remediation: "Set --anonymous-auth=false",
+					},
+					{
+						ID:     "1.3.1",
+						Status: resultStatus(inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusSKIP),
+					},
+				},
+			},
+			expectedSucceeded: false,
+			expectedSummary: []interface{}{
+				map[string]interface{}{
+					totalKey:   3,
+					passedKey:  1,
+					failedKey:  1,
+					warnedKey:  0,
+					skippedKey: 1,
+				},
+			},
+			expectedResults: []interface{}{
+				map[string]interface{}{
+					idKey:          "1.1.1",
+					descriptionKey: "",
+					statusKey:      "PASS",
+					remediationKey: "",
+				},
+				map[string]interface{}{
+					idKey:          "1.2.1",
+					descriptionKey: "Ensure that the --anonymous-auth argument is set to false",
+					statusKey:      "FAIL",
+					remediationKey: "Set --anonymous-auth=false",
+				},
+				map[string]interface{}{
+					idKey:          "1.3.1",
+					descriptionKey: "",
+					statusKey:      "SKIP",
+					remediationKey: "",
+				},
+			},
+		},
+		{
+			description: "scenario without summary and with warnings only",
+			input: &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReport{
+				Results: []*inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReportResult{
+					{
+						ID:     "[sig-api-machinery] Servers with support for Table transformation should return a 406",
+						Status: resultStatus(inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusWARN),
+					},
+				},
+			},
+			expectedSucceeded: true,
+			expectedSummary:   nil,
+			expectedResults: []interface{}{
+				map[string]interface{}{
+					idKey:          "[sig-api-machinery] Servers with support for Table transformation should return a 406",
+					descriptionKey: "",
+					statusKey:      "WARN",
+					remediationKey: "",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			require.Equal(t, test.expectedSucceeded, reportSucceeded(test.input))
+			require.Equal(t, test.expectedSummary, flattenReportSummary(test.input))
+			require.Equal(t, test.expectedResults, flattenReportResults(test.input))
+		})
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package inspection
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	inspectionmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/inspection"
+)
+
+// ResourceInspection runs an inspection on a cluster and waits for its scan to complete.
+// An inspection cannot be updated, changing any of its arguments runs a new one.
+func ResourceInspection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceInspectionCreate,
+		ReadContext:   resourceInspectionRead,
+		DeleteContext: resourceInspectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceInspectionImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: inspectionSchema,
+	}
+}
+
+var inspectionSchema = map[string]*schema.Schema{
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the inspection.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	ClusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the cluster to inspect.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	ManagementClusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the management cluster.",
+		Default:     attachedValue,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	ProvisionerNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the provisioner.",
+		Default:     attachedValue,
+		Optional:    true,
+		ForceNew:    true,
+	},
+	specKey: {
+		Type:        schema.TypeList,
+		Description: "Spec for the inspection.",
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				typeKey: {
+					Type:         schema.TypeString,
+					Description:  "Type of the inspection: CIS for a CIS benchmark, LITE for a lite Kubernetes conformance or CONFORMANCE for a full Kubernetes conformance inspection.",
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringInSlice([]string{cisType, liteType, conformanceType}, false),
+				},
+			},
+		},
+	},
+	statusKey: {
+		Type:        schema.TypeMap,
+		Description: "Status of the inspection: phase, phase_info and tarball_download_url.",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+}
+
+func resourceInspectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructFullname(d)
+
+	resp, err := config.TMCConnection.InspectionScanResourceService.ManageV1alpha1ClusterInspectionScanResourceServiceGet(fn)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			d.SetId("")
+			return diags
+		}
+
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control inspection entry, name : %s", fn.Name))
+	}
+
+	scan := resp.Scan
+
+	d.SetId(scan.Meta.UID)
+
+	if err := d.Set(specKey, []interface{}{map[string]interface{}{typeKey: flattenSpecType(scan.Spec)}}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(statusKey, flattenStatus(scan.Status)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceInspectionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructFullname(d)
+
+	inspectionType, _ := d.Get(helper.GetFirstElementOf(specKey, typeKey)).(string)
+
+	request := &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanRequest{
+		Scan: &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanScan{
+			FullName: fn,
+			Spec:     expandSpec(inspectionType),
+		},
+	}
+
+	response, err := config.TMCConnection.InspectionScanResourceService.ManageV1alpha1ClusterInspectionScanResourceServiceCreate(request)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control inspection entry, name : %s", fn.Name))
+	}
+
+	d.SetId(response.Scan.Meta.UID)
+
+	if diags = waitForCompletion(ctx, config, fn, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceInspectionRead(ctx, d, m)...)
+}
+
+func resourceInspectionDelete(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+	fn := constructFullname(d)
+
+	err := config.TMCConnection.InspectionScanResourceService.ManageV1alpha1ClusterInspectionScanResourceServiceDelete(fn)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control inspection entry, name : %s", fn.Name))
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+// resourceInspectionImporter imports an inspection using an ID of the form <management_cluster_name>/<provisioner_name>/<cluster_name>/<name>.
+func resourceInspectionImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	keys := []string{ManagementClusterNameKey, ProvisionerNameKey, ClusterNameKey, NameKey}
+
+	parts, err := helper.ParseImportID(d.Id(), keys...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, key := range keys {
+		if err = d.Set(key, parts[i]); err != nil {
+			return nil, errors.Wrapf(err, "Failed to set %s for %s", key, parts[3])
+		}
+	}
+
+	return helper.ReadImportedState(ctx, d, m, resourceInspectionRead)
+}
@@ -0,0 +1,164 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package inspection
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	inspectionmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/inspection"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+const (
+	https                = "https:/"
+	clAPIVersionAndGroup = "v1alpha1/clusters"
+)
+
+// bodyInspectingResponder checks the body of the request against the expected content before responding.
+func bodyInspectingResponder(t *testing.T, expectedContent interface{}, successResponse int, successResponseBody interface{}) httpmock.Responder {
+	return func(r *http.Request) (*http.Response, error) {
+		if expectedContent != nil {
+			expectedBytes, err := json.Marshal(expectedContent)
+			if err != nil {
+				return nil, err
+			}
+
+			if r.Body == nil {
+				t.Errorf("expected body on request %s %s", r.Method, r.URL)
+				return httpmock.NewStringResponse(http.StatusBadRequest, "expected body on request"), nil
+			}
+
+			bodyBytes, err := io.ReadAll(r.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			var bodyInterface, expectedInterface map[string]interface{}
+
+			if err := json.Unmarshal(bodyBytes, &bodyInterface); err != nil {
+				return nil, err
+			}
+
+			if err := json.Unmarshal(expectedBytes, &expectedInterface); err != nil {
+				return nil, err
+			}
+
+			if diff := deep.Equal(bodyInterface, expectedInterface); diff != nil {
+				t.Errorf("unexpected body on request %s %s: %v", r.Method, r.URL, diff)
+				return httpmock.NewStringResponse(http.StatusBadRequest, "unexpected body on request"), nil
+			}
+		}
+
+		return httpmock.NewJsonResponse(successResponse, successResponseBody)
+	}
+}
+
+// Register a new responder when the given call is made.
+func changeStateResponder(registerFunc func(), successResponse int, successResponseBody interface{}) httpmock.Responder {
+	return func(r *http.Request) (*http.Response, error) {
+		registerFunc()
+		return httpmock.NewJsonResponse(successResponse, successResponseBody)
+	}
+}
+
+func (testConfig *testAcceptanceConfig) setupHTTPMocks(t *testing.T) {
+	httpmock.Activate()
+	t.Cleanup(httpmock.Deactivate)
+
+	endpoint := os.Getenv("TMC_ENDPOINT")
+
+	fullName := &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanFullName{
+		ClusterName:           testConfig.ClusterName,
+		ManagementClusterName: attachedValue,
+		Name:                  testConfig.InspectionName,
+		ProvisionerName:       attachedValue,
+	}
+
+	spec := &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanSpec{
+		CisSpec: &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanCISSpec{},
+	}
+
+	postRequest := &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanRequest{
+		Scan: &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanScan{
+			FullName: fullName,
+			Spec:     spec,
+		},
+	}
+
+	meta := &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+		UID:             "inspection-uid",
+		ResourceVersion: "v1",
+	}
+
+	postResponse := &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanResponse{
+		Scan: &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanScan{
+			FullName: fullName,
+			Meta:     meta,
+			Spec:     spec,
+			Status: &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanStatus{
+				Phase: inspectionmodel.NewVmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhase(inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhasePENDING),
+			},
+		},
+	}
+
+	getResponse := &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanResponse{
+		Scan: &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanScan{
+			FullName: fullName,
+			Meta:     meta,
+			Spec:     spec,
+			Status: &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanStatus{
+				Phase:              inspectionmodel.NewVmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhase(inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanStatusPhaseCOMPLETE),
+				TarballDownloadURL: "https://dummy.tmc.mock.vmware.com/inspection.tar.gz",
+				Report: &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReport{
+					Summary: &inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReportSummary{
+						Total:  2,
+						Passed: 1,
+						Warned: 1,
+					},
+					Results: []*inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReportResult{
+						{
+							ID:          "1.1.1",
+							Description: "Ensure that the API server pod specification file permissions are set to 644 or more restrictive",
+							Status:      inspectionmodel.NewVmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus(inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusPASS),
+						},
+						{
+							ID:          "1.2.1",
+							Description: "Ensure that the --anonymous-auth argument is set to false",
+							Status:      inspectionmodel.NewVmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatus(inspectionmodel.VmwareTanzuManageV1alpha1ClusterInspectionScanReportResultStatusWARN),
+							Remediation: "Edit the API server pod specification file and set --anonymous-auth=false",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	postEndpoint := helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ClusterName, "inspection/scans").String()
+	getEndpoint := helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ClusterName, "inspection/scans", testConfig.InspectionName).String()
+
+	httpmock.RegisterResponder("POST", postEndpoint,
+		bodyInspectingResponder(t, postRequest, http.StatusOK, postResponse))
+
+	httpmock.RegisterResponder("GET", getEndpoint,
+		bodyInspectingResponder(t, nil, http.StatusOK, getResponse))
+
+	httpmock.RegisterResponder("DELETE", getEndpoint, changeStateResponder(
+		// Set up the get to return 404 after the inspection has been 'deleted'.
+		func() {
+			httpmock.RegisterResponder("GET", getEndpoint,
+				httpmock.NewStringResponder(http.StatusNotFound, "Not found"))
+		},
+		http.StatusOK,
+		nil))
+}
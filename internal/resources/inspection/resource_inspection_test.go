@@ -0,0 +1,144 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package inspection
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	testhelper "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/testing"
+)
+
+const (
+	inspectionEnvTestFlag = "ENABLE_INSPECTION_ENV_TEST"
+	inspectionResourceVar = "test_inspection"
+	resultsDataSourceVar  = "test_inspection_results"
+	inspectionNamePrefix  = "tf-inspection-test"
+)
+
+type testAcceptanceConfig struct {
+	Provider               *schema.Provider
+	ClusterName            string
+	InspectionName         string
+	InspectionResourceName string
+	ResultsDataSourceName  string
+}
+
+func testGetDefaultAcceptanceConfig(t *testing.T) *testAcceptanceConfig {
+	return &testAcceptanceConfig{
+		Provider:               initTestProvider(t),
+		ClusterName:            getEnvOrDefault("INSPECTION_CLUSTER_NAME", acctest.RandomWithPrefix(inspectionNamePrefix)),
+		InspectionName:         acctest.RandomWithPrefix(inspectionNamePrefix),
+		InspectionResourceName: fmt.Sprintf("%s.%s", ResourceName, inspectionResourceVar),
+		ResultsDataSourceName:  fmt.Sprintf("data.%s.%s", ResultsResourceName, resultsDataSourceVar),
+	}
+}
+
+func getEnvOrDefault(name string, defaultValue string) string {
+	if value, found := os.LookupEnv(name); found {
+		return value
+	}
+
+	return defaultValue
+}
+
+func getConfigureContextFunc() func(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	if _, found := os.LookupEnv(inspectionEnvTestFlag); !found {
+		return authctx.ProviderConfigureContextWithDefaultTransportForTesting
+	}
+
+	return authctx.ProviderConfigureContext
+}
+
+func TestAcceptanceForInspectionResource(t *testing.T) {
+	testConfig := testGetDefaultAcceptanceConfig(t)
+
+	// If the flag to execute inspection tests is not found, run this as a mock test by setting up an http intercept for each endpoint.
+	_, found := os.LookupEnv(inspectionEnvTestFlag)
+	if !found {
+		os.Setenv("TF_ACC", "true")
+		os.Setenv("TMC_ENDPOINT", "dummy.tmc.mock.vmware.com")
+		os.Setenv("VMW_CLOUD_API_TOKEN", "dummy")
+		os.Setenv("VMW_CLOUD_ENDPOINT", "console.cloud.vmware.com")
+
+		log.Println("Setting up the mock endpoints...")
+		testConfig.setupHTTPMocks(t)
+	} else {
+		// Environment variables with non default values required for a successful call to the inspection service.
+		requiredVars := []string{
+			"VMW_CLOUD_ENDPOINT",
+			"TMC_ENDPOINT",
+			"VMW_CLOUD_API_TOKEN",
+			"INSPECTION_CLUSTER_NAME",
+		}
+
+		// Check if the required environment variables are set.
+		for _, name := range requiredVars {
+			if _, found := os.LookupEnv(name); !found {
+				t.Errorf("required environment variable '%s' missing", name)
+			}
+		}
+	}
+
+	t.Log("start inspection resource acceptance tests!")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testhelper.TestPreCheck(t),
+		ProviderFactories: testhelper.GetTestProviderFactories(testConfig.Provider),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig.getTestInspectionResourceConfigValue(),
+				Check:  testConfig.checkInspectionResourceAttributes(),
+			},
+		},
+	},
+	)
+
+	t.Log("inspection resource acceptance test completed")
+}
+
+func (testConfig *testAcceptanceConfig) getTestInspectionResourceConfigValue() string {
+	return fmt.Sprintf(`
+	resource "%s" "%s" {
+	  name         = "%s"
+	  cluster_name = "%s"
+
+	  spec {
+	    type = "CIS"
+	  }
+	}
+
+	data "%s" "%s" {
+	  name         = %s.name
+	  cluster_name = %s.cluster_name
+	}
+	`,
+		ResourceName, inspectionResourceVar, testConfig.InspectionName, testConfig.ClusterName,
+		ResultsResourceName, resultsDataSourceVar, testConfig.InspectionResourceName, testConfig.InspectionResourceName,
+	)
+}
+
+func (testConfig *testAcceptanceConfig) checkInspectionResourceAttributes() resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr(testConfig.InspectionResourceName, NameKey, testConfig.InspectionName),
+		resource.TestCheckResourceAttr(testConfig.InspectionResourceName, "spec.0.type", cisType),
+		resource.TestCheckResourceAttr(testConfig.InspectionResourceName, "status.phase", "COMPLETE"),
+		resource.TestCheckResourceAttr(testConfig.ResultsDataSourceName, typeKey, cisType),
+		resource.TestCheckResourceAttr(testConfig.ResultsDataSourceName, "status.phase", "COMPLETE"),
+		resource.TestCheckResourceAttrSet(testConfig.ResultsDataSourceName, "summary.0.total"),
+		resource.TestCheckResourceAttrSet(testConfig.ResultsDataSourceName, succeededKey),
+	)
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
 	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	kustomizationclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kustomization/cluster"
 	kustomizationclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/kustomization/clustergroup"
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
@@ -41,6 +42,9 @@ func ResourceKustomization() *schema.Resource {
 		ReadContext:   resourceKustomizationRead,
 		UpdateContext: resourceKustomizationInPlaceUpdate,
 		DeleteContext: resourceKustomizationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKustomizationImporter,
+		},
 		CustomizeDiff: schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey})),
 	}
 }
@@ -217,7 +221,7 @@ func resourceKustomizationCreate(ctx context.Context, d *schema.ResourceData, m
 
 	err := enableContinuousDelivery(&config, scopedFullnameData, meta)
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control kustomization entry, name : %s", kustomizationName))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control kustomization entry, name : %s", kustomizationName))
 	}
 
 	switch scopedFullnameData.Scope {
@@ -233,7 +237,7 @@ func resourceKustomizationCreate(ctx context.Context, d *schema.ResourceData, m
 
 			kustomizationResponse, err := config.TMCConnection.ClusterKustomizationResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdKustomizationResourceServiceCreate(kustomizationReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster kustomization entry, name : %s", kustomizationName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster kustomization entry, name : %s", kustomizationName))
 			}
 
 			UID = kustomizationResponse.Kustomization.Meta.UID
@@ -250,7 +254,7 @@ func resourceKustomizationCreate(ctx context.Context, d *schema.ResourceData, m
 
 			kustomizationResponse, err := config.TMCConnection.ClusterGroupKustomizationResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdKustomizationResourceServiceCreate(kustomizationReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group kustomization entry, name : %s", kustomizationName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group kustomization entry, name : %s", kustomizationName))
 			}
 
 			UID = kustomizationResponse.Kustomization.Meta.UID
@@ -289,14 +293,14 @@ func resourceKustomizationDelete(ctx context.Context, d *schema.ResourceData, m
 		if scopedFullnameData.FullnameCluster != nil {
 			err := config.TMCConnection.ClusterKustomizationResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdKustomizationResourceServiceDelete(scopedFullnameData.FullnameCluster)
 			if err != nil && !clienterrors.IsNotFoundError(err) {
-				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster kustomization entry, name : %s", kustomizationName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster kustomization entry, name : %s", kustomizationName))
 			}
 		}
 	case commonscope.ClusterGroupScope:
 		if scopedFullnameData.FullnameClusterGroup != nil {
 			err := config.TMCConnection.ClusterGroupKustomizationResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdKustomizationResourceServiceDelete(scopedFullnameData.FullnameClusterGroup)
 			if err != nil && !clienterrors.IsNotFoundError(err) {
-				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group kustomization entry, name : %s", kustomizationName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group kustomization entry, name : %s", kustomizationName))
 			}
 		}
 	case commonscope.UnknownScope:
@@ -363,7 +367,7 @@ func resourceKustomizationInPlaceUpdate(ctx context.Context, d *schema.ResourceD
 
 			_, err = config.TMCConnection.ClusterKustomizationResourceService.VmwareTanzuManageV1alpha1ClusterFluxcdKustomizationResourceServiceUpdate(kustomizationReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster kustomization entry, name : %s", kustomizationName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster kustomization entry, name : %s", kustomizationName))
 			}
 		}
 	case commonscope.ClusterGroupScope:
@@ -380,7 +384,7 @@ func resourceKustomizationInPlaceUpdate(ctx context.Context, d *schema.ResourceD
 
 			_, err = config.TMCConnection.ClusterGroupKustomizationResourceService.VmwareTanzuManageV1alpha1ClustergroupFluxcdKustomizationResourceServiceUpdate(kustomizationReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group kustomization entry, name : %s", kustomizationName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group kustomization entry, name : %s", kustomizationName))
 			}
 		}
 	case commonscope.UnknownScope:
@@ -436,3 +440,28 @@ func updateCheckForSpec(d *schema.ResourceData, atomicSpec *kustomizationcluster
 
 	return true
 }
+
+// resourceKustomizationImporter imports a kustomization using an ID of the form cluster/<management_cluster_name>/<provisioner_name>/<cluster_name>/<namespace_name>/<name>
+// or cluster_group/<cluster_group_name>/<namespace_name>/<name>.
+func resourceKustomizationImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	scopedFullnameData, err := scope.ConstructScopeFromImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	scopeData, name, namespaceName := scope.FlattenScope(scopedFullnameData)
+
+	if err = d.Set(nameKey, name); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the kustomization %s", name)
+	}
+
+	if err = d.Set(namespaceNameKey, namespaceName); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set namespace name for the kustomization %s", name)
+	}
+
+	if err = d.Set(commonscope.ScopeKey, scopeData); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set scope for the kustomization %s", name)
+	}
+
+	return helper.ReadImportedState(ctx, d, m, resourceKustomizationRead)
+}
@@ -0,0 +1,36 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package managementcluster
+
+const (
+	ResourceName                    = "tanzu-mission-control_management_cluster"
+	ManagementClustersResourceName  = "tanzu-mission-control_management_clusters"
+	NameKey                         = "name"
+	SpecKey                         = "spec"
+	StatusKey                       = "status"
+	kubernetesProviderTypeKey       = "kubernetes_provider_type"
+	clusterGroupKey                 = "cluster_group"
+	proxyNameKey                    = "proxy_name"
+	imageRegistryKey                = "image_registry"
+	workloadClusterProxyNameKey     = "managed_workload_cluster_proxy_name"
+	workloadClusterImageRegistryKey = "managed_workload_cluster_image_registry"
+	registerKey                     = "register_management_cluster"
+	kubeConfigPathKey               = "kubeconfig_file"
+	kubeConfigRawKey                = "kubeconfig_raw"
+	registrationLinkKey             = "registration_link"
+	phaseKey                        = "phase"
+	healthKey                       = "health"
+	kubeServerVersionKey            = "kube_server_version"
+	managementClustersKey           = "management_clusters"
+	uidKey                          = "uid"
+	labelsKey                       = "labels"
+	defaultClusterGroup             = "default"
+
+	// TkgKubernetesProviderType is the provider type of Tanzu Kubernetes Grid management clusters.
+	TkgKubernetesProviderType = "VMWARE_TANZU_KUBERNETES_GRID"
+	// TkgServiceKubernetesProviderType is the provider type of vSphere with Tanzu supervisor clusters.
+	TkgServiceKubernetesProviderType = "VMWARE_TANZU_KUBERNETES_GRID_SERVICE"
+)
@@ -0,0 +1,180 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package managementcluster
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/pagination"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	managementclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/managementcluster"
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+func DataSourceManagementClusters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceManagementClustersRead,
+		Schema: map[string]*schema.Schema{
+			NameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the management clusters to list, supports globbing with *.",
+				Optional:    true,
+			},
+			labelsKey: {
+				Type:        schema.TypeMap,
+				Description: "Only list the management clusters having all these labels.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			managementClustersKey: {
+				Type:        schema.TypeList,
+				Description: "Management clusters matching the filters, sorted by name.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						uidKey: {
+							Type:        schema.TypeString,
+							Description: "UID of the management cluster.",
+							Computed:    true,
+						},
+						NameKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the management cluster.",
+							Computed:    true,
+						},
+						kubernetesProviderTypeKey: {
+							Type:        schema.TypeString,
+							Description: "Kubernetes provider type of the management cluster.",
+							Computed:    true,
+						},
+						clusterGroupKey: {
+							Type:        schema.TypeString,
+							Description: "Default cluster group for the workload clusters of the management cluster.",
+							Computed:    true,
+						},
+						phaseKey: {
+							Type:        schema.TypeString,
+							Description: "Phase of the management cluster.",
+							Computed:    true,
+						},
+						healthKey: {
+							Type:        schema.TypeString,
+							Description: "Health of the management cluster.",
+							Computed:    true,
+						},
+						labelsKey: {
+							Type:        schema.TypeMap,
+							Description: "Labels of the management cluster.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceManagementClustersRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+
+	name, _ := d.Get(NameKey).(string)
+	selector := helper.GetStringMap(d.Get(labelsKey))
+
+	request := &managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterListManagementClustersRequestParameters{
+		SearchScope: &managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterSearchScope{
+			Name: name,
+		},
+		IncludeTotalCount: true,
+	}
+
+	managementClusters, err := pagination.ListAll(pagination.DefaultPageSize, func(options *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions) (*pagination.Page[*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster], error) {
+		request.Pagination = options
+
+		resp, err := config.TMCConnection.ManagementClusterResourceService.ManageV1alpha1ManagementClusterResourceServiceList(request)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pagination.Page[*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster]{Items: resp.ManagementClusters, TotalCount: resp.TotalCount}, nil
+	})
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrap(err, "Unable to list Tanzu Mission Control management clusters"))
+	}
+
+	if err := d.Set(managementClustersKey, flattenManagementClusters(filterManagementClusters(managementClusters, selector))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helper.ConstructListDataSourceID(name, helper.LabelSelectorString(selector)))
+
+	return nil
+}
+
+func filterManagementClusters(managementClusters []*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster, selector map[string]string) []*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster {
+	filtered := make([]*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster, 0, len(managementClusters))
+
+	for _, managementCluster := range managementClusters {
+		if managementCluster == nil || managementCluster.FullName == nil {
+			continue
+		}
+
+		var labels map[string]string
+		if managementCluster.Meta != nil {
+			labels = managementCluster.Meta.Labels
+		}
+
+		if helper.MatchLabels(labels, selector) {
+			filtered = append(filtered, managementCluster)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].FullName.Name < filtered[j].FullName.Name
+	})
+
+	return filtered
+}
+
+func flattenManagementClusters(managementClusters []*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster) []interface{} {
+	data := make([]interface{}, 0, len(managementClusters))
+
+	for _, managementCluster := range managementClusters {
+		flattenManagementCluster := map[string]interface{}{
+			NameKey: managementCluster.FullName.Name,
+		}
+
+		if managementCluster.Meta != nil {
+			flattenManagementCluster[uidKey] = managementCluster.Meta.UID
+			flattenManagementCluster[labelsKey] = managementCluster.Meta.Labels
+		}
+
+		if managementCluster.Spec != nil {
+			flattenManagementCluster[kubernetesProviderTypeKey] = managementCluster.Spec.KubernetesProviderType
+			flattenManagementCluster[clusterGroupKey] = managementCluster.Spec.DefaultClusterGroup
+		}
+
+		if managementCluster.Status != nil {
+			flattenManagementCluster[phaseKey] = managementCluster.Status.Phase
+			flattenManagementCluster[healthKey] = managementCluster.Status.Health
+		}
+
+		data = append(data, flattenManagementCluster)
+	}
+
+	return data
+}
@@ -0,0 +1,127 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package managementcluster
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	managementclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/managementcluster"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+func TestFlattenSpec(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterSpec
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil spec",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete spec",
+			input: &managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterSpec{
+				KubernetesProviderType:              TkgKubernetesProviderType,
+				DefaultClusterGroup:                 "default",
+				ProxyName:                           "proxy",
+				ImageRegistry:                       "registry",
+				DefaultWorkloadClusterProxyName:     "workload-proxy",
+				DefaultWorkloadClusterImageRegistry: "workload-registry",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					kubernetesProviderTypeKey:       TkgKubernetesProviderType,
+					clusterGroupKey:                 "default",
+					proxyNameKey:                    "proxy",
+					imageRegistryKey:                "registry",
+					workloadClusterProxyNameKey:     "workload-proxy",
+					workloadClusterImageRegistryKey: "workload-registry",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenSpec(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestConstructSpec(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, managementClusterSchema, map[string]interface{}{
+		NameKey: "tkgm-mc",
+		SpecKey: []interface{}{
+			map[string]interface{}{
+				kubernetesProviderTypeKey: TkgServiceKubernetesProviderType,
+				proxyNameKey:              "proxy",
+			},
+		},
+	})
+
+	require.Equal(t, &managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterSpec{
+		KubernetesProviderType: TkgServiceKubernetesProviderType,
+		DefaultClusterGroup:    defaultClusterGroup,
+		ProxyName:              "proxy",
+	}, constructSpec(d))
+}
+
+func TestFilterManagementClusters(t *testing.T) {
+	t.Parallel()
+
+	managementCluster := func(name string, labels map[string]string) *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster {
+		return &managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster{
+			FullName: &managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterFullName{Name: name},
+			Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: labels},
+		}
+	}
+
+	cases := []struct {
+		description string
+		selector    map[string]string
+		expected    []string
+	}{
+		{
+			description: "no selector returns all management clusters sorted by name",
+			expected:    []string{"attached", "tkgm", "tkgs"},
+		},
+		{
+			description: "selector filters on labels",
+			selector:    map[string]string{"env": "prod"},
+			expected:    []string{"tkgs"},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := filterManagementClusters([]*managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster{
+				managementCluster("tkgs", map[string]string{"env": "prod"}),
+				nil,
+				managementCluster("tkgm", map[string]string{"env": "dev"}),
+				managementCluster("attached", nil),
+			}, test.selector)
+
+			names := make([]string, 0, len(actual))
+			for _, each := range actual {
+				names = append(names, each.FullName.Name)
+			}
+
+			require.Equal(t, test.expected, names)
+		})
+	}
+}
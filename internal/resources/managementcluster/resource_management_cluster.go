@@ -0,0 +1,237 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package managementcluster
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	managementclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/managementcluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/cluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/cluster/manifest"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+const registrationLinkPollInterval = 5 * time.Second
+
+func ResourceManagementCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceManagementClusterCreate,
+		ReadContext:   resourceManagementClusterRead,
+		UpdateContext: resourceManagementClusterInPlaceUpdate,
+		DeleteContext: resourceManagementClusterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceManagementClusterImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: managementClusterSchema,
+	}
+}
+
+func constructFullname(d *schema.ResourceData) *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterFullName {
+	name, _ := d.Get(NameKey).(string)
+
+	return &managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterFullName{
+		Name: name,
+	}
+}
+
+func resourceManagementClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	fn := constructFullname(d)
+
+	request := &managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterRequest{
+		ManagementCluster: &managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster{
+			FullName: fn,
+			Meta:     common.ConstructMeta(d),
+			Spec:     constructSpec(d),
+		},
+	}
+
+	response, err := config.TMCConnection.ManagementClusterResourceService.ManageV1alpha1ManagementClusterResourceServiceCreate(request)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to register Tanzu Mission Control management cluster entry, name : %s", fn.Name))
+	}
+
+	d.SetId(response.ManagementCluster.Meta.UID)
+
+	if _, ok := d.GetOk(registerKey); ok {
+		if err := applyRegistrationManifest(ctx, d, config, response.ManagementCluster); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+	}
+
+	return append(diags, resourceManagementClusterRead(ctx, d, m)...)
+}
+
+// applyRegistrationManifest applies the manifest of the registration link on the management cluster through the
+// kubeconfig of the register_management_cluster block, waiting for the link to be issued when needed.
+func applyRegistrationManifest(ctx context.Context, d *schema.ResourceData, config authctx.TanzuContext, managementCluster *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterManagementCluster) error {
+	kubeConfigFile, _ := d.Get(helper.GetFirstElementOf(registerKey, kubeConfigPathKey)).(string)
+	rawKubeConfig, _ := d.Get(helper.GetFirstElementOf(registerKey, kubeConfigRawKey)).(string)
+
+	k8sclient, err := cluster.NewK8sClient(kubeConfigFile, rawKubeConfig)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to create kubernetes client of management cluster %s", managementCluster.FullName.Name)
+	}
+
+	registrationLink := ""
+	if managementCluster.Status != nil {
+		registrationLink = managementCluster.Status.RegistrationURL
+	}
+
+	getRegistrationLink := func() (retry bool, err error) {
+		resp, err := config.TMCConnection.ManagementClusterResourceService.ManageV1alpha1ManagementClusterResourceServiceGetWithContext(ctx, managementCluster.FullName)
+		if err != nil {
+			return false, err
+		}
+
+		if resp.ManagementCluster.Status != nil {
+			registrationLink = resp.ManagementCluster.Status.RegistrationURL
+		}
+
+		return registrationLink == "", nil
+	}
+
+	if registrationLink == "" {
+		_, err = helper.RetryUntilTimeoutWithContext(ctx, getRegistrationLink, registrationLinkPollInterval, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return errors.Wrapf(err, "Unable to get registration link of management cluster %s", managementCluster.FullName.Name)
+		}
+
+		if registrationLink == "" {
+			return errors.Errorf("registration link of management cluster %s was not issued in time", managementCluster.FullName.Name)
+		}
+	}
+
+	registrationManifest, err := manifest.GetK8sManifest(registrationLink)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Applying registration manifest of management cluster %s", managementCluster.FullName.Name)
+
+	return manifest.Create(ctx, k8sclient, string(registrationManifest), true)
+}
+
+func resourceManagementClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	fn := constructFullname(d)
+
+	resp, err := config.TMCConnection.ManagementClusterResourceService.ManageV1alpha1ManagementClusterResourceServiceGet(fn)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) && !helper.IsDataRead(ctx) {
+			_ = schema.RemoveFromState(d, m)
+			return diags
+		}
+
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control management cluster entry, name : %s", fn.Name))
+	}
+
+	managementCluster := resp.ManagementCluster
+
+	d.SetId(managementCluster.Meta.UID)
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(managementCluster.Meta)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(SpecKey, flattenSpec(managementCluster.Spec)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(StatusKey, flattenStatus(managementCluster.Status)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	registrationLink := ""
+	if managementCluster.Status != nil {
+		registrationLink = managementCluster.Status.RegistrationURL
+	}
+
+	if err := d.Set(registrationLinkKey, registrationLink); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceManagementClusterInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+
+	if !common.HasMetaChanged(d) && !d.HasChange(SpecKey) {
+		return resourceManagementClusterRead(ctx, d, m)
+	}
+
+	fn := constructFullname(d)
+
+	getResp, err := config.TMCConnection.ManagementClusterResourceService.ManageV1alpha1ManagementClusterResourceServiceGet(fn)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control management cluster entry, name : %s", fn.Name))
+	}
+
+	meta := common.ConstructMeta(d)
+
+	if value, ok := getResp.ManagementCluster.Meta.Labels[common.CreatorLabelKey]; ok {
+		meta.Labels[common.CreatorLabelKey] = value
+	}
+
+	getResp.ManagementCluster.Meta.Labels = meta.Labels
+	getResp.ManagementCluster.Meta.Description = meta.Description
+	getResp.ManagementCluster.Spec = constructSpec(d)
+
+	_, err = config.TMCConnection.ManagementClusterResourceService.ManageV1alpha1ManagementClusterResourceServiceUpdate(
+		&managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterRequest{
+			ManagementCluster: getResp.ManagementCluster,
+		},
+	)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control management cluster entry, name : %s", fn.Name))
+	}
+
+	return resourceManagementClusterRead(ctx, d, m)
+}
+
+func resourceManagementClusterDelete(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	fn := constructFullname(d)
+
+	err := config.TMCConnection.ManagementClusterResourceService.ManageV1alpha1ManagementClusterResourceServiceDelete(fn)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to deregister Tanzu Mission Control management cluster entry, name : %s", fn.Name))
+	}
+
+	_ = schema.RemoveFromState(d, m)
+
+	return diags
+}
+
+// resourceManagementClusterImporter imports a management cluster using an ID of the form <name>.
+func resourceManagementClusterImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts, err := helper.ParseImportID(d.Id(), NameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.Set(NameKey, parts[0]); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the management cluster %s", parts[0])
+	}
+
+	return helper.ReadImportedState(ctx, d, m, resourceManagementClusterRead)
+}
@@ -0,0 +1,177 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package managementcluster
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	managementclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/managementcluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+var managementClusterSchema = map[string]*schema.Schema{
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the management cluster",
+		Required:    true,
+		ForceNew:    true,
+	},
+	common.MetaKey: common.Meta,
+	SpecKey:        specSchema,
+	registerKey:    registerSchema,
+	registrationLinkKey: {
+		Type:        schema.TypeString,
+		Description: "Link to the registration manifest to apply on the management cluster to register it with Tanzu Mission Control",
+		Computed:    true,
+	},
+	StatusKey: statusSchema,
+}
+
+var specSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Spec for the management cluster",
+	Required:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			kubernetesProviderTypeKey: {
+				Type:         schema.TypeString,
+				Description:  "Kubernetes provider type of the management cluster: VMWARE_TANZU_KUBERNETES_GRID for a Tanzu Kubernetes Grid management cluster or VMWARE_TANZU_KUBERNETES_GRID_SERVICE for a vSphere with Tanzu supervisor cluster",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{TkgKubernetesProviderType, TkgServiceKubernetesProviderType}, false),
+			},
+			clusterGroupKey: {
+				Type:        schema.TypeString,
+				Description: "Default cluster group for the workload clusters of the management cluster",
+				Optional:    true,
+				Default:     defaultClusterGroup,
+			},
+			proxyNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the proxy configuration used by the management cluster to reach Tanzu Mission Control",
+				Optional:    true,
+			},
+			imageRegistryKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the image registry configuration used by the management cluster",
+				Optional:    true,
+			},
+			workloadClusterProxyNameKey: {
+				Type:        schema.TypeString,
+				Description: "Default proxy configuration for the workload clusters of the management cluster",
+				Optional:    true,
+			},
+			workloadClusterImageRegistryKey: {
+				Type:        schema.TypeString,
+				Description: "Default image registry configuration for the workload clusters of the management cluster",
+				Optional:    true,
+			},
+		},
+	},
+}
+
+var registerSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Apply the registration manifest on the management cluster through its kubeconfig once it is registered with Tanzu Mission Control",
+	Optional:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			kubeConfigPathKey: {
+				Type:         schema.TypeString,
+				Description:  "Management cluster KUBECONFIG path",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{helper.GetFirstElementOf(registerKey, kubeConfigPathKey), helper.GetFirstElementOf(registerKey, kubeConfigRawKey)},
+			},
+			kubeConfigRawKey: {
+				Type:        schema.TypeString,
+				Description: "Management cluster KUBECONFIG",
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+			},
+		},
+	},
+}
+
+var statusSchema = &schema.Schema{
+	Type:        schema.TypeMap,
+	Description: "Status of the management cluster: phase, health and kube_server_version",
+	Computed:    true,
+	Elem: &schema.Schema{
+		Type: schema.TypeString,
+	},
+}
+
+func constructSpec(d *schema.ResourceData) *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterSpec {
+	spec := &managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterSpec{}
+
+	data, _ := d.Get(SpecKey).([]interface{})
+	if len(data) == 0 || data[0] == nil {
+		return spec
+	}
+
+	specData, _ := data[0].(map[string]interface{})
+
+	if v, ok := specData[kubernetesProviderTypeKey]; ok {
+		spec.KubernetesProviderType, _ = v.(string)
+	}
+
+	if v, ok := specData[clusterGroupKey]; ok {
+		spec.DefaultClusterGroup, _ = v.(string)
+	}
+
+	if v, ok := specData[proxyNameKey]; ok {
+		spec.ProxyName, _ = v.(string)
+	}
+
+	if v, ok := specData[imageRegistryKey]; ok {
+		spec.ImageRegistry, _ = v.(string)
+	}
+
+	if v, ok := specData[workloadClusterProxyNameKey]; ok {
+		spec.DefaultWorkloadClusterProxyName, _ = v.(string)
+	}
+
+	if v, ok := specData[workloadClusterImageRegistryKey]; ok {
+		spec.DefaultWorkloadClusterImageRegistry, _ = v.(string)
+	}
+
+	return spec
+}
+
+func flattenSpec(spec *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterSpec) (data []interface{}) {
+	if spec == nil {
+		return data
+	}
+
+	flattenSpecData := map[string]interface{}{
+		kubernetesProviderTypeKey:       spec.KubernetesProviderType,
+		clusterGroupKey:                 spec.DefaultClusterGroup,
+		proxyNameKey:                    spec.ProxyName,
+		imageRegistryKey:                spec.ImageRegistry,
+		workloadClusterProxyNameKey:     spec.DefaultWorkloadClusterProxyName,
+		workloadClusterImageRegistryKey: spec.DefaultWorkloadClusterImageRegistry,
+	}
+
+	return []interface{}{flattenSpecData}
+}
+
+func flattenStatus(status *managementclustermodel.VmwareTanzuManageV1alpha1ManagementclusterStatus) map[string]interface{} {
+	if status == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		phaseKey:             status.Phase,
+		healthKey:            status.Health,
+		kubeServerVersionKey: status.KubeServerVersion,
+	}
+}
@@ -17,4 +17,8 @@ const (
 	workspaceNameDefaultValue = "default"
 	attachKey                 = "attach"
 	ResourceName              = "tanzu-mission-control_namespace"
+	NamespacesResourceName    = "tanzu-mission-control_namespaces"
+	namespacesKey             = "namespaces"
+	uidKey                    = "uid"
+	labelsKey                 = "labels"
 )
@@ -46,7 +46,7 @@ func dataSourceNamespaceRead(ctx context.Context, d *schema.ResourceData, m inte
 			return diags
 		}
 
-		return diag.FromErr(errors.Wrapf(err, "unable to get Tanzu Mission Control namespace entry, name : %s", namespaceName))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to get Tanzu Mission Control namespace entry, name : %s", namespaceName))
 	}
 
 	d.SetId(resp.Namespace.Meta.UID)
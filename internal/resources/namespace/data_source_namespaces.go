@@ -0,0 +1,234 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package namespace
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/pagination"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	namespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/namespace"
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+)
+
+func DataSourceNamespaces() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNamespacesRead,
+		Schema: map[string]*schema.Schema{
+			NameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the namespaces to list, supports globbing with *.",
+				Optional:    true,
+			},
+			ClusterNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the cluster of the namespaces to list, supports globbing with *.",
+				Optional:    true,
+			},
+			ManagementClusterNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the management cluster of the namespaces to list, supports globbing with *.",
+				Optional:    true,
+			},
+			ProvisionerNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the provisioner of the namespaces to list, supports globbing with *.",
+				Optional:    true,
+			},
+			workspaceNameKey: {
+				Type:        schema.TypeString,
+				Description: "Only list the namespaces attached to this workspace.",
+				Optional:    true,
+			},
+			labelsKey: {
+				Type:        schema.TypeMap,
+				Description: "Only list the namespaces having all these labels.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			namespacesKey: {
+				Type:        schema.TypeList,
+				Description: "Namespaces matching the filters, sorted by management cluster, provisioner, cluster and name.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						uidKey: {
+							Type:        schema.TypeString,
+							Description: "UID of the namespace.",
+							Computed:    true,
+						},
+						NameKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the namespace.",
+							Computed:    true,
+						},
+						ClusterNameKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the cluster of the namespace.",
+							Computed:    true,
+						},
+						ManagementClusterNameKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the management cluster of the namespace.",
+							Computed:    true,
+						},
+						ProvisionerNameKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the provisioner of the namespace.",
+							Computed:    true,
+						},
+						workspaceNameKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the workspace of the namespace.",
+							Computed:    true,
+						},
+						labelsKey: {
+							Type:        schema.TypeMap,
+							Description: "Labels of the namespace.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNamespacesRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+	request := constructListNamespacesRequest(d)
+
+	namespaces, err := pagination.ListAll(pagination.DefaultPageSize, func(options *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions) (*pagination.Page[*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace], error) {
+		request.Pagination = options
+
+		resp, err := config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceList(request)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pagination.Page[*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace]{Items: resp.Namespaces, TotalCount: resp.TotalCount}, nil
+	})
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrap(err, "Unable to list Tanzu Mission Control namespaces"))
+	}
+
+	filter := namespacesFilter{
+		labels: helper.GetStringMap(d.Get(labelsKey)),
+	}
+
+	filter.workspaceName, _ = d.Get(workspaceNameKey).(string)
+
+	if err := d.Set(namespacesKey, flattenNamespaces(filter.apply(namespaces))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helper.ConstructListDataSourceID(
+		request.SearchScope.ManagementClusterName,
+		request.SearchScope.ProvisionerName,
+		request.SearchScope.ClusterName,
+		request.SearchScope.Name,
+		filter.workspaceName,
+		helper.LabelSelectorString(filter.labels),
+	))
+
+	return nil
+}
+
+func constructListNamespacesRequest(d *schema.ResourceData) *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesRequestParameters {
+	searchScope := &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSearchScope{}
+
+	searchScope.Name, _ = d.Get(NameKey).(string)
+	searchScope.ClusterName, _ = d.Get(ClusterNameKey).(string)
+	searchScope.ManagementClusterName, _ = d.Get(ManagementClusterNameKey).(string)
+	searchScope.ProvisionerName, _ = d.Get(ProvisionerNameKey).(string)
+
+	return &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceListNamespacesRequestParameters{
+		SearchScope:       searchScope,
+		IncludeTotalCount: true,
+	}
+}
+
+// namespacesFilter holds the filters which are not part of the search scope of the list API.
+type namespacesFilter struct {
+	workspaceName string
+	labels        map[string]string
+}
+
+func (f namespacesFilter) apply(namespaces []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace) []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace {
+	filtered := make([]*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace, 0, len(namespaces))
+
+	for _, namespace := range namespaces {
+		if namespace == nil || namespace.FullName == nil {
+			continue
+		}
+
+		if f.matches(namespace) {
+			filtered = append(filtered, namespace)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return namespaceSortKey(filtered[i].FullName) < namespaceSortKey(filtered[j].FullName)
+	})
+
+	return filtered
+}
+
+func (f namespacesFilter) matches(namespace *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace) bool {
+	if f.workspaceName != "" && (namespace.Spec == nil || namespace.Spec.WorkspaceName != f.workspaceName) {
+		return false
+	}
+
+	var labels map[string]string
+	if namespace.Meta != nil {
+		labels = namespace.Meta.Labels
+	}
+
+	return helper.MatchLabels(labels, f.labels)
+}
+
+func flattenNamespaces(namespaces []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace) []interface{} {
+	data := make([]interface{}, 0, len(namespaces))
+
+	for _, namespace := range namespaces {
+		flattenNamespace := map[string]interface{}{
+			NameKey:                  namespace.FullName.Name,
+			ClusterNameKey:           namespace.FullName.ClusterName,
+			ManagementClusterNameKey: namespace.FullName.ManagementClusterName,
+			ProvisionerNameKey:       namespace.FullName.ProvisionerName,
+		}
+
+		if namespace.Meta != nil {
+			flattenNamespace[uidKey] = namespace.Meta.UID
+			flattenNamespace[labelsKey] = namespace.Meta.Labels
+		}
+
+		if namespace.Spec != nil {
+			flattenNamespace[workspaceNameKey] = namespace.Spec.WorkspaceName
+		}
+
+		data = append(data, flattenNamespace)
+	}
+
+	return data
+}
+
+func namespaceSortKey(fn *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName) string {
+	return strings.Join([]string{fn.ManagementClusterName, fn.ProvisionerName, fn.ClusterName, fn.Name}, "/")
+}
@@ -0,0 +1,79 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package namespace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	namespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/namespace"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+)
+
+func testNamespace(clusterName string, name string, workspaceName string, labels map[string]string) *namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace {
+	return &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
+		FullName: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName{
+			Name:                  name,
+			ClusterName:           clusterName,
+			ManagementClusterName: attachedValue,
+			ProvisionerName:       attachedValue,
+		},
+		Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+			Labels: labels,
+		},
+		Spec: &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceSpec{
+			WorkspaceName: workspaceName,
+		},
+	}
+}
+
+func TestNamespacesFilter(t *testing.T) {
+	t.Parallel()
+
+	namespaces := []*namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceNamespace{
+		testNamespace("c2", "app", "prod", map[string]string{"team": "a"}),
+		testNamespace("c1", "app", "prod", map[string]string{"team": "b"}),
+		testNamespace("c1", "tools", workspaceNameDefaultValue, nil),
+		nil,
+	}
+
+	cases := []struct {
+		name     string
+		filter   namespacesFilter
+		expected []string
+	}{
+		{
+			name:     "case for no filter",
+			filter:   namespacesFilter{},
+			expected: []string{"c1/app", "c1/tools", "c2/app"},
+		},
+		{
+			name:     "case for a workspace",
+			filter:   namespacesFilter{workspaceName: "prod"},
+			expected: []string{"c1/app", "c2/app"},
+		},
+		{
+			name:     "case for a workspace and labels",
+			filter:   namespacesFilter{workspaceName: "prod", labels: map[string]string{"team": "a"}},
+			expected: []string{"c2/app"},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			actual := make([]string, 0)
+
+			for _, namespace := range flattenNamespaces(test.filter.apply(namespaces)) {
+				data := namespace.(map[string]interface{})
+				actual = append(actual, data[ClusterNameKey].(string)+"/"+data[NameKey].(string))
+			}
+
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -25,7 +25,10 @@ func ResourceNamespace() *schema.Resource {
 		ReadContext:   dataSourceNamespaceRead,
 		UpdateContext: resourceNamespaceInPlaceUpdate,
 		DeleteContext: resourceNamespaceDelete,
-		Schema:        namespaceSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNamespaceImporter,
+		},
+		Schema: namespaceSchema,
 	}
 }
 
@@ -152,7 +155,7 @@ func resourceNamespaceCreate(ctx context.Context, d *schema.ResourceData, m inte
 	namespaceResponse, err := config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceCreate(namespaceRequest)
 
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "unable to create Tanzu Mission Control namespace entry, name : %s", NameKey))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to create Tanzu Mission Control namespace entry, name : %s", NameKey))
 	}
 
 	d.SetId(namespaceResponse.Namespace.Meta.UID)
@@ -170,7 +173,7 @@ func resourceNamespaceDelete(_ context.Context, d *schema.ResourceData, m interf
 
 	err := config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceDelete(constructFullname(d))
 	if err != nil && !clienterrors.IsNotFoundError(err) {
-		return diag.FromErr(errors.Wrapf(err, "unable to delete Tanzu Mission Control namespace entry, name : %s", namespaceName))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to delete Tanzu Mission Control namespace entry, name : %s", namespaceName))
 	}
 
 	// d.SetId("") is automatically called assuming delete returns no errors, but
@@ -199,7 +202,7 @@ func resourceNamespaceInPlaceUpdate(ctx context.Context, d *schema.ResourceData,
 
 	getResp, err := config.TMCConnection.NamespaceResourceService.ManageV1alpha1NamespaceResourceServiceGet(constructFullname(d))
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "unable to get Tanzu Mission Control namespace entry, name : %s", d.Get(ClusterNameKey)))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to get Tanzu Mission Control namespace entry, name : %s", d.Get(ClusterNameKey)))
 	}
 
 	if common.HasMetaChanged(d) {
@@ -225,8 +228,41 @@ func resourceNamespaceInPlaceUpdate(ctx context.Context, d *schema.ResourceData,
 		},
 	)
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "unable to update Tanzu Mission Control namespace entry, name : %s", d.Get(ClusterNameKey)))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "unable to update Tanzu Mission Control namespace entry, name : %s", d.Get(ClusterNameKey)))
 	}
 
 	return dataSourceNamespaceRead(ctx, d, m)
 }
+
+// resourceNamespaceImporter imports a namespace using an ID of the form <management_cluster_name>/<provisioner_name>/<cluster_name>/<name>.
+func resourceNamespaceImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts, err := helper.ParseImportID(d.Id(), ManagementClusterNameKey, ProvisionerNameKey, ClusterNameKey, NameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	fullname := &namespacemodel.VmwareTanzuManageV1alpha1ClusterNamespaceFullName{
+		ManagementClusterName: parts[0],
+		ProvisionerName:       parts[1],
+		ClusterName:           parts[2],
+		Name:                  parts[3],
+	}
+
+	if err = d.Set(ManagementClusterNameKey, fullname.ManagementClusterName); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set management cluster name for the namespace %s", fullname.Name)
+	}
+
+	if err = d.Set(ProvisionerNameKey, fullname.ProvisionerName); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set provisioner name for the namespace %s", fullname.Name)
+	}
+
+	if err = d.Set(ClusterNameKey, fullname.ClusterName); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set cluster name for the namespace %s", fullname.Name)
+	}
+
+	if err = d.Set(NameKey, fullname.Name); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the namespace %s", fullname.Name)
+	}
+
+	return helper.ReadImportedState(ctx, d, m, dataSourceNamespaceRead)
+}
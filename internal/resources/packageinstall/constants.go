@@ -0,0 +1,17 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packageinstall
+
+import "time"
+
+const (
+	ResourceName     = "tanzu-mission-control_package_install"
+	nameKey          = "name"
+	namespaceNameKey = "namespace_name"
+	statusKey        = "status"
+
+	reconcilePollInterval = 10 * time.Second
+)
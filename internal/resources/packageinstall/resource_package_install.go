@@ -0,0 +1,540 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packageinstall
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	packageinstallclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packageinstall/cluster"
+	packageinstallclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packageinstall/clustergroup"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/packageinstall/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/packageinstall/spec"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/packageinstall/status"
+)
+
+type dataFromServer struct {
+	UID                     string
+	meta                    *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+	atomicSpec              *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec
+	clusterScopeStatus      *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus
+	clusterGroupScopeStatus *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallStatus
+}
+
+func ResourcePackageInstall() *schema.Resource {
+	return &schema.Resource{
+		Schema:        packageInstallSchema,
+		CreateContext: resourcePackageInstallCreate,
+		ReadContext:   resourcePackageInstallRead,
+		UpdateContext: resourcePackageInstallInPlaceUpdate,
+		DeleteContext: resourcePackageInstallDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePackageInstallImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		CustomizeDiff: schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey})),
+	}
+}
+
+var packageInstallSchema = map[string]*schema.Schema{
+	nameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the package install.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	namespaceNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the namespace to install the package in.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	commonscope.ScopeKey: scope.ScopeSchema,
+	common.MetaKey:       common.Meta,
+	spec.SpecKey:         spec.SpecSchema,
+	statusKey:            status.StatusSchema,
+}
+
+func resourcePackageInstallCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	packageInstallName, ok := d.Get(nameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read package install name")
+	}
+
+	packageInstallNamespaceName, ok := d.Get(namespaceNameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read package install namespace name")
+	}
+
+	scopedFullnameData := scope.ConstructScope(d, packageInstallName, packageInstallNamespaceName)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to create Tanzu Mission Control package install entry; Scope full name is empty")
+	}
+
+	var (
+		UID  string
+		meta = common.ConstructMeta(d)
+	)
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			packageInstallReq := &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest{
+				Install: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall{
+					FullName: scopedFullnameData.FullnameCluster,
+					Meta:     meta,
+					Spec:     spec.ConstructSpecForClusterScope(d),
+				},
+			}
+
+			packageInstallResponse, err := config.TMCConnection.ClusterPackageInstallResourceService.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceCreate(packageInstallReq)
+			if err != nil {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster package install entry, name : %s", packageInstallName))
+			}
+
+			UID = packageInstallResponse.Install.Meta.UID
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			packageInstallReq := &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest{
+				Install: &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstall{
+					FullName: scopedFullnameData.FullnameClusterGroup,
+					Meta:     meta,
+					Spec:     spec.ConstructSpecForClusterGroupScope(d),
+				},
+			}
+
+			packageInstallResponse, err := config.TMCConnection.ClusterGroupPackageInstallResourceService.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceCreate(packageInstallReq)
+			if err != nil {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group package install entry, name : %s", packageInstallName))
+			}
+
+			UID = packageInstallResponse.Install.Meta.UID
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	// always run
+	d.SetId(UID)
+
+	if diags = waitForReconcile(ctx, config, scopedFullnameData, status.ReconcileBaseline{}, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		return diags
+	}
+
+	return resourcePackageInstallRead(ctx, d, m)
+}
+
+func resourcePackageInstallRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	packageInstallName, ok := d.Get(nameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read package install name")
+	}
+
+	packageInstallNamespaceName, ok := d.Get(namespaceNameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read package install namespace name")
+	}
+
+	scopedFullnameData := scope.ConstructScope(d, packageInstallName, packageInstallNamespaceName)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to get Tanzu Mission Control package install entry; Scope full name is empty")
+	}
+
+	packageInstallDataFromServer, err := retrievePackageInstallDataFromServer(config, scopedFullnameData, d)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			_ = schema.RemoveFromState(d, m)
+			return
+		}
+
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(packageInstallDataFromServer.UID)
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(packageInstallDataFromServer.meta)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var (
+		flattenedSpec   []interface{}
+		flattenedStatus interface{}
+	)
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		flattenedSpec, err = spec.FlattenSpecForClusterScope(packageInstallDataFromServer.atomicSpec)
+		flattenedStatus = status.FlattenStatusForClusterScope(packageInstallDataFromServer.clusterScopeStatus)
+	case commonscope.ClusterGroupScope:
+		clusterGroupScopeSpec := &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallSpec{
+			AtomicSpec: packageInstallDataFromServer.atomicSpec,
+		}
+		flattenedSpec, err = spec.FlattenSpecForClusterGroupScope(clusterGroupScopeSpec)
+		flattenedStatus = status.FlattenStatusForClusterGroupScope(packageInstallDataFromServer.clusterGroupScopeStatus)
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(spec.SpecKey, flattenedSpec); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(statusKey, flattenedStatus); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourcePackageInstallDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	packageInstallName, ok := d.Get(nameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read package install name")
+	}
+
+	packageInstallNamespaceName, ok := d.Get(namespaceNameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read package install namespace name")
+	}
+
+	scopedFullnameData := scope.ConstructScope(d, packageInstallName, packageInstallNamespaceName)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to delete Tanzu Mission Control package install entry; Scope full name is empty")
+	}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			err := config.TMCConnection.ClusterPackageInstallResourceService.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceDelete(scopedFullnameData.FullnameCluster)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster package install entry, name : %s", packageInstallName))
+			}
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			err := config.TMCConnection.ClusterGroupPackageInstallResourceService.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceDelete(scopedFullnameData.FullnameClusterGroup)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group package install entry, name : %s", packageInstallName))
+			}
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+func resourcePackageInstallInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	packageInstallName, ok := d.Get(nameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read package install name")
+	}
+
+	packageInstallNamespaceName, ok := d.Get(namespaceNameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read package install namespace name")
+	}
+
+	scopedFullnameData := scope.ConstructScope(d, packageInstallName, packageInstallNamespaceName)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to update Tanzu Mission Control package install entry; Scope full name is empty")
+	}
+
+	packageInstallDataFromServer, err := retrievePackageInstallDataFromServer(config, scopedFullnameData, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var updateAvailable, specChanged bool
+
+	if updateCheckForMeta(d, packageInstallDataFromServer.meta) {
+		updateAvailable = true
+	}
+
+	if updateCheckForSpec(d, packageInstallDataFromServer, scopedFullnameData.Scope) {
+		updateAvailable = true
+		specChanged = true
+	}
+
+	if !updateAvailable {
+		log.Printf("[INFO] package install update is not required")
+		return
+	}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			packageInstallReq := &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest{
+				Install: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall{
+					FullName: scopedFullnameData.FullnameCluster,
+					Meta:     packageInstallDataFromServer.meta,
+					Spec:     packageInstallDataFromServer.atomicSpec,
+				},
+			}
+
+			_, err = config.TMCConnection.ClusterPackageInstallResourceService.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceUpdate(packageInstallReq)
+			if err != nil {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster package install entry, name : %s", packageInstallName))
+			}
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			packageInstallReq := &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstallRequest{
+				Install: &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallInstall{
+					FullName: scopedFullnameData.FullnameClusterGroup,
+					Meta:     packageInstallDataFromServer.meta,
+					Spec: &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallSpec{
+						AtomicSpec: packageInstallDataFromServer.atomicSpec,
+					},
+				},
+			}
+
+			_, err = config.TMCConnection.ClusterGroupPackageInstallResourceService.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceUpdate(packageInstallReq)
+			if err != nil {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group package install entry, name : %s", packageInstallName))
+			}
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	log.Printf("[INFO] package install update successful")
+
+	// Only a change of the spec gets reconciled by kapp-controller.
+	if !specChanged {
+		return resourcePackageInstallRead(ctx, d, m)
+	}
+
+	if diags = waitForReconcile(ctx, config, scopedFullnameData, packageInstallDataFromServer.reconcileBaseline(), d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+		return diags
+	}
+
+	return resourcePackageInstallRead(ctx, d, m)
+}
+
+func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) bool {
+	if !common.HasMetaChanged(d) {
+		return false
+	}
+
+	objectMeta := common.ConstructMeta(d)
+
+	if value, ok := meta.Labels[common.CreatorLabelKey]; ok {
+		objectMeta.Labels[common.CreatorLabelKey] = value
+	}
+
+	meta.Labels = objectMeta.Labels
+	meta.Description = objectMeta.Description
+
+	log.Printf("[INFO] updating package install meta data")
+
+	return true
+}
+
+func updateCheckForSpec(d *schema.ResourceData, packageInstallDataFromServer *dataFromServer, scope commonscope.Scope) bool {
+	if !spec.HasSpecChanged(d) {
+		return false
+	}
+
+	switch scope {
+	case commonscope.ClusterScope:
+		packageInstallDataFromServer.atomicSpec = spec.ConstructSpecForClusterScope(d)
+	case commonscope.ClusterGroupScope:
+		packageInstallDataFromServer.atomicSpec = spec.ConstructSpecForClusterGroupScope(d).AtomicSpec
+	}
+
+	log.Printf("[INFO] updating package install spec")
+
+	return true
+}
+
+// reconcileBaseline returns the reconcile result reported by the package install before an update.
+func (data *dataFromServer) reconcileBaseline() status.ReconcileBaseline {
+	if data.clusterGroupScopeStatus != nil {
+		return status.ReconcileBaselineForClusterGroupScope(data.clusterGroupScopeStatus)
+	}
+
+	return status.ReconcileBaselineForClusterScope(data.clusterScopeStatus)
+}
+
+func retrievePackageInstallDataFromServer(config authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname, d *schema.ResourceData) (*dataFromServer, error) {
+	var packageInstallDataFromServer = &dataFromServer{}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			resp, err := config.TMCConnection.ClusterPackageInstallResourceService.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceGet(scopedFullnameData.FullnameCluster)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return packageInstallDataFromServer, err
+				}
+
+				return packageInstallDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster package install entry, name : %s", scopedFullnameData.FullnameCluster.Name)
+			}
+
+			scopedFullnameData.FullnameCluster = resp.Install.FullName
+			packageInstallDataFromServer.UID = resp.Install.Meta.UID
+			packageInstallDataFromServer.meta = resp.Install.Meta
+			packageInstallDataFromServer.atomicSpec = resp.Install.Spec
+			packageInstallDataFromServer.clusterScopeStatus = resp.Install.Status
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			resp, err := config.TMCConnection.ClusterGroupPackageInstallResourceService.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceGet(scopedFullnameData.FullnameClusterGroup)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return packageInstallDataFromServer, err
+				}
+
+				return packageInstallDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster group package install entry, name : %s", scopedFullnameData.FullnameClusterGroup.Name)
+			}
+
+			scopedFullnameData.FullnameClusterGroup = resp.Install.FullName
+			packageInstallDataFromServer.UID = resp.Install.Meta.UID
+			packageInstallDataFromServer.meta = resp.Install.Meta
+			packageInstallDataFromServer.clusterGroupScopeStatus = resp.Install.Status
+
+			if resp.Install.Spec != nil {
+				packageInstallDataFromServer.atomicSpec = resp.Install.Spec.AtomicSpec
+			}
+		}
+	case commonscope.UnknownScope:
+		return packageInstallDataFromServer, errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	fullName, name, namespace := scope.FlattenScope(scopedFullnameData)
+
+	if err := d.Set(nameKey, name); err != nil {
+		return packageInstallDataFromServer, err
+	}
+
+	if err := d.Set(namespaceNameKey, namespace); err != nil {
+		return packageInstallDataFromServer, err
+	}
+
+	if err := d.Set(commonscope.ScopeKey, fullName); err != nil {
+		return packageInstallDataFromServer, err
+	}
+
+	return packageInstallDataFromServer, nil
+}
+
+// waitForReconcile polls the package install until kapp-controller reports it as reconciled on the cluster,
+// or until it is applied on the member clusters of the cluster group, so that changes to the values are rolled out
+// before the apply completes.
+// Only a result reported after the baseline is accepted, so that the result of the previous reconciliation is ignored
+// after an update.
+func waitForReconcile(ctx context.Context, config authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname, baseline status.ReconcileBaseline, timeout time.Duration) diag.Diagnostics {
+	var getErr, reconcileErr error
+
+	reconcileRetryable := func() (retry bool, err error) {
+		var reconciled bool
+
+		switch scopedFullnameData.Scope {
+		case commonscope.ClusterScope:
+			resp, err := config.TMCConnection.ClusterPackageInstallResourceService.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceGetWithContext(ctx, scopedFullnameData.FullnameCluster)
+			if err != nil {
+				getErr = err
+				return false, err
+			}
+
+			reconciled, reconcileErr = status.IsReconciledForClusterScope(resp.Install.Status, baseline)
+		case commonscope.ClusterGroupScope:
+			resp, err := config.TMCConnection.ClusterGroupPackageInstallResourceService.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallResourceServiceGetWithContext(ctx, scopedFullnameData.FullnameClusterGroup)
+			if err != nil {
+				getErr = err
+				return false, err
+			}
+
+			reconciled, reconcileErr = status.IsReconciledForClusterGroupScope(resp.Install.Status, baseline)
+		}
+
+		if reconcileErr != nil {
+			return false, reconcileErr
+		}
+
+		if !reconciled {
+			return true, errors.New("package install is not reconciled yet")
+		}
+
+		return false, nil
+	}
+
+	_, err := helper.RetryUntilTimeoutWithContext(ctx, reconcileRetryable, reconcilePollInterval, timeout)
+
+	switch {
+	case getErr != nil:
+		return clienterrors.ToDiagnostics(errors.Wrap(getErr, "Unable to get Tanzu Mission Control package install entry"))
+	case reconcileErr != nil:
+		return diag.FromErr(reconcileErr)
+	case err != nil:
+		return diag.FromErr(errors.Wrap(err, "timed out waiting for Tanzu Mission Control package install to reconcile"))
+	}
+
+	return nil
+}
+
+// resourcePackageInstallImporter imports a package install using an ID of the form cluster/<management_cluster_name>/<provisioner_name>/<cluster_name>/<namespace_name>/<name>
+// or cluster_group/<cluster_group_name>/<namespace_name>/<name>.
+func resourcePackageInstallImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	scopedFullnameData, err := scope.ConstructScopeFromImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	scopeData, name, namespaceName := scope.FlattenScope(scopedFullnameData)
+
+	if err = d.Set(nameKey, name); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the package install %s", name)
+	}
+
+	if err = d.Set(namespaceNameKey, namespaceName); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set namespace name for the package install %s", name)
+	}
+
+	if err = d.Set(commonscope.ScopeKey, scopeData); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set scope for the package install %s", name)
+	}
+
+	return helper.ReadImportedState(ctx, d, m, resourcePackageInstallRead)
+}
@@ -0,0 +1,185 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packageinstall
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	packageinstallclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packageinstall/cluster"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+const (
+	https                = "https:/"
+	clAPIVersionAndGroup = "v1alpha1/clusters"
+	apiSubGroup          = "namespaces"
+	apiKind              = "tanzupackage/installedpackages"
+	attachedValue        = "attached"
+)
+
+// bodyInspectingResponder checks the body of the request against the expected content before responding.
+func bodyInspectingResponder(t *testing.T, expectedContent interface{}, successResponse int, successResponseBody interface{}) httpmock.Responder {
+	return func(r *http.Request) (*http.Response, error) {
+		if expectedContent != nil {
+			expectedBytes, err := json.Marshal(expectedContent)
+			if err != nil {
+				return nil, err
+			}
+
+			if r.Body == nil {
+				t.Errorf("expected body on request %s %s", r.Method, r.URL)
+				return httpmock.NewStringResponse(http.StatusBadRequest, "expected body on request"), nil
+			}
+
+			bodyBytes, err := io.ReadAll(r.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			var bodyInterface, expectedInterface map[string]interface{}
+
+			if err := json.Unmarshal(bodyBytes, &bodyInterface); err != nil {
+				return nil, err
+			}
+
+			if err := json.Unmarshal(expectedBytes, &expectedInterface); err != nil {
+				return nil, err
+			}
+
+			if diff := deep.Equal(bodyInterface, expectedInterface); diff != nil {
+				t.Errorf("unexpected body on request %s %s: %v", r.Method, r.URL, diff)
+				return httpmock.NewStringResponse(http.StatusBadRequest, "unexpected body on request"), nil
+			}
+		}
+
+		return httpmock.NewJsonResponse(successResponse, successResponseBody)
+	}
+}
+
+// Register a new responder when the given call is made.
+func changeStateResponder(registerFunc func(), responder httpmock.Responder) httpmock.Responder {
+	return func(r *http.Request) (*http.Response, error) {
+		resp, err := responder(r)
+		if err == nil && resp.StatusCode == http.StatusOK {
+			registerFunc()
+		}
+
+		return resp, err
+	}
+}
+
+func (testConfig *testAcceptanceConfig) packageInstall(replicas int) *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall {
+	return &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall{
+		FullName: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallFullName{
+			ClusterName:           testConfig.ClusterName,
+			ManagementClusterName: attachedValue,
+			ProvisionerName:       attachedValue,
+			NamespaceName:         packageInstallNamespace,
+			Name:                  testConfig.PackageInstallName,
+		},
+		Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{},
+		Spec: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec{
+			PackageRef: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallPackageRef{
+				PackageMetadataName: packageInstallMetadataName,
+				VersionSelection: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallVersionSelection{
+					Constraints: packageInstallConstraints,
+				},
+			},
+			RoleBindingScope: "CLUSTER",
+			InlineValues: map[string]interface{}{
+				"replicas": replicas,
+			},
+		},
+	}
+}
+
+func (testConfig *testAcceptanceConfig) reconciledPackageInstall(replicas int) *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall {
+	install := testConfig.packageInstall(replicas)
+
+	install.Meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+		UID:             "package-install-uid",
+		ResourceVersion: "v1",
+	}
+
+	install.Status = &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus{
+		ResolvedVersion: packageInstallConstraints,
+		Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+			"ReconcileSucceeded": {
+				Type:   "ReconcileSucceeded",
+				Status: statusmodel.NewVmwareTanzuCoreV1alpha1StatusConditionStatus(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE),
+			},
+		},
+	}
+
+	return install
+}
+
+func (testConfig *testAcceptanceConfig) setupHTTPMocks(t *testing.T) {
+	httpmock.Activate()
+	t.Cleanup(httpmock.Deactivate)
+
+	endpoint := os.Getenv("TMC_ENDPOINT")
+
+	postRequest := &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest{
+		Install: testConfig.packageInstall(1),
+	}
+
+	postResponse := &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallResponse{
+		Install: testConfig.reconciledPackageInstall(1),
+	}
+
+	getResponse := &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallGetInstallResponse{
+		Install: testConfig.reconciledPackageInstall(1),
+	}
+
+	// The update sends the metadata of the package install read from the server along with the new values.
+	putRequest := &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest{
+		Install: testConfig.packageInstall(2),
+	}
+	putRequest.Install.Meta = getResponse.Install.Meta
+
+	putResponse := &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallResponse{
+		Install: testConfig.reconciledPackageInstall(2),
+	}
+
+	updatedGetResponse := &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallGetInstallResponse{
+		Install: testConfig.reconciledPackageInstall(2),
+	}
+
+	postEndpoint := helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ClusterName, apiSubGroup, packageInstallNamespace, apiKind).String()
+	getEndpoint := helper.ConstructRequestURL(https, endpoint, clAPIVersionAndGroup, testConfig.ClusterName, apiSubGroup, packageInstallNamespace, apiKind, testConfig.PackageInstallName).String()
+
+	httpmock.RegisterResponder("POST", postEndpoint,
+		bodyInspectingResponder(t, postRequest, http.StatusOK, postResponse))
+
+	httpmock.RegisterResponder("GET", getEndpoint,
+		bodyInspectingResponder(t, nil, http.StatusOK, getResponse))
+
+	httpmock.RegisterResponder("PUT", getEndpoint, changeStateResponder(
+		// Set up the get to return the new values after the package install has been 'updated'.
+		func() {
+			httpmock.RegisterResponder("GET", getEndpoint,
+				bodyInspectingResponder(t, nil, http.StatusOK, updatedGetResponse))
+		},
+		bodyInspectingResponder(t, putRequest, http.StatusOK, putResponse)))
+
+	httpmock.RegisterResponder("DELETE", getEndpoint, changeStateResponder(
+		// Set up the get to return 404 after the package install has been 'deleted'.
+		func() {
+			httpmock.RegisterResponder("GET", getEndpoint,
+				httpmock.NewStringResponder(http.StatusNotFound, "Not found"))
+		},
+		httpmock.NewStringResponder(http.StatusOK, "{}")))
+}
@@ -0,0 +1,157 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packageinstall
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	testhelper "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/testing"
+)
+
+const (
+	packageInstallEnvTestFlag  = "ENABLE_PACKAGE_INSTALL_ENV_TEST"
+	packageInstallResourceVar  = "test_package_install"
+	packageInstallNamePrefix   = "tf-package-install-test"
+	packageInstallNamespace    = "tanzu-package-repo-global"
+	packageInstallMetadataName = "cert-manager.tanzu.vmware.com"
+	packageInstallConstraints  = "1.10.2+vmware.1-tkg.1"
+)
+
+type testAcceptanceConfig struct {
+	Provider                   *schema.Provider
+	ClusterName                string
+	PackageInstallName         string
+	PackageInstallResourceName string
+}
+
+func testGetDefaultAcceptanceConfig(t *testing.T) *testAcceptanceConfig {
+	return &testAcceptanceConfig{
+		Provider:                   initTestProvider(t),
+		ClusterName:                getEnvOrDefault("PACKAGE_INSTALL_CLUSTER_NAME", acctest.RandomWithPrefix(packageInstallNamePrefix)),
+		PackageInstallName:         acctest.RandomWithPrefix(packageInstallNamePrefix),
+		PackageInstallResourceName: fmt.Sprintf("%s.%s", ResourceName, packageInstallResourceVar),
+	}
+}
+
+func getEnvOrDefault(name string, defaultValue string) string {
+	if value, found := os.LookupEnv(name); found {
+		return value
+	}
+
+	return defaultValue
+}
+
+func getConfigureContextFunc() func(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	if _, found := os.LookupEnv(packageInstallEnvTestFlag); !found {
+		return authctx.ProviderConfigureContextWithDefaultTransportForTesting
+	}
+
+	return authctx.ProviderConfigureContext
+}
+
+func TestAcceptanceForPackageInstallResource(t *testing.T) {
+	testConfig := testGetDefaultAcceptanceConfig(t)
+
+	// If the flag to execute package install tests is not found, run this as a mock test by setting up an http intercept for each endpoint.
+	_, found := os.LookupEnv(packageInstallEnvTestFlag)
+	if !found {
+		os.Setenv("TF_ACC", "true")
+		os.Setenv("TMC_ENDPOINT", "dummy.tmc.mock.vmware.com")
+		os.Setenv("VMW_CLOUD_API_TOKEN", "dummy")
+		os.Setenv("VMW_CLOUD_ENDPOINT", "console.cloud.vmware.com")
+
+		log.Println("Setting up the mock endpoints...")
+		testConfig.setupHTTPMocks(t)
+	} else {
+		// Environment variables with non default values required for a successful call to the package install service.
+		requiredVars := []string{
+			"VMW_CLOUD_ENDPOINT",
+			"TMC_ENDPOINT",
+			"VMW_CLOUD_API_TOKEN",
+			"PACKAGE_INSTALL_CLUSTER_NAME",
+		}
+
+		// Check if the required environment variables are set.
+		for _, name := range requiredVars {
+			if _, found := os.LookupEnv(name); !found {
+				t.Errorf("required environment variable '%s' missing", name)
+			}
+		}
+	}
+
+	t.Log("start package install resource acceptance tests!")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testhelper.TestPreCheck(t),
+		ProviderFactories: testhelper.GetTestProviderFactories(testConfig.Provider),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig.getTestPackageInstallResourceConfigValue(1),
+				Check:  testConfig.checkPackageInstallResourceAttributes(1),
+			},
+			{
+				Config: testConfig.getTestPackageInstallResourceConfigValue(2),
+				Check:  testConfig.checkPackageInstallResourceAttributes(2),
+			},
+		},
+	},
+	)
+
+	t.Log("package install resource acceptance test completed")
+}
+
+func (testConfig *testAcceptanceConfig) getTestPackageInstallResourceConfigValue(replicas int) string {
+	return fmt.Sprintf(`
+	resource "%s" "%s" {
+	  name           = "%s"
+	  namespace_name = "%s"
+
+	  scope {
+	    cluster {
+	      name = "%s"
+	    }
+	  }
+
+	  spec {
+	    package_ref {
+	      package_metadata_name = "%s"
+
+	      version_selection {
+	        constraints = "%s"
+	      }
+	    }
+
+	    inline_values = yamlencode({
+	      replicas = %d
+	    })
+	  }
+	}
+	`,
+		ResourceName, packageInstallResourceVar, testConfig.PackageInstallName, packageInstallNamespace,
+		testConfig.ClusterName, packageInstallMetadataName, packageInstallConstraints, replicas,
+	)
+}
+
+func (testConfig *testAcceptanceConfig) checkPackageInstallResourceAttributes(replicas int) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr(testConfig.PackageInstallResourceName, nameKey, testConfig.PackageInstallName),
+		resource.TestCheckResourceAttr(testConfig.PackageInstallResourceName, namespaceNameKey, packageInstallNamespace),
+		resource.TestCheckResourceAttr(testConfig.PackageInstallResourceName, "spec.0.package_ref.0.package_metadata_name", packageInstallMetadataName),
+		resource.TestCheckResourceAttr(testConfig.PackageInstallResourceName, "spec.0.inline_values", fmt.Sprintf("replicas: %d\n", replicas)),
+		resource.TestCheckResourceAttr(testConfig.PackageInstallResourceName, "status.state", "ReconcileSucceeded"),
+	)
+}
@@ -0,0 +1,169 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packageinstall
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	packageinstallclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packageinstall/cluster"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/packageinstall/spec"
+)
+
+// mockClusterPackageInstallClient returns the given package installs in order on get, repeating the last one.
+type mockClusterPackageInstallClient struct {
+	getResponses []*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall
+	getCalls     int
+	updated      *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall
+}
+
+func (m *mockClusterPackageInstallClient) VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceCreate(_ *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest) (*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockClusterPackageInstallClient) VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceDelete(_ *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallFullName) error {
+	return errors.New("not implemented")
+}
+
+func (m *mockClusterPackageInstallClient) VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceGet(_ *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallFullName) (*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallGetInstallResponse, error) {
+	install := m.getResponses[len(m.getResponses)-1]
+	if m.getCalls < len(m.getResponses) {
+		install = m.getResponses[m.getCalls]
+	}
+
+	m.getCalls++
+
+	return &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallGetInstallResponse{Install: install}, nil
+}
+
+func (m *mockClusterPackageInstallClient) VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceGetWithContext(_ context.Context, fn *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallFullName) (*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallGetInstallResponse, error) {
+	return m.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceGet(fn)
+}
+
+func (m *mockClusterPackageInstallClient) VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallResourceServiceUpdate(request *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallRequest) (*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallResponse, error) {
+	m.updated = request.Install
+
+	return &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstallResponse{Install: request.Install}, nil
+}
+
+func installWithCondition(conditionType string, message string, transitionTime strfmt.DateTime) *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall {
+	install := (&testAcceptanceConfig{ClusterName: "test-cluster", PackageInstallName: "test-install"}).packageInstall(1)
+
+	install.Meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "package-install-uid"}
+	install.Status = &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus{
+		Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+			conditionType: {
+				Type:               conditionType,
+				Status:             statusmodel.NewVmwareTanzuCoreV1alpha1StatusConditionStatus(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE),
+				Message:            message,
+				LastTransitionTime: transitionTime,
+			},
+		},
+	}
+
+	return install
+}
+
+func TestPackageInstallUpdateWaitsForNewReconcile(t *testing.T) {
+	t.Parallel()
+
+	before := strfmt.DateTime(time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC))
+	after := strfmt.DateTime(time.Date(2023, 5, 1, 10, 5, 0, 0, time.UTC))
+
+	cases := []struct {
+		description  string
+		getResponses []*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall
+		expectedErr  string
+	}{
+		{
+			description: "reconciled with the new values",
+			getResponses: []*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall{
+				installWithCondition("ReconcileSucceeded", "", before),
+				installWithCondition("ReconcileSucceeded", "", after),
+			},
+		},
+		{
+			description: "reconcile with the new values failed",
+			getResponses: []*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall{
+				installWithCondition("ReconcileSucceeded", "", before),
+				installWithCondition("ReconcileFailed", "values schema check failed", after),
+			},
+			expectedErr: "package install reconciliation failed: values schema check failed",
+		},
+		{
+			description: "succeeded condition of the previous reconcile",
+			getResponses: []*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall{
+				installWithCondition("ReconcileSucceeded", "", before),
+			},
+			expectedErr: "timed out waiting for Tanzu Mission Control package install to reconcile",
+		},
+		{
+			description: "failed condition of the previous reconcile",
+			getResponses: []*packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallInstall{
+				installWithCondition("ReconcileFailed", "values schema check failed", before),
+			},
+			expectedErr: "timed out waiting for Tanzu Mission Control package install to reconcile",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			mockClient := &mockClusterPackageInstallClient{getResponses: test.getResponses}
+			config := authctx.TanzuContext{
+				TMCConnection: &client.TanzuMissionControl{ClusterPackageInstallResourceService: mockClient},
+			}
+
+			d := schema.TestResourceDataRaw(t, packageInstallSchema, map[string]interface{}{
+				nameKey:          "test-install",
+				namespaceNameKey: packageInstallNamespace,
+				commonscope.ScopeKey: []interface{}{map[string]interface{}{
+					commonscope.ClusterKey: []interface{}{map[string]interface{}{
+						commonscope.NameKey: "test-cluster",
+					}},
+				}},
+				spec.SpecKey: []interface{}{map[string]interface{}{
+					"package_ref": []interface{}{map[string]interface{}{
+						"package_metadata_name": packageInstallMetadataName,
+						"version_selection": []interface{}{map[string]interface{}{
+							"constraints": packageInstallConstraints,
+						}},
+					}},
+					"inline_values": "replicas: 2\n",
+				}},
+			})
+			d.SetId("package-install-uid")
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			diags := resourcePackageInstallInPlaceUpdate(ctx, d, config)
+
+			require.NotNil(t, mockClient.updated)
+
+			if test.expectedErr == "" {
+				require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+				return
+			}
+
+			require.True(t, diags.HasError())
+			require.Contains(t, diags[0].Summary, test.expectedErr)
+		})
+	}
+}
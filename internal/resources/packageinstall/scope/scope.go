@@ -0,0 +1,130 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package scope
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	packageinstallclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packageinstall/cluster"
+	packageinstallclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packageinstall/clustergroup"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+// ScopedFullname is a struct for all types of package install full names.
+type ScopedFullname struct {
+	Scope                commonscope.Scope
+	FullnameCluster      *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallFullName
+	FullnameClusterGroup *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName
+}
+
+var (
+	ScopesAllowed = [...]string{commonscope.ClusterKey, commonscope.ClusterGroupKey}
+	ScopeSchema   = commonscope.GetScopeSchema(
+		commonscope.WithDescription(fmt.Sprintf("Scope for the package install, having one of the valid scopes: %v.", strings.Join(ScopesAllowed[:], `, `))),
+		commonscope.WithScopes(ScopesAllowed[:]))
+)
+
+func ConstructScope(d *schema.ResourceData, name, namespace string) (scopedFullnameData *ScopedFullname) {
+	value, ok := d.GetOk(commonscope.ScopeKey)
+
+	if !ok {
+		return scopedFullnameData
+	}
+
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return scopedFullnameData
+	}
+
+	scopeData := data[0].(map[string]interface{})
+
+	if clusterData, ok := scopeData[commonscope.ClusterKey]; ok && slices.Contains(ScopesAllowed[:], commonscope.ClusterKey) {
+		if clusterValue, ok := clusterData.([]interface{}); ok && len(clusterValue) != 0 {
+			scopedFullnameData = &ScopedFullname{
+				Scope:           commonscope.ClusterScope,
+				FullnameCluster: ConstructClusterPackageInstallFullname(clusterValue, name, namespace),
+			}
+		}
+	}
+
+	if clusterGroupData, ok := scopeData[commonscope.ClusterGroupKey]; ok && slices.Contains(ScopesAllowed[:], commonscope.ClusterGroupKey) {
+		if clusterGroupValue, ok := clusterGroupData.([]interface{}); ok && len(clusterGroupValue) != 0 {
+			scopedFullnameData = &ScopedFullname{
+				Scope:                commonscope.ClusterGroupScope,
+				FullnameClusterGroup: ConstructClusterGroupPackageInstallFullname(clusterGroupValue, name, namespace),
+			}
+		}
+	}
+
+	return scopedFullnameData
+}
+
+func FlattenScope(scopedFullname *ScopedFullname) (data []interface{}, name, namespace string) {
+	if scopedFullname == nil {
+		return data, name, namespace
+	}
+
+	flattenScopeData := make(map[string]interface{})
+
+	switch scopedFullname.Scope {
+	case commonscope.ClusterScope:
+		if slices.Contains(ScopesAllowed[:], commonscope.ClusterKey) {
+			name = scopedFullname.FullnameCluster.Name
+			namespace = scopedFullname.FullnameCluster.NamespaceName
+			flattenScopeData[commonscope.ClusterKey] = FlattenClusterPackageInstallFullname(scopedFullname.FullnameCluster)
+		}
+	case commonscope.ClusterGroupScope:
+		if slices.Contains(ScopesAllowed[:], commonscope.ClusterGroupKey) {
+			name = scopedFullname.FullnameClusterGroup.Name
+			namespace = scopedFullname.FullnameClusterGroup.NamespaceName
+			flattenScopeData[commonscope.ClusterGroupKey] = FlattenClusterGroupPackageInstallFullname(scopedFullname.FullnameClusterGroup)
+		}
+	case commonscope.UnknownScope:
+		fmt.Printf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(ScopesAllowed[:], `, `))
+	}
+
+	return []interface{}{flattenScopeData}, name, namespace
+}
+
+// ConstructScopeFromImportID parses a package install import ID of the form cluster/<management_cluster_name>/<provisioner_name>/<cluster_name>/<namespace_name>/<name>
+// or cluster_group/<cluster_group_name>/<namespace_name>/<name>.
+func ConstructScopeFromImportID(id string) (scopedFullnameData *ScopedFullname, err error) {
+	scopeKey, parts, err := helper.ParseScopedImportID(id, commonscope.ImportIDFormats(ScopesAllowed[:], "namespace_name", commonscope.NameKey))
+	if err != nil {
+		return nil, err
+	}
+
+	switch scopeKey {
+	case commonscope.ClusterKey:
+		scopedFullnameData = &ScopedFullname{
+			Scope: commonscope.ClusterScope,
+			FullnameCluster: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallFullName{
+				ManagementClusterName: parts[0],
+				ProvisionerName:       parts[1],
+				ClusterName:           parts[2],
+				NamespaceName:         parts[3],
+				Name:                  parts[4],
+			},
+		}
+	case commonscope.ClusterGroupKey:
+		scopedFullnameData = &ScopedFullname{
+			Scope: commonscope.ClusterGroupScope,
+			FullnameClusterGroup: &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallFullName{
+				ClusterGroupName: parts[0],
+				NamespaceName:    parts[1],
+				Name:             parts[2],
+			},
+		}
+	}
+
+	return scopedFullnameData, nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package spec
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	packageinstallclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packageinstall/cluster"
+)
+
+func ConstructSpecForClusterScope(d *schema.ResourceData) (spec *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec) {
+	value, ok := d.GetOk(SpecKey)
+	if !ok {
+		return spec
+	}
+
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return spec
+	}
+
+	specData := data[0].(map[string]interface{})
+
+	spec = &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec{}
+
+	if packageRef, ok := specData[packageRefKey]; ok {
+		if packageRefData, ok := packageRef.([]interface{}); ok {
+			spec.PackageRef = expandPackageRef(packageRefData)
+		}
+	}
+
+	if roleBindingScopeValue, ok := specData[roleBindingScopeKey]; ok {
+		helper.SetPrimitiveValue(roleBindingScopeValue, &spec.RoleBindingScope, roleBindingScopeKey)
+	}
+
+	if inlineValuesValue, ok := specData[inlineValuesKey]; ok {
+		// The document is validated at plan time.
+		spec.InlineValues, _ = ExpandInlineValues(inlineValuesValue.(string))
+	}
+
+	return spec
+}
+
+func FlattenSpecForClusterScope(spec *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec) (data []interface{}, err error) {
+	if spec == nil {
+		return data, nil
+	}
+
+	flattenSpecData := make(map[string]interface{})
+
+	flattenSpecData[packageRefKey] = flattenPackageRef(spec.PackageRef)
+	flattenSpecData[roleBindingScopeKey] = spec.RoleBindingScope
+
+	flattenSpecData[inlineValuesKey], err = FlattenInlineValues(spec.InlineValues)
+	if err != nil {
+		return data, err
+	}
+
+	return []interface{}{flattenSpecData}, nil
+}
@@ -0,0 +1,19 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package spec
+
+const (
+	SpecKey                = "spec"
+	packageRefKey          = "package_ref"
+	packageMetadataNameKey = "package_metadata_name"
+	versionSelectionKey    = "version_selection"
+	constraintsKey         = "constraints"
+	roleBindingScopeKey    = "role_binding_scope"
+	inlineValuesKey        = "inline_values"
+
+	roleBindingScopeCluster   = "CLUSTER"
+	roleBindingScopeNamespace = "NAMESPACE"
+)
@@ -0,0 +1,198 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package spec
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	packageinstallclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packageinstall/cluster"
+)
+
+var SpecSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Spec for the package install.",
+	Required:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			packageRefKey: packageRefSchema,
+			roleBindingScopeKey: {
+				Type:         schema.TypeString,
+				Description:  fmt.Sprintf("Role binding scope of the service account used to install the package, one of %s or %s.", roleBindingScopeCluster, roleBindingScopeNamespace),
+				Optional:     true,
+				Default:      roleBindingScopeCluster,
+				ValidateFunc: validation.StringInSlice([]string{roleBindingScopeCluster, roleBindingScopeNamespace}, false),
+			},
+			inlineValuesKey: {
+				Type:             schema.TypeString,
+				Description:      "Values of the package install, as a YAML or JSON document, for example the output of `yamlencode()`. Changes to the values are applied in place.",
+				Optional:         true,
+				ValidateFunc:     validateInlineValues,
+				DiffSuppressFunc: suppressEquivalentInlineValues,
+			},
+		},
+	},
+}
+
+var packageRefSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Reference to the package to install.",
+	Required:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			packageMetadataNameKey: {
+				Type:         schema.TypeString,
+				Description:  "Name of the package metadata, for example `cert-manager.tanzu.vmware.com`.",
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			versionSelectionKey: {
+				Type:        schema.TypeList,
+				Description: "Selection of the version of the package.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						constraintsKey: {
+							Type:         schema.TypeString,
+							Description:  "Constraints to select the version of the package, a version such as `1.10.2+vmware.1-tkg.1` or a semver range such as `>= 1.10.0`.",
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// ExpandInlineValues parses the YAML or JSON document of the inline values.
+func ExpandInlineValues(document string) (map[string]interface{}, error) {
+	if strings.TrimSpace(document) == "" {
+		return nil, nil
+	}
+
+	values := make(map[string]interface{})
+
+	if err := yaml.Unmarshal([]byte(document), &values); err != nil {
+		return nil, errors.Wrap(err, "inline values must be a YAML or JSON object")
+	}
+
+	return values, nil
+}
+
+// FlattenInlineValues renders the inline values as a YAML document.
+func FlattenInlineValues(values map[string]interface{}) (string, error) {
+	if len(values) == 0 {
+		return "", nil
+	}
+
+	document, err := yaml.Marshal(values)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render inline values")
+	}
+
+	return string(document), nil
+}
+
+func validateInlineValues(value interface{}, key string) (warnings []string, errs []error) {
+	document, ok := value.(string)
+	if !ok {
+		return nil, []error{errors.Errorf("expected %s to be a string", key)}
+	}
+
+	if _, err := ExpandInlineValues(document); err != nil {
+		return nil, []error{errors.Wrapf(err, "invalid %s", key)}
+	}
+
+	return nil, nil
+}
+
+// suppressEquivalentInlineValues ignores formatting differences between inline values documents holding the same values.
+func suppressEquivalentInlineValues(_, old, new string, _ *schema.ResourceData) bool {
+	oldValues, err := ExpandInlineValues(old)
+	if err != nil {
+		return false
+	}
+
+	newValues, err := ExpandInlineValues(new)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldValues, newValues)
+}
+
+func expandPackageRef(data []interface{}) (packageRef *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallPackageRef) {
+	if len(data) == 0 || data[0] == nil {
+		return packageRef
+	}
+
+	packageRefData, _ := data[0].(map[string]interface{})
+
+	packageRef = &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallPackageRef{}
+
+	if packageMetadataNameValue, ok := packageRefData[packageMetadataNameKey]; ok {
+		helper.SetPrimitiveValue(packageMetadataNameValue, &packageRef.PackageMetadataName, packageMetadataNameKey)
+	}
+
+	if versionSelectionValue, ok := packageRefData[versionSelectionKey]; ok {
+		if versionSelectionData, ok := versionSelectionValue.([]interface{}); ok && len(versionSelectionData) != 0 && versionSelectionData[0] != nil {
+			versionSelection, _ := versionSelectionData[0].(map[string]interface{})
+			packageRef.VersionSelection = &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallVersionSelection{}
+
+			if constraintsValue, ok := versionSelection[constraintsKey]; ok {
+				helper.SetPrimitiveValue(constraintsValue, &packageRef.VersionSelection.Constraints, constraintsKey)
+			}
+		}
+	}
+
+	return packageRef
+}
+
+func flattenPackageRef(packageRef *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallPackageRef) (data []interface{}) {
+	if packageRef == nil {
+		return data
+	}
+
+	flattenPackageRefData := make(map[string]interface{})
+
+	flattenPackageRefData[packageMetadataNameKey] = packageRef.PackageMetadataName
+
+	if packageRef.VersionSelection != nil {
+		flattenPackageRefData[versionSelectionKey] = []interface{}{
+			map[string]interface{}{
+				constraintsKey: packageRef.VersionSelection.Constraints,
+			},
+		}
+	}
+
+	return []interface{}{flattenPackageRefData}
+}
+
+func HasSpecChanged(d *schema.ResourceData) bool {
+	updateRequired := false
+
+	switch {
+	case d.HasChange(helper.GetFirstElementOf(SpecKey, packageRefKey)):
+		fallthrough
+	case d.HasChange(helper.GetFirstElementOf(SpecKey, roleBindingScopeKey)):
+		fallthrough
+	case d.HasChange(helper.GetFirstElementOf(SpecKey, inlineValuesKey)):
+		updateRequired = true
+	}
+
+	return updateRequired
+}
@@ -0,0 +1,184 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	packageinstallclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packageinstall/cluster"
+)
+
+func TestFlattenSpecForClusterScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil cluster package install spec",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete cluster package install spec",
+			input: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec{
+				PackageRef: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallPackageRef{
+					PackageMetadataName: "cert-manager.tanzu.vmware.com",
+					VersionSelection: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallVersionSelection{
+						Constraints: ">= 1.10.0",
+					},
+				},
+				RoleBindingScope: roleBindingScopeCluster,
+				InlineValues: map[string]interface{}{
+					"namespace": "cert-manager",
+					"replicas":  float64(2),
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					packageRefKey: []interface{}{
+						map[string]interface{}{
+							packageMetadataNameKey: "cert-manager.tanzu.vmware.com",
+							versionSelectionKey: []interface{}{
+								map[string]interface{}{
+									constraintsKey: ">= 1.10.0",
+								},
+							},
+						},
+					},
+					roleBindingScopeKey: roleBindingScopeCluster,
+					inlineValuesKey:     "namespace: cert-manager\nreplicas: 2\n",
+				},
+			},
+		},
+		{
+			description: "scenario for a cluster package install spec without values",
+			input: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallSpec{
+				PackageRef: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallPackageRef{
+					PackageMetadataName: "contour.tanzu.vmware.com",
+				},
+				RoleBindingScope: roleBindingScopeNamespace,
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					packageRefKey: []interface{}{
+						map[string]interface{}{
+							packageMetadataNameKey: "contour.tanzu.vmware.com",
+						},
+					},
+					roleBindingScopeKey: roleBindingScopeNamespace,
+					inlineValuesKey:     "",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual, err := FlattenSpecForClusterScope(test.input)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestExpandInlineValues(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       string
+		expected    map[string]interface{}
+		expectErr   bool
+	}{
+		{
+			description: "empty values",
+			input:       " \n",
+			expected:    nil,
+		},
+		{
+			description: "YAML values",
+			input:       "contour:\n  replicas: 2\n",
+			expected: map[string]interface{}{
+				"contour": map[string]interface{}{"replicas": float64(2)},
+			},
+		},
+		{
+			description: "JSON values",
+			input:       `{"contour": {"replicas": 2}}`,
+			expected: map[string]interface{}{
+				"contour": map[string]interface{}{"replicas": float64(2)},
+			},
+		},
+		{
+			description: "values which are not an object",
+			input:       "- replicas\n",
+			expectErr:   true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual, err := ExpandInlineValues(test.input)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestSuppressEquivalentInlineValues(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		old         string
+		new         string
+		expected    bool
+	}{
+		{
+			description: "values rendered differently",
+			old:         "replicas: 2\nnamespace: contour\n",
+			new:         "\"namespace\": \"contour\"\n\"replicas\": 2\n",
+			expected:    true,
+		},
+		{
+			description: "YAML and JSON values",
+			old:         "replicas: 2\n",
+			new:         `{"replicas": 2}`,
+			expected:    true,
+		},
+		{
+			description: "changed values",
+			old:         "replicas: 2\n",
+			new:         "replicas: 3\n",
+			expected:    false,
+		},
+		{
+			description: "invalid values",
+			old:         "replicas: 2\n",
+			new:         "replicas: [2\n",
+			expected:    false,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			require.Equal(t, test.expected, suppressEquivalentInlineValues("", test.old, test.new, nil))
+		})
+	}
+}
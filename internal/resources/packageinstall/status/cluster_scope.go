@@ -0,0 +1,56 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package status
+
+import (
+	"github.com/pkg/errors"
+
+	packageinstallclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packageinstall/cluster"
+)
+
+func FlattenStatusForClusterScope(status *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus) (data interface{}) {
+	if status == nil {
+		return data
+	}
+
+	flattenStatusData := make(map[string]interface{})
+
+	if status.ResolvedVersion != "" {
+		flattenStatusData[resolvedVersionKey] = status.ResolvedVersion
+	}
+
+	if state := reconcileState(status.Conditions); state != "" {
+		flattenStatusData[stateKey] = state
+	}
+
+	return flattenStatusData
+}
+
+// ReconcileBaselineForClusterScope returns the reconcile result reported on the cluster before an update.
+func ReconcileBaselineForClusterScope(status *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus) ReconcileBaseline {
+	if status == nil {
+		return ReconcileBaseline{}
+	}
+
+	return ReconcileBaseline{TransitionTime: lastTransitionTime(status.Conditions)}
+}
+
+// IsReconciledForClusterScope checks whether kapp-controller reconciled the package install on the cluster after the baseline,
+// and returns an error with the condition message when the reconciliation failed.
+func IsReconciledForClusterScope(status *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus, baseline ReconcileBaseline) (bool, error) {
+	if status == nil {
+		return false, nil
+	}
+
+	switch reconcileStateAfter(status.Conditions, baseline.TransitionTime) {
+	case conditionReconcileFailed:
+		return false, errors.Errorf("package install reconciliation failed: %s", status.Conditions[conditionReconcileFailed].Message)
+	case conditionReconcileSucceeded:
+		return true, nil
+	}
+
+	return false, nil
+}
@@ -0,0 +1,249 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package status
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	packageinstallclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packageinstall/cluster"
+	packageinstallclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packageinstall/clustergroup"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+func condition(conditionType string, conditionStatus statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatus, message string) statusmodel.VmwareTanzuCoreV1alpha1StatusCondition {
+	return statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+		Type:    conditionType,
+		Status:  statusmodel.NewVmwareTanzuCoreV1alpha1StatusConditionStatus(conditionStatus),
+		Message: message,
+	}
+}
+
+func conditionAt(conditionType string, conditionStatus statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatus, message string, transitionTime strfmt.DateTime) statusmodel.VmwareTanzuCoreV1alpha1StatusCondition {
+	c := condition(conditionType, conditionStatus, message)
+	c.LastTransitionTime = transitionTime
+
+	return c
+}
+
+func TestFlattenStatusForClusterScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus
+		expected    interface{}
+	}{
+		{
+			description: "check for nil cluster package install status",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with a reconciled cluster package install",
+			input: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus{
+				ResolvedVersion: "1.10.2+vmware.1-tkg.1",
+				Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					conditionReconciling:        condition(conditionReconciling, statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusFALSE, ""),
+					conditionReconcileSucceeded: condition(conditionReconcileSucceeded, statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE, ""),
+				},
+			},
+			expected: map[string]interface{}{
+				resolvedVersionKey: "1.10.2+vmware.1-tkg.1",
+				stateKey:           conditionReconcileSucceeded,
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			require.Equal(t, test.expected, FlattenStatusForClusterScope(test.input))
+		})
+	}
+}
+
+func TestIsReconciledForClusterScope(t *testing.T) {
+	t.Parallel()
+
+	before := strfmt.DateTime(time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC))
+	after := strfmt.DateTime(time.Date(2023, 5, 1, 10, 5, 0, 0, time.UTC))
+
+	cases := []struct {
+		description string
+		input       *packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus
+		baseline    ReconcileBaseline
+		expected    bool
+		expectedErr string
+	}{
+		{
+			description: "no status yet",
+			input:       nil,
+			expected:    false,
+		},
+		{
+			description: "reconciling package install",
+			input: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus{
+				Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					conditionReconciling: condition(conditionReconciling, statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE, ""),
+				},
+			},
+			expected: false,
+		},
+		{
+			description: "reconciled package install",
+			input: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus{
+				Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					conditionReconcileSucceeded: condition(conditionReconcileSucceeded, statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE, ""),
+				},
+			},
+			expected: true,
+		},
+		{
+			description: "failed package install",
+			input: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus{
+				Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					conditionReconcileFailed: condition(conditionReconcileFailed, statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE, "values schema check failed"),
+				},
+			},
+			expected:    false,
+			expectedErr: "package install reconciliation failed: values schema check failed",
+		},
+		{
+			description: "reconciled package install before the update",
+			input: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus{
+				Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					conditionReconcileSucceeded: conditionAt(conditionReconcileSucceeded, statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE, "", before),
+				},
+			},
+			baseline: ReconcileBaseline{TransitionTime: before},
+			expected: false,
+		},
+		{
+			description: "failed package install before the update",
+			input: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus{
+				Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					conditionReconcileFailed: conditionAt(conditionReconcileFailed, statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE, "values schema check failed", before),
+				},
+			},
+			baseline: ReconcileBaseline{TransitionTime: before},
+			expected: false,
+		},
+		{
+			description: "reconciled package install after the update",
+			input: &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus{
+				Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					conditionReconcileFailed:    conditionAt(conditionReconcileFailed, statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusFALSE, "", after),
+					conditionReconcileSucceeded: conditionAt(conditionReconcileSucceeded, statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE, "", after),
+				},
+			},
+			baseline: ReconcileBaseline{TransitionTime: before},
+			expected: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual, err := IsReconciledForClusterScope(test.input, test.baseline)
+			if test.expectedErr != "" {
+				require.EqualError(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestIsReconciledForClusterGroupScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallStatus
+		baseline    ReconcileBaseline
+		expected    bool
+		expectErr   bool
+	}{
+		{
+			description: "no status yet",
+			input:       nil,
+			expected:    false,
+		},
+		{
+			description: "pending package install",
+			input: &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallStatus{
+				Phase: statusmodel.NewVmwareTanzuManageV1alpha1CommonBatchPhase(statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhasePENDING),
+			},
+			expected: false,
+		},
+		{
+			description: "applied package install",
+			input: &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallStatus{
+				Phase: statusmodel.NewVmwareTanzuManageV1alpha1CommonBatchPhase(statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED),
+			},
+			expected: true,
+		},
+		{
+			description: "failed package install",
+			input: &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallStatus{
+				Phase:   statusmodel.NewVmwareTanzuManageV1alpha1CommonBatchPhase(statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseERROR),
+				Details: &statusmodel.VmwareTanzuManageV1alpha1CommonBatchDetails{Error: 1},
+			},
+			expected:  false,
+			expectErr: true,
+		},
+		{
+			description: "applied package install before the update",
+			input: &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallStatus{
+				ObservedGeneration: "1",
+				Phase:              statusmodel.NewVmwareTanzuManageV1alpha1CommonBatchPhase(statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED),
+			},
+			baseline: ReconcileBaseline{ObservedGeneration: "1"},
+			expected: false,
+		},
+		{
+			description: "applied package install after the update",
+			input: &packageinstallclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupNamespaceTanzupackageInstallStatus{
+				ObservedGeneration: "2",
+				Phase:              statusmodel.NewVmwareTanzuManageV1alpha1CommonBatchPhase(statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED),
+			},
+			baseline: ReconcileBaseline{ObservedGeneration: "1"},
+			expected: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual, err := IsReconciledForClusterGroupScope(test.input, test.baseline)
+			require.Equal(t, test.expectErr, err != nil)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestReconcileBaselineForClusterScope(t *testing.T) {
+	t.Parallel()
+
+	before := strfmt.DateTime(time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC))
+	after := strfmt.DateTime(time.Date(2023, 5, 1, 10, 5, 0, 0, time.UTC))
+
+	status := &packageinstallclustermodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageInstallStatus{
+		Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+			conditionReconciling:        conditionAt(conditionReconciling, statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusFALSE, "", after),
+			conditionReconcileSucceeded: conditionAt(conditionReconcileSucceeded, statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE, "", before),
+		},
+	}
+
+	require.Equal(t, ReconcileBaseline{}, ReconcileBaselineForClusterScope(nil))
+	require.Equal(t, ReconcileBaseline{TransitionTime: after}, ReconcileBaselineForClusterScope(status))
+}
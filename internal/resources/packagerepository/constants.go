@@ -0,0 +1,16 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packagerepository
+
+import "time"
+
+const (
+	ResourceName = "tanzu-mission-control_package_repository"
+	nameKey      = "name"
+	statusKey    = "status"
+
+	reconcilePollInterval = 10 * time.Second
+)
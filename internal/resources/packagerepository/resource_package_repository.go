@@ -0,0 +1,501 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packagerepository
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	packagerepositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packagerepository/cluster"
+	packagerepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packagerepository/clustergroup"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/packagerepository/scope"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/packagerepository/spec"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/packagerepository/status"
+)
+
+type dataFromServer struct {
+	UID                     string
+	meta                    *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta
+	atomicSpec              *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositorySpec
+	clusterScopeStatus      *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryStatus
+	clusterGroupScopeStatus *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryStatus
+}
+
+func ResourcePackageRepository() *schema.Resource {
+	return &schema.Resource{
+		Schema:        packageRepositorySchema,
+		CreateContext: resourcePackageRepositoryCreate,
+		ReadContext:   resourcePackageRepositoryRead,
+		UpdateContext: resourcePackageRepositoryInPlaceUpdate,
+		DeleteContext: resourcePackageRepositoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePackageRepositoryImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+		CustomizeDiff: schema.CustomizeDiffFunc(commonscope.ValidateScope([]string{commonscope.ClusterKey, commonscope.ClusterGroupKey})),
+	}
+}
+
+var packageRepositorySchema = map[string]*schema.Schema{
+	nameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the package repository.",
+		Required:    true,
+		ForceNew:    true,
+	},
+	commonscope.ScopeKey: scope.ScopeSchema,
+	common.MetaKey:       common.Meta,
+	spec.SpecKey:         spec.SpecSchema,
+	statusKey:            status.StatusSchema,
+}
+
+func resourcePackageRepositoryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	packageRepositoryName, ok := d.Get(nameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read package repository name")
+	}
+
+	scopedFullnameData := scope.ConstructScope(d, packageRepositoryName)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to create Tanzu Mission Control package repository entry; Scope full name is empty")
+	}
+
+	var (
+		UID  string
+		meta = common.ConstructMeta(d)
+	)
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			packageRepositoryReq := &packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepositoryRequest{
+				Repository: &packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepository{
+					FullName: scopedFullnameData.FullnameCluster,
+					Meta:     meta,
+					Spec:     spec.ConstructSpecForClusterScope(d),
+				},
+			}
+
+			packageRepositoryResponse, err := config.TMCConnection.ClusterPackageRepositoryResourceService.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceCreate(packageRepositoryReq)
+			if err != nil {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster package repository entry, name : %s", packageRepositoryName))
+			}
+
+			UID = packageRepositoryResponse.Repository.Meta.UID
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			packageRepositoryReq := &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryRequest{
+				Repository: &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepository{
+					FullName: scopedFullnameData.FullnameClusterGroup,
+					Meta:     meta,
+					Spec:     spec.ConstructSpecForClusterGroupScope(d),
+				},
+			}
+
+			packageRepositoryResponse, err := config.TMCConnection.ClusterGroupPackageRepositoryResourceService.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceCreate(packageRepositoryReq)
+			if err != nil {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group package repository entry, name : %s", packageRepositoryName))
+			}
+
+			UID = packageRepositoryResponse.Repository.Meta.UID
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	// always run
+	d.SetId(UID)
+
+	if diags = waitForReconcile(ctx, config, scopedFullnameData, status.ReconcileBaseline{}, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		return diags
+	}
+
+	return resourcePackageRepositoryRead(ctx, d, m)
+}
+
+func resourcePackageRepositoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	packageRepositoryName, ok := d.Get(nameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read package repository name")
+	}
+
+	scopedFullnameData := scope.ConstructScope(d, packageRepositoryName)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to get Tanzu Mission Control package repository entry; Scope full name is empty")
+	}
+
+	packageRepositoryDataFromServer, err := retrievePackageRepositoryDataFromServer(config, scopedFullnameData, d)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			_ = schema.RemoveFromState(d, m)
+			return
+		}
+
+		return diag.FromErr(err)
+	}
+
+	// always run
+	d.SetId(packageRepositoryDataFromServer.UID)
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(packageRepositoryDataFromServer.meta)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var (
+		flattenedSpec   []interface{}
+		flattenedStatus interface{}
+	)
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		flattenedSpec = spec.FlattenSpecForClusterScope(packageRepositoryDataFromServer.atomicSpec)
+		flattenedStatus = status.FlattenStatusForClusterScope(packageRepositoryDataFromServer.clusterScopeStatus)
+	case commonscope.ClusterGroupScope:
+		clusterGroupScopeSpec := &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositorySpec{
+			AtomicSpec: packageRepositoryDataFromServer.atomicSpec,
+		}
+		flattenedSpec = spec.FlattenSpecForClusterGroupScope(clusterGroupScopeSpec)
+		flattenedStatus = status.FlattenStatusForClusterGroupScope(packageRepositoryDataFromServer.clusterGroupScopeStatus)
+	}
+
+	if err := d.Set(spec.SpecKey, flattenedSpec); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(statusKey, flattenedStatus); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourcePackageRepositoryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	packageRepositoryName, ok := d.Get(nameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read package repository name")
+	}
+
+	scopedFullnameData := scope.ConstructScope(d, packageRepositoryName)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to delete Tanzu Mission Control package repository entry; Scope full name is empty")
+	}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			err := config.TMCConnection.ClusterPackageRepositoryResourceService.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceDelete(scopedFullnameData.FullnameCluster)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster package repository entry, name : %s", packageRepositoryName))
+			}
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			err := config.TMCConnection.ClusterGroupPackageRepositoryResourceService.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceDelete(scopedFullnameData.FullnameClusterGroup)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group package repository entry, name : %s", packageRepositoryName))
+			}
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	// d.SetId("") is automatically called assuming delete returns no errors, but
+	// it is added here for explicitness.
+	d.SetId("")
+
+	return diags
+}
+
+func resourcePackageRepositoryInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	packageRepositoryName, ok := d.Get(nameKey).(string)
+	if !ok {
+		return diag.Errorf("Unable to read package repository name")
+	}
+
+	scopedFullnameData := scope.ConstructScope(d, packageRepositoryName)
+
+	if scopedFullnameData == nil {
+		return diag.Errorf("Unable to update Tanzu Mission Control package repository entry; Scope full name is empty")
+	}
+
+	packageRepositoryDataFromServer, err := retrievePackageRepositoryDataFromServer(config, scopedFullnameData, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var updateAvailable, specChanged bool
+
+	if updateCheckForMeta(d, packageRepositoryDataFromServer.meta) {
+		updateAvailable = true
+	}
+
+	if updateCheckForSpec(d, packageRepositoryDataFromServer, scopedFullnameData.Scope) {
+		updateAvailable = true
+		specChanged = true
+	}
+
+	if !updateAvailable {
+		log.Printf("[INFO] package repository update is not required")
+		return
+	}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			packageRepositoryReq := &packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepositoryRequest{
+				Repository: &packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryRepository{
+					FullName: scopedFullnameData.FullnameCluster,
+					Meta:     packageRepositoryDataFromServer.meta,
+					Spec:     packageRepositoryDataFromServer.atomicSpec,
+				},
+			}
+
+			_, err = config.TMCConnection.ClusterPackageRepositoryResourceService.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceUpdate(packageRepositoryReq)
+			if err != nil {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster package repository entry, name : %s", packageRepositoryName))
+			}
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			packageRepositoryReq := &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryRequest{
+				Repository: &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepository{
+					FullName: scopedFullnameData.FullnameClusterGroup,
+					Meta:     packageRepositoryDataFromServer.meta,
+					Spec: &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositorySpec{
+						AtomicSpec: packageRepositoryDataFromServer.atomicSpec,
+					},
+				},
+			}
+
+			_, err = config.TMCConnection.ClusterGroupPackageRepositoryResourceService.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceUpdate(packageRepositoryReq)
+			if err != nil {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group package repository entry, name : %s", packageRepositoryName))
+			}
+		}
+	case commonscope.UnknownScope:
+		return diag.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	log.Printf("[INFO] package repository update successful")
+
+	// Only a change of the spec gets reconciled by kapp-controller.
+	if !specChanged {
+		return resourcePackageRepositoryRead(ctx, d, m)
+	}
+
+	if diags = waitForReconcile(ctx, config, scopedFullnameData, packageRepositoryDataFromServer.reconcileBaseline(), d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+		return diags
+	}
+
+	return resourcePackageRepositoryRead(ctx, d, m)
+}
+
+func updateCheckForMeta(d *schema.ResourceData, meta *objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta) bool {
+	if !common.HasMetaChanged(d) {
+		return false
+	}
+
+	objectMeta := common.ConstructMeta(d)
+
+	if value, ok := meta.Labels[common.CreatorLabelKey]; ok {
+		objectMeta.Labels[common.CreatorLabelKey] = value
+	}
+
+	meta.Labels = objectMeta.Labels
+	meta.Description = objectMeta.Description
+
+	log.Printf("[INFO] updating package repository meta data")
+
+	return true
+}
+
+func updateCheckForSpec(d *schema.ResourceData, packageRepositoryDataFromServer *dataFromServer, scope commonscope.Scope) bool {
+	if !spec.HasSpecChanged(d) {
+		return false
+	}
+
+	switch scope {
+	case commonscope.ClusterScope:
+		packageRepositoryDataFromServer.atomicSpec = spec.ConstructSpecForClusterScope(d)
+	case commonscope.ClusterGroupScope:
+		packageRepositoryDataFromServer.atomicSpec = spec.ConstructSpecForClusterGroupScope(d).AtomicSpec
+	}
+
+	log.Printf("[INFO] updating package repository spec")
+
+	return true
+}
+
+// reconcileBaseline returns the reconcile result reported by the package repository before an update.
+func (data *dataFromServer) reconcileBaseline() status.ReconcileBaseline {
+	if data.clusterGroupScopeStatus != nil {
+		return status.ReconcileBaselineForClusterGroupScope(data.clusterGroupScopeStatus)
+	}
+
+	return status.ReconcileBaselineForClusterScope(data.clusterScopeStatus)
+}
+
+func retrievePackageRepositoryDataFromServer(config authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname, d *schema.ResourceData) (*dataFromServer, error) {
+	var packageRepositoryDataFromServer = &dataFromServer{}
+
+	switch scopedFullnameData.Scope {
+	case commonscope.ClusterScope:
+		if scopedFullnameData.FullnameCluster != nil {
+			resp, err := config.TMCConnection.ClusterPackageRepositoryResourceService.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceGet(scopedFullnameData.FullnameCluster)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return packageRepositoryDataFromServer, err
+				}
+
+				return packageRepositoryDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster package repository entry, name : %s", scopedFullnameData.FullnameCluster.Name)
+			}
+
+			scopedFullnameData.FullnameCluster = resp.Repository.FullName
+			packageRepositoryDataFromServer.UID = resp.Repository.Meta.UID
+			packageRepositoryDataFromServer.meta = resp.Repository.Meta
+			packageRepositoryDataFromServer.atomicSpec = resp.Repository.Spec
+			packageRepositoryDataFromServer.clusterScopeStatus = resp.Repository.Status
+		}
+	case commonscope.ClusterGroupScope:
+		if scopedFullnameData.FullnameClusterGroup != nil {
+			resp, err := config.TMCConnection.ClusterGroupPackageRepositoryResourceService.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceGet(scopedFullnameData.FullnameClusterGroup)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return packageRepositoryDataFromServer, err
+				}
+
+				return packageRepositoryDataFromServer, errors.Wrapf(err, "Unable to get Tanzu Mission Control cluster group package repository entry, name : %s", scopedFullnameData.FullnameClusterGroup.Name)
+			}
+
+			scopedFullnameData.FullnameClusterGroup = resp.Repository.FullName
+			packageRepositoryDataFromServer.UID = resp.Repository.Meta.UID
+			packageRepositoryDataFromServer.meta = resp.Repository.Meta
+			packageRepositoryDataFromServer.clusterGroupScopeStatus = resp.Repository.Status
+
+			if resp.Repository.Spec != nil {
+				packageRepositoryDataFromServer.atomicSpec = resp.Repository.Spec.AtomicSpec
+			}
+		}
+	case commonscope.UnknownScope:
+		return packageRepositoryDataFromServer, errors.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scope.ScopesAllowed[:], `, `))
+	}
+
+	fullName, name := scope.FlattenScope(scopedFullnameData)
+
+	if err := d.Set(nameKey, name); err != nil {
+		return packageRepositoryDataFromServer, err
+	}
+
+	if err := d.Set(commonscope.ScopeKey, fullName); err != nil {
+		return packageRepositoryDataFromServer, err
+	}
+
+	return packageRepositoryDataFromServer, nil
+}
+
+// waitForReconcile polls the package repository until kapp-controller reports it as reconciled on the cluster,
+// or until it is applied on the member clusters of the cluster group.
+// Only a result reported after the baseline is accepted, so that the result of the previous reconciliation is ignored
+// after an update.
+func waitForReconcile(ctx context.Context, config authctx.TanzuContext, scopedFullnameData *scope.ScopedFullname, baseline status.ReconcileBaseline, timeout time.Duration) diag.Diagnostics {
+	var getErr, reconcileErr error
+
+	reconcileRetryable := func() (retry bool, err error) {
+		var reconciled bool
+
+		switch scopedFullnameData.Scope {
+		case commonscope.ClusterScope:
+			resp, err := config.TMCConnection.ClusterPackageRepositoryResourceService.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryResourceServiceGetWithContext(ctx, scopedFullnameData.FullnameCluster)
+			if err != nil {
+				getErr = err
+				return false, err
+			}
+
+			reconciled, reconcileErr = status.IsReconciledForClusterScope(resp.Repository.Status, baseline)
+		case commonscope.ClusterGroupScope:
+			resp, err := config.TMCConnection.ClusterGroupPackageRepositoryResourceService.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryResourceServiceGetWithContext(ctx, scopedFullnameData.FullnameClusterGroup)
+			if err != nil {
+				getErr = err
+				return false, err
+			}
+
+			reconciled, reconcileErr = status.IsReconciledForClusterGroupScope(resp.Repository.Status, baseline)
+		}
+
+		if reconcileErr != nil {
+			return false, reconcileErr
+		}
+
+		if !reconciled {
+			return true, errors.New("package repository is not reconciled yet")
+		}
+
+		return false, nil
+	}
+
+	_, err := helper.RetryUntilTimeoutWithContext(ctx, reconcileRetryable, reconcilePollInterval, timeout)
+
+	switch {
+	case getErr != nil:
+		return clienterrors.ToDiagnostics(errors.Wrap(getErr, "Unable to get Tanzu Mission Control package repository entry"))
+	case reconcileErr != nil:
+		return diag.FromErr(reconcileErr)
+	case err != nil:
+		return diag.FromErr(errors.Wrap(err, "timed out waiting for Tanzu Mission Control package repository to reconcile"))
+	}
+
+	return nil
+}
+
+// resourcePackageRepositoryImporter imports a package repository using an ID of the form cluster/<management_cluster_name>/<provisioner_name>/<cluster_name>/<name>
+// or cluster_group/<cluster_group_name>/<name>.
+func resourcePackageRepositoryImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	scopedFullnameData, err := scope.ConstructScopeFromImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	scopeData, name := scope.FlattenScope(scopedFullnameData)
+
+	if err = d.Set(nameKey, name); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the package repository %s", name)
+	}
+
+	if err = d.Set(commonscope.ScopeKey, scopeData); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set scope for the package repository %s", name)
+	}
+
+	return helper.ReadImportedState(ctx, d, m, resourcePackageRepositoryRead)
+}
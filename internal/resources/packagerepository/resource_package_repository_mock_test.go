@@ -0,0 +1,174 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packagerepository
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/jarcoal/httpmock"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	packagerepositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packagerepository/cluster"
+	packagerepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packagerepository/clustergroup"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+const (
+	https                = "https:/"
+	cgAPIVersionAndGroup = "v1alpha1/clustergroups"
+	apiKind              = "tanzupackage/repositories"
+)
+
+// bodyInspectingResponder checks the body of the request against the expected content before responding.
+func bodyInspectingResponder(t *testing.T, expectedContent interface{}, successResponse int, successResponseBody interface{}) httpmock.Responder {
+	return func(r *http.Request) (*http.Response, error) {
+		if expectedContent != nil {
+			expectedBytes, err := json.Marshal(expectedContent)
+			if err != nil {
+				return nil, err
+			}
+
+			if r.Body == nil {
+				t.Errorf("expected body on request %s %s", r.Method, r.URL)
+				return httpmock.NewStringResponse(http.StatusBadRequest, "expected body on request"), nil
+			}
+
+			bodyBytes, err := io.ReadAll(r.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			var bodyInterface, expectedInterface map[string]interface{}
+
+			if err := json.Unmarshal(bodyBytes, &bodyInterface); err != nil {
+				return nil, err
+			}
+
+			if err := json.Unmarshal(expectedBytes, &expectedInterface); err != nil {
+				return nil, err
+			}
+
+			if diff := deep.Equal(bodyInterface, expectedInterface); diff != nil {
+				t.Errorf("unexpected body on request %s %s: %v", r.Method, r.URL, diff)
+				return httpmock.NewStringResponse(http.StatusBadRequest, "unexpected body on request"), nil
+			}
+		}
+
+		return httpmock.NewJsonResponse(successResponse, successResponseBody)
+	}
+}
+
+// Register a new responder when the given call is made.
+func changeStateResponder(registerFunc func(), responder httpmock.Responder) httpmock.Responder {
+	return func(r *http.Request) (*http.Response, error) {
+		resp, err := responder(r)
+		if err == nil && resp.StatusCode == http.StatusOK {
+			registerFunc()
+		}
+
+		return resp, err
+	}
+}
+
+func (testConfig *testAcceptanceConfig) packageRepository(image string) *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepository {
+	return &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepository{
+		FullName: &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryFullName{
+			ClusterGroupName: testConfig.ClusterGroupName,
+			Name:             testConfig.PackageRepositoryName,
+		},
+		Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{},
+		Spec: &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositorySpec{
+			AtomicSpec: &packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositorySpec{
+				ImgpkgBundle: &packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryImgPkgBundleSpec{
+					Image: image,
+				},
+			},
+		},
+	}
+}
+
+func (testConfig *testAcceptanceConfig) appliedPackageRepository(image string) *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepository {
+	repository := testConfig.packageRepository(image)
+
+	repository.Meta = &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+		UID:             "package-repository-uid",
+		ResourceVersion: "v1",
+	}
+
+	repository.Status = &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryStatus{
+		Phase: statusmodel.NewVmwareTanzuManageV1alpha1CommonBatchPhase(statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED),
+		Details: &statusmodel.VmwareTanzuManageV1alpha1CommonBatchDetails{
+			Applied:          1,
+			AvailableTargets: 1,
+		},
+	}
+
+	return repository
+}
+
+func (testConfig *testAcceptanceConfig) setupHTTPMocks(t *testing.T) {
+	httpmock.Activate()
+	t.Cleanup(httpmock.Deactivate)
+
+	endpoint := os.Getenv("TMC_ENDPOINT")
+
+	postRequest := &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryRequest{
+		Repository: testConfig.packageRepository(packageRepositoryImage),
+	}
+
+	postResponse := &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryResponse{
+		Repository: testConfig.appliedPackageRepository(packageRepositoryImage),
+	}
+
+	getResponse := &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryGetRepositoryResponse{
+		Repository: testConfig.appliedPackageRepository(packageRepositoryImage),
+	}
+
+	// The update sends the metadata of the package repository read from the server along with the new image.
+	putRequest := &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryRequest{
+		Repository: testConfig.packageRepository(packageRepositoryNewImage),
+	}
+	putRequest.Repository.Meta = getResponse.Repository.Meta
+
+	putResponse := &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryRepositoryResponse{
+		Repository: testConfig.appliedPackageRepository(packageRepositoryNewImage),
+	}
+
+	updatedGetResponse := &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryGetRepositoryResponse{
+		Repository: testConfig.appliedPackageRepository(packageRepositoryNewImage),
+	}
+
+	postEndpoint := helper.ConstructRequestURL(https, endpoint, cgAPIVersionAndGroup, testConfig.ClusterGroupName, apiKind).String()
+	getEndpoint := helper.ConstructRequestURL(https, endpoint, cgAPIVersionAndGroup, testConfig.ClusterGroupName, apiKind, testConfig.PackageRepositoryName).String()
+
+	httpmock.RegisterResponder("POST", postEndpoint,
+		bodyInspectingResponder(t, postRequest, http.StatusOK, postResponse))
+
+	httpmock.RegisterResponder("GET", getEndpoint,
+		bodyInspectingResponder(t, nil, http.StatusOK, getResponse))
+
+	httpmock.RegisterResponder("PUT", getEndpoint, changeStateResponder(
+		// Set up the get to return the new image after the package repository has been 'updated'.
+		func() {
+			httpmock.RegisterResponder("GET", getEndpoint,
+				bodyInspectingResponder(t, nil, http.StatusOK, updatedGetResponse))
+		},
+		bodyInspectingResponder(t, putRequest, http.StatusOK, putResponse)))
+
+	httpmock.RegisterResponder("DELETE", getEndpoint, changeStateResponder(
+		// Set up the get to return 404 after the package repository has been 'deleted'.
+		func() {
+			httpmock.RegisterResponder("GET", getEndpoint,
+				httpmock.NewStringResponder(http.StatusNotFound, "Not found"))
+		},
+		httpmock.NewStringResponder(http.StatusOK, "{}")))
+}
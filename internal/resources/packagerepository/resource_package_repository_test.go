@@ -0,0 +1,145 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package packagerepository
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	testhelper "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/testing"
+)
+
+const (
+	packageRepositoryEnvTestFlag = "ENABLE_PACKAGE_REPOSITORY_ENV_TEST"
+	packageRepositoryResourceVar = "test_package_repository"
+	packageRepositoryNamePrefix  = "tf-package-repository-test"
+	packageRepositoryImage       = "projects.registry.vmware.com/tkg/packages/standard/repo:v2.2.0"
+	packageRepositoryNewImage    = "projects.registry.vmware.com/tkg/packages/standard/repo:v2.2.0_update.2"
+)
+
+type testAcceptanceConfig struct {
+	Provider                      *schema.Provider
+	ClusterGroupName              string
+	PackageRepositoryName         string
+	PackageRepositoryResourceName string
+}
+
+func testGetDefaultAcceptanceConfig(t *testing.T) *testAcceptanceConfig {
+	return &testAcceptanceConfig{
+		Provider:                      initTestProvider(t),
+		ClusterGroupName:              getEnvOrDefault("PACKAGE_REPOSITORY_CLUSTER_GROUP_NAME", acctest.RandomWithPrefix(packageRepositoryNamePrefix)),
+		PackageRepositoryName:         acctest.RandomWithPrefix(packageRepositoryNamePrefix),
+		PackageRepositoryResourceName: fmt.Sprintf("%s.%s", ResourceName, packageRepositoryResourceVar),
+	}
+}
+
+func getEnvOrDefault(name string, defaultValue string) string {
+	if value, found := os.LookupEnv(name); found {
+		return value
+	}
+
+	return defaultValue
+}
+
+func getConfigureContextFunc() func(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	if _, found := os.LookupEnv(packageRepositoryEnvTestFlag); !found {
+		return authctx.ProviderConfigureContextWithDefaultTransportForTesting
+	}
+
+	return authctx.ProviderConfigureContext
+}
+
+func TestAcceptanceForPackageRepositoryResource(t *testing.T) {
+	testConfig := testGetDefaultAcceptanceConfig(t)
+
+	// If the flag to execute package repository tests is not found, run this as a mock test by setting up an http intercept for each endpoint.
+	_, found := os.LookupEnv(packageRepositoryEnvTestFlag)
+	if !found {
+		os.Setenv("TF_ACC", "true")
+		os.Setenv("TMC_ENDPOINT", "dummy.tmc.mock.vmware.com")
+		os.Setenv("VMW_CLOUD_API_TOKEN", "dummy")
+		os.Setenv("VMW_CLOUD_ENDPOINT", "console.cloud.vmware.com")
+
+		log.Println("Setting up the mock endpoints...")
+		testConfig.setupHTTPMocks(t)
+	} else {
+		// Environment variables with non default values required for a successful call to the package repository service.
+		requiredVars := []string{
+			"VMW_CLOUD_ENDPOINT",
+			"TMC_ENDPOINT",
+			"VMW_CLOUD_API_TOKEN",
+			"PACKAGE_REPOSITORY_CLUSTER_GROUP_NAME",
+		}
+
+		// Check if the required environment variables are set.
+		for _, name := range requiredVars {
+			if _, found := os.LookupEnv(name); !found {
+				t.Errorf("required environment variable '%s' missing", name)
+			}
+		}
+	}
+
+	t.Log("start package repository resource acceptance tests!")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testhelper.TestPreCheck(t),
+		ProviderFactories: testhelper.GetTestProviderFactories(testConfig.Provider),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testConfig.getTestPackageRepositoryResourceConfigValue(packageRepositoryImage),
+				Check:  testConfig.checkPackageRepositoryResourceAttributes(packageRepositoryImage),
+			},
+			{
+				Config: testConfig.getTestPackageRepositoryResourceConfigValue(packageRepositoryNewImage),
+				Check:  testConfig.checkPackageRepositoryResourceAttributes(packageRepositoryNewImage),
+			},
+		},
+	},
+	)
+
+	t.Log("package repository resource acceptance test completed")
+}
+
+func (testConfig *testAcceptanceConfig) getTestPackageRepositoryResourceConfigValue(image string) string {
+	return fmt.Sprintf(`
+	resource "%s" "%s" {
+	  name = "%s"
+
+	  scope {
+	    cluster_group {
+	      name = "%s"
+	    }
+	  }
+
+	  spec {
+	    imgpkg_bundle {
+	      image = "%s"
+	    }
+	  }
+	}
+	`,
+		ResourceName, packageRepositoryResourceVar, testConfig.PackageRepositoryName, testConfig.ClusterGroupName, image,
+	)
+}
+
+func (testConfig *testAcceptanceConfig) checkPackageRepositoryResourceAttributes(image string) resource.TestCheckFunc {
+	return resource.ComposeTestCheckFunc(
+		resource.TestCheckResourceAttr(testConfig.PackageRepositoryResourceName, nameKey, testConfig.PackageRepositoryName),
+		resource.TestCheckResourceAttr(testConfig.PackageRepositoryResourceName, "scope.0.cluster_group.0.name", testConfig.ClusterGroupName),
+		resource.TestCheckResourceAttr(testConfig.PackageRepositoryResourceName, "spec.0.imgpkg_bundle.0.image", image),
+		resource.TestCheckResourceAttr(testConfig.PackageRepositoryResourceName, "status.phase", "APPLIED"),
+	)
+}
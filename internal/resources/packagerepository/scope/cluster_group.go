@@ -0,0 +1,42 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package scope
+
+import (
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	packagerepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packagerepository/clustergroup"
+	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
+)
+
+func ConstructClusterGroupPackageRepositoryFullname(data []interface{}, name string) (fullname *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryFullName) {
+	if len(data) == 0 || data[0] == nil {
+		return fullname
+	}
+
+	fullNameData, _ := data[0].(map[string]interface{})
+
+	fullname = &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryFullName{}
+
+	if nameValue, ok := fullNameData[commonscope.NameKey]; ok {
+		helper.SetPrimitiveValue(nameValue, &fullname.ClusterGroupName, commonscope.NameKey)
+	}
+
+	fullname.Name = name
+
+	return fullname
+}
+
+func FlattenClusterGroupPackageRepositoryFullname(fullname *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryFullName) (data []interface{}) {
+	if fullname == nil {
+		return data
+	}
+
+	flattenFullname := make(map[string]interface{})
+
+	flattenFullname[commonscope.NameKey] = fullname.ClusterGroupName
+
+	return []interface{}{flattenFullname}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package spec
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	packagerepositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packagerepository/cluster"
+)
+
+func ConstructSpecForClusterScope(d *schema.ResourceData) (spec *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositorySpec) {
+	value, ok := d.GetOk(SpecKey)
+	if !ok {
+		return spec
+	}
+
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return spec
+	}
+
+	specData := data[0].(map[string]interface{})
+
+	spec = &packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositorySpec{}
+
+	if bundle, ok := specData[imgpkgBundleKey]; ok {
+		if bundleData, ok := bundle.([]interface{}); ok {
+			spec.ImgpkgBundle = expandImgpkgBundle(bundleData)
+		}
+	}
+
+	return spec
+}
+
+func FlattenSpecForClusterScope(spec *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositorySpec) (data []interface{}) {
+	if spec == nil {
+		return data
+	}
+
+	flattenSpecData := make(map[string]interface{})
+
+	if spec.ImgpkgBundle != nil {
+		flattenSpecData[imgpkgBundleKey] = flattenImgpkgBundle(spec.ImgpkgBundle)
+	}
+
+	return []interface{}{flattenSpecData}
+}
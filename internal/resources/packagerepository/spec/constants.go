@@ -0,0 +1,12 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package spec
+
+const (
+	SpecKey         = "spec"
+	imgpkgBundleKey = "imgpkg_bundle"
+	imageKey        = "image"
+)
@@ -0,0 +1,73 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package spec
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	packagerepositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packagerepository/cluster"
+)
+
+var SpecSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Spec for the package repository.",
+	Required:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			imgpkgBundleKey: {
+				Type:        schema.TypeList,
+				Description: "Imgpkg bundle containing the packages of the repository.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						imageKey: {
+							Type:         schema.TypeString,
+							Description:  "Image URL of the imgpkg bundle, for example `projects.registry.vmware.com/tkg/packages/standard/repo:v2.2.0`.",
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func expandImgpkgBundle(data []interface{}) (bundle *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryImgPkgBundleSpec) {
+	if len(data) == 0 || data[0] == nil {
+		return bundle
+	}
+
+	bundleData, _ := data[0].(map[string]interface{})
+
+	bundle = &packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryImgPkgBundleSpec{}
+
+	if imageValue, ok := bundleData[imageKey]; ok {
+		helper.SetPrimitiveValue(imageValue, &bundle.Image, imageKey)
+	}
+
+	return bundle
+}
+
+func flattenImgpkgBundle(bundle *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryImgPkgBundleSpec) (data []interface{}) {
+	if bundle == nil {
+		return data
+	}
+
+	flattenBundleData := make(map[string]interface{})
+
+	flattenBundleData[imageKey] = bundle.Image
+
+	return []interface{}{flattenBundleData}
+}
+
+func HasSpecChanged(d *schema.ResourceData) bool {
+	return d.HasChange(helper.GetFirstElementOf(SpecKey, imgpkgBundleKey))
+}
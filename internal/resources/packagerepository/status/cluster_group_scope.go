@@ -0,0 +1,65 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package status
+
+import (
+	"github.com/pkg/errors"
+
+	packagerepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packagerepository/clustergroup"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+func FlattenStatusForClusterGroupScope(status *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryStatus) (data interface{}) {
+	if status == nil {
+		return data
+	}
+
+	if status.Phase == nil {
+		return data
+	}
+
+	flattenStatusData := make(map[string]interface{})
+
+	flattenStatusData[phaseKey] = string(*status.Phase)
+
+	return flattenStatusData
+}
+
+// ReconcileBaselineForClusterGroupScope returns the generation the cluster group status reported before an update.
+func ReconcileBaselineForClusterGroupScope(status *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryStatus) ReconcileBaseline {
+	if status == nil {
+		return ReconcileBaseline{}
+	}
+
+	return ReconcileBaseline{ObservedGeneration: status.ObservedGeneration}
+}
+
+// IsReconciledForClusterGroupScope checks whether the package repository got applied on the member clusters of the cluster group.
+func IsReconciledForClusterGroupScope(status *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryStatus, baseline ReconcileBaseline) (bool, error) {
+	if status == nil || status.Phase == nil {
+		return false, nil
+	}
+
+	// The phase is still the one of the generation applied before the update.
+	if baseline.ObservedGeneration != "" && status.ObservedGeneration == baseline.ObservedGeneration {
+		return false, nil
+	}
+
+	switch *status.Phase {
+	case statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseERROR:
+		var failed int32
+
+		if status.Details != nil {
+			failed = status.Details.Error
+		}
+
+		return false, errors.Errorf("package repository failed to apply on %d member cluster(s)", failed)
+	case statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED:
+		return true, nil
+	}
+
+	return false, nil
+}
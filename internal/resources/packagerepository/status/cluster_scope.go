@@ -0,0 +1,56 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package status
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	packagerepositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packagerepository/cluster"
+)
+
+func FlattenStatusForClusterScope(status *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryStatus) (data interface{}) {
+	if status == nil {
+		return data
+	}
+
+	flattenStatusData := make(map[string]interface{})
+
+	flattenStatusData[disabledKey] = strconv.FormatBool(status.Disabled)
+
+	if state := reconcileState(status.Conditions); state != "" {
+		flattenStatusData[stateKey] = state
+	}
+
+	return flattenStatusData
+}
+
+// ReconcileBaselineForClusterScope returns the reconcile result reported on the cluster before an update.
+func ReconcileBaselineForClusterScope(status *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryStatus) ReconcileBaseline {
+	if status == nil {
+		return ReconcileBaseline{}
+	}
+
+	return ReconcileBaseline{TransitionTime: lastTransitionTime(status.Conditions)}
+}
+
+// IsReconciledForClusterScope checks whether kapp-controller reconciled the package repository on the cluster after the baseline,
+// and returns an error with the condition message when the reconciliation failed.
+func IsReconciledForClusterScope(status *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryStatus, baseline ReconcileBaseline) (bool, error) {
+	if status == nil {
+		return false, nil
+	}
+
+	switch reconcileStateAfter(status.Conditions, baseline.TransitionTime) {
+	case conditionReconcileFailed:
+		return false, errors.Errorf("package repository reconciliation failed: %s", status.Conditions[conditionReconcileFailed].Message)
+	case conditionReconcileSucceeded:
+		return true, nil
+	}
+
+	return false, nil
+}
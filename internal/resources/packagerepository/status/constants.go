@@ -0,0 +1,16 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package status
+
+const (
+	conditionReconcileSucceeded = "ReconcileSucceeded"
+	conditionReconcileFailed    = "ReconcileFailed"
+	conditionReconciling        = "Reconciling"
+
+	stateKey    = "state"
+	disabledKey = "disabled"
+	phaseKey    = "phase"
+)
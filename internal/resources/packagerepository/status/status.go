@@ -0,0 +1,70 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package status
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+var StatusSchema = &schema.Schema{
+	Type:        schema.TypeMap,
+	Description: "Status for the package repository.",
+	Computed:    true,
+	Elem:        &schema.Schema{Type: schema.TypeString},
+}
+
+// ReconcileBaseline is the reconcile result reported before an update of the package repository. Waiting for the update to
+// reconcile only accepts a result reported after the baseline, so the result of the previous reconciliation is ignored.
+// The zero value accepts any result.
+type ReconcileBaseline struct {
+	// TransitionTime is the latest transition of the kapp-controller reconcile conditions on the cluster.
+	TransitionTime strfmt.DateTime
+
+	// ObservedGeneration is the generation the cluster group status was last updated for.
+	ObservedGeneration string
+}
+
+// reconcileState returns the reconcile condition of kapp-controller which is true, if any.
+func reconcileState(conditions map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition) string {
+	return reconcileStateAfter(conditions, strfmt.DateTime{})
+}
+
+// reconcileStateAfter returns the reconcile condition of kapp-controller which is true and transitioned after the given
+// time, if any.
+func reconcileStateAfter(conditions map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition, after strfmt.DateTime) string {
+	for _, conditionType := range []string{conditionReconcileFailed, conditionReconcileSucceeded, conditionReconciling} {
+		condition, ok := conditions[conditionType]
+		if !ok || condition.Status == nil || *condition.Status != statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE {
+			continue
+		}
+
+		if !time.Time(after).IsZero() && !time.Time(condition.LastTransitionTime).After(time.Time(after)) {
+			continue
+		}
+
+		return conditionType
+	}
+
+	return ""
+}
+
+// lastTransitionTime returns the latest transition of the kapp-controller reconcile conditions.
+func lastTransitionTime(conditions map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition) strfmt.DateTime {
+	var last strfmt.DateTime
+
+	for _, conditionType := range []string{conditionReconcileFailed, conditionReconcileSucceeded, conditionReconciling} {
+		if condition, ok := conditions[conditionType]; ok && time.Time(condition.LastTransitionTime).After(time.Time(last)) {
+			last = condition.LastTransitionTime
+		}
+	}
+
+	return last
+}
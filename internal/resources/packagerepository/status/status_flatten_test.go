@@ -0,0 +1,194 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package status
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	packagerepositoryclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packagerepository/cluster"
+	packagerepositoryclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/packagerepository/clustergroup"
+	statusmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/status"
+)
+
+func TestFlattenStatusForClusterScope(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryStatus
+		expected    interface{}
+	}{
+		{
+			description: "check for nil cluster package repository status",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with a reconciling cluster package repository",
+			input: &packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryStatus{
+				Conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+					conditionReconciling: {
+						Type:   conditionReconciling,
+						Status: statusmodel.NewVmwareTanzuCoreV1alpha1StatusConditionStatus(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE),
+					},
+				},
+			},
+			expected: map[string]interface{}{
+				disabledKey: "false",
+				stateKey:    conditionReconciling,
+			},
+		},
+		{
+			description: "scenario with a disabled cluster package repository",
+			input: &packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryStatus{
+				Disabled: true,
+			},
+			expected: map[string]interface{}{
+				disabledKey: "true",
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			require.Equal(t, test.expected, FlattenStatusForClusterScope(test.input))
+		})
+	}
+}
+
+func TestIsReconciledForClusterScope(t *testing.T) {
+	t.Parallel()
+
+	before := strfmt.DateTime(time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC))
+	after := strfmt.DateTime(time.Date(2023, 5, 1, 10, 5, 0, 0, time.UTC))
+
+	cases := []struct {
+		description string
+		conditions  map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition
+		baseline    ReconcileBaseline
+		expected    bool
+		expectErr   bool
+	}{
+		{
+			description: "package repository without conditions",
+			conditions:  nil,
+			expected:    false,
+		},
+		{
+			description: "reconciled package repository",
+			conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+				conditionReconcileSucceeded: {
+					Status: statusmodel.NewVmwareTanzuCoreV1alpha1StatusConditionStatus(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE),
+				},
+			},
+			expected: true,
+		},
+		{
+			description: "failed package repository",
+			conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+				conditionReconcileSucceeded: {
+					Status: statusmodel.NewVmwareTanzuCoreV1alpha1StatusConditionStatus(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusFALSE),
+				},
+				conditionReconcileFailed: {
+					Status:  statusmodel.NewVmwareTanzuCoreV1alpha1StatusConditionStatus(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE),
+					Message: "fetching image: unauthorized",
+				},
+			},
+			expected:  false,
+			expectErr: true,
+		},
+		{
+			description: "package repository reconciled before the update",
+			conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+				conditionReconcileSucceeded: {
+					Status:             statusmodel.NewVmwareTanzuCoreV1alpha1StatusConditionStatus(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE),
+					LastTransitionTime: before,
+				},
+			},
+			baseline: ReconcileBaseline{TransitionTime: before},
+			expected: false,
+		},
+		{
+			description: "package repository failed before the update",
+			conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+				conditionReconcileFailed: {
+					Status:             statusmodel.NewVmwareTanzuCoreV1alpha1StatusConditionStatus(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE),
+					Message:            "fetching image: unauthorized",
+					LastTransitionTime: before,
+				},
+			},
+			baseline: ReconcileBaseline{TransitionTime: before},
+			expected: false,
+		},
+		{
+			description: "package repository reconciled after the update",
+			conditions: map[string]statusmodel.VmwareTanzuCoreV1alpha1StatusCondition{
+				conditionReconcileSucceeded: {
+					Status:             statusmodel.NewVmwareTanzuCoreV1alpha1StatusConditionStatus(statusmodel.VmwareTanzuCoreV1alpha1StatusConditionStatusTRUE),
+					LastTransitionTime: after,
+				},
+			},
+			baseline: ReconcileBaseline{TransitionTime: before},
+			expected: true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual, err := IsReconciledForClusterScope(&packagerepositoryclustermodel.VmwareTanzuManageV1alpha1ClusterTanzupackageRepositoryStatus{
+				Conditions: test.conditions,
+			}, test.baseline)
+			require.Equal(t, test.expectErr, err != nil)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestIsReconciledForClusterGroupScope(t *testing.T) {
+	t.Parallel()
+
+	applied := statusmodel.NewVmwareTanzuManageV1alpha1CommonBatchPhase(statusmodel.VmwareTanzuManageV1alpha1CommonBatchPhaseAPPLIED)
+
+	cases := []struct {
+		description string
+		input       *packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryStatus
+		baseline    ReconcileBaseline
+		expected    bool
+	}{
+		{
+			description: "applied package repository",
+			input:       &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryStatus{Phase: applied},
+			expected:    true,
+		},
+		{
+			description: "package repository applied before the update",
+			input:       &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryStatus{ObservedGeneration: "1", Phase: applied},
+			baseline:    ReconcileBaseline{ObservedGeneration: "1"},
+			expected:    false,
+		},
+		{
+			description: "package repository applied after the update",
+			input:       &packagerepositoryclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupTanzupackageRepositoryStatus{ObservedGeneration: "2", Phase: applied},
+			baseline:    ReconcileBaseline{ObservedGeneration: "1"},
+			expected:    true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual, err := IsReconciledForClusterGroupScope(test.input, test.baseline)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
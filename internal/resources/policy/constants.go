@@ -14,6 +14,7 @@ const (
 	SpecKey                   = "spec"
 	NameKey                   = "name"
 	InputKey                  = "input"
+	FailOnConflictKey         = "fail_on_conflict"
 	RecipeVersionDefaultValue = "v1"
 	UnknownRecipe             = ""
 )
@@ -0,0 +1,27 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package effectivepolicy
+
+const (
+	ResourceName = "tanzu-mission-control_effective_policy"
+
+	ClusterNameKey           = "cluster_name"
+	ManagementClusterNameKey = "management_cluster_name"
+	ProvisionerNameKey       = "provisioner_name"
+	NamespaceNameKey         = "namespace_name"
+	PolicyTypeKey            = "policy_type"
+	attachedValue            = "attached"
+
+	policiesKey      = "policies"
+	nameKey          = "name"
+	typeKey          = "type"
+	recipeKey        = "recipe"
+	recipeVersionKey = "recipe_version"
+	inheritedKey     = "inherited"
+	sourceRidKey     = "source_rid"
+	inputKey         = "input"
+	resourcesKey     = "resources"
+)
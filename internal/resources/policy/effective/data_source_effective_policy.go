@@ -0,0 +1,181 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package effectivepolicy
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policyeffectivemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/effective"
+)
+
+func DataSourceEffectivePolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEffectivePolicyRead,
+		Schema: map[string]*schema.Schema{
+			ClusterNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the cluster.",
+				Required:    true,
+			},
+			ManagementClusterNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the management cluster.",
+				Default:     attachedValue,
+				Optional:    true,
+			},
+			ProvisionerNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the provisioner.",
+				Default:     attachedValue,
+				Optional:    true,
+			},
+			NamespaceNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the namespace. When set, the policies effective on the namespace are returned instead of those effective on the cluster.",
+				Optional:    true,
+			},
+			PolicyTypeKey: {
+				Type:        schema.TypeString,
+				Description: "Type of the policies to return, e.g. security-policy, namespace-quota-policy or custom-policy. All types are returned when not set.",
+				Optional:    true,
+			},
+			policiesKey: {
+				Type:        schema.TypeList,
+				Description: "Policies effective on the cluster or namespace, attached directly to it or inherited from its workspace, cluster group or organization.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						nameKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the policy.",
+							Computed:    true,
+						},
+						typeKey: {
+							Type:        schema.TypeString,
+							Description: "Type of the policy.",
+							Computed:    true,
+						},
+						recipeKey: {
+							Type:        schema.TypeString,
+							Description: "Recipe of the policy, or name of the custom policy template for custom policies using one.",
+							Computed:    true,
+						},
+						recipeVersionKey: {
+							Type:        schema.TypeString,
+							Description: "Version of the recipe of the policy.",
+							Computed:    true,
+						},
+						inheritedKey: {
+							Type:        schema.TypeBool,
+							Description: "Whether the policy is inherited from a parent of the cluster or namespace.",
+							Computed:    true,
+						},
+						sourceRidKey: {
+							Type:        schema.TypeString,
+							Description: "Resource ID of the organization, cluster group, workspace, cluster or namespace the policy is attached to.",
+							Computed:    true,
+						},
+						inputKey: {
+							Type:        schema.TypeString,
+							Description: "Input of the policy recipe, as a JSON document.",
+							Computed:    true,
+						},
+						resourcesKey: {
+							Type:        schema.TypeList,
+							Description: "Kubernetes resources generated from the policy, as YAML documents.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEffectivePolicyRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+	searchScope := constructSearchScope(d)
+
+	resp, err := config.TMCConnection.EffectivePolicyService.ManageV1alpha1EffectivePolicyServiceList(
+		&policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicyListRequestParameters{
+			SearchScope: searchScope,
+		},
+	)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control effective policies, cluster : %s", searchScope.ClusterName))
+	}
+
+	d.SetId(strings.Join([]string{searchScope.ManagementClusterName, searchScope.ProvisionerName, searchScope.ClusterName, searchScope.NamespaceName, searchScope.PolicyType}, "/"))
+
+	if err := d.Set(policiesKey, flattenEffectivePolicies(resp.EffectivePolicies)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func constructSearchScope(d *schema.ResourceData) *policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySearchScope {
+	searchScope := &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySearchScope{}
+
+	helper.SetPrimitiveValue(d.Get(ClusterNameKey), &searchScope.ClusterName, ClusterNameKey)
+	helper.SetPrimitiveValue(d.Get(ManagementClusterNameKey), &searchScope.ManagementClusterName, ManagementClusterNameKey)
+	helper.SetPrimitiveValue(d.Get(ProvisionerNameKey), &searchScope.ProvisionerName, ProvisionerNameKey)
+	helper.SetPrimitiveValue(d.Get(NamespaceNameKey), &searchScope.NamespaceName, NamespaceNameKey)
+	helper.SetPrimitiveValue(d.Get(PolicyTypeKey), &searchScope.PolicyType, PolicyTypeKey)
+
+	return searchScope
+}
+
+func flattenEffectivePolicies(effectivePolicies []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicy) (data []interface{}) {
+	for _, effectivePolicy := range effectivePolicies {
+		if effectivePolicy == nil || effectivePolicy.Spec == nil {
+			continue
+		}
+
+		for _, policySpec := range effectivePolicy.Spec.PolicySpecs {
+			if policySpec == nil {
+				continue
+			}
+
+			flattenPolicyData := map[string]interface{}{
+				nameKey:      policySpec.PolicyName,
+				typeKey:      effectivePolicy.Spec.PolicyType,
+				inheritedKey: policySpec.Inherited,
+				sourceRidKey: policySpec.SourceRid,
+			}
+
+			if spec := policySpec.Spec; spec != nil {
+				if spec.Type != "" {
+					flattenPolicyData[typeKey] = spec.Type
+				}
+
+				flattenPolicyData[recipeKey] = spec.Recipe
+				flattenPolicyData[recipeVersionKey] = spec.RecipeVersion
+				flattenPolicyData[resourcesKey] = spec.Resources
+
+				if spec.Input != nil {
+					if input, err := json.Marshal(spec.Input); err == nil {
+						flattenPolicyData[inputKey] = string(input)
+					}
+				}
+			}
+
+			data = append(data, flattenPolicyData)
+		}
+	}
+
+	return data
+}
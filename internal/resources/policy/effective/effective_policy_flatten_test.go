@@ -0,0 +1,91 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package effectivepolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+	policyeffectivemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/effective"
+)
+
+func TestFlattenEffectivePolicies(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicy
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil effective policies",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with direct and inherited policies",
+			input: []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicy{
+				{
+					Spec: &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec{
+						PolicyType: "security-policy",
+						PolicySpecs: []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpecPolicySpec{
+							{
+								Inherited:  true,
+								PolicyName: "org-baseline",
+								SourceRid:  "rid:o:org-id",
+								Spec: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+									Input: map[string]interface{}{
+										"audit": false,
+									},
+									Recipe:        "baseline",
+									RecipeVersion: "v1",
+									Resources:     []string{"kind: K8sPSPPrivilegedContainer"},
+									Type:          "security-policy",
+								},
+							},
+							{
+								Inherited:  false,
+								PolicyName: "cluster-strict",
+								SourceRid:  "rid:c:org-id:attached:attached:test-cluster",
+							},
+						},
+					},
+				},
+				{
+					Spec: nil,
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					nameKey:          "org-baseline",
+					typeKey:          "security-policy",
+					inheritedKey:     true,
+					sourceRidKey:     "rid:o:org-id",
+					recipeKey:        "baseline",
+					recipeVersionKey: "v1",
+					resourcesKey:     []string{"kind: K8sPSPPrivilegedContainer"},
+					inputKey:         `{"audit":false}`,
+				},
+				map[string]interface{}{
+					nameKey:      "cluster-strict",
+					typeKey:      "security-policy",
+					inheritedKey: false,
+					sourceRidKey: "rid:c:org-id:attached:attached:test-cluster",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenEffectivePolicies(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policy
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var FailOnConflict = &schema.Schema{
+	Type:        schema.TypeBool,
+	Description: "Fail the plan when the policy is shadowed by or conflicts with a policy effective on the cluster or namespace it is attached to, instead of only logging a warning",
+	Optional:    true,
+	Default:     false,
+}
@@ -12,7 +12,7 @@ import (
 
 const (
 	ResourceName = "tanzu-mission-control_custom_policy"
-	typePolicy   = "custom-policy" // Type of Policy as defined in API
+	TypePolicy   = "custom-policy" // Type of Policy as defined in API
 )
 
 // Allowed input recipes.
@@ -24,4 +24,5 @@ const (
 	TMCExternalIPSRecipe              Recipe = reciperesource.TMCExternalIPSKey
 	TMCHTTPSIngressRecipe             Recipe = reciperesource.TMCHTTPSIngressKey
 	TMCRequireLabelsRecipe            Recipe = reciperesource.TMCRequireLabelsKey
+	CustomRecipe                      Recipe = reciperesource.CustomKey
 )
@@ -20,7 +20,7 @@ import (
 var (
 	inputSchema = &schema.Schema{
 		Type:        schema.TypeList,
-		Description: "Input for the custom policy, having one of the valid recipes: tmc_block_nodeport_service, tmc_block_resources, tmc_block_rolebinding_subjects, tmc_external_ips, tmc_https_ingress or tmc_require_labels.",
+		Description: "Input for the custom policy, having one of the valid recipes: tmc_block_nodeport_service, tmc_block_resources, tmc_block_rolebinding_subjects, tmc_external_ips, tmc_https_ingress, tmc_require_labels or custom.",
 		Required:    true,
 		MaxItems:    1,
 		MinItems:    1,
@@ -33,10 +33,11 @@ var (
 				reciperesource.TMCExternalIPSKey:              reciperesource.TMCExternalIps,
 				reciperesource.TMCHTTPSIngressKey:             reciperesource.TMCHTTPSIngress,
 				reciperesource.TMCRequireLabelsKey:            reciperesource.TMCRequireLabels,
+				reciperesource.CustomKey:                      reciperesource.Custom,
 			},
 		},
 	}
-	RecipesAllowed = [...]string{reciperesource.TMCBlockNodeportServiceKey, reciperesource.TMCBlockResourcesKey, reciperesource.TMCBlockRolebindingSubjectsKey, reciperesource.TMCExternalIPSKey, reciperesource.TMCHTTPSIngressKey, reciperesource.TMCRequireLabelsKey}
+	RecipesAllowed = [...]string{reciperesource.TMCBlockNodeportServiceKey, reciperesource.TMCBlockResourcesKey, reciperesource.TMCBlockRolebindingSubjectsKey, reciperesource.TMCExternalIPSKey, reciperesource.TMCHTTPSIngressKey, reciperesource.TMCRequireLabelsKey, reciperesource.CustomKey}
 )
 
 type (
@@ -50,6 +51,8 @@ type (
 		inputTMCExternalIps              *policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TMCExternalIPS
 		inputTMCHTTPSIngress             *policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TMCCommonRecipe
 		inputTMCRequireLabels            *policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TMCRequireLabels
+		inputCustom                      *policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1Custom
+		customTemplateName               string
 	}
 )
 
@@ -114,6 +117,17 @@ func constructInput(data []interface{}) (inputRecipeData *inputRecipe) {
 		}
 	}
 
+	if input, ok := inputData[reciperesource.CustomKey]; ok {
+		if recipeType, ok := input.([]interface{}); ok && len(recipeType) != 0 {
+			templateName, custom := reciperesource.ConstructCustom(recipeType)
+			inputRecipeData = &inputRecipe{
+				recipe:             CustomRecipe,
+				inputCustom:        custom,
+				customTemplateName: templateName,
+			}
+		}
+	}
+
 	return inputRecipeData
 }
 
@@ -137,6 +151,8 @@ func flattenInput(inputRecipeData *inputRecipe) (data []interface{}) {
 		flattenInputData[reciperesource.TMCHTTPSIngressKey] = reciperesource.FlattenTMCCommonRecipe(inputRecipeData.inputTMCHTTPSIngress)
 	case TMCRequireLabelsRecipe:
 		flattenInputData[reciperesource.TMCRequireLabelsKey] = reciperesource.FlattenTMCRequireLabels(inputRecipeData.inputTMCRequireLabels)
+	case CustomRecipe:
+		flattenInputData[reciperesource.CustomKey] = reciperesource.FlattenCustom(inputRecipeData.customTemplateName, inputRecipeData.inputCustom)
 
 	case UnknownRecipe:
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
@@ -212,6 +228,12 @@ func ValidateInput(ctx context.Context, diff *schema.ResourceDiff, i interface{}
 		}
 	}
 
+	if recipeData, ok := inputData[reciperesource.CustomKey]; ok {
+		if recipeType, ok := recipeData.([]interface{}); ok && len(recipeType) != 0 {
+			recipesFound = append(recipesFound, reciperesource.CustomKey)
+		}
+	}
+
 	if len(recipesFound) == 0 {
 		return fmt.Errorf("no valid input recipe block found: minimum one valid input recipe block is required among: %v", strings.Join(RecipesAllowed[:], `, `))
 	} else if len(recipesFound) > 1 {
@@ -12,6 +12,8 @@ const (
 	TMCBlockNodeportServiceKey     = "tmc_block_nodeport_service"
 	TMCBlockResourcesKey           = "tmc_block_resources"
 	TMCHTTPSIngressKey             = "tmc_https_ingress"
+	CustomKey                      = "custom"
+	TemplateNameKey                = "template_name"
 	AuditKey                       = "audit"
 	TargetKubernetesResourcesKey   = "target_kubernetes_resources"
 	ParametersKey                  = "parameters"
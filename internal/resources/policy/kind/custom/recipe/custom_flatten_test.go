@@ -0,0 +1,83 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipecustommodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom"
+	policyrecipecustomcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom/common"
+)
+
+func TestFlattenCustom(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description  string
+		templateName string
+		input        *policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1Custom
+		expected     []interface{}
+	}{
+		{
+			description: "check for nil custom policy custom recipe",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description:  "normal scenario with complete custom policy custom recipe",
+			templateName: "k8srequiredreplicas",
+			input: &policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1Custom{
+				Audit: true,
+				Parameters: map[string]interface{}{
+					"min":        float64(2),
+					"namespaces": []interface{}{"default"},
+				},
+				TargetKubernetesResources: []*policyrecipecustomcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TargetKubernetesResources{
+					{
+						APIGroups: []string{"apps"},
+						Kinds:     []string{"Deployment"},
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					TemplateNameKey: "k8srequiredreplicas",
+					AuditKey:        true,
+					ParametersKey:   `{"min":2,"namespaces":["default"]}`,
+					TargetKubernetesResourcesKey: []interface{}{
+						map[string]interface{}{
+							APIGroupsKey: []string{"apps"},
+							KindsKey:     []string{"Deployment"},
+						},
+					},
+				},
+			},
+		},
+		{
+			description:  "scenario with custom recipe without parameters",
+			templateName: "k8sblockall",
+			input: &policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1Custom{
+				Audit: false,
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					TemplateNameKey: "k8sblockall",
+					AuditKey:        false,
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenCustom(test.templateName, test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
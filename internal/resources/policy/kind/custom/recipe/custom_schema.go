@@ -0,0 +1,123 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+// Package recipe contains schema and helper functions for different input recipes.
+// Contains recipe schema for custom recipe, backed by a custom policy template.
+package recipe
+
+import (
+	"encoding/json"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policyrecipecustommodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom"
+	policyrecipecustomcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/custom/common"
+)
+
+// templateNameRegex matches the lowercase form of a ConstraintTemplate kind.
+var templateNameRegex = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+var Custom = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The input schema for custom policy using a custom policy template",
+	Optional:    true,
+	ForceNew:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			TemplateNameKey: {
+				Type:         schema.TypeString,
+				Description:  "Name of the custom policy template to use, which is the lowercase form of the kind of its ConstraintTemplate.",
+				Required:     true,
+				ValidateFunc: validation.StringMatch(templateNameRegex, "must be the lowercase form of the kind of the ConstraintTemplate, e.g. k8srequiredlabels"),
+			},
+			AuditKey: {
+				Type:        schema.TypeBool,
+				Description: "Audit (dry-run).",
+				Optional:    true,
+				Default:     false,
+			},
+			ParametersKey: {
+				Type:             schema.TypeString,
+				Description:      "Parameters passed to the custom policy template, as a JSON document matching the parameters schema of the template. Use `jsonencode()` to provide the parameters as structured HCL.",
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			TargetKubernetesResourcesKey: targetKubernetesResources,
+		},
+	},
+}
+
+func ConstructCustom(data []interface{}) (templateName string, custom *policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1Custom) {
+	if len(data) == 0 || data[0] == nil {
+		return templateName, custom
+	}
+
+	customData, _ := data[0].(map[string]interface{})
+
+	custom = &policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1Custom{}
+
+	if v, ok := customData[TemplateNameKey]; ok {
+		helper.SetPrimitiveValue(v, &templateName, TemplateNameKey)
+	}
+
+	if v, ok := customData[AuditKey]; ok {
+		helper.SetPrimitiveValue(v, &custom.Audit, AuditKey)
+	}
+
+	if v, ok := customData[ParametersKey]; ok {
+		if parameters, ok := v.(string); ok && parameters != "" {
+			_ = json.Unmarshal([]byte(parameters), &custom.Parameters)
+		}
+	}
+
+	if v, ok := customData[TargetKubernetesResourcesKey]; ok {
+		if vs, ok := v.([]interface{}); ok {
+			if len(vs) != 0 && vs[0] != nil {
+				custom.TargetKubernetesResources = make([]*policyrecipecustomcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1TargetKubernetesResources, 0)
+
+				for _, raw := range vs {
+					custom.TargetKubernetesResources = append(custom.TargetKubernetesResources, expandTargetKubernetesResources(raw))
+				}
+			}
+		}
+	}
+
+	return templateName, custom
+}
+
+func FlattenCustom(templateName string, custom *policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1Custom) (data []interface{}) {
+	if custom == nil {
+		return data
+	}
+
+	flattenCustom := make(map[string]interface{})
+
+	flattenCustom[TemplateNameKey] = templateName
+	flattenCustom[AuditKey] = custom.Audit
+
+	if len(custom.Parameters) != 0 {
+		if parameters, err := json.Marshal(custom.Parameters); err == nil {
+			flattenCustom[ParametersKey] = string(parameters)
+		}
+	}
+
+	if custom.TargetKubernetesResources != nil {
+		var tkrs []interface{}
+
+		for _, tkr := range custom.TargetKubernetesResources {
+			tkrs = append(tkrs, flattenTargetKubernetesResources(tkr))
+		}
+
+		flattenCustom[TargetKubernetesResourcesKey] = tkrs
+	}
+
+	return []interface{}{flattenCustom}
+}
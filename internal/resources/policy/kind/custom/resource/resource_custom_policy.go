@@ -22,11 +22,16 @@ func ResourceCustomPolicy() *schema.Resource {
 		ReadContext:   schema.ReadContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindcustom.ResourceName), policyoperations.WithOperationType(policyoperations.Read))),
 		UpdateContext: schema.UpdateContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindcustom.ResourceName), policyoperations.WithOperationType(policyoperations.Update))),
 		DeleteContext: schema.DeleteContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindcustom.ResourceName), policyoperations.WithOperationType(policyoperations.Delete))),
-		Schema:        customPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: policyoperations.ResourcePolicyImporter(policykindcustom.ResourceName),
+		},
+		Schema: customPolicySchema,
 		CustomizeDiff: customdiff.All(
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindcustom.ResourceName])),
 			policykindcustom.ValidateInput,
+			policykindcustom.ValidateTemplateName,
 			policy.ValidateSpecLabelSelectorRequirement,
+			policyoperations.CheckEffectivePolicyConflicts(policykindcustom.TypePolicy),
 		),
 	}
 }
@@ -38,7 +43,8 @@ var customPolicySchema = map[string]*schema.Schema{
 		Required:    true,
 		ForceNew:    true,
 	},
-	scope.ScopeKey: scope.ScopeSchema,
-	common.MetaKey: common.Meta,
-	policy.SpecKey: policykindcustom.SpecSchema,
+	scope.ScopeKey:           scope.ScopeSchema,
+	common.MetaKey:           common.Meta,
+	policy.SpecKey:           policykindcustom.SpecSchema,
+	policy.FailOnConflictKey: policy.FailOnConflict,
 }
@@ -93,6 +93,45 @@ func TestFlattenSpec(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "scenario with a recipe backed by a custom policy template",
+			input: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+				Input: map[string]interface{}{
+					"audit": true,
+					"parameters": map[string]interface{}{
+						"min": float64(2),
+					},
+					"targetKubernetesResources": []interface{}{
+						map[string]interface{}{
+							"apiGroups": []interface{}{"apps"},
+							"kinds":     []interface{}{"Deployment"},
+						},
+					},
+				},
+				Recipe: "k8srequiredreplicas",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					policy.InputKey: []interface{}{
+						map[string]interface{}{
+							reciperesource.CustomKey: []interface{}{
+								map[string]interface{}{
+									reciperesource.TemplateNameKey: "k8srequiredreplicas",
+									reciperesource.AuditKey:        true,
+									reciperesource.ParametersKey:   `{"min":2}`,
+									reciperesource.TargetKubernetesResourcesKey: []interface{}{
+										map[string]interface{}{
+											reciperesource.APIGroupsKey: []string{"apps"},
+											reciperesource.KindsKey:     []string{"Deployment"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, each := range cases {
@@ -45,7 +45,7 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 	specData := data[0].(map[string]interface{})
 
 	spec = &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
-		Type:          typePolicy,
+		Type:          TypePolicy,
 		RecipeVersion: policy.RecipeVersionDefaultValue,
 	}
 
@@ -67,6 +67,11 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 
 	spec.Recipe = strings.ReplaceAll(string(inputRecipeData.recipe), "_", "-")
 
+	if inputRecipeData.recipe == CustomRecipe {
+		// Policies using a custom policy template refer to the template by name as their recipe.
+		spec.Recipe = inputRecipeData.customTemplateName
+	}
+
 	switch inputRecipeData.recipe {
 	case TMCBlockNodeportServiceRecipe:
 		if inputRecipeData.inputTMCBlockNodeportService != nil {
@@ -92,6 +97,10 @@ func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV
 		if inputRecipeData.inputTMCRequireLabels != nil {
 			spec.Input = *inputRecipeData.inputTMCRequireLabels
 		}
+	case CustomRecipe:
+		if inputRecipeData.inputCustom != nil {
+			spec.Input = *inputRecipeData.inputCustom
+		}
 	case UnknownRecipe:
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
 	}
@@ -203,6 +212,20 @@ func FlattenSpec(spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) (d
 		}
 	case string(UnknownRecipe):
 		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
+	default:
+		// Any other recipe is the name of a custom policy template.
+		var customRecipeInput policyrecipecustommodel.VmwareTanzuManageV1alpha1CommonPolicySpecCustomV1Custom
+
+		err = customRecipeInput.UnmarshalBinary(byteSlice)
+		if err != nil {
+			return data
+		}
+
+		inputRecipeData = &inputRecipe{
+			recipe:             CustomRecipe,
+			inputCustom:        &customRecipeInput,
+			customTemplateName: spec.Recipe,
+		}
 	}
 
 	flattenSpecData[policy.InputKey] = flattenInput(inputRecipeData)
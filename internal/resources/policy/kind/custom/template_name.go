@@ -0,0 +1,64 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policykindcustom
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	custompolicytemplatemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/custompolicytemplate"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/custompolicytemplate"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	reciperesource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom/recipe"
+)
+
+var templateNameKey = helper.GetFirstElementOf(policy.SpecKey, policy.InputKey, reciperesource.CustomKey, reciperesource.TemplateNameKey)
+
+// ValidateTemplateName checks at plan time that the template name of the custom recipe is the lowercase form of the kind
+// of the ConstraintTemplate wrapped by the referenced custom policy template.
+// A template which can't be read, e.g. because it is created in the same run, is left to the server to validate.
+func ValidateTemplateName(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.HasChange(templateNameKey) || !diff.NewValueKnown(templateNameKey) {
+		return nil
+	}
+
+	templateName, _ := diff.Get(templateNameKey).(string)
+	if templateName == "" {
+		return nil
+	}
+
+	config, ok := m.(authctx.TanzuContext)
+	if !ok || config.TMCConnection == nil {
+		return nil
+	}
+
+	resp, err := config.TMCConnection.CustomPolicyTemplateResourceService.CustomPolicyTemplateResourceServiceGet(
+		&custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName{Name: templateName},
+	)
+	if err != nil || resp == nil || resp.Template == nil || resp.Template.Spec == nil {
+		log.Printf("[WARN] Unable to get the custom policy template %s to check its kind: %v", templateName, err)
+		return nil
+	}
+
+	kind, err := custompolicytemplate.ConstraintTemplateKind(resp.Template.Spec.Object)
+	if err != nil {
+		log.Printf("[WARN] Unable to get the kind of the custom policy template %s: %v", templateName, err)
+		return nil
+	}
+
+	if templateName != strings.ToLower(kind) {
+		return errors.Errorf("%s %s must be the lowercase form of the kind %s of the ConstraintTemplate, i.e. %s",
+			reciperesource.TemplateNameKey, templateName, kind, strings.ToLower(kind))
+	}
+
+	return nil
+}
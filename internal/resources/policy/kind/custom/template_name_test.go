@@ -0,0 +1,147 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policykindcustom
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client"
+	custompolicytemplatemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/custompolicytemplate"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	reciperesource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom/recipe"
+)
+
+const testConstraintTemplate = `apiVersion: templates.gatekeeper.sh/v1beta1
+kind: ConstraintTemplate
+metadata:
+  name: k8srequiredreplicas
+spec:
+  crd:
+    spec:
+      names:
+        kind: K8sRequiredReplicas
+  targets:
+  - target: admission.k8s.gatekeeper.sh
+    rego: package k8srequiredreplicas
+`
+
+type mockCustomPolicyTemplateClient struct {
+	getResp *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData
+	getErr  error
+}
+
+func (m *mockCustomPolicyTemplateClient) CustomPolicyTemplateResourceServiceCreate(_ *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData) (*custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCustomPolicyTemplateClient) CustomPolicyTemplateResourceServiceDelete(_ *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName) error {
+	return errors.New("not implemented")
+}
+
+func (m *mockCustomPolicyTemplateClient) CustomPolicyTemplateResourceServiceGet(_ *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateFullName) (*custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData, error) {
+	return m.getResp, m.getErr
+}
+
+func (m *mockCustomPolicyTemplateClient) CustomPolicyTemplateResourceServiceUpdate(_ *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData) (*custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestValidateTemplateName(t *testing.T) {
+	t.Parallel()
+
+	template := &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData{
+		Template: &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplate{
+			Spec: &custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateSpec{Object: testConstraintTemplate},
+		},
+	}
+
+	cases := []struct {
+		description  string
+		templateName string
+		getResp      *custompolicytemplatemodel.VmwareTanzuManageV1alpha1PolicyTemplateData
+		getErr       error
+		expectedErr  string
+	}{
+		{
+			description:  "template name matching the kind of the ConstraintTemplate",
+			templateName: "k8srequiredreplicas",
+			getResp:      template,
+		},
+		{
+			description:  "template name not matching the kind of the ConstraintTemplate",
+			templateName: "k8sreplicas",
+			getResp:      template,
+			expectedErr:  "template_name k8sreplicas must be the lowercase form of the kind K8sRequiredReplicas of the ConstraintTemplate, i.e. k8srequiredreplicas",
+		},
+		{
+			description:  "template name which is not a lowercase kind",
+			templateName: "required-replicas",
+			expectedErr:  "must be the lowercase form of the kind of the ConstraintTemplate, e.g. k8srequiredlabels",
+		},
+		{
+			description:  "template not created yet",
+			templateName: "k8srequiredreplicas",
+			getErr:       errors.New("not found"),
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			resource := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					policy.SpecKey: SpecSchema,
+				},
+				CustomizeDiff: ValidateTemplateName,
+			}
+
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				policy.SpecKey: []interface{}{map[string]interface{}{
+					policy.InputKey: []interface{}{map[string]interface{}{
+						reciperesource.CustomKey: []interface{}{map[string]interface{}{
+							reciperesource.TemplateNameKey: test.templateName,
+							reciperesource.TargetKubernetesResourcesKey: []interface{}{map[string]interface{}{
+								reciperesource.APIGroupsKey: []interface{}{"apps"},
+								reciperesource.KindsKey:     []interface{}{"Deployment"},
+							}},
+						}},
+					}},
+				}},
+			})
+
+			meta := authctx.TanzuContext{
+				TMCConnection: &client.TanzuMissionControl{
+					CustomPolicyTemplateResourceService: &mockCustomPolicyTemplateClient{getResp: test.getResp, getErr: test.getErr},
+				},
+			}
+
+			diags := resource.Validate(config)
+			if diags.HasError() {
+				require.NotEmpty(t, test.expectedErr)
+				require.Contains(t, diags[0].Summary, test.expectedErr)
+
+				return
+			}
+
+			_, err := resource.Diff(context.Background(), nil, config, meta)
+
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
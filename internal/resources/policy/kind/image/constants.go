@@ -12,7 +12,7 @@ import (
 
 const (
 	ResourceName = "tanzu-mission-control_image_policy"
-	typePolicy   = "image-policy" // Type of Policy as defined in API
+	TypePolicy   = "image-policy" // Type of Policy as defined in API
 )
 
 // Allowed input recipes.
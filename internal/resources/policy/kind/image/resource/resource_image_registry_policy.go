@@ -22,7 +22,10 @@ func ResourceImagePolicy() *schema.Resource {
 		ReadContext:   schema.ReadContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindimage.ResourceName), policyoperations.WithOperationType(policyoperations.Read))),
 		UpdateContext: schema.UpdateContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindimage.ResourceName), policyoperations.WithOperationType(policyoperations.Update))),
 		DeleteContext: schema.DeleteContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindimage.ResourceName), policyoperations.WithOperationType(policyoperations.Delete))),
-		Schema:        imagePolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: policyoperations.ResourcePolicyImporter(policykindimage.ResourceName),
+		},
+		Schema: imagePolicySchema,
 		CustomizeDiff: customdiff.All(
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindimage.ResourceName])),
 			policykindimage.ValidateInput,
@@ -0,0 +1,24 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policykindmutation
+
+import (
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	reciperesource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation/recipe"
+)
+
+const (
+	ResourceName = "tanzu-mission-control_mutation_policy"
+	TypePolicy   = "mutation-policy" // Type of Policy as defined in API
+)
+
+// Allowed input recipes.
+const (
+	UnknownRecipe     Recipe = policy.UnknownRecipe
+	LabelRecipe       Recipe = reciperesource.LabelKey
+	AnnotationRecipe  Recipe = reciperesource.AnnotationKey
+	PodSecurityRecipe Recipe = reciperesource.PodSecurityKey
+)
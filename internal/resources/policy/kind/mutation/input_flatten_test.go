@@ -0,0 +1,80 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policykindmutation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+	policyrecipemutationcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation/common"
+	reciperesource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation/recipe"
+)
+
+func TestFlattenInput(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *inputRecipe
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil input",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete input",
+			input: &inputRecipe{
+				recipe: LabelRecipe,
+				inputLabel: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label{
+					Label: &policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1KeyValue{
+						Key:   "team",
+						Value: "platform",
+					},
+					Scope: policyrecipemutationcommonmodel.NewVmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope(policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ScopeNamespaced),
+					TargetKubernetesResources: []*policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1TargetKubernetesResources{
+						{
+							APIGroups: []string{"apps"},
+							Kinds:     []string{"Deployment"},
+						},
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					reciperesource.LabelKey: []interface{}{
+						map[string]interface{}{
+							reciperesource.LabelKey: []interface{}{
+								map[string]interface{}{
+									reciperesource.KeyKey:   "team",
+									reciperesource.ValueKey: "platform",
+								},
+							},
+							reciperesource.ScopeKey: "Namespaced",
+							reciperesource.TargetKubernetesResourcesKey: []interface{}{
+								map[string]interface{}{
+									reciperesource.APIGroupsKey: []string{"apps"},
+									reciperesource.KindsKey:     []string{"Deployment"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenInput(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policykindmutation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	reciperesource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation/recipe"
+)
+
+var (
+	inputSchema = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Input for the mutation policy, having one of the valid recipes: label, annotation or pod_security.",
+		Required:    true,
+		MaxItems:    1,
+		MinItems:    1,
+		ForceNew:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				reciperesource.LabelKey:       reciperesource.Label,
+				reciperesource.AnnotationKey:  reciperesource.Annotation,
+				reciperesource.PodSecurityKey: reciperesource.PodSecurity,
+			},
+		},
+	}
+	RecipesAllowed = [...]string{reciperesource.LabelKey, reciperesource.AnnotationKey, reciperesource.PodSecurityKey}
+)
+
+type (
+	Recipe string
+	// InputRecipe is a struct for all types of mutation policy inputs.
+	inputRecipe struct {
+		recipe           Recipe
+		inputLabel       *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label
+		inputAnnotation  *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Annotation
+		inputPodSecurity *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity
+	}
+)
+
+func constructInput(data []interface{}) (inputRecipeData *inputRecipe) {
+	if len(data) == 0 || data[0] == nil {
+		return inputRecipeData
+	}
+
+	inputData, _ := data[0].(map[string]interface{})
+
+	if v, ok := inputData[reciperesource.LabelKey]; ok {
+		if v1, ok := v.([]interface{}); ok && len(v1) != 0 {
+			inputRecipeData = &inputRecipe{
+				recipe:     LabelRecipe,
+				inputLabel: reciperesource.ConstructLabel(v1),
+			}
+		}
+	}
+
+	if v, ok := inputData[reciperesource.AnnotationKey]; ok {
+		if v1, ok := v.([]interface{}); ok && len(v1) != 0 {
+			inputRecipeData = &inputRecipe{
+				recipe:          AnnotationRecipe,
+				inputAnnotation: reciperesource.ConstructAnnotation(v1),
+			}
+		}
+	}
+
+	if v, ok := inputData[reciperesource.PodSecurityKey]; ok {
+		if v1, ok := v.([]interface{}); ok && len(v1) != 0 {
+			inputRecipeData = &inputRecipe{
+				recipe:           PodSecurityRecipe,
+				inputPodSecurity: reciperesource.ConstructPodSecurity(v1),
+			}
+		}
+	}
+
+	return inputRecipeData
+}
+
+func flattenInput(inputRecipeData *inputRecipe) (data []interface{}) {
+	if inputRecipeData == nil {
+		return data
+	}
+
+	flattenInputData := make(map[string]interface{})
+
+	switch inputRecipeData.recipe {
+	case LabelRecipe:
+		flattenInputData[reciperesource.LabelKey] = reciperesource.FlattenLabel(inputRecipeData.inputLabel)
+	case AnnotationRecipe:
+		flattenInputData[reciperesource.AnnotationKey] = reciperesource.FlattenAnnotation(inputRecipeData.inputAnnotation)
+	case PodSecurityRecipe:
+		flattenInputData[reciperesource.PodSecurityKey] = reciperesource.FlattenPodSecurity(inputRecipeData.inputPodSecurity)
+	case UnknownRecipe:
+		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
+	}
+
+	return []interface{}{flattenInputData}
+}
+
+func ValidateInput(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	value, ok := diff.GetOk(policy.SpecKey)
+	if !ok {
+		return fmt.Errorf("spec: %v is not valid: minimum one valid spec block is required", value)
+	}
+
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return fmt.Errorf("spec data: %v is not valid: minimum one valid spec block is required among: %v", data, strings.Join(RecipesAllowed[:], `, `))
+	}
+
+	specData := data[0].(map[string]interface{})
+
+	v, ok := specData[policy.InputKey]
+	if !ok {
+		return fmt.Errorf("input: %v is not valid: minimum one valid input block is required", v)
+	}
+
+	v1, ok := v.([]interface{})
+	if !ok {
+		return fmt.Errorf("type of input block data: %v is not valid", v1)
+	}
+
+	if len(v1) == 0 || v1[0] == nil {
+		return fmt.Errorf("input data: %v is not valid: minimum one valid input block is required", v1)
+	}
+
+	inputData, _ := v1[0].(map[string]interface{})
+	recipesFound := make([]string, 0)
+
+	if v, ok := inputData[reciperesource.LabelKey]; ok {
+		if v1, ok := v.([]interface{}); ok && len(v1) != 0 {
+			recipesFound = append(recipesFound, reciperesource.LabelKey)
+		}
+	}
+
+	if v, ok := inputData[reciperesource.AnnotationKey]; ok {
+		if v1, ok := v.([]interface{}); ok && len(v1) != 0 {
+			recipesFound = append(recipesFound, reciperesource.AnnotationKey)
+		}
+	}
+
+	if v, ok := inputData[reciperesource.PodSecurityKey]; ok {
+		if v1, ok := v.([]interface{}); ok && len(v1) != 0 {
+			recipesFound = append(recipesFound, reciperesource.PodSecurityKey)
+		}
+	}
+
+	if len(recipesFound) == 0 {
+		return fmt.Errorf("no valid input recipe block found: minimum one valid input recipe block is required among: %v", strings.Join(RecipesAllowed[:], `, `))
+	} else if len(recipesFound) > 1 {
+		return fmt.Errorf("found input recipes: %v are not valid: maximum one valid input recipe block is allowed", strings.Join(recipesFound, `, `))
+	}
+
+	return nil
+}
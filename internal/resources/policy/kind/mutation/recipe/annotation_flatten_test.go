@@ -0,0 +1,103 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+	policyrecipemutationcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation/common"
+)
+
+func TestFlattenAnnotation(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Annotation
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil mutation policy annotation recipe",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete mutation policy annotation recipe",
+			input: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Annotation{
+				Annotation: &policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1KeyValue{
+					Key:   "owner",
+					Value: "platform-team",
+				},
+				Scope: policyrecipemutationcommonmodel.NewVmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope(policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ScopeAll),
+				TargetKubernetesResources: []*policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1TargetKubernetesResources{
+					{
+						APIGroups: []string{""},
+						Kinds:     []string{"Pod", "Service"},
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					AnnotationKey: []interface{}{
+						map[string]interface{}{
+							KeyKey:   "owner",
+							ValueKey: "platform-team",
+						},
+					},
+					ScopeKey: "*",
+					TargetKubernetesResourcesKey: []interface{}{
+						map[string]interface{}{
+							APIGroupsKey: []string{""},
+							KindsKey:     []string{"Pod", "Service"},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "scenario with mutation policy annotation recipe without scope",
+			input: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Annotation{
+				Annotation: &policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1KeyValue{
+					Key: "owner",
+				},
+				TargetKubernetesResources: []*policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1TargetKubernetesResources{
+					{
+						APIGroups: []string{"apps"},
+						Kinds:     []string{"Deployment"},
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					AnnotationKey: []interface{}{
+						map[string]interface{}{
+							KeyKey:   "owner",
+							ValueKey: "",
+						},
+					},
+					ScopeKey: "*",
+					TargetKubernetesResourcesKey: []interface{}{
+						map[string]interface{}{
+							APIGroupsKey: []string{"apps"},
+							KindsKey:     []string{"Deployment"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenAnnotation(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
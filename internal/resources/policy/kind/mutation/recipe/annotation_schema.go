@@ -0,0 +1,100 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+	policyrecipemutationcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation/common"
+)
+
+var Annotation = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The input schema for mutation policy annotation recipe version v1",
+	Optional:    true,
+	ForceNew:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			TargetKubernetesResourcesKey: targetKubernetesResources,
+			ScopeKey:                     targetKubernetesResourcesScopeSchema,
+			AnnotationKey: {
+				Type:        schema.TypeList,
+				Description: "Annotation to set on the target resources.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						KeyKey: {
+							Type:         schema.TypeString,
+							Description:  "Key of the annotation.",
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						ValueKey: {
+							Type:        schema.TypeString,
+							Description: "Value of the annotation.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func ConstructAnnotation(data []interface{}) (annotationRecipe *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Annotation) {
+	if len(data) == 0 || data[0] == nil {
+		return annotationRecipe
+	}
+
+	annotationData, _ := data[0].(map[string]interface{})
+
+	annotationRecipe = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Annotation{
+		TargetKubernetesResources: make([]*policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1TargetKubernetesResources, 0),
+	}
+
+	if v, ok := annotationData[TargetKubernetesResourcesKey]; ok {
+		vs, _ := v.([]interface{})
+		for _, raw := range vs {
+			annotationRecipe.TargetKubernetesResources = append(annotationRecipe.TargetKubernetesResources, expandTargetKubernetesResources(raw))
+		}
+	}
+
+	if v, ok := annotationData[ScopeKey]; ok {
+		annotationRecipe.Scope = expandTargetKubernetesResourcesScope(v)
+	}
+
+	if v, ok := annotationData[AnnotationKey]; ok {
+		if v1, ok := v.([]interface{}); ok {
+			annotationRecipe.Annotation = expandKeyValue(v1)
+		}
+	}
+
+	return annotationRecipe
+}
+
+func FlattenAnnotation(annotationRecipe *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Annotation) (data []interface{}) {
+	if annotationRecipe == nil {
+		return data
+	}
+
+	flattenAnnotation := make(map[string]interface{})
+
+	tkrs := make([]interface{}, 0)
+
+	for _, tkr := range annotationRecipe.TargetKubernetesResources {
+		tkrs = append(tkrs, flattenTargetKubernetesResources(tkr))
+	}
+
+	flattenAnnotation[TargetKubernetesResourcesKey] = tkrs
+	flattenAnnotation[ScopeKey] = flattenTargetKubernetesResourcesScope(annotationRecipe.Scope)
+	flattenAnnotation[AnnotationKey] = flattenKeyValue(annotationRecipe.Annotation)
+
+	return []interface{}{flattenAnnotation}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	policyrecipemutationcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation/common"
+)
+
+var targetKubernetesResources = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "A list of kubernetes api resources on which the policy will be enforced, identified using apiGroups and kinds.",
+	Required:    true,
+	MinItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			APIGroupsKey: {
+				Type:        schema.TypeList,
+				Description: "APIGroup is a group containing the resource type, use an empty string for the core group.",
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			KindsKey: {
+				Type:        schema.TypeList,
+				Description: "Kind is the name of the object schema (resource type).",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.All(
+						validation.StringIsNotEmpty,
+						validation.StringIsNotWhiteSpace,
+					),
+				},
+			},
+		},
+	},
+}
+
+var targetKubernetesResourcesScopeSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "Scope of the target resources, one of *, Cluster or Namespaced.",
+	Optional:    true,
+	Default:     targetKubernetesResourcesScope,
+	ValidateFunc: validation.StringInSlice([]string{
+		string(policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ScopeAll),
+		string(policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ScopeCluster),
+		string(policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ScopeNamespaced),
+	}, false),
+}
+
+func expandTargetKubernetesResources(data interface{}) (kubernetesResources *policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1TargetKubernetesResources) {
+	if data == nil {
+		return kubernetesResources
+	}
+
+	kubernetesResourcesData, ok := data.(map[string]interface{})
+	if !ok {
+		return kubernetesResources
+	}
+
+	kubernetesResources = &policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1TargetKubernetesResources{}
+
+	if v, ok := kubernetesResourcesData[APIGroupsKey]; ok {
+		vs, _ := v.([]interface{})
+		for _, raw := range vs {
+			var value string
+			if raw != nil {
+				value = raw.(string)
+			}
+
+			kubernetesResources.APIGroups = append(kubernetesResources.APIGroups, value)
+		}
+	}
+
+	if v, ok := kubernetesResourcesData[KindsKey]; ok {
+		vs, _ := v.([]interface{})
+		for _, raw := range vs {
+			kubernetesResources.Kinds = append(kubernetesResources.Kinds, raw.(string))
+		}
+	}
+
+	return kubernetesResources
+}
+
+func flattenTargetKubernetesResources(kubernetesResources *policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1TargetKubernetesResources) (data interface{}) {
+	if kubernetesResources == nil {
+		return data
+	}
+
+	flattenTargetKubernetesResources := make(map[string]interface{})
+
+	flattenTargetKubernetesResources[APIGroupsKey] = kubernetesResources.APIGroups
+	flattenTargetKubernetesResources[KindsKey] = kubernetesResources.Kinds
+
+	return flattenTargetKubernetesResources
+}
+
+func expandKeyValue(data []interface{}) (keyValue *policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1KeyValue) {
+	if len(data) == 0 || data[0] == nil {
+		return keyValue
+	}
+
+	keyValueData, _ := data[0].(map[string]interface{})
+
+	keyValue = &policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1KeyValue{}
+
+	if v, ok := keyValueData[KeyKey]; ok {
+		keyValue.Key, _ = v.(string)
+	}
+
+	if v, ok := keyValueData[ValueKey]; ok {
+		keyValue.Value, _ = v.(string)
+	}
+
+	return keyValue
+}
+
+func flattenKeyValue(keyValue *policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1KeyValue) (data []interface{}) {
+	if keyValue == nil {
+		return data
+	}
+
+	flattenKeyValue := make(map[string]interface{})
+
+	flattenKeyValue[KeyKey] = keyValue.Key
+	flattenKeyValue[ValueKey] = keyValue.Value
+
+	return []interface{}{flattenKeyValue}
+}
+
+func expandTargetKubernetesResourcesScope(data interface{}) *policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope {
+	scope, _ := data.(string)
+	if scope == "" {
+		scope = targetKubernetesResourcesScope
+	}
+
+	return policyrecipemutationcommonmodel.NewVmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope(policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope(scope))
+}
+
+func flattenTargetKubernetesResourcesScope(scope *policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope) string {
+	if scope == nil {
+		return targetKubernetesResourcesScope
+	}
+
+	return string(*scope)
+}
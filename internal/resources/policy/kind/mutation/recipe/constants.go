@@ -0,0 +1,37 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+const (
+	LabelKey                       = "label"
+	AnnotationKey                  = "annotation"
+	PodSecurityKey                 = "pod_security"
+	TargetKubernetesResourcesKey   = "target_kubernetes_resources"
+	APIGroupsKey                   = "api_groups"
+	KindsKey                       = "kinds"
+	ScopeKey                       = "scope"
+	KeyKey                         = "key"
+	ValueKey                       = "value"
+	ValuesKey                      = "values"
+	ConditionKey                   = "condition"
+	OperationKey                   = "operation"
+	AllowPrivilegeEscalationKey    = "allow_privilege_escalation"
+	CapabilitiesAddKey             = "capabilities_add"
+	CapabilitiesDropKey            = "capabilities_drop"
+	FsGroupKey                     = "fs_group"
+	PrivilegedKey                  = "privileged"
+	ReadOnlyRootFilesystemKey      = "read_only_root_filesystem"
+	RunAsGroupKey                  = "run_as_group"
+	RunAsNonRootKey                = "run_as_non_root"
+	RunAsUserKey                   = "run_as_user"
+	SeLinuxOptionsKey              = "se_linux_options"
+	SupplementalGroupsKey          = "supplemental_groups"
+	LevelKey                       = "level"
+	RoleKey                        = "role"
+	TypeKey                        = "type"
+	UserKey                        = "user"
+	targetKubernetesResourcesScope = "*"
+)
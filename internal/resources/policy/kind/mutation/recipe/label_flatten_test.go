@@ -0,0 +1,72 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+	policyrecipemutationcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation/common"
+)
+
+func TestFlattenLabel(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil mutation policy label recipe",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete mutation policy label recipe",
+			input: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label{
+				Label: &policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1KeyValue{
+					Key:   "team",
+					Value: "platform",
+				},
+				Scope: policyrecipemutationcommonmodel.NewVmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Scope(policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1ScopeCluster),
+				TargetKubernetesResources: []*policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1TargetKubernetesResources{
+					{
+						APIGroups: []string{""},
+						Kinds:     []string{"Namespace"},
+					},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					LabelKey: []interface{}{
+						map[string]interface{}{
+							KeyKey:   "team",
+							ValueKey: "platform",
+						},
+					},
+					ScopeKey: "Cluster",
+					TargetKubernetesResourcesKey: []interface{}{
+						map[string]interface{}{
+							APIGroupsKey: []string{""},
+							KindsKey:     []string{"Namespace"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenLabel(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+	policyrecipemutationcommonmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation/common"
+)
+
+var Label = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The input schema for mutation policy label recipe version v1",
+	Optional:    true,
+	ForceNew:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			TargetKubernetesResourcesKey: targetKubernetesResources,
+			ScopeKey:                     targetKubernetesResourcesScopeSchema,
+			LabelKey: {
+				Type:        schema.TypeList,
+				Description: "Label to set on the target resources.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						KeyKey: {
+							Type:         schema.TypeString,
+							Description:  "Key of the label.",
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						ValueKey: {
+							Type:        schema.TypeString,
+							Description: "Value of the label.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func ConstructLabel(data []interface{}) (labelRecipe *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label) {
+	if len(data) == 0 || data[0] == nil {
+		return labelRecipe
+	}
+
+	labelData, _ := data[0].(map[string]interface{})
+
+	labelRecipe = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label{
+		TargetKubernetesResources: make([]*policyrecipemutationcommonmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1TargetKubernetesResources, 0),
+	}
+
+	if v, ok := labelData[TargetKubernetesResourcesKey]; ok {
+		vs, _ := v.([]interface{})
+		for _, raw := range vs {
+			labelRecipe.TargetKubernetesResources = append(labelRecipe.TargetKubernetesResources, expandTargetKubernetesResources(raw))
+		}
+	}
+
+	if v, ok := labelData[ScopeKey]; ok {
+		labelRecipe.Scope = expandTargetKubernetesResourcesScope(v)
+	}
+
+	if v, ok := labelData[LabelKey]; ok {
+		if v1, ok := v.([]interface{}); ok {
+			labelRecipe.Label = expandKeyValue(v1)
+		}
+	}
+
+	return labelRecipe
+}
+
+func FlattenLabel(labelRecipe *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label) (data []interface{}) {
+	if labelRecipe == nil {
+		return data
+	}
+
+	flattenLabel := make(map[string]interface{})
+
+	tkrs := make([]interface{}, 0)
+
+	for _, tkr := range labelRecipe.TargetKubernetesResources {
+		tkrs = append(tkrs, flattenTargetKubernetesResources(tkr))
+	}
+
+	flattenLabel[TargetKubernetesResourcesKey] = tkrs
+	flattenLabel[ScopeKey] = flattenTargetKubernetesResourcesScope(labelRecipe.Scope)
+	flattenLabel[LabelKey] = flattenKeyValue(labelRecipe.Label)
+
+	return []interface{}{flattenLabel}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+)
+
+func TestFlattenPodSecurity(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil mutation policy pod security recipe",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete mutation policy pod security recipe",
+			input: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity{
+				AllowPrivilegeEscalation: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition{
+					Condition: policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionAlways,
+					Value:     false,
+				},
+				CapabilitiesAdd: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCapabilities{
+					Operation: policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperationMerge,
+					Values:    []string{"NET_BIND_SERVICE"},
+				},
+				CapabilitiesDrop: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCapabilities{
+					Operation: policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperationOverride,
+					Values:    []string{"ALL"},
+				},
+				FsGroup: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityIntCondition{
+					Condition: policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionIfFieldDoesNotExist,
+					Value:     2000,
+				},
+				Privileged: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition{
+					Condition: policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionIfFieldExists,
+					Value:     false,
+				},
+				ReadOnlyRootFilesystem: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition{
+					Condition: policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionAlways,
+					Value:     true,
+				},
+				RunAsGroup: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityIntCondition{
+					Condition: policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionIfFieldDoesNotExist,
+					Value:     3000,
+				},
+				RunAsNonRoot: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition{
+					Condition: policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionAlways,
+					Value:     true,
+				},
+				RunAsUser: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityIntCondition{
+					Condition: policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionIfFieldDoesNotExist,
+					Value:     1000,
+				},
+				SeLinuxOptions: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySELinuxOptions{
+					Condition: policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionAlways,
+					Level:     "s0:c123,c456",
+					Type:      "container_t",
+				},
+				SupplementalGroups: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySupplementalGroups{
+					Condition: policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionIfFieldDoesNotExist,
+					Values:    []int64{4000, 5000},
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					AllowPrivilegeEscalationKey: []interface{}{
+						map[string]interface{}{
+							ConditionKey: "Always",
+							ValueKey:     false,
+						},
+					},
+					CapabilitiesAddKey: []interface{}{
+						map[string]interface{}{
+							OperationKey: "merge",
+							ValuesKey:    []string{"NET_BIND_SERVICE"},
+						},
+					},
+					CapabilitiesDropKey: []interface{}{
+						map[string]interface{}{
+							OperationKey: "override",
+							ValuesKey:    []string{"ALL"},
+						},
+					},
+					FsGroupKey: []interface{}{
+						map[string]interface{}{
+							ConditionKey: "IfFieldDoesNotExist",
+							ValueKey:     2000,
+						},
+					},
+					PrivilegedKey: []interface{}{
+						map[string]interface{}{
+							ConditionKey: "IfFieldExists",
+							ValueKey:     false,
+						},
+					},
+					ReadOnlyRootFilesystemKey: []interface{}{
+						map[string]interface{}{
+							ConditionKey: "Always",
+							ValueKey:     true,
+						},
+					},
+					RunAsGroupKey: []interface{}{
+						map[string]interface{}{
+							ConditionKey: "IfFieldDoesNotExist",
+							ValueKey:     3000,
+						},
+					},
+					RunAsNonRootKey: []interface{}{
+						map[string]interface{}{
+							ConditionKey: "Always",
+							ValueKey:     true,
+						},
+					},
+					RunAsUserKey: []interface{}{
+						map[string]interface{}{
+							ConditionKey: "IfFieldDoesNotExist",
+							ValueKey:     1000,
+						},
+					},
+					SeLinuxOptionsKey: []interface{}{
+						map[string]interface{}{
+							ConditionKey: "Always",
+							LevelKey:     "s0:c123,c456",
+							RoleKey:      "",
+							TypeKey:      "container_t",
+							UserKey:      "",
+						},
+					},
+					SupplementalGroupsKey: []interface{}{
+						map[string]interface{}{
+							ConditionKey: "IfFieldDoesNotExist",
+							ValuesKey:    []interface{}{4000, 5000},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenPodSecurity(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
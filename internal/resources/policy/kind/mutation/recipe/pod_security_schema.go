@@ -0,0 +1,423 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package recipe
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+)
+
+var PodSecurity = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The input schema for mutation policy pod security recipe version v1",
+	Optional:    true,
+	ForceNew:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			AllowPrivilegeEscalationKey: booleanConditionSchema("Allow privilege escalation"),
+			CapabilitiesAddKey:          capabilitiesSchema("Linux capabilities to add"),
+			CapabilitiesDropKey:         capabilitiesSchema("Linux capabilities to drop"),
+			FsGroupKey:                  intConditionSchema("Filesystem group id"),
+			PrivilegedKey:               booleanConditionSchema("Privileged containers"),
+			ReadOnlyRootFilesystemKey:   booleanConditionSchema("Read only root filesystem"),
+			RunAsGroupKey:               intConditionSchema("Group id to run the containers as"),
+			RunAsNonRootKey:             booleanConditionSchema("Run the containers as non root"),
+			RunAsUserKey:                intConditionSchema("User id to run the containers as"),
+			SeLinuxOptionsKey: {
+				Type:        schema.TypeList,
+				Description: "SELinux options to set on the pods",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						ConditionKey: conditionSchema,
+						LevelKey: {
+							Type:        schema.TypeString,
+							Description: "SELinux level",
+							Optional:    true,
+						},
+						RoleKey: {
+							Type:        schema.TypeString,
+							Description: "SELinux role",
+							Optional:    true,
+						},
+						TypeKey: {
+							Type:        schema.TypeString,
+							Description: "SELinux type",
+							Optional:    true,
+						},
+						UserKey: {
+							Type:        schema.TypeString,
+							Description: "SELinux user",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			SupplementalGroupsKey: {
+				Type:        schema.TypeList,
+				Description: "Supplemental group ids to set on the pods",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						ConditionKey: conditionSchema,
+						ValuesKey: {
+							Type:        schema.TypeList,
+							Description: "List of group ids",
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var conditionSchema = &schema.Schema{
+	Type:        schema.TypeString,
+	Description: "Condition to set the field, one of Always, IfFieldDoesNotExist or IfFieldExists",
+	Required:    true,
+	ValidateFunc: validation.StringInSlice([]string{
+		string(policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionAlways),
+		string(policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionIfFieldDoesNotExist),
+		string(policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionIfFieldExists),
+	}, false),
+}
+
+func booleanConditionSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				ConditionKey: conditionSchema,
+				ValueKey: {
+					Type:        schema.TypeBool,
+					Description: "Value of the field",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+func intConditionSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				ConditionKey: conditionSchema,
+				ValueKey: {
+					Type:         schema.TypeInt,
+					Description:  "Value of the field",
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func capabilitiesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				OperationKey: {
+					Type:        schema.TypeString,
+					Description: "Operation to apply on the capabilities of the containers, one of merge, override or prune",
+					Required:    true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperationMerge),
+						string(policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperationOverride),
+						string(policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperationPrune),
+					}, false),
+				},
+				ValuesKey: {
+					Type:        schema.TypeList,
+					Description: "List of capabilities",
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func ConstructPodSecurity(data []interface{}) (podSecurity *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity) {
+	if len(data) == 0 || data[0] == nil {
+		return podSecurity
+	}
+
+	podSecurityData, _ := data[0].(map[string]interface{})
+
+	podSecurity = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity{}
+
+	if v, ok := podSecurityData[AllowPrivilegeEscalationKey]; ok {
+		podSecurity.AllowPrivilegeEscalation = expandBooleanCondition(v)
+	}
+
+	if v, ok := podSecurityData[CapabilitiesAddKey]; ok {
+		podSecurity.CapabilitiesAdd = expandCapabilities(v)
+	}
+
+	if v, ok := podSecurityData[CapabilitiesDropKey]; ok {
+		podSecurity.CapabilitiesDrop = expandCapabilities(v)
+	}
+
+	if v, ok := podSecurityData[FsGroupKey]; ok {
+		podSecurity.FsGroup = expandIntCondition(v)
+	}
+
+	if v, ok := podSecurityData[PrivilegedKey]; ok {
+		podSecurity.Privileged = expandBooleanCondition(v)
+	}
+
+	if v, ok := podSecurityData[ReadOnlyRootFilesystemKey]; ok {
+		podSecurity.ReadOnlyRootFilesystem = expandBooleanCondition(v)
+	}
+
+	if v, ok := podSecurityData[RunAsGroupKey]; ok {
+		podSecurity.RunAsGroup = expandIntCondition(v)
+	}
+
+	if v, ok := podSecurityData[RunAsNonRootKey]; ok {
+		podSecurity.RunAsNonRoot = expandBooleanCondition(v)
+	}
+
+	if v, ok := podSecurityData[RunAsUserKey]; ok {
+		podSecurity.RunAsUser = expandIntCondition(v)
+	}
+
+	if v, ok := podSecurityData[SeLinuxOptionsKey]; ok {
+		podSecurity.SeLinuxOptions = expandSELinuxOptions(v)
+	}
+
+	if v, ok := podSecurityData[SupplementalGroupsKey]; ok {
+		podSecurity.SupplementalGroups = expandSupplementalGroups(v)
+	}
+
+	return podSecurity
+}
+
+func FlattenPodSecurity(podSecurity *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity) (data []interface{}) {
+	if podSecurity == nil {
+		return data
+	}
+
+	flattenPodSecurity := make(map[string]interface{})
+
+	flattenPodSecurity[AllowPrivilegeEscalationKey] = flattenBooleanCondition(podSecurity.AllowPrivilegeEscalation)
+	flattenPodSecurity[CapabilitiesAddKey] = flattenCapabilities(podSecurity.CapabilitiesAdd)
+	flattenPodSecurity[CapabilitiesDropKey] = flattenCapabilities(podSecurity.CapabilitiesDrop)
+	flattenPodSecurity[FsGroupKey] = flattenIntCondition(podSecurity.FsGroup)
+	flattenPodSecurity[PrivilegedKey] = flattenBooleanCondition(podSecurity.Privileged)
+	flattenPodSecurity[ReadOnlyRootFilesystemKey] = flattenBooleanCondition(podSecurity.ReadOnlyRootFilesystem)
+	flattenPodSecurity[RunAsGroupKey] = flattenIntCondition(podSecurity.RunAsGroup)
+	flattenPodSecurity[RunAsNonRootKey] = flattenBooleanCondition(podSecurity.RunAsNonRoot)
+	flattenPodSecurity[RunAsUserKey] = flattenIntCondition(podSecurity.RunAsUser)
+	flattenPodSecurity[SeLinuxOptionsKey] = flattenSELinuxOptions(podSecurity.SeLinuxOptions)
+	flattenPodSecurity[SupplementalGroupsKey] = flattenSupplementalGroups(podSecurity.SupplementalGroups)
+
+	return []interface{}{flattenPodSecurity}
+}
+
+func expandCondition(data interface{}) policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCondition {
+	condition, _ := data.(string)
+
+	return *policyrecipemutationmodel.NewVmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCondition(policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCondition(condition))
+}
+
+func expandBooleanCondition(data interface{}) (booleanCondition *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition) {
+	v, _ := data.([]interface{})
+	if len(v) == 0 || v[0] == nil {
+		return booleanCondition
+	}
+
+	booleanConditionData, _ := v[0].(map[string]interface{})
+
+	booleanCondition = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition{
+		Condition: expandCondition(booleanConditionData[ConditionKey]),
+	}
+
+	booleanCondition.Value, _ = booleanConditionData[ValueKey].(bool)
+
+	return booleanCondition
+}
+
+func flattenBooleanCondition(booleanCondition *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition) (data []interface{}) {
+	if booleanCondition == nil {
+		return data
+	}
+
+	flattenBooleanCondition := make(map[string]interface{})
+
+	flattenBooleanCondition[ConditionKey] = string(booleanCondition.Condition)
+	flattenBooleanCondition[ValueKey] = booleanCondition.Value
+
+	return []interface{}{flattenBooleanCondition}
+}
+
+func expandIntCondition(data interface{}) (intCondition *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityIntCondition) {
+	v, _ := data.([]interface{})
+	if len(v) == 0 || v[0] == nil {
+		return intCondition
+	}
+
+	intConditionData, _ := v[0].(map[string]interface{})
+
+	intCondition = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityIntCondition{
+		Condition: expandCondition(intConditionData[ConditionKey]),
+	}
+
+	if value, ok := intConditionData[ValueKey].(int); ok {
+		intCondition.Value = int64(value)
+	}
+
+	return intCondition
+}
+
+func flattenIntCondition(intCondition *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityIntCondition) (data []interface{}) {
+	if intCondition == nil {
+		return data
+	}
+
+	flattenIntCondition := make(map[string]interface{})
+
+	flattenIntCondition[ConditionKey] = string(intCondition.Condition)
+	flattenIntCondition[ValueKey] = int(intCondition.Value)
+
+	return []interface{}{flattenIntCondition}
+}
+
+func expandCapabilities(data interface{}) (capabilities *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCapabilities) {
+	v, _ := data.([]interface{})
+	if len(v) == 0 || v[0] == nil {
+		return capabilities
+	}
+
+	capabilitiesData, _ := v[0].(map[string]interface{})
+
+	operation, _ := capabilitiesData[OperationKey].(string)
+
+	capabilities = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCapabilities{
+		Operation: policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityOperation(operation),
+		Values:    make([]string, 0),
+	}
+
+	values, _ := capabilitiesData[ValuesKey].([]interface{})
+	for _, raw := range values {
+		capabilities.Values = append(capabilities.Values, raw.(string))
+	}
+
+	return capabilities
+}
+
+func flattenCapabilities(capabilities *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityCapabilities) (data []interface{}) {
+	if capabilities == nil {
+		return data
+	}
+
+	flattenCapabilities := make(map[string]interface{})
+
+	flattenCapabilities[OperationKey] = string(capabilities.Operation)
+	flattenCapabilities[ValuesKey] = capabilities.Values
+
+	return []interface{}{flattenCapabilities}
+}
+
+func expandSELinuxOptions(data interface{}) (seLinuxOptions *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySELinuxOptions) {
+	v, _ := data.([]interface{})
+	if len(v) == 0 || v[0] == nil {
+		return seLinuxOptions
+	}
+
+	seLinuxOptionsData, _ := v[0].(map[string]interface{})
+
+	seLinuxOptions = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySELinuxOptions{
+		Condition: expandCondition(seLinuxOptionsData[ConditionKey]),
+	}
+
+	seLinuxOptions.Level, _ = seLinuxOptionsData[LevelKey].(string)
+	seLinuxOptions.Role, _ = seLinuxOptionsData[RoleKey].(string)
+	seLinuxOptions.Type, _ = seLinuxOptionsData[TypeKey].(string)
+	seLinuxOptions.User, _ = seLinuxOptionsData[UserKey].(string)
+
+	return seLinuxOptions
+}
+
+func flattenSELinuxOptions(seLinuxOptions *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySELinuxOptions) (data []interface{}) {
+	if seLinuxOptions == nil {
+		return data
+	}
+
+	flattenSELinuxOptions := make(map[string]interface{})
+
+	flattenSELinuxOptions[ConditionKey] = string(seLinuxOptions.Condition)
+	flattenSELinuxOptions[LevelKey] = seLinuxOptions.Level
+	flattenSELinuxOptions[RoleKey] = seLinuxOptions.Role
+	flattenSELinuxOptions[TypeKey] = seLinuxOptions.Type
+	flattenSELinuxOptions[UserKey] = seLinuxOptions.User
+
+	return []interface{}{flattenSELinuxOptions}
+}
+
+func expandSupplementalGroups(data interface{}) (supplementalGroups *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySupplementalGroups) {
+	v, _ := data.([]interface{})
+	if len(v) == 0 || v[0] == nil {
+		return supplementalGroups
+	}
+
+	supplementalGroupsData, _ := v[0].(map[string]interface{})
+
+	supplementalGroups = &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySupplementalGroups{
+		Condition: expandCondition(supplementalGroupsData[ConditionKey]),
+		Values:    make([]int64, 0),
+	}
+
+	values, _ := supplementalGroupsData[ValuesKey].([]interface{})
+	for _, raw := range values {
+		if value, ok := raw.(int); ok {
+			supplementalGroups.Values = append(supplementalGroups.Values, int64(value))
+		}
+	}
+
+	return supplementalGroups
+}
+
+func flattenSupplementalGroups(supplementalGroups *policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecuritySupplementalGroups) (data []interface{}) {
+	if supplementalGroups == nil {
+		return data
+	}
+
+	flattenSupplementalGroups := make(map[string]interface{})
+
+	values := make([]interface{}, 0)
+
+	for _, value := range supplementalGroups.Values {
+		values = append(values, int(value))
+	}
+
+	flattenSupplementalGroups[ConditionKey] = string(supplementalGroups.Condition)
+	flattenSupplementalGroups[ValuesKey] = values
+
+	return []interface{}{flattenSupplementalGroups}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package mutationpolicyresource
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	policykindmutation "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation"
+	policyoperations "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/operations"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
+)
+
+func ResourceMutationPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: schema.CreateContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindmutation.ResourceName), policyoperations.WithOperationType(policyoperations.Create))),
+		ReadContext:   schema.ReadContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindmutation.ResourceName), policyoperations.WithOperationType(policyoperations.Read))),
+		UpdateContext: schema.UpdateContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindmutation.ResourceName), policyoperations.WithOperationType(policyoperations.Update))),
+		DeleteContext: schema.DeleteContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindmutation.ResourceName), policyoperations.WithOperationType(policyoperations.Delete))),
+		Importer: &schema.ResourceImporter{
+			StateContext: policyoperations.ResourcePolicyImporter(policykindmutation.ResourceName),
+		},
+		Schema: mutationPolicySchema,
+		CustomizeDiff: customdiff.All(
+			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindmutation.ResourceName])),
+			policykindmutation.ValidateInput,
+			policy.ValidateSpecLabelSelectorRequirement,
+		),
+	}
+}
+
+var mutationPolicySchema = map[string]*schema.Schema{
+	policy.NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the mutation policy",
+		Required:    true,
+		ForceNew:    true,
+	},
+	scope.ScopeKey: scope.ScopeSchema,
+	common.MetaKey: common.Meta,
+	policy.SpecKey: policykindmutation.SpecSchema,
+}
@@ -0,0 +1,292 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package mutationpolicyresource
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/proxy"
+	policyclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/clustergroup"
+	policyorganizationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/organization"
+	policyworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/workspace"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	policykindmutation "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation"
+	policyoperations "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/operations"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
+	testhelper "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/testing"
+)
+
+const (
+	mutationPolicyResource    = policykindmutation.ResourceName
+	mutationPolicyResourceVar = "test_mutation_policy"
+	mutationPolicyNamePrefix  = "tf-mp-test"
+)
+
+type testAcceptanceConfig struct {
+	Provider                   *schema.Provider
+	MutationPolicyResource     string
+	MutationPolicyResourceVar  string
+	MutationPolicyResourceName string
+	MutationPolicyName         string
+	ScopeHelperResources       *policy.ScopeHelperResources
+}
+
+func testGetDefaultAcceptanceConfig(t *testing.T) *testAcceptanceConfig {
+	return &testAcceptanceConfig{
+		Provider:                   initTestProvider(t),
+		MutationPolicyResource:     mutationPolicyResource,
+		MutationPolicyResourceVar:  mutationPolicyResourceVar,
+		MutationPolicyResourceName: fmt.Sprintf("%s.%s", mutationPolicyResource, mutationPolicyResourceVar),
+		MutationPolicyName:         acctest.RandomWithPrefix(mutationPolicyNamePrefix),
+		ScopeHelperResources:       policy.NewScopeHelperResources(),
+	}
+}
+
+func TestAcceptanceForMutationPolicyResource(t *testing.T) {
+	testConfig := testGetDefaultAcceptanceConfig(t)
+
+	t.Log("start mutation policy resource acceptance tests!")
+
+	for _, recipe := range []policykindmutation.Recipe{policykindmutation.LabelRecipe, policykindmutation.AnnotationRecipe, policykindmutation.PodSecurityRecipe} {
+		resource.Test(t, resource.TestCase{
+			PreCheck:          testhelper.TestPreCheck(t),
+			ProviderFactories: testhelper.GetTestProviderFactories(testConfig.Provider),
+			CheckDestroy:      nil,
+			Steps: []resource.TestStep{
+				{
+					Config: testConfig.getTestMutationPolicyResourceBasicConfigValue(scope.ClusterGroupScope, recipe),
+					Check:  testConfig.checkMutationPolicyResourceAttributes(scope.ClusterGroupScope),
+				},
+				{
+					Config: testConfig.getTestMutationPolicyResourceBasicConfigValue(scope.WorkspaceScope, recipe),
+					Check:  testConfig.checkMutationPolicyResourceAttributes(scope.WorkspaceScope),
+				},
+				{
+					PreConfig: func() {
+						if testConfig.ScopeHelperResources.OrgID == "" {
+							t.Skip("ORG_ID env var is not set for organization scoped mutation policy acceptance test")
+						}
+					},
+					Config: testConfig.getTestMutationPolicyResourceBasicConfigValue(scope.OrganizationScope, recipe),
+					Check:  testConfig.checkMutationPolicyResourceAttributes(scope.OrganizationScope),
+				},
+			},
+		},
+		)
+
+		t.Logf("mutation policy resource acceptance test complete for %s recipe!", recipe)
+	}
+
+	t.Log("all mutation policy resource acceptance tests complete!")
+}
+
+func (testConfig *testAcceptanceConfig) getTestMutationPolicyResourceBasicConfigValue(scope scope.Scope, recipe policykindmutation.Recipe) string {
+	helperBlock, scopeBlock := testConfig.ScopeHelperResources.GetTestPolicyResourceHelperAndScope(scope, policyoperations.ScopeMap[testConfig.MutationPolicyResource], false)
+	inputBlock := testConfig.getTestMutationPolicyResourceInput(recipe)
+
+	return fmt.Sprintf(`
+	%s
+
+	resource "%s" "%s" {
+	 name = "%s"
+
+	 %s
+
+	 spec {
+	   %s
+
+	   namespace_selector {
+	     match_expressions {
+	       key      = "component"
+	       operator = "NotIn"
+	       values   = [
+	         "api-server",
+	         "agent-gateway"
+	       ]
+	     }
+	   }
+	 }
+	}
+	`, helperBlock, testConfig.MutationPolicyResource, testConfig.MutationPolicyResourceVar, testConfig.MutationPolicyName, scopeBlock, inputBlock)
+}
+
+// getTestMutationPolicyResourceInput builds the input block for mutation policy resource based a recipe.
+func (testConfig *testAcceptanceConfig) getTestMutationPolicyResourceInput(recipe policykindmutation.Recipe) string {
+	var inputBlock string
+
+	switch recipe {
+	case policykindmutation.LabelRecipe:
+		inputBlock = `
+    input {
+      label {
+        target_kubernetes_resources {
+          api_groups = ["apps"]
+          kinds      = ["Deployment", "StatefulSet"]
+        }
+        scope = "Namespaced"
+        label {
+          key   = "team"
+          value = "platform"
+        }
+      }
+    }
+`
+	case policykindmutation.AnnotationRecipe:
+		inputBlock = `
+    input {
+      annotation {
+        target_kubernetes_resources {
+          api_groups = [""]
+          kinds      = ["Pod"]
+        }
+        annotation {
+          key   = "owner"
+          value = "platform-team"
+        }
+      }
+    }
+`
+	case policykindmutation.PodSecurityRecipe:
+		inputBlock = `
+    input {
+      pod_security {
+        allow_privilege_escalation {
+          condition = "Always"
+          value     = false
+        }
+        capabilities_drop {
+          operation = "merge"
+          values    = ["NET_RAW"]
+        }
+        run_as_non_root {
+          condition = "IfFieldDoesNotExist"
+          value     = true
+        }
+        supplemental_groups {
+          condition = "IfFieldDoesNotExist"
+          values    = [1000]
+        }
+      }
+    }
+`
+	case policykindmutation.UnknownRecipe:
+		log.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(policykindmutation.RecipesAllowed[:], `, `))
+	}
+
+	return inputBlock
+}
+
+// checkMutationPolicyResourceAttributes checks for mutation policy creation along with meta attributes.
+func (testConfig *testAcceptanceConfig) checkMutationPolicyResourceAttributes(scopeType scope.Scope) resource.TestCheckFunc {
+	var check = []resource.TestCheckFunc{
+		testConfig.verifyMutationPolicyResourceCreation(scopeType),
+		resource.TestCheckResourceAttr(testConfig.MutationPolicyResourceName, "name", testConfig.MutationPolicyName),
+	}
+
+	switch scopeType {
+	case scope.ClusterGroupScope:
+		check = append(check, resource.TestCheckResourceAttr(testConfig.MutationPolicyResourceName, "scope.0.cluster_group.0.cluster_group", testConfig.ScopeHelperResources.ClusterGroup.Name))
+	case scope.WorkspaceScope:
+		check = append(check, resource.TestCheckResourceAttr(testConfig.MutationPolicyResourceName, "scope.0.workspace.0.workspace", testConfig.ScopeHelperResources.Workspace.Name))
+	case scope.OrganizationScope:
+		check = append(check, resource.TestCheckResourceAttr(testConfig.MutationPolicyResourceName, "scope.0.organization.0.organization", testConfig.ScopeHelperResources.OrgID))
+	case scope.ClusterScope, scope.UnknownScope:
+		log.Printf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(policyoperations.ScopeMap[testConfig.MutationPolicyResource], `, `))
+	}
+
+	check = append(check, policy.MetaResourceAttributeCheck(testConfig.MutationPolicyResourceName)...)
+
+	return resource.ComposeTestCheckFunc(check...)
+}
+
+func (testConfig *testAcceptanceConfig) verifyMutationPolicyResourceCreation(scopeType scope.Scope) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if testConfig.Provider == nil {
+			return fmt.Errorf("provider not initialised")
+		}
+
+		rs, ok := s.RootModule().Resources[testConfig.MutationPolicyResourceName]
+		if !ok {
+			return fmt.Errorf("not found resource: %s", testConfig.MutationPolicyResourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("ID not set, resource: %s", testConfig.MutationPolicyResourceName)
+		}
+
+		config := authctx.TanzuContext{
+			ServerEndpoint:   os.Getenv(authctx.ServerEndpointEnvVar),
+			Token:            os.Getenv(authctx.VMWCloudAPITokenEnvVar),
+			VMWCloudEndPoint: os.Getenv(authctx.VMWCloudEndpointEnvVar),
+			TLSConfig:        &proxy.TLSConfig{},
+		}
+
+		err := config.Setup()
+		if err != nil {
+			return errors.Wrap(err, "unable to set the context")
+		}
+
+		switch scopeType {
+		case scope.ClusterGroupScope:
+			fn := &policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyFullName{
+				ClusterGroupName: testConfig.ScopeHelperResources.ClusterGroup.Name,
+				Name:             testConfig.MutationPolicyName,
+			}
+
+			resp, err := config.TMCConnection.ClusterGroupPolicyResourceService.ManageV1alpha1ClustergroupPolicyResourceServiceGet(fn)
+			if err != nil {
+				return errors.Wrap(err, "cluster group scoped mutation policy resource not found")
+			}
+
+			if resp == nil {
+				return errors.Wrapf(err, "cluster group scoped mutation policy resource is empty, resource: %s", testConfig.MutationPolicyResourceName)
+			}
+		case scope.WorkspaceScope:
+			fn := &policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyFullName{
+				WorkspaceName: testConfig.ScopeHelperResources.Workspace.Name,
+				Name:          testConfig.MutationPolicyName,
+			}
+
+			resp, err := config.TMCConnection.WorkspacePolicyResourceService.ManageV1alpha1WorkspacePolicyResourceServiceGet(fn)
+			if err != nil {
+				return errors.Wrap(err, "workspace scoped mutation policy resource not found")
+			}
+
+			if resp == nil {
+				return errors.Wrapf(err, "workspace scoped mutation policy resource is empty, resource: %s", testConfig.MutationPolicyResourceName)
+			}
+		case scope.OrganizationScope:
+			fn := &policyorganizationmodel.VmwareTanzuManageV1alpha1OrganizationPolicyFullName{
+				OrgID: testConfig.ScopeHelperResources.OrgID,
+				Name:  testConfig.MutationPolicyName,
+			}
+
+			resp, err := config.TMCConnection.OrganizationPolicyResourceService.ManageV1alpha1OrganizationPolicyResourceServiceGet(fn)
+			if err != nil {
+				return errors.Wrap(err, "organization scoped mutation policy resource not found")
+			}
+
+			if resp == nil {
+				return errors.Wrapf(err, "organization scoped mutation policy resource is empty, resource: %s", testConfig.MutationPolicyResourceName)
+			}
+		case scope.ClusterScope, scope.UnknownScope:
+			return errors.Errorf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(policyoperations.ScopeMap[testConfig.MutationPolicyResource], `, `))
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policykindmutation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	reciperesource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation/recipe"
+)
+
+func TestFlattenSpec(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil spec",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete spec",
+			input: &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+				Input: constructPodSecurityRecipeInput(),
+				NamespaceSelector: &policymodel.VmwareTanzuManageV1alpha1CommonPolicyLabelSelector{
+					MatchExpressions: []*policymodel.K8sIoApimachineryPkgApisMetaV1LabelSelectorRequirement{
+						{
+							Key:      "k1",
+							Operator: "In",
+							Values: []string{
+								"v1",
+								"v2",
+							},
+						},
+					},
+				},
+				Recipe: "pod-security",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					policy.InputKey: []interface{}{
+						map[string]interface{}{
+							reciperesource.PodSecurityKey: []interface{}{
+								map[string]interface{}{
+									reciperesource.AllowPrivilegeEscalationKey: []interface{}{
+										map[string]interface{}{
+											reciperesource.ConditionKey: "Always",
+											reciperesource.ValueKey:     false,
+										},
+									},
+									reciperesource.CapabilitiesAddKey:        []interface{}(nil),
+									reciperesource.CapabilitiesDropKey:       []interface{}(nil),
+									reciperesource.FsGroupKey:                []interface{}(nil),
+									reciperesource.PrivilegedKey:             []interface{}(nil),
+									reciperesource.ReadOnlyRootFilesystemKey: []interface{}(nil),
+									reciperesource.RunAsGroupKey:             []interface{}(nil),
+									reciperesource.RunAsNonRootKey:           []interface{}(nil),
+									reciperesource.RunAsUserKey: []interface{}{
+										map[string]interface{}{
+											reciperesource.ConditionKey: "IfFieldDoesNotExist",
+											reciperesource.ValueKey:     1000,
+										},
+									},
+									reciperesource.SeLinuxOptionsKey:     []interface{}(nil),
+									reciperesource.SupplementalGroupsKey: []interface{}(nil),
+								},
+							},
+						},
+					},
+					policy.NamespaceSelectorKey: []interface{}{
+						map[string]interface{}{
+							policy.MatchExpressionsKey: []interface{}{
+								map[string]interface{}{
+									policy.KeyKey:      "k1",
+									policy.OperatorKey: "In",
+									policy.ValuesKey: []string{
+										"v1",
+										"v2",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenSpec(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func constructPodSecurityRecipeInput() (podSecurityRecipeInput map[string]interface{}) {
+	podSecurityInput := policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity{
+		AllowPrivilegeEscalation: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityBooleanCondition{
+			Condition: policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionAlways,
+			Value:     false,
+		},
+		RunAsUser: &policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityIntCondition{
+			Condition: policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurityConditionIfFieldDoesNotExist,
+			Value:     1000,
+		},
+	}
+
+	binary, err := podSecurityInput.MarshalBinary()
+	if err != nil {
+		return nil
+	}
+
+	err = json.Unmarshal(binary, &podSecurityRecipeInput)
+	if err != nil {
+		return nil
+	}
+
+	return podSecurityRecipeInput
+}
@@ -0,0 +1,167 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policykindmutation
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+	policyrecipemutationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/recipe/mutation"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+)
+
+var SpecSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Spec for the mutation policy",
+	Required:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			policy.InputKey:             inputSchema,
+			policy.NamespaceSelectorKey: policy.NamespaceSelector,
+		},
+	},
+}
+
+func ConstructSpec(d *schema.ResourceData) (spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) {
+	value, ok := d.GetOk(policy.SpecKey)
+	if !ok {
+		return spec
+	}
+
+	data, _ := value.([]interface{})
+
+	if len(data) == 0 || data[0] == nil {
+		return spec
+	}
+
+	specData := data[0].(map[string]interface{})
+
+	spec = &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{
+		Type:          TypePolicy,
+		RecipeVersion: policy.RecipeVersionDefaultValue,
+	}
+
+	v, ok := specData[policy.InputKey]
+	if !ok {
+		return spec
+	}
+
+	v1, ok := v.([]interface{})
+	if !ok {
+		return spec
+	}
+
+	inputRecipeData := constructInput(v1)
+
+	if inputRecipeData == nil || inputRecipeData.recipe == "" {
+		return spec
+	}
+
+	spec.Recipe = strings.ReplaceAll(string(inputRecipeData.recipe), "_", "-")
+
+	switch inputRecipeData.recipe {
+	case LabelRecipe:
+		if inputRecipeData.inputLabel != nil {
+			spec.Input = *inputRecipeData.inputLabel
+		}
+	case AnnotationRecipe:
+		if inputRecipeData.inputAnnotation != nil {
+			spec.Input = *inputRecipeData.inputAnnotation
+		}
+	case PodSecurityRecipe:
+		if inputRecipeData.inputPodSecurity != nil {
+			spec.Input = *inputRecipeData.inputPodSecurity
+		}
+	case UnknownRecipe:
+		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
+	}
+
+	if v, ok := specData[policy.NamespaceSelectorKey]; ok {
+		if v1, ok := v.([]interface{}); ok {
+			spec.NamespaceSelector = policy.ConstructNamespaceSelector(v1)
+		}
+	}
+
+	return spec
+}
+
+func FlattenSpec(spec *policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec) (data []interface{}) {
+	if spec == nil {
+		return data
+	}
+
+	flattenSpecData := make(map[string]interface{})
+
+	if spec.Input == nil {
+		return data
+	}
+
+	v1, ok := spec.Input.(map[string]interface{})
+	if !ok {
+		return data
+	}
+
+	var inputRecipeData *inputRecipe
+
+	byteSlice, err := json.Marshal(v1)
+	if err != nil {
+		return data
+	}
+
+	switch strings.ReplaceAll(spec.Recipe, "-", "_") {
+	case string(LabelRecipe):
+		var labelRecipeInput policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Label
+
+		err = labelRecipeInput.UnmarshalBinary(byteSlice)
+		if err != nil {
+			return data
+		}
+
+		inputRecipeData = &inputRecipe{
+			recipe:     LabelRecipe,
+			inputLabel: &labelRecipeInput,
+		}
+	case string(AnnotationRecipe):
+		var annotationRecipeInput policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1Annotation
+
+		err = annotationRecipeInput.UnmarshalBinary(byteSlice)
+		if err != nil {
+			return data
+		}
+
+		inputRecipeData = &inputRecipe{
+			recipe:          AnnotationRecipe,
+			inputAnnotation: &annotationRecipeInput,
+		}
+	case string(PodSecurityRecipe):
+		var podSecurityRecipeInput policyrecipemutationmodel.VmwareTanzuManageV1alpha1CommonPolicySpecMutationV1PodSecurity
+
+		err = podSecurityRecipeInput.UnmarshalBinary(byteSlice)
+		if err != nil {
+			return data
+		}
+
+		inputRecipeData = &inputRecipe{
+			recipe:           PodSecurityRecipe,
+			inputPodSecurity: &podSecurityRecipeInput,
+		}
+	case string(UnknownRecipe):
+		fmt.Printf("[ERROR]: No valid input recipe block found: minimum one valid input recipe block is required among: %v. Please check the schema.", strings.Join(RecipesAllowed[:], `, `))
+	}
+
+	flattenSpecData[policy.InputKey] = flattenInput(inputRecipeData)
+
+	if spec.NamespaceSelector != nil {
+		flattenSpecData[policy.NamespaceSelectorKey] = policy.FlattenNamespaceSelector(spec.NamespaceSelector)
+	}
+
+	return []interface{}{flattenSpecData}
+}
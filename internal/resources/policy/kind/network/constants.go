@@ -12,7 +12,7 @@ import (
 
 const (
 	ResourceName = "tanzu-mission-control_network_policy"
-	typePolicy   = "network-policy" // Type of Policy as defined in API
+	TypePolicy   = "network-policy" // Type of Policy as defined in API
 
 	// Allowed input recipes.
 	UnknownRecipe        Recipe = policy.UnknownRecipe
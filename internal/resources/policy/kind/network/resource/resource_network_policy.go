@@ -22,11 +22,15 @@ func ResourceNetworkPolicy() *schema.Resource {
 		ReadContext:   schema.ReadContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindnetwork.ResourceName), policyoperations.WithOperationType(policyoperations.Read))),
 		UpdateContext: schema.UpdateContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindnetwork.ResourceName), policyoperations.WithOperationType(policyoperations.Update))),
 		DeleteContext: schema.DeleteContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindnetwork.ResourceName), policyoperations.WithOperationType(policyoperations.Delete))),
-		Schema:        networkPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: policyoperations.ResourcePolicyImporter(policykindnetwork.ResourceName),
+		},
+		Schema: networkPolicySchema,
 		CustomizeDiff: customdiff.All(
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindnetwork.ResourceName])),
 			policykindnetwork.ValidateInput,
 			policy.ValidateSpecLabelSelectorRequirement,
+			policy.ValidateNamespaceSelectorForScope,
 		),
 	}
 }
@@ -22,6 +22,7 @@ import (
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/proxy"
+	policynamespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/namespace"
 	policyorganizationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/organization"
 	policyworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/workspace"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
@@ -134,6 +135,14 @@ func TestAcceptanceForNetworkPolicyResource(t *testing.T) {
 				steps = append(steps, resource.TestStep{
 					Config: testConfig.getTestNetworkPolicyResourceBasicConfigValue(scope.WorkspaceScope, policykindNetwork.AllowAllRecipe, WithFromOwnNamespace("true")),
 					Check:  testConfig.checkNetworkPolicyResourceAttributes(scope.WorkspaceScope, policykindNetwork.AllowAllRecipe),
+				}, resource.TestStep{
+					PreConfig: func() {
+						if testConfig.ScopeHelperResources.Cluster.KubeConfigPath == "" {
+							t.Skip("KUBECONFIG env var is not set for namespace scoped network policy acceptance test")
+						}
+					},
+					Config: testConfig.getTestNetworkPolicyResourceBasicConfigValue(scope.NamespaceScope, policykindNetwork.AllowAllRecipe),
+					Check:  testConfig.checkNetworkPolicyResourceAttributes(scope.NamespaceScope, policykindNetwork.AllowAllRecipe),
 				})
 			}
 			return steps
@@ -376,7 +385,7 @@ func WithPodLabel(k, v string) OperationOption {
 	}
 }
 
-func (testConfig *testAcceptanceConfig) getTestNetworkPolicyResourceBasicConfigValue(scope scope.Scope, recipe policykindNetwork.Recipe, opts ...OperationOption) string {
+func (testConfig *testAcceptanceConfig) getTestNetworkPolicyResourceBasicConfigValue(scopeType scope.Scope, recipe policykindNetwork.Recipe, opts ...OperationOption) string {
 	var (
 		helperBlock string
 		scopeBlock  string
@@ -385,7 +394,24 @@ func (testConfig *testAcceptanceConfig) getTestNetworkPolicyResourceBasicConfigV
 	inputBlock := testConfig.getTestNetworkPolicyResourceInput(recipe, opts...)
 
 	_, found := os.LookupEnv("ENABLE_POLICY_ENV_TEST")
-	helperBlock, scopeBlock = testConfig.ScopeHelperResources.GetTestPolicyResourceHelperAndScope(scope, policyoperations.ScopeMap[testConfig.NetworkPolicyResource], !found)
+	helperBlock, scopeBlock = testConfig.ScopeHelperResources.GetTestPolicyResourceHelperAndScope(scopeType, policyoperations.ScopeMap[testConfig.NetworkPolicyResource], !found)
+
+	// A namespace scoped policy only applies to its namespace and does not take a namespace selector.
+	if scopeType == scope.NamespaceScope {
+		return fmt.Sprintf(`
+	%s
+	
+	resource "%s" "%s" {
+	 name = "%s"
+	
+	 %s
+	
+	 spec {
+	   %s
+	 }
+	}
+	`, helperBlock, testConfig.NetworkPolicyResource, testConfig.NetworkPolicyResourceVar, testConfig.NetworkPolicyName+strings.ReplaceAll(string(recipe), "_", "-"), scopeBlock, inputBlock)
+	}
 
 	return fmt.Sprintf(`
 	%s
@@ -591,6 +617,9 @@ func (testConfig *testAcceptanceConfig) checkNetworkPolicyResourceAttributes(sco
 		check = append(check, resource.TestCheckResourceAttr(testConfig.NetworkPolicyResourceName, "scope.0.workspace.0.workspace", workspaceName))
 	case scope.OrganizationScope:
 		check = append(check, resource.TestCheckResourceAttr(testConfig.NetworkPolicyResourceName, "scope.0.organization.0.organization", testConfig.ScopeHelperResources.OrgID))
+	case scope.NamespaceScope:
+		check = append(check, resource.TestCheckResourceAttr(testConfig.NetworkPolicyResourceName, "scope.0.namespace.0.cluster_name", testConfig.ScopeHelperResources.Cluster.Name))
+		check = append(check, resource.TestCheckResourceAttr(testConfig.NetworkPolicyResourceName, "scope.0.namespace.0.name", testConfig.ScopeHelperResources.Namespace.Name))
 	case scope.UnknownScope:
 		log.Printf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(policyoperations.ScopeMap[testConfig.NetworkPolicyResource], `, `))
 	}
@@ -662,6 +691,23 @@ func (testConfig *testAcceptanceConfig) verifyNetworkPolicyResourceCreation(scop
 			if resp == nil {
 				return errors.Wrapf(err, "organization scoped network policy resource is empty, resource: %s", testConfig.NetworkPolicyResourceName)
 			}
+		case scope.NamespaceScope:
+			fn := &policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName{
+				ClusterName:           testConfig.ScopeHelperResources.Cluster.Name,
+				ManagementClusterName: scope.AttachedValue,
+				Name:                  testConfig.NetworkPolicyName + strings.ReplaceAll(string(recipe), "_", "-"),
+				NamespaceName:         testConfig.ScopeHelperResources.Namespace.Name,
+				ProvisionerName:       scope.AttachedValue,
+			}
+
+			resp, err := config.TMCConnection.NamespacePolicyResourceService.ManageV1alpha1ClusterNamespacePolicyResourceServiceGet(fn)
+			if err != nil {
+				return errors.Wrap(err, "namespace scoped network policy resource not found")
+			}
+
+			if resp == nil {
+				return errors.Wrapf(err, "namespace scoped network policy resource is empty, resource: %s", testConfig.NetworkPolicyResourceName)
+			}
 		case scope.UnknownScope:
 			return errors.Errorf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(policyoperations.ScopeMap[testConfig.NetworkPolicyResource], `, `))
 		}
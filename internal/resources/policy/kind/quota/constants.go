@@ -12,7 +12,7 @@ import (
 
 const (
 	ResourceName = "tanzu-mission-control_namespace_quota_policy"
-	typePolicy   = "namespace-quota-policy" // Type of Policy as defined in API
+	TypePolicy   = "namespace-quota-policy" // Type of Policy as defined in API
 )
 
 // Allowed input recipes.
@@ -22,11 +22,16 @@ func ResourceQuotaPolicy() *schema.Resource {
 		ReadContext:   schema.ReadContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindquota.ResourceName), policyoperations.WithOperationType(policyoperations.Read))),
 		UpdateContext: schema.UpdateContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindquota.ResourceName), policyoperations.WithOperationType(policyoperations.Update))),
 		DeleteContext: schema.DeleteContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindquota.ResourceName), policyoperations.WithOperationType(policyoperations.Delete))),
-		Schema:        quotaPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: policyoperations.ResourcePolicyImporter(policykindquota.ResourceName),
+		},
+		Schema: quotaPolicySchema,
 		CustomizeDiff: customdiff.All(
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindquota.ResourceName])),
 			policykindquota.ValidateInput,
 			policy.ValidateSpecLabelSelectorRequirement,
+			policy.ValidateNamespaceSelectorForScope,
+			policyoperations.CheckEffectivePolicyConflicts(policykindquota.TypePolicy),
 		),
 	}
 }
@@ -38,7 +43,8 @@ var quotaPolicySchema = map[string]*schema.Schema{
 		Required:    true,
 		ForceNew:    true,
 	},
-	scope.ScopeKey: scope.ScopeSchema,
-	common.MetaKey: common.Meta,
-	policy.SpecKey: policykindquota.SpecSchema,
+	scope.ScopeKey:           scope.ScopeSchema,
+	common.MetaKey:           common.Meta,
+	policy.SpecKey:           policykindquota.SpecSchema,
+	policy.FailOnConflictKey: policy.FailOnConflict,
 }
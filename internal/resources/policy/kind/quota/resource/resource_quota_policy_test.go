@@ -22,6 +22,7 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/proxy"
 	policyclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/cluster"
 	policyclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/clustergroup"
+	policynamespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/namespace"
 	policyorganizationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/organization"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
 	policykindquota "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/quota"
@@ -89,6 +90,15 @@ func TestAcceptanceForQuotaPolicyResource(t *testing.T) {
 				Config: testConfig.getTestQuotaPolicyResourceBasicConfigValue(scope.OrganizationScope, policykindquota.CustomRecipe),
 				Check:  testConfig.checkQuotaPolicyResourceAttributes(scope.OrganizationScope),
 			},
+			{
+				PreConfig: func() {
+					if testConfig.ScopeHelperResources.Cluster.KubeConfigPath == "" {
+						t.Skip("KUBECONFIG env var is not set for namespace scoped namespace quota policy acceptance test")
+					}
+				},
+				Config: testConfig.getTestQuotaPolicyResourceBasicConfigValue(scope.NamespaceScope, policykindquota.CustomRecipe),
+				Check:  testConfig.checkQuotaPolicyResourceAttributes(scope.NamespaceScope),
+			},
 		},
 	},
 	)
@@ -199,10 +209,27 @@ func TestAcceptanceForQuotaPolicyResource(t *testing.T) {
 	t.Log("all namespace quota policy resource acceptance tests complete!")
 }
 
-func (testConfig *testAcceptanceConfig) getTestQuotaPolicyResourceBasicConfigValue(scope scope.Scope, recipe policykindquota.Recipe) string {
-	helperBlock, scopeBlock := testConfig.ScopeHelperResources.GetTestPolicyResourceHelperAndScope(scope, policyoperations.ScopeMap[testConfig.QuotaPolicyResource], false)
+func (testConfig *testAcceptanceConfig) getTestQuotaPolicyResourceBasicConfigValue(scopeType scope.Scope, recipe policykindquota.Recipe) string {
+	helperBlock, scopeBlock := testConfig.ScopeHelperResources.GetTestPolicyResourceHelperAndScope(scopeType, policyoperations.ScopeMap[testConfig.QuotaPolicyResource], false)
 	inputBlock := testConfig.getTestQuotaPolicyResourceInput(recipe)
 
+	// A namespace scoped policy only applies to its namespace and does not take a namespace selector.
+	if scopeType == scope.NamespaceScope {
+		return fmt.Sprintf(`
+	%s
+	
+	resource "%s" "%s" {
+	 name = "%s"
+	
+	 %s
+	
+	 spec {
+	   %s
+	 }
+	}
+	`, helperBlock, testConfig.QuotaPolicyResource, testConfig.QuotaPolicyResourceVar, testConfig.QuotaPolicyName, scopeBlock, inputBlock)
+	}
+
 	return fmt.Sprintf(`
 	%s
 	
@@ -302,6 +329,9 @@ func (testConfig *testAcceptanceConfig) checkQuotaPolicyResourceAttributes(scope
 		check = append(check, resource.TestCheckResourceAttr(testConfig.QuotaPolicyResourceName, "scope.0.cluster_group.0.cluster_group", testConfig.ScopeHelperResources.ClusterGroup.Name))
 	case scope.OrganizationScope:
 		check = append(check, resource.TestCheckResourceAttr(testConfig.QuotaPolicyResourceName, "scope.0.organization.0.organization", testConfig.ScopeHelperResources.OrgID))
+	case scope.NamespaceScope:
+		check = append(check, resource.TestCheckResourceAttr(testConfig.QuotaPolicyResourceName, "scope.0.namespace.0.cluster_name", testConfig.ScopeHelperResources.Cluster.Name))
+		check = append(check, resource.TestCheckResourceAttr(testConfig.QuotaPolicyResourceName, "scope.0.namespace.0.name", testConfig.ScopeHelperResources.Namespace.Name))
 	case scope.UnknownScope:
 		log.Printf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(policyoperations.ScopeMap[testConfig.QuotaPolicyResource], `, `))
 	}
@@ -383,6 +413,23 @@ func (testConfig *testAcceptanceConfig) verifyQuotaPolicyResourceCreation(scopeT
 			if resp == nil {
 				return errors.Wrapf(err, "organization scoped namespace quota policy resource is empty, resource: %s", testConfig.QuotaPolicyResourceName)
 			}
+		case scope.NamespaceScope:
+			fn := &policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName{
+				ClusterName:           testConfig.ScopeHelperResources.Cluster.Name,
+				ManagementClusterName: scope.AttachedValue,
+				Name:                  testConfig.QuotaPolicyName,
+				NamespaceName:         testConfig.ScopeHelperResources.Namespace.Name,
+				ProvisionerName:       scope.AttachedValue,
+			}
+
+			resp, err := config.TMCConnection.NamespacePolicyResourceService.ManageV1alpha1ClusterNamespacePolicyResourceServiceGet(fn)
+			if err != nil {
+				return errors.Wrap(err, "namespace scoped namespace quota policy resource not found")
+			}
+
+			if resp == nil {
+				return errors.Wrapf(err, "namespace scoped namespace quota policy resource is empty, resource: %s", testConfig.QuotaPolicyResourceName)
+			}
 		case scope.UnknownScope:
 			return errors.Errorf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(policyoperations.ScopeMap[testConfig.QuotaPolicyResource], `, `))
 		}
@@ -12,7 +12,7 @@ import (
 
 const (
 	ResourceName = "tanzu-mission-control_security_policy"
-	typePolicy   = "security-policy" // Type of Policy as defined in API
+	TypePolicy   = "security-policy" // Type of Policy as defined in API
 )
 
 // Allowed input recipes.
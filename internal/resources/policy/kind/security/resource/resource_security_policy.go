@@ -22,11 +22,15 @@ func ResourceSecurityPolicy() *schema.Resource {
 		ReadContext:   schema.ReadContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindsecurity.ResourceName), policyoperations.WithOperationType(policyoperations.Read))),
 		UpdateContext: schema.UpdateContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindsecurity.ResourceName), policyoperations.WithOperationType(policyoperations.Update))),
 		DeleteContext: schema.DeleteContextFunc(policyoperations.ResourceOperation(policyoperations.WithResourceName(policykindsecurity.ResourceName), policyoperations.WithOperationType(policyoperations.Delete))),
-		Schema:        securityPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: policyoperations.ResourcePolicyImporter(policykindsecurity.ResourceName),
+		},
+		Schema: securityPolicySchema,
 		CustomizeDiff: customdiff.All(
 			schema.CustomizeDiffFunc(scope.ValidateScope(policyoperations.ScopeMap[policykindsecurity.ResourceName])),
 			policykindsecurity.ValidateInput,
 			policy.ValidateSpecLabelSelectorRequirement,
+			policyoperations.CheckEffectivePolicyConflicts(policykindsecurity.TypePolicy),
 		),
 	}
 }
@@ -38,7 +42,8 @@ var securityPolicySchema = map[string]*schema.Schema{
 		Required:    true,
 		ForceNew:    true,
 	},
-	scope.ScopeKey: scope.ScopeSchema,
-	common.MetaKey: common.Meta,
-	policy.SpecKey: policykindsecurity.SpecSchema,
+	scope.ScopeKey:           scope.ScopeSchema,
+	common.MetaKey:           common.Meta,
+	policy.SpecKey:           policykindsecurity.SpecSchema,
+	policy.FailOnConflictKey: policy.FailOnConflict,
 }
@@ -6,9 +6,14 @@ SPDX-License-Identifier: MPL-2.0
 package policy
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
 )
 
 var NamespaceSelector = &schema.Schema{
@@ -67,3 +72,19 @@ func FlattenNamespaceSelector(namespaceSelector *policymodel.VmwareTanzuManageV1
 
 	return []interface{}{flattenNamespaceSelector}
 }
+
+// ValidateNamespaceSelectorForScope rejects a namespace selector on a policy attached to a namespace scope,
+// since such a policy only ever applies to the namespace it is attached to.
+func ValidateNamespaceSelectorForScope(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	namespaceScopeData, _ := diff.Get(helper.GetFirstElementOf(scope.ScopeKey, scope.NamespaceKey)).([]interface{})
+	if len(namespaceScopeData) == 0 || namespaceScopeData[0] == nil {
+		return nil
+	}
+
+	namespaceSelectorData, _ := diff.Get(helper.GetFirstElementOf(SpecKey, NamespaceSelectorKey)).([]interface{})
+	if len(namespaceSelectorData) != 0 {
+		return fmt.Errorf("%s is not valid for a policy attached to a %s scope: the policy only applies to the namespace of the scope", NamespaceSelectorKey, scope.NamespaceKey)
+	}
+
+	return nil
+}
@@ -14,15 +14,18 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
 	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
 	policyclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/cluster"
 	policyclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/clustergroup"
+	policynamespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/namespace"
 	policyorganizationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/organization"
 	policyworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/workspace"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
 	policykindcustom "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom"
 	policykindimage "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/image"
+	policykindmutation "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation"
 	policykindnetwork "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/network"
 	policykindquota "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/quota"
 	policykindsecurity "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security"
@@ -51,6 +54,8 @@ func ResourcePolicyCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		policySpec = policykindquota.ConstructSpec(d)
 	case policykindnetwork.ResourceName:
 		policySpec = policykindnetwork.ConstructSpec(d)
+	case policykindmutation.ResourceName:
+		policySpec = policykindmutation.ConstructSpec(d)
 	}
 
 	var UID string
@@ -68,7 +73,7 @@ func ResourcePolicyCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 			policyResponse, err := config.TMCConnection.ClusterPolicyResourceService.ManageV1alpha1ClusterPolicyResourceServiceCreate(policyReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster %s policy entry, name : %s", rn, policyName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster %s policy entry, name : %s", rn, policyName))
 			}
 
 			UID = policyResponse.Policy.Meta.UID
@@ -85,7 +90,7 @@ func ResourcePolicyCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 			policyResponse, err := config.TMCConnection.ClusterGroupPolicyResourceService.ManageV1alpha1ClustergroupPolicyResourceServiceCreate(policyReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group %s policy entry, name : %s", rn, policyName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group %s policy entry, name : %s", rn, policyName))
 			}
 
 			UID = policyResponse.Policy.Meta.UID
@@ -102,7 +107,7 @@ func ResourcePolicyCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 			policyResponse, err := config.TMCConnection.WorkspacePolicyResourceService.ManageV1alpha1WorkspacePolicyResourceServiceCreate(policyReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control workspace %s policy entry, name : %s", rn, policyName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control workspace %s policy entry, name : %s", rn, policyName))
 			}
 
 			UID = policyResponse.Policy.Meta.UID
@@ -119,7 +124,24 @@ func ResourcePolicyCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 			policyResponse, err := config.TMCConnection.OrganizationPolicyResourceService.ManageV1alpha1OrganizationPolicyResourceServiceCreate(policyReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control organization %s policy entry, name : %s", rn, policyName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control organization %s policy entry, name : %s", rn, policyName))
+			}
+
+			UID = policyResponse.Policy.Meta.UID
+		}
+	case scope.NamespaceScope:
+		if scopedFullnameData.FullnameNamespace != nil {
+			policyReq := &policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyRequest{
+				Policy: &policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicy{
+					FullName: scopedFullnameData.FullnameNamespace,
+					Meta:     common.ConstructMeta(d),
+					Spec:     policySpec,
+				},
+			}
+
+			policyResponse, err := config.TMCConnection.NamespacePolicyResourceService.ManageV1alpha1ClusterNamespacePolicyResourceServiceCreate(policyReq)
+			if err != nil {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control namespace %s policy entry, name : %s", rn, policyName))
 			}
 
 			UID = policyResponse.Policy.Meta.UID
@@ -33,28 +33,35 @@ func ResourcePolicyDelete(_ context.Context, d *schema.ResourceData, m interface
 		if scopedFullnameData.FullnameCluster != nil {
 			err := config.TMCConnection.ClusterPolicyResourceService.ManageV1alpha1ClusterPolicyResourceServiceDelete(scopedFullnameData.FullnameCluster)
 			if err != nil && !clienterrors.IsNotFoundError(err) {
-				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster %s policy entry, name : %s", rn, policyName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster %s policy entry, name : %s", rn, policyName))
 			}
 		}
 	case scope.ClusterGroupScope:
 		if scopedFullnameData.FullnameClusterGroup != nil {
 			err := config.TMCConnection.ClusterGroupPolicyResourceService.ManageV1alpha1ClustergroupPolicyResourceServiceDelete(scopedFullnameData.FullnameClusterGroup)
 			if err != nil && !clienterrors.IsNotFoundError(err) {
-				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group %s policy entry, name : %s", rn, policyName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group %s policy entry, name : %s", rn, policyName))
 			}
 		}
 	case scope.WorkspaceScope:
 		if scopedFullnameData.FullnameWorkspace != nil {
 			err := config.TMCConnection.WorkspacePolicyResourceService.ManageV1alpha1WorkspacePolicyResourceServiceDelete(scopedFullnameData.FullnameWorkspace)
 			if err != nil && !clienterrors.IsNotFoundError(err) {
-				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control workspace %s policy entry, name : %s", rn, policyName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control workspace %s policy entry, name : %s", rn, policyName))
 			}
 		}
 	case scope.OrganizationScope:
 		if scopedFullnameData.FullnameOrganization != nil {
 			err := config.TMCConnection.OrganizationPolicyResourceService.ManageV1alpha1OrganizationPolicyResourceServiceDelete(scopedFullnameData.FullnameOrganization)
 			if err != nil && !clienterrors.IsNotFoundError(err) {
-				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control organization %s policy entry, name : %s", rn, policyName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control organization %s policy entry, name : %s", rn, policyName))
+			}
+		}
+	case scope.NamespaceScope:
+		if scopedFullnameData.FullnameNamespace != nil {
+			err := config.TMCConnection.NamespacePolicyResourceService.ManageV1alpha1ClusterNamespacePolicyResourceServiceDelete(scopedFullnameData.FullnameNamespace)
+			if err != nil && !clienterrors.IsNotFoundError(err) {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control namespace %s policy entry, name : %s", rn, policyName))
 			}
 		}
 	case scope.UnknownScope:
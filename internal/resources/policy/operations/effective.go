@@ -0,0 +1,189 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyoperations
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policyeffectivemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/effective"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	policykindcustom "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom"
+	customrecipe "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom/recipe"
+	policykindquota "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/quota"
+	policykindsecurity "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
+)
+
+// exclusivePolicyTypes are the policy types of which a single policy is enforced on a cluster,
+// so that any other policy of the same type effective on the cluster conflicts with a new one.
+var exclusivePolicyTypes = map[string]bool{
+	policykindsecurity.TypePolicy: true,
+	policykindquota.TypePolicy:    true,
+}
+
+// CheckEffectivePolicyConflicts returns a CustomizeDiffFunc which looks up the policies effective on the cluster
+// or namespace of a cluster or namespace scoped policy of type policyType, and logs a warning at plan time for each
+// of them which the policy is shadowed by or conflicts with. The plan fails on conflicts when fail_on_conflict is set,
+// while a failed lookup never fails the plan.
+func CheckEffectivePolicyConflicts(policyType string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+		if diff.Id() != "" && !diff.HasChange(policy.SpecKey) && !diff.HasChange(scope.ScopeKey) && !diff.HasChange(policy.FailOnConflictKey) {
+			return nil
+		}
+
+		config, ok := m.(authctx.TanzuContext)
+		if !ok || config.TMCConnection == nil {
+			return nil
+		}
+
+		searchScope := searchScopeFromDiff(diff)
+		if searchScope == nil {
+			return nil
+		}
+
+		searchScope.PolicyType = policyType
+
+		resp, err := config.TMCConnection.EffectivePolicyService.ManageV1alpha1EffectivePolicyServiceList(
+			&policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicyListRequestParameters{
+				SearchScope: searchScope,
+			},
+		)
+		if err != nil {
+			log.Printf("[WARN] Unable to get the policies effective on cluster %s: %v", searchScope.ClusterName, err)
+			return nil
+		}
+
+		name, _ := diff.Get(policy.NameKey).(string)
+
+		conflicts := effectivePolicyConflicts(resp.EffectivePolicies, name, policyType, recipeFromDiff(diff, policyType))
+		for _, conflict := range conflicts {
+			log.Printf("[WARN] %s %s on cluster %s is shadowed by or conflicts with %s", policyType, name, searchScope.ClusterName, conflict)
+		}
+
+		if failOnConflict, _ := diff.Get(policy.FailOnConflictKey).(bool); failOnConflict && len(conflicts) != 0 {
+			return errors.Errorf("%s %s on cluster %s is shadowed by or conflicts with: %s", policyType, name, searchScope.ClusterName, strings.Join(conflicts, "; "))
+		}
+
+		return nil
+	}
+}
+
+// searchScopeFromDiff returns the effective policy search scope for the cluster or namespace scope of the policy,
+// or nil when the policy is not attached to a cluster or a namespace or when its scope is not known yet.
+func searchScopeFromDiff(diff *schema.ResourceDiff) *policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySearchScope {
+	scopeKey := helper.GetFirstElementOf(scope.ScopeKey, scope.ClusterKey)
+	clusterNameKey := scope.ClusterNameKey
+
+	if namespaceData, _ := diff.Get(helper.GetFirstElementOf(scope.ScopeKey, scope.NamespaceKey)).([]interface{}); len(namespaceData) != 0 {
+		scopeKey = helper.GetFirstElementOf(scope.ScopeKey, scope.NamespaceKey)
+		clusterNameKey = scope.NamespaceClusterNameKey
+
+		if !diff.NewValueKnown(helper.GetFirstElementOf(scopeKey, scope.NamespaceNameKey)) {
+			return nil
+		}
+	}
+
+	if !diff.NewValueKnown(helper.GetFirstElementOf(scopeKey, clusterNameKey)) {
+		return nil
+	}
+
+	scopeData, _ := diff.Get(scopeKey).([]interface{})
+	if len(scopeData) == 0 || scopeData[0] == nil {
+		return nil
+	}
+
+	fullNameData, _ := scopeData[0].(map[string]interface{})
+	searchScope := &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySearchScope{}
+
+	helper.SetPrimitiveValue(fullNameData[clusterNameKey], &searchScope.ClusterName, clusterNameKey)
+	helper.SetPrimitiveValue(fullNameData[scope.ManagementClusterNameKey], &searchScope.ManagementClusterName, scope.ManagementClusterNameKey)
+	helper.SetPrimitiveValue(fullNameData[scope.ProvisionerNameKey], &searchScope.ProvisionerName, scope.ProvisionerNameKey)
+
+	if clusterNameKey == scope.NamespaceClusterNameKey {
+		helper.SetPrimitiveValue(fullNameData[scope.NamespaceNameKey], &searchScope.NamespaceName, scope.NamespaceNameKey)
+	}
+
+	return searchScope
+}
+
+// effectivePolicyConflicts returns a description of each effective policy of type policyType that a policy named name
+// using recipe conflicts with. The policy itself, reported as a direct policy of the same name, is ignored.
+func effectivePolicyConflicts(effectivePolicies []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicy, name, policyType, recipe string) (conflicts []string) {
+	for _, effectivePolicy := range effectivePolicies {
+		if effectivePolicy == nil || effectivePolicy.Spec == nil {
+			continue
+		}
+
+		for _, policySpec := range effectivePolicy.Spec.PolicySpecs {
+			if policySpec == nil || policySpec.Spec == nil {
+				continue
+			}
+
+			effectiveType := policySpec.Spec.Type
+			if effectiveType == "" {
+				effectiveType = effectivePolicy.Spec.PolicyType
+			}
+
+			if effectiveType != policyType {
+				continue
+			}
+
+			if !policySpec.Inherited && policySpec.PolicyName == name {
+				continue
+			}
+
+			if !exclusivePolicyTypes[policyType] && (recipe == "" || policySpec.Spec.Recipe != recipe) {
+				continue
+			}
+
+			kind := "direct policy"
+			if policySpec.Inherited {
+				kind = "inherited policy"
+			}
+
+			conflicts = append(conflicts, fmt.Sprintf("%s %s (recipe %s) attached to %s", kind, policySpec.PolicyName, policySpec.Spec.Recipe, policySpec.SourceRid))
+		}
+	}
+
+	return conflicts
+}
+
+// recipeFromDiff returns the recipe of the policy as named in the API, from the input recipe block set in the diff.
+func recipeFromDiff(diff *schema.ResourceDiff, policyType string) string {
+	inputData, _ := diff.Get(helper.GetFirstElementOf(policy.SpecKey, policy.InputKey)).([]interface{})
+	if len(inputData) == 0 || inputData[0] == nil {
+		return ""
+	}
+
+	recipes, _ := inputData[0].(map[string]interface{})
+
+	for key, value := range recipes {
+		recipeData, ok := value.([]interface{})
+		if !ok || len(recipeData) == 0 || recipeData[0] == nil {
+			continue
+		}
+
+		// Custom policies using a custom policy template refer to the template by name as their recipe.
+		if policyType == policykindcustom.TypePolicy && key == customrecipe.CustomKey {
+			customData, _ := recipeData[0].(map[string]interface{})
+			templateName, _ := customData[customrecipe.TemplateNameKey].(string)
+
+			return templateName
+		}
+
+		return strings.ReplaceAll(key, "_", "-")
+	}
+
+	return ""
+}
@@ -0,0 +1,225 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyoperations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client"
+	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
+	policyeffectivemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/effective"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	policykindsecurity "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security"
+	securityrecipe "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security/recipe"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
+)
+
+func TestEffectivePolicyConflicts(t *testing.T) {
+	t.Parallel()
+
+	effectivePolicies := []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicy{
+		{
+			Spec: &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec{
+				PolicyType: "security-policy",
+				PolicySpecs: []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpecPolicySpec{
+					{
+						Inherited:  true,
+						PolicyName: "org-baseline",
+						SourceRid:  "rid:o:org-id",
+						Spec:       &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{Recipe: "baseline"},
+					},
+					{
+						PolicyName: "tf-sp-test",
+						SourceRid:  "rid:c:org-id:attached:attached:test-cluster",
+						Spec:       &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{Recipe: "strict"},
+					},
+				},
+			},
+		},
+		{
+			Spec: &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec{
+				PolicyType: "custom-policy",
+				PolicySpecs: []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpecPolicySpec{
+					{
+						Inherited:  true,
+						PolicyName: "cg-require-labels",
+						SourceRid:  "rid:cg:org-id:test-cg",
+						Spec:       &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{Recipe: "tmc-require-labels"},
+					},
+					{
+						Inherited:  true,
+						PolicyName: "cg-https-ingress",
+						SourceRid:  "rid:cg:org-id:test-cg",
+						Spec:       &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{Recipe: "tmc-https-ingress"},
+					},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		description string
+		name        string
+		policyType  string
+		recipe      string
+		expected    []string
+	}{
+		{
+			description: "policy of an exclusive type conflicts with any other policy of the type",
+			name:        "tf-sp-test",
+			policyType:  "security-policy",
+			recipe:      "strict",
+			expected:    []string{"inherited policy org-baseline (recipe baseline) attached to rid:o:org-id"},
+		},
+		{
+			description: "new policy of an exclusive type conflicts with the existing direct policy",
+			name:        "tf-sp-other",
+			policyType:  "security-policy",
+			recipe:      "baseline",
+			expected: []string{
+				"inherited policy org-baseline (recipe baseline) attached to rid:o:org-id",
+				"direct policy tf-sp-test (recipe strict) attached to rid:c:org-id:attached:attached:test-cluster",
+			},
+		},
+		{
+			description: "policy of a non exclusive type conflicts with policies using the same recipe",
+			name:        "tf-custom-test",
+			policyType:  "custom-policy",
+			recipe:      "tmc-require-labels",
+			expected:    []string{"inherited policy cg-require-labels (recipe tmc-require-labels) attached to rid:cg:org-id:test-cg"},
+		},
+		{
+			description: "no conflict for a policy type without effective policies",
+			name:        "tf-quota-test",
+			policyType:  "namespace-quota-policy",
+			recipe:      "small",
+			expected:    nil,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := effectivePolicyConflicts(effectivePolicies, test.name, test.policyType, test.recipe)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+type mockEffectivePolicyClient struct {
+	listResp *policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicyListResponse
+	listErr  error
+}
+
+func (m *mockEffectivePolicyClient) ManageV1alpha1EffectivePolicyServiceList(_ *policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicyListRequestParameters) (*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicyListResponse, error) {
+	return m.listResp, m.listErr
+}
+
+func TestCheckEffectivePolicyConflicts(t *testing.T) {
+	t.Parallel()
+
+	conflicting := &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicyListResponse{
+		EffectivePolicies: []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicy{
+			{
+				Spec: &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpec{
+					PolicyType: policykindsecurity.TypePolicy,
+					PolicySpecs: []*policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicySpecPolicySpec{
+						{
+							Inherited:  true,
+							PolicyName: "org-baseline",
+							SourceRid:  "rid:o:org-id",
+							Spec:       &policymodel.VmwareTanzuManageV1alpha1CommonPolicySpec{Recipe: "baseline"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		description    string
+		failOnConflict bool
+		listResp       *policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicyListResponse
+		listErr        error
+		expectedErr    string
+	}{
+		{
+			description: "conflicts only warn by default",
+			listResp:    conflicting,
+		},
+		{
+			description:    "conflicts fail the plan with fail_on_conflict",
+			failOnConflict: true,
+			listResp:       conflicting,
+			expectedErr:    "security-policy tf-sp-test on cluster test-cluster is shadowed by or conflicts with: inherited policy org-baseline (recipe baseline) attached to rid:o:org-id",
+		},
+		{
+			description:    "no conflict with fail_on_conflict",
+			failOnConflict: true,
+			listResp:       &policyeffectivemodel.VmwareTanzuManageV1alpha1PolicyEffectivePolicyListResponse{},
+		},
+		{
+			description:    "failed lookup never fails the plan",
+			failOnConflict: true,
+			listErr:        errors.New("unavailable"),
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			resource := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					policy.NameKey:           {Type: schema.TypeString, Required: true},
+					scope.ScopeKey:           scope.ScopeSchema,
+					policy.SpecKey:           policykindsecurity.SpecSchema,
+					policy.FailOnConflictKey: policy.FailOnConflict,
+				},
+				CustomizeDiff: CheckEffectivePolicyConflicts(policykindsecurity.TypePolicy),
+			}
+
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				policy.NameKey: "tf-sp-test",
+				scope.ScopeKey: []interface{}{map[string]interface{}{
+					scope.ClusterKey: []interface{}{map[string]interface{}{
+						scope.ClusterNameKey:           "test-cluster",
+						scope.ManagementClusterNameKey: "attached",
+						scope.ProvisionerNameKey:       "attached",
+					}},
+				}},
+				policy.SpecKey: []interface{}{map[string]interface{}{
+					policy.InputKey: []interface{}{map[string]interface{}{
+						securityrecipe.StrictKey: []interface{}{map[string]interface{}{
+							securityrecipe.AuditKey: false,
+						}},
+					}},
+				}},
+				policy.FailOnConflictKey: test.failOnConflict,
+			})
+
+			meta := authctx.TanzuContext{
+				TMCConnection: &client.TanzuMissionControl{
+					EffectivePolicyService: &mockEffectivePolicyClient{listResp: test.listResp, listErr: test.listErr},
+				},
+			}
+
+			_, err := resource.Diff(context.Background(), nil, config, meta)
+
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
 	policykindcustom "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom"
 	policykindimage "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/image"
+	policykindmutation "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation"
 	policykindnetwork "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/network"
 	policykindquota "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/quota"
 	policykindsecurity "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security"
@@ -29,9 +30,10 @@ import (
 var ScopeMap = map[string][]string{
 	policykindcustom.ResourceName:   {scope.ClusterKey, scope.ClusterGroupKey, scope.OrganizationKey},
 	policykindimage.ResourceName:    {scope.WorkspaceKey, scope.OrganizationKey},
-	policykindquota.ResourceName:    {scope.ClusterKey, scope.ClusterGroupKey, scope.OrganizationKey},
+	policykindquota.ResourceName:    {scope.NamespaceKey, scope.ClusterKey, scope.ClusterGroupKey, scope.OrganizationKey},
 	policykindsecurity.ResourceName: {scope.ClusterKey, scope.ClusterGroupKey, scope.OrganizationKey},
-	policykindnetwork.ResourceName:  {scope.WorkspaceKey, scope.OrganizationKey},
+	policykindnetwork.ResourceName:  {scope.NamespaceKey, scope.WorkspaceKey, scope.OrganizationKey},
+	policykindmutation.ResourceName: {scope.ClusterGroupKey, scope.WorkspaceKey, scope.OrganizationKey},
 }
 
 // nolint: gocognit
@@ -163,6 +165,37 @@ func RetrievePolicyUIDMetaAndSpecFromServer(config authctx.TanzuContext, scopedF
 				return "", nil, nil, err
 			}
 
+			UID = resp.Policy.Meta.UID
+			meta = resp.Policy.Meta
+			spec = resp.Policy.Spec
+		}
+	case scope.NamespaceScope:
+		if scopedFullnameData.FullnameNamespace != nil {
+			resp, err := config.TMCConnection.NamespacePolicyResourceService.ManageV1alpha1ClusterNamespacePolicyResourceServiceGet(scopedFullnameData.FullnameNamespace)
+			if err != nil {
+				if clienterrors.IsNotFoundError(err) {
+					d.SetId("")
+					return "", nil, nil, nil
+				}
+
+				return "", nil, nil, errors.Wrapf(err, "Unable to get Tanzu Mission Control namespace %s policy entry, name : %s", rn, policyName)
+			}
+
+			scopedFullnameData = &scope.ScopedFullname{
+				Scope:             scope.NamespaceScope,
+				FullnameNamespace: resp.Policy.FullName,
+			}
+
+			fullName, name := scope.FlattenScope(scopedFullnameData, ScopeMap[rn])
+
+			if err := d.Set(policy.NameKey, name); err != nil {
+				return "", nil, nil, err
+			}
+
+			if err := d.Set(scope.ScopeKey, fullName); err != nil {
+				return "", nil, nil, err
+			}
+
 			UID = resp.Policy.Meta.UID
 			meta = resp.Policy.Meta
 			spec = resp.Policy.Spec
@@ -0,0 +1,40 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package policyoperations
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
+)
+
+// ResourcePolicyImporter returns the import function for the policy resource rn.
+// The import ID is the policy scope followed by the scope full name and the policy name, e.g. cluster_group/<cluster_group_name>/<name>.
+func ResourcePolicyImporter(rn string) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		scopedFullnameData, err := scope.ConstructScopeFromImportID(d.Id(), ScopeMap[rn])
+		if err != nil {
+			return nil, err
+		}
+
+		scopeData, policyName := scope.FlattenScope(scopedFullnameData, ScopeMap[rn])
+
+		if err = d.Set(policy.NameKey, policyName); err != nil {
+			return nil, errors.Wrapf(err, "Failed to set name for the %s policy %s", rn, policyName)
+		}
+
+		if err = d.Set(scope.ScopeKey, scopeData); err != nil {
+			return nil, errors.Wrapf(err, "Failed to set scope for the %s policy %s", rn, policyName)
+		}
+
+		return helper.ReadImportedState(ctx, d, m, schema.ReadContextFunc(ResourceOperation(WithResourceName(rn), WithOperationType(Read))))
+	}
+}
@@ -15,17 +15,20 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
 	policymodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy"
 	policyclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/cluster"
 	policyclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/clustergroup"
+	policynamespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/namespace"
 	policyorganizationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/organization"
 	policyworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/workspace"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy"
 	policykindcustom "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/custom"
 	policykindimage "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/image"
+	policykindmutation "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/mutation"
 	policykindnetwork "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/network"
 	policykindquota "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/quota"
 	policykindsecurity "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/kind/security"
@@ -80,7 +83,7 @@ func ResourcePolicyInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 
 			_, err := config.TMCConnection.ClusterPolicyResourceService.ManageV1alpha1ClusterPolicyResourceServiceUpdate(policyReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster %s policy entry, name : %s", rn, policyName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster %s policy entry, name : %s", rn, policyName))
 			}
 		}
 	case scope.ClusterGroupScope:
@@ -95,7 +98,7 @@ func ResourcePolicyInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 
 			_, err := config.TMCConnection.ClusterGroupPolicyResourceService.ManageV1alpha1ClustergroupPolicyResourceServiceUpdate(policyReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group %s policy entry, name : %s", rn, policyName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group %s policy entry, name : %s", rn, policyName))
 			}
 		}
 	case scope.WorkspaceScope:
@@ -110,7 +113,7 @@ func ResourcePolicyInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 
 			_, err := config.TMCConnection.WorkspacePolicyResourceService.ManageV1alpha1WorkspacePolicyResourceServiceUpdate(policyReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control workspace %s policy entry, name : %s", rn, policyName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control workspace %s policy entry, name : %s", rn, policyName))
 			}
 		}
 	case scope.OrganizationScope:
@@ -125,7 +128,22 @@ func ResourcePolicyInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m
 
 			_, err := config.TMCConnection.OrganizationPolicyResourceService.ManageV1alpha1OrganizationPolicyResourceServiceUpdate(policyReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control organization %s policy entry, name : %s", rn, policyName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control organization %s policy entry, name : %s", rn, policyName))
+			}
+		}
+	case scope.NamespaceScope:
+		if scopedFullnameData.FullnameNamespace != nil {
+			policyReq := &policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicyRequest{
+				Policy: &policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyPolicy{
+					FullName: scopedFullnameData.FullnameNamespace,
+					Meta:     meta,
+					Spec:     spec,
+				},
+			}
+
+			_, err := config.TMCConnection.NamespacePolicyResourceService.ManageV1alpha1ClusterNamespacePolicyResourceServiceUpdate(policyReq)
+			if err != nil {
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control namespace %s policy entry, name : %s", rn, policyName))
 			}
 		}
 	case scope.UnknownScope:
@@ -174,6 +192,8 @@ func updateCheckForSpec(d *schema.ResourceData, spec *policymodel.VmwareTanzuMan
 		policySpec = policykindquota.ConstructSpec(d)
 	case policykindnetwork.ResourceName:
 		policySpec = policykindnetwork.ConstructSpec(d)
+	case policykindmutation.ResourceName:
+		policySpec = policykindmutation.ConstructSpec(d)
 	}
 
 	spec.Input = policySpec.Input
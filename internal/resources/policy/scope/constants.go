@@ -13,11 +13,14 @@ const (
 	ClusterGroupNameKey      = "cluster_group"
 	WorkspaceNameKey         = "workspace"
 	OrganizationIDKey        = "organization"
+	NamespaceClusterNameKey  = "cluster_name"
+	NamespaceNameKey         = "name"
 	ScopeKey                 = "scope"
 	ClusterKey               = "cluster"
 	ClusterGroupKey          = "cluster_group"
 	WorkspaceKey             = "workspace"
 	OrganizationKey          = "organization"
+	NamespaceKey             = "namespace"
 )
 
 // Allowed scopes.
@@ -27,4 +30,5 @@ const (
 	ClusterGroupScope
 	WorkspaceScope
 	OrganizationScope
+	NamespaceScope
 )
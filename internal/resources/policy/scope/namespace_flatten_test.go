@@ -0,0 +1,55 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package scope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	policynamespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/namespace"
+)
+
+func TestFlattenNamespacePolicyFullname(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       *policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName
+		expected    []interface{}
+	}{
+		{
+			description: "check for nil namespace policy full name",
+			input:       nil,
+			expected:    nil,
+		},
+		{
+			description: "normal scenario with complete namespace policy full name",
+			input: &policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName{
+				ClusterName:           "c",
+				ManagementClusterName: "m",
+				ProvisionerName:       "p",
+				NamespaceName:         "ns",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					NamespaceClusterNameKey:  "c",
+					ManagementClusterNameKey: "m",
+					ProvisionerNameKey:       "p",
+					NamespaceNameKey:         "ns",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := FlattenNamespacePolicyFullname(test.input)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
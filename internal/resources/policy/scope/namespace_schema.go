@@ -0,0 +1,96 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package scope
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	policynamespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/namespace"
+)
+
+var NamespacePolicyFullname = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The schema for namespace policy full name",
+	Optional:    true,
+	ForceNew:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			ManagementClusterNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the management cluster",
+				Default:     AttachedValue,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			ProvisionerNameKey: {
+				Type:        schema.TypeString,
+				Description: "Provisioner of the cluster",
+				Default:     AttachedValue,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			NamespaceClusterNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the cluster of this namespace",
+				Required:    true,
+				ForceNew:    true,
+			},
+			NamespaceNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of this namespace",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	},
+}
+
+func ConstructNamespacePolicyFullname(data []interface{}, name string) (fullname *policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName) {
+	if len(data) == 0 || data[0] == nil {
+		return fullname
+	}
+
+	fullNameData, _ := data[0].(map[string]interface{})
+
+	fullname = &policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName{}
+
+	if v, ok := fullNameData[ManagementClusterNameKey]; ok {
+		helper.SetPrimitiveValue(v, &fullname.ManagementClusterName, ManagementClusterNameKey)
+	}
+
+	if v, ok := fullNameData[ProvisionerNameKey]; ok {
+		helper.SetPrimitiveValue(v, &fullname.ProvisionerName, ProvisionerNameKey)
+	}
+
+	if v, ok := fullNameData[NamespaceClusterNameKey]; ok {
+		helper.SetPrimitiveValue(v, &fullname.ClusterName, NamespaceClusterNameKey)
+	}
+
+	if v, ok := fullNameData[NamespaceNameKey]; ok {
+		helper.SetPrimitiveValue(v, &fullname.NamespaceName, NamespaceNameKey)
+	}
+
+	fullname.Name = name
+
+	return fullname
+}
+
+func FlattenNamespacePolicyFullname(fullname *policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName) (data []interface{}) {
+	if fullname == nil {
+		return data
+	}
+
+	flattenFullname := make(map[string]interface{})
+
+	flattenFullname[ManagementClusterNameKey] = fullname.ManagementClusterName
+	flattenFullname[ProvisionerNameKey] = fullname.ProvisionerName
+	flattenFullname[NamespaceClusterNameKey] = fullname.ClusterName
+	flattenFullname[NamespaceNameKey] = fullname.NamespaceName
+
+	return []interface{}{flattenFullname}
+}
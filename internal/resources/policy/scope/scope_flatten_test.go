@@ -12,6 +12,7 @@ import (
 
 	policyclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/cluster"
 	policyclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/clustergroup"
+	policynamespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/namespace"
 	policyorganizationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/organization"
 	policyworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/workspace"
 )
@@ -116,6 +117,32 @@ func TestFlattenScope(t *testing.T) {
 			},
 			expectedName: "n",
 		},
+		{
+			description: "normal scenario with complete namespace scope",
+			input: &ScopedFullname{
+				Scope: NamespaceScope,
+				FullnameNamespace: &policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName{
+					Name:                  "n",
+					ClusterName:           "c",
+					ManagementClusterName: "m",
+					ProvisionerName:       "p",
+					NamespaceName:         "ns",
+				},
+			},
+			expectedData: []interface{}{
+				map[string]interface{}{
+					NamespaceKey: []interface{}{
+						map[string]interface{}{
+							ManagementClusterNameKey: "m",
+							NamespaceClusterNameKey:  "c",
+							ProvisionerNameKey:       "p",
+							NamespaceNameKey:         "ns",
+						},
+					},
+				},
+			},
+			expectedName: "n",
+		},
 	}
 
 	for _, each := range cases {
@@ -13,8 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"golang.org/x/exp/slices"
 
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	policyclustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/cluster"
 	policyclustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/clustergroup"
+	policynamespacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/namespace"
 	policyorganizationmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/organization"
 	policyworkspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/policy/workspace"
 )
@@ -22,7 +24,7 @@ import (
 var (
 	ScopeSchema = &schema.Schema{
 		Type:        schema.TypeList,
-		Description: "Scope for the custom, security, image, network and namespace quota policy, having one of the valid scopes for custom, security and namespace quota policy: cluster, cluster_group or organization and valid scopes for image and network policy: workspace or organization.",
+		Description: "Scope for the custom, security, image, network and namespace quota policy, having one of the valid scopes for custom and security policy: cluster, cluster_group or organization, for namespace quota policy: namespace, cluster, cluster_group or organization, for image policy: workspace or organization and for network policy: namespace, workspace or organization.",
 		Required:    true,
 		ForceNew:    true,
 		MaxItems:    1,
@@ -33,6 +35,7 @@ var (
 				ClusterGroupKey: ClusterGroupPolicyFullname,
 				WorkspaceKey:    WorkspacePolicyFullname,
 				OrganizationKey: OrganizationPolicyFullname,
+				NamespaceKey:    NamespacePolicyFullname,
 			},
 		},
 	}
@@ -47,6 +50,7 @@ type (
 		FullnameClusterGroup *policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyFullName
 		FullnameWorkspace    *policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyFullName
 		FullnameOrganization *policyorganizationmodel.VmwareTanzuManageV1alpha1OrganizationPolicyFullName
+		FullnameNamespace    *policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName
 	}
 )
 
@@ -100,6 +104,15 @@ func ConstructScope(d *schema.ResourceData, name string) (scopedFullnameData *Sc
 		}
 	}
 
+	if v, ok := scopeData[NamespaceKey]; ok {
+		if v1, ok := v.([]interface{}); ok && len(v1) != 0 {
+			scopedFullnameData = &ScopedFullname{
+				Scope:             NamespaceScope,
+				FullnameNamespace: ConstructNamespacePolicyFullname(v1, name),
+			}
+		}
+	}
+
 	return scopedFullnameData
 }
 
@@ -123,6 +136,9 @@ func FlattenScope(scopedFullname *ScopedFullname, scopesAllowed []string) (data
 	case OrganizationScope:
 		name = scopedFullname.FullnameOrganization.Name
 		flattenScopeData[OrganizationKey] = FlattenOrganizationPolicyFullname(scopedFullname.FullnameOrganization)
+	case NamespaceScope:
+		name = scopedFullname.FullnameNamespace.Name
+		flattenScopeData[NamespaceKey] = FlattenNamespacePolicyFullname(scopedFullname.FullnameNamespace)
 	case UnknownScope:
 		fmt.Printf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scopesAllowed, `, `))
 	}
@@ -172,6 +188,12 @@ func ValidateScope(scopesAllowed []string) ValidateScopeType {
 			}
 		}
 
+		if v, ok := scopeData[NamespaceKey]; ok {
+			if v1, ok := v.([]interface{}); ok && len(v1) != 0 {
+				scopesFound = append(scopesFound, NamespaceKey)
+			}
+		}
+
 		if len(scopesFound) == 0 {
 			return fmt.Errorf("no valid scope type block found: minimum one valid scope type block is required among: %v", strings.Join(scopesAllowed, `, `))
 		} else if len(scopesFound) > 1 {
@@ -185,3 +207,84 @@ func ValidateScope(scopesAllowed []string) ValidateScopeType {
 		return nil
 	}
 }
+
+// ImportIDFormats returns, for each allowed scope, the import ID components identifying the policy.
+func ImportIDFormats(scopesAllowed []string) map[string][]string {
+	scopeComponents := map[string][]string{
+		ClusterKey:      {ManagementClusterNameKey, ProvisionerNameKey, "cluster_name", "name"},
+		ClusterGroupKey: {"cluster_group_name", "name"},
+		WorkspaceKey:    {"workspace_name", "name"},
+		OrganizationKey: {"organization_id", "name"},
+		NamespaceKey:    {ManagementClusterNameKey, ProvisionerNameKey, "cluster_name", "namespace_name", "name"},
+	}
+
+	formats := make(map[string][]string, len(scopesAllowed))
+
+	for _, scope := range scopesAllowed {
+		if components, ok := scopeComponents[scope]; ok {
+			formats[scope] = components
+		}
+	}
+
+	return formats
+}
+
+// ConstructScopeFromImportID parses a policy import ID whose first component is one of the allowed scopes,
+// e.g. cluster/<management_cluster_name>/<provisioner_name>/<cluster_name>/<name>, workspace/<workspace_name>/<name>
+// or namespace/<management_cluster_name>/<provisioner_name>/<cluster_name>/<namespace_name>/<name>.
+func ConstructScopeFromImportID(id string, scopesAllowed []string) (scopedFullnameData *ScopedFullname, err error) {
+	scopeKey, parts, err := helper.ParseScopedImportID(id, ImportIDFormats(scopesAllowed))
+	if err != nil {
+		return nil, err
+	}
+
+	switch scopeKey {
+	case ClusterKey:
+		scopedFullnameData = &ScopedFullname{
+			Scope: ClusterScope,
+			FullnameCluster: &policyclustermodel.VmwareTanzuManageV1alpha1ClusterPolicyFullName{
+				ManagementClusterName: parts[0],
+				ProvisionerName:       parts[1],
+				ClusterName:           parts[2],
+				Name:                  parts[3],
+			},
+		}
+	case ClusterGroupKey:
+		scopedFullnameData = &ScopedFullname{
+			Scope: ClusterGroupScope,
+			FullnameClusterGroup: &policyclustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupPolicyFullName{
+				ClusterGroupName: parts[0],
+				Name:             parts[1],
+			},
+		}
+	case WorkspaceKey:
+		scopedFullnameData = &ScopedFullname{
+			Scope: WorkspaceScope,
+			FullnameWorkspace: &policyworkspacemodel.VmwareTanzuManageV1alpha1WorkspacePolicyFullName{
+				WorkspaceName: parts[0],
+				Name:          parts[1],
+			},
+		}
+	case OrganizationKey:
+		scopedFullnameData = &ScopedFullname{
+			Scope: OrganizationScope,
+			FullnameOrganization: &policyorganizationmodel.VmwareTanzuManageV1alpha1OrganizationPolicyFullName{
+				OrgID: parts[0],
+				Name:  parts[1],
+			},
+		}
+	case NamespaceKey:
+		scopedFullnameData = &ScopedFullname{
+			Scope: NamespaceScope,
+			FullnameNamespace: &policynamespacemodel.VmwareTanzuManageV1alpha1ClusterNamespacePolicyFullName{
+				ManagementClusterName: parts[0],
+				ProvisionerName:       parts[1],
+				ClusterName:           parts[2],
+				NamespaceName:         parts[3],
+				Name:                  parts[4],
+			},
+		}
+	}
+
+	return scopedFullnameData, nil
+}
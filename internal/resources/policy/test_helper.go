@@ -16,6 +16,7 @@ import (
 
 	clusterresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/cluster"
 	clustergroupresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/clustergroup"
+	namespaceresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/namespace"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/policy/scope"
 	testhelper "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/testing"
 	workspaceresource "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/workspace"
@@ -39,6 +40,11 @@ const (
 	workspaceResource    = workspaceresource.ResourceName
 	workspaceResourceVar = "test_workspace"
 	workspaceNamePrefix  = "tf-workspace-test"
+
+	// Namespace.
+	namespaceResource    = namespaceresource.ResourceName
+	namespaceResourceVar = "test_namespace"
+	namespaceNamePrefix  = "tf-ns-test"
 )
 
 type Cluster struct {
@@ -66,11 +72,19 @@ type Workspace struct {
 	Name         string
 }
 
+type Namespace struct {
+	ResourceName string
+	Resource     string
+	ResourceVar  string
+	Name         string
+}
+
 type ScopeHelperResources struct {
 	Meta         string
 	Cluster      *Cluster
 	ClusterGroup *ClusterGroup
 	Workspace    *Workspace
+	Namespace    *Namespace
 	OrgID        string
 }
 
@@ -99,6 +113,12 @@ func NewScopeHelperResources() *ScopeHelperResources {
 			ResourceVar:  workspaceResourceVar,
 			Name:         acctest.RandomWithPrefix(workspaceNamePrefix),
 		},
+		Namespace: &Namespace{
+			ResourceName: fmt.Sprintf("%s.%s", namespaceResource, namespaceResourceVar),
+			Resource:     namespaceResource,
+			ResourceVar:  namespaceResourceVar,
+			Name:         acctest.RandomWithPrefix(namespaceNamePrefix),
+		},
 		OrgID: os.Getenv("ORG_ID"),
 	}
 }
@@ -145,6 +165,21 @@ resource "%s" "%s" {
 `, shr.Workspace.Resource, shr.Workspace.ResourceVar, shr.Workspace.Name, shr.Meta)
 }
 
+func (shr *ScopeHelperResources) getTestResourceNamespaceConfigValue() string {
+	return fmt.Sprintf(`
+%s
+
+resource "%s" "%s" {
+  management_cluster_name = %[5]s.management_cluster_name
+  provisioner_name        = %[5]s.provisioner_name
+  cluster_name            = %[5]s.name
+  name                    = "%[4]s"
+
+  %[6]s
+}
+`, shr.getTestResourceClusterConfigValue(), shr.Namespace.Resource, shr.Namespace.ResourceVar, shr.Namespace.Name, shr.Cluster.ResourceName, shr.Meta)
+}
+
 // GetTestPolicyResourceHelperAndScope builds the helper resource and scope blocks for policy resource based on a scope type.
 func (shr *ScopeHelperResources) GetTestPolicyResourceHelperAndScope(scopeType scope.Scope, scopesAllowed []string, mock bool) (string, string) {
 	var (
@@ -204,6 +239,18 @@ func (shr *ScopeHelperResources) GetTestPolicyResourceHelperAndScope(scopeType s
 		}
 	}
 	`, shr.OrgID)
+	case scope.NamespaceScope:
+		helperBlock = shr.getTestResourceNamespaceConfigValue()
+		scopeBlock = fmt.Sprintf(`
+	scope {
+	  namespace {
+	    management_cluster_name = %[1]s.management_cluster_name
+		  provisioner_name        = %[1]s.provisioner_name
+		  cluster_name            = %[1]s.cluster_name
+		  name                    = %[1]s.name
+		}
+	}
+	`, shr.Namespace.ResourceName)
 	case scope.UnknownScope:
 		log.Printf("[ERROR]: No valid scope type block found: minimum one valid scope type block is required among: %v. Please check the schema.", strings.Join(scopesAllowed, `, `))
 	}
@@ -0,0 +1,28 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package providermetadata
+
+const (
+	EKSKubernetesVersionsResourceName = "tanzu-mission-control_eks_kubernetes_versions"
+	AKSKubernetesVersionsResourceName = "tanzu-mission-control_aks_kubernetes_versions"
+	TKGAWSInstanceTypesResourceName   = "tanzu-mission-control_tkg_aws_instance_types"
+	TKGVsphereTKRVersionsResourceName = "tanzu-mission-control_tkg_vsphere_tkr_versions"
+	CredentialNameKey                 = "credential_name" //nolint:gosec
+	RegionKey                         = "region"
+	SubscriptionIDKey                 = "subscription_id"
+	LocationKey                       = "location"
+	ManagementClusterNameKey          = "management_cluster_name"
+	ProvisionerNameKey                = "provisioner_name"
+	includeIncompatibleKey            = "include_incompatible"
+	versionsKey                       = "versions"
+	latestVersionKey                  = "latest_version"
+	instanceTypesKey                  = "instance_types"
+	tanzuKubernetesReleasesKey        = "tanzu_kubernetes_releases"
+	nameKey                           = "name"
+	versionKey                        = "version"
+	kubernetesVersionKey              = "kubernetes_version"
+	compatibleKey                     = "compatible"
+)
@@ -0,0 +1,67 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package providermetadata
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+)
+
+func DataSourceTKGAWSInstanceTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTKGAWSInstanceTypesRead,
+		Schema: map[string]*schema.Schema{
+			CredentialNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the AWS credential in Tanzu Mission Control.",
+				Required:    true,
+			},
+			RegionKey: {
+				Type:        schema.TypeString,
+				Description: "AWS region of the cluster nodes.",
+				Required:    true,
+			},
+			instanceTypesKey: {
+				Type:        schema.TypeList,
+				Description: "EC2 instance types available for the cluster nodes, sorted alphabetically.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceTKGAWSInstanceTypesRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+
+	credentialName, _ := d.Get(CredentialNameKey).(string)
+	region, _ := d.Get(RegionKey).(string)
+
+	resp, err := config.TMCConnection.ProviderMetadataService.ProviderMetadataServiceGetAwsInstanceTypes(credentialName, region)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get AWS instance types, credential : %s, region : %s", credentialName, region))
+	}
+
+	d.SetId(helper.ConstructListDataSourceID(credentialName, region))
+
+	instanceTypes := make([]string, len(resp.InstanceTypes))
+	copy(instanceTypes, resp.InstanceTypes)
+	sort.Strings(instanceTypes)
+
+	if err := d.Set(instanceTypesKey, instanceTypes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package providermetadata
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+)
+
+var versionsSchema = map[string]*schema.Schema{
+	versionsKey: {
+		Type:        schema.TypeList,
+		Description: "Kubernetes versions available for new clusters, sorted from the oldest to the newest.",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	latestVersionKey: {
+		Type:        schema.TypeString,
+		Description: "Newest Kubernetes version available for new clusters.",
+		Computed:    true,
+	},
+}
+
+func DataSourceEKSKubernetesVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEKSKubernetesVersionsRead,
+		Schema: withVersionsSchema(map[string]*schema.Schema{
+			CredentialNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the AWS credential in Tanzu Mission Control.",
+				Required:    true,
+			},
+			RegionKey: {
+				Type:        schema.TypeString,
+				Description: "AWS region of the clusters.",
+				Required:    true,
+			},
+		}),
+	}
+}
+
+func DataSourceAKSKubernetesVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAKSKubernetesVersionsRead,
+		Schema: withVersionsSchema(map[string]*schema.Schema{
+			CredentialNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the Azure credential in Tanzu Mission Control.",
+				Required:    true,
+			},
+			SubscriptionIDKey: {
+				Type:        schema.TypeString,
+				Description: "Azure subscription of the clusters.",
+				Required:    true,
+			},
+			LocationKey: {
+				Type:        schema.TypeString,
+				Description: "Azure location (region) of the clusters.",
+				Required:    true,
+			},
+		}),
+	}
+}
+
+func dataSourceEKSKubernetesVersionsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+
+	credentialName, _ := d.Get(CredentialNameKey).(string)
+	region, _ := d.Get(RegionKey).(string)
+
+	resp, err := config.TMCConnection.ProviderMetadataService.ProviderMetadataServiceGetEksKubernetesVersions(credentialName, region)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get EKS Kubernetes versions, credential : %s, region : %s", credentialName, region))
+	}
+
+	d.SetId(helper.ConstructListDataSourceID(credentialName, region))
+
+	return setVersions(d, resp.Versions)
+}
+
+func dataSourceAKSKubernetesVersionsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+
+	credentialName, _ := d.Get(CredentialNameKey).(string)
+	subscriptionID, _ := d.Get(SubscriptionIDKey).(string)
+	location, _ := d.Get(LocationKey).(string)
+
+	resp, err := config.TMCConnection.ProviderMetadataService.ProviderMetadataServiceGetAksKubernetesVersions(credentialName, subscriptionID, location)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get AKS Kubernetes versions, credential : %s, location : %s", credentialName, location))
+	}
+
+	d.SetId(helper.ConstructListDataSourceID(credentialName, subscriptionID, location))
+
+	return setVersions(d, resp.Versions)
+}
+
+func withVersionsSchema(keys map[string]*schema.Schema) map[string]*schema.Schema {
+	for k, v := range versionsSchema {
+		keys[k] = v
+	}
+
+	return keys
+}
+
+// setVersions sets the sorted versions and the newest of them.
+func setVersions(d *schema.ResourceData, versions []string) diag.Diagnostics {
+	sorted := helper.SortKubernetesVersions(versions)
+
+	var latest string
+	if len(sorted) > 0 {
+		latest = sorted[len(sorted)-1]
+	}
+
+	if err := d.Set(versionsKey, sorted); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(latestVersionKey, latest); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package providermetadata
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	providermetadatamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/providermetadata"
+)
+
+func DataSourceTKGVsphereTKRVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTKGVsphereTKRVersionsRead,
+		Schema: map[string]*schema.Schema{
+			ManagementClusterNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the vSphere management cluster.",
+				Required:    true,
+			},
+			ProvisionerNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the provisioner.",
+				Required:    true,
+			},
+			includeIncompatibleKey: {
+				Type:        schema.TypeBool,
+				Description: "Include the Tanzu Kubernetes releases which are not compatible with the management cluster.",
+				Default:     false,
+				Optional:    true,
+			},
+			versionsKey: {
+				Type:        schema.TypeList,
+				Description: "Versions of the Tanzu Kubernetes releases, sorted from the oldest to the newest.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			latestVersionKey: {
+				Type:        schema.TypeString,
+				Description: "Newest version of the Tanzu Kubernetes releases.",
+				Computed:    true,
+			},
+			tanzuKubernetesReleasesKey: {
+				Type:        schema.TypeList,
+				Description: "Tanzu Kubernetes releases of the provisioner, sorted from the oldest to the newest.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						nameKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the Tanzu Kubernetes release.",
+							Computed:    true,
+						},
+						versionKey: {
+							Type:        schema.TypeString,
+							Description: "Version of the Tanzu Kubernetes release.",
+							Computed:    true,
+						},
+						kubernetesVersionKey: {
+							Type:        schema.TypeString,
+							Description: "Kubernetes version of the Tanzu Kubernetes release.",
+							Computed:    true,
+						},
+						compatibleKey: {
+							Type:        schema.TypeBool,
+							Description: "Whether the Tanzu Kubernetes release is compatible with the management cluster.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTKGVsphereTKRVersionsRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+
+	managementClusterName, _ := d.Get(ManagementClusterNameKey).(string)
+	provisionerName, _ := d.Get(ProvisionerNameKey).(string)
+	includeIncompatible, _ := d.Get(includeIncompatibleKey).(bool)
+
+	resp, err := config.TMCConnection.ProviderMetadataService.ProviderMetadataServiceListTanzuKubernetesReleases(managementClusterName, provisionerName)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to list Tanzu Kubernetes releases, management cluster : %s, provisioner : %s", managementClusterName, provisionerName))
+	}
+
+	d.SetId(helper.ConstructListDataSourceID(managementClusterName, provisionerName))
+
+	releases := sortTanzuKubernetesReleases(resp.TanzuKubernetesReleases, includeIncompatible)
+
+	versions := make([]string, 0, len(releases))
+	for _, tkr := range releases {
+		versions = append(versions, tkr.Spec.Version)
+	}
+
+	if diags := setVersions(d, versions); diags.HasError() {
+		return diags
+	}
+
+	if err := d.Set(tanzuKubernetesReleasesKey, flattenTanzuKubernetesReleases(releases)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// sortTanzuKubernetesReleases drops the releases without a spec, and the incompatible ones unless requested, and sorts
+// the rest by version from the oldest to the newest.
+func sortTanzuKubernetesReleases(releases []*providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesRelease, includeIncompatible bool) []*providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesRelease {
+	filtered := make([]*providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesRelease, 0, len(releases))
+	versions := make([]string, 0, len(releases))
+
+	for _, tkr := range releases {
+		if tkr == nil || tkr.Spec == nil {
+			continue
+		}
+
+		if !includeIncompatible && (tkr.Status == nil || !tkr.Status.Compatible) {
+			continue
+		}
+
+		filtered = append(filtered, tkr)
+		versions = append(versions, tkr.Spec.Version)
+	}
+
+	rank := make(map[string]int, len(versions))
+	for i, v := range helper.SortKubernetesVersions(versions) {
+		rank[v] = i
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return rank[filtered[i].Spec.Version] < rank[filtered[j].Spec.Version]
+	})
+
+	return filtered
+}
+
+func flattenTanzuKubernetesReleases(releases []*providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesRelease) []interface{} {
+	flattenReleasesData := make([]interface{}, 0, len(releases))
+
+	for _, tkr := range releases {
+		flattenReleaseData := map[string]interface{}{
+			nameKey:              tkr.Name,
+			versionKey:           tkr.Spec.Version,
+			kubernetesVersionKey: tkr.Spec.KubernetesVersion,
+			compatibleKey:        tkr.Status != nil && tkr.Status.Compatible,
+		}
+
+		flattenReleasesData = append(flattenReleasesData, flattenReleaseData)
+	}
+
+	return flattenReleasesData
+}
@@ -0,0 +1,105 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package providermetadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	providermetadatamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/providermetadata"
+)
+
+func TestFlattenTanzuKubernetesReleases(t *testing.T) {
+	t.Parallel()
+
+	tkr := func(name, version, kubernetesVersion string, compatible bool) *providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesRelease {
+		return &providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesRelease{
+			Name: name,
+			Spec: &providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesReleaseSpec{
+				Version:           version,
+				KubernetesVersion: kubernetesVersion,
+			},
+			Status: &providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesReleaseStatus{
+				Compatible: compatible,
+			},
+		}
+	}
+
+	releases := []*providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesRelease{
+		tkr("v1.26.5---vmware.2-tkg.1", "v1.26.5+vmware.2-tkg.1", "v1.26.5+vmware.2", true),
+		nil,
+		tkr("v1.24.9---vmware.1-tkg.1", "v1.24.9+vmware.1-tkg.1", "v1.24.9+vmware.1", false),
+		{Name: "no-spec"},
+		tkr("v1.25.7---vmware.2-tkg.1", "v1.25.7+vmware.2-tkg.1", "v1.25.7+vmware.2", true),
+	}
+
+	cases := []struct {
+		description         string
+		input               []*providermetadatamodel.VmwareTanzuManageV1alpha1ProvidermetadataTanzuKubernetesRelease
+		includeIncompatible bool
+		expected            []interface{}
+	}{
+		{
+			description: "check for no releases",
+			input:       nil,
+			expected:    []interface{}{},
+		},
+		{
+			description: "compatible releases sorted by version",
+			input:       releases,
+			expected: []interface{}{
+				map[string]interface{}{
+					nameKey:              "v1.25.7---vmware.2-tkg.1",
+					versionKey:           "v1.25.7+vmware.2-tkg.1",
+					kubernetesVersionKey: "v1.25.7+vmware.2",
+					compatibleKey:        true,
+				},
+				map[string]interface{}{
+					nameKey:              "v1.26.5---vmware.2-tkg.1",
+					versionKey:           "v1.26.5+vmware.2-tkg.1",
+					kubernetesVersionKey: "v1.26.5+vmware.2",
+					compatibleKey:        true,
+				},
+			},
+		},
+		{
+			description:         "incompatible releases included",
+			input:               releases,
+			includeIncompatible: true,
+			expected: []interface{}{
+				map[string]interface{}{
+					nameKey:              "v1.24.9---vmware.1-tkg.1",
+					versionKey:           "v1.24.9+vmware.1-tkg.1",
+					kubernetesVersionKey: "v1.24.9+vmware.1",
+					compatibleKey:        false,
+				},
+				map[string]interface{}{
+					nameKey:              "v1.25.7---vmware.2-tkg.1",
+					versionKey:           "v1.25.7+vmware.2-tkg.1",
+					kubernetesVersionKey: "v1.25.7+vmware.2",
+					compatibleKey:        true,
+				},
+				map[string]interface{}{
+					nameKey:              "v1.26.5---vmware.2-tkg.1",
+					versionKey:           "v1.26.5+vmware.2-tkg.1",
+					kubernetesVersionKey: "v1.26.5+vmware.2",
+					compatibleKey:        true,
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			actual := flattenTanzuKubernetesReleases(sortTanzuKubernetesReleases(test.input, test.includeIncompatible))
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
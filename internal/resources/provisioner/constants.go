@@ -0,0 +1,17 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package provisioner
+
+const (
+	ResourceName             = "tanzu-mission-control_provisioner"
+	ProvisionersResourceName = "tanzu-mission-control_provisioners"
+	ManagementClusterNameKey = "management_cluster_name"
+	NameKey                  = "name"
+	phaseKey                 = "phase"
+	provisionersKey          = "provisioners"
+	uidKey                   = "uid"
+	labelsKey                = "labels"
+)
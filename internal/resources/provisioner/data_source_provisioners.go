@@ -0,0 +1,166 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package provisioner
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/pagination"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+	provisionermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/provisioner"
+)
+
+func DataSourceProvisioners() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceProvisionersRead,
+		Schema: map[string]*schema.Schema{
+			ManagementClusterNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the management cluster of the provisioners to list.",
+				Required:    true,
+			},
+			NameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the provisioners to list, supports globbing with *.",
+				Optional:    true,
+			},
+			labelsKey: {
+				Type:        schema.TypeMap,
+				Description: "Only list the provisioners having all these labels.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			provisionersKey: {
+				Type:        schema.TypeList,
+				Description: "Provisioners matching the filters, sorted by name.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						uidKey: {
+							Type:        schema.TypeString,
+							Description: "UID of the provisioner.",
+							Computed:    true,
+						},
+						NameKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the provisioner.",
+							Computed:    true,
+						},
+						phaseKey: {
+							Type:        schema.TypeString,
+							Description: "Phase of the provisioner.",
+							Computed:    true,
+						},
+						labelsKey: {
+							Type:        schema.TypeMap,
+							Description: "Labels of the provisioner.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceProvisionersRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+
+	managementClusterName, _ := d.Get(ManagementClusterNameKey).(string)
+	name, _ := d.Get(NameKey).(string)
+	selector := helper.GetStringMap(d.Get(labelsKey))
+
+	request := &provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerListProvisionersRequestParameters{
+		SearchScope: &provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerSearchScope{
+			ManagementClusterName: managementClusterName,
+			Name:                  name,
+		},
+		IncludeTotalCount: true,
+	}
+
+	provisioners, err := pagination.ListAll(pagination.DefaultPageSize, func(options *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions) (*pagination.Page[*provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner], error) {
+		request.Pagination = options
+
+		resp, err := config.TMCConnection.ProvisionerResourceService.ManageV1alpha1ProvisionerResourceServiceList(request)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pagination.Page[*provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner]{Items: resp.Provisioners, TotalCount: resp.TotalCount}, nil
+	})
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to list Tanzu Mission Control provisioners of management cluster %s", managementClusterName))
+	}
+
+	if err := d.Set(provisionersKey, flattenProvisioners(filterProvisioners(provisioners, selector))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helper.ConstructListDataSourceID(managementClusterName, name, helper.LabelSelectorString(selector)))
+
+	return nil
+}
+
+func filterProvisioners(provisioners []*provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner, selector map[string]string) []*provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner {
+	filtered := make([]*provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner, 0, len(provisioners))
+
+	for _, provisioner := range provisioners {
+		if provisioner == nil || provisioner.FullName == nil {
+			continue
+		}
+
+		var labels map[string]string
+		if provisioner.Meta != nil {
+			labels = provisioner.Meta.Labels
+		}
+
+		if helper.MatchLabels(labels, selector) {
+			filtered = append(filtered, provisioner)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].FullName.Name < filtered[j].FullName.Name
+	})
+
+	return filtered
+}
+
+func flattenProvisioners(provisioners []*provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner) []interface{} {
+	data := make([]interface{}, 0, len(provisioners))
+
+	for _, provisioner := range provisioners {
+		flattenProvisioner := map[string]interface{}{
+			NameKey: provisioner.FullName.Name,
+		}
+
+		if provisioner.Meta != nil {
+			flattenProvisioner[uidKey] = provisioner.Meta.UID
+			flattenProvisioner[labelsKey] = provisioner.Meta.Labels
+		}
+
+		if provisioner.Status != nil {
+			flattenProvisioner[phaseKey] = provisioner.Status.Phase
+		}
+
+		data = append(data, flattenProvisioner)
+	}
+
+	return data
+}
@@ -0,0 +1,73 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package provisioner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	provisionermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/provisioner"
+)
+
+func TestFlattenProvisioners(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       []*provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner
+		selector    map[string]string
+		expected    []interface{}
+	}{
+		{
+			description: "check for no provisioners",
+			input:       nil,
+			expected:    []interface{}{},
+		},
+		{
+			description: "provisioners are filtered on labels and sorted by name",
+			input: []*provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner{
+				{
+					FullName: &provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerFullName{ManagementClusterName: "mc", Name: "prod-b"},
+					Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "uid-b", Labels: map[string]string{"env": "prod"}},
+					Status:   &provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerStatus{Phase: "READY"},
+				},
+				nil,
+				{
+					FullName: &provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerFullName{ManagementClusterName: "mc", Name: "dev"},
+					Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "uid-dev", Labels: map[string]string{"env": "dev"}},
+				},
+				{
+					FullName: &provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerFullName{ManagementClusterName: "mc", Name: "prod-a"},
+					Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{UID: "uid-a", Labels: map[string]string{"env": "prod"}},
+				},
+			},
+			selector: map[string]string{"env": "prod"},
+			expected: []interface{}{
+				map[string]interface{}{
+					NameKey:   "prod-a",
+					uidKey:    "uid-a",
+					labelsKey: map[string]string{"env": "prod"},
+				},
+				map[string]interface{}{
+					NameKey:   "prod-b",
+					uidKey:    "uid-b",
+					labelsKey: map[string]string{"env": "prod"},
+					phaseKey:  "READY",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual := flattenProvisioners(filterProvisioners(test.input, test.selector))
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package provisioner
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	provisionermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/provisioner"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+func ResourceProvisioner() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProvisionerCreate,
+		ReadContext:   resourceProvisionerRead,
+		UpdateContext: resourceProvisionerInPlaceUpdate,
+		DeleteContext: resourceProvisionerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProvisionerImporter,
+		},
+		Schema: provisionerSchema,
+	}
+}
+
+var provisionerSchema = map[string]*schema.Schema{
+	ManagementClusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the management cluster of the provisioner",
+		Required:    true,
+		ForceNew:    true,
+	},
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the provisioner, which is the namespace of the management cluster where the workload clusters are provisioned",
+		Required:    true,
+		ForceNew:    true,
+	},
+	common.MetaKey: common.Meta,
+	phaseKey: {
+		Type:        schema.TypeString,
+		Description: "Phase of the provisioner",
+		Computed:    true,
+	},
+}
+
+func constructFullname(d *schema.ResourceData) *provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerFullName {
+	managementClusterName, _ := d.Get(ManagementClusterNameKey).(string)
+	name, _ := d.Get(NameKey).(string)
+
+	return &provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerFullName{
+		ManagementClusterName: managementClusterName,
+		Name:                  name,
+	}
+}
+
+func resourceProvisionerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+
+	fn := constructFullname(d)
+
+	request := &provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerRequest{
+		Provisioner: &provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerProvisioner{
+			FullName: fn,
+			Meta:     common.ConstructMeta(d),
+		},
+	}
+
+	response, err := config.TMCConnection.ProvisionerResourceService.ManageV1alpha1ProvisionerResourceServiceCreate(request)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control provisioner entry, management cluster name : %s, name : %s", fn.ManagementClusterName, fn.Name))
+	}
+
+	d.SetId(response.Provisioner.Meta.UID)
+
+	return resourceProvisionerRead(ctx, d, m)
+}
+
+func resourceProvisionerRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	fn := constructFullname(d)
+
+	resp, err := config.TMCConnection.ProvisionerResourceService.ManageV1alpha1ProvisionerResourceServiceGet(fn)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) && !helper.IsDataRead(ctx) {
+			_ = schema.RemoveFromState(d, m)
+			return diags
+		}
+
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control provisioner entry, management cluster name : %s, name : %s", fn.ManagementClusterName, fn.Name))
+	}
+
+	d.SetId(resp.Provisioner.Meta.UID)
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(resp.Provisioner.Meta)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	phase := ""
+	if resp.Provisioner.Status != nil {
+		phase = resp.Provisioner.Status.Phase
+	}
+
+	if err := d.Set(phaseKey, phase); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceProvisionerInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+
+	if !common.HasMetaChanged(d) {
+		return resourceProvisionerRead(ctx, d, m)
+	}
+
+	fn := constructFullname(d)
+
+	getResp, err := config.TMCConnection.ProvisionerResourceService.ManageV1alpha1ProvisionerResourceServiceGet(fn)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control provisioner entry, management cluster name : %s, name : %s", fn.ManagementClusterName, fn.Name))
+	}
+
+	meta := common.ConstructMeta(d)
+
+	if value, ok := getResp.Provisioner.Meta.Labels[common.CreatorLabelKey]; ok {
+		meta.Labels[common.CreatorLabelKey] = value
+	}
+
+	getResp.Provisioner.Meta.Labels = meta.Labels
+	getResp.Provisioner.Meta.Description = meta.Description
+
+	_, err = config.TMCConnection.ProvisionerResourceService.ManageV1alpha1ProvisionerResourceServiceUpdate(
+		&provisionermodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerRequest{
+			Provisioner: getResp.Provisioner,
+		},
+	)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control provisioner entry, management cluster name : %s, name : %s", fn.ManagementClusterName, fn.Name))
+	}
+
+	return resourceProvisionerRead(ctx, d, m)
+}
+
+func resourceProvisionerDelete(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	fn := constructFullname(d)
+
+	err := config.TMCConnection.ProvisionerResourceService.ManageV1alpha1ProvisionerResourceServiceDelete(fn)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control provisioner entry, management cluster name : %s, name : %s", fn.ManagementClusterName, fn.Name))
+	}
+
+	_ = schema.RemoveFromState(d, m)
+
+	return diags
+}
+
+// resourceProvisionerImporter imports a provisioner using an ID of the form <management_cluster_name>/<name>.
+func resourceProvisionerImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts, err := helper.ParseImportID(d.Id(), ManagementClusterNameKey, NameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.Set(ManagementClusterNameKey, parts[0]); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set management cluster name for the provisioner %s", d.Id())
+	}
+
+	if err = d.Set(NameKey, parts[1]); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the provisioner %s", d.Id())
+	}
+
+	return helper.ReadImportedState(ctx, d, m, resourceProvisionerRead)
+}
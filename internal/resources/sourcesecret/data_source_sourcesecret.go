@@ -57,7 +57,7 @@ func resourceSourcesecretRead(ctx context.Context, d *schema.ResourceData, m int
 	if err != nil {
 		if clienterrors.IsNotFoundError(err) {
 			if ctx.Value(contextMethodKey{}) == DataSourceRead {
-				return diag.FromErr(errors.Wrapf(err, "Tanzu Mission Control source secret entry not found, name : %s", d.Get(nameKey)))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Tanzu Mission Control source secret entry not found, name : %s", d.Get(nameKey)))
 			}
 
 			_ = schema.RemoveFromState(d, m)
@@ -35,7 +35,10 @@ func ResourceSourceSecret() *schema.Resource {
 		DeleteContext: resourceSourcesecretDelete,
 		UpdateContext: resourceSourcesecretInPlaceUpdate,
 		ReadContext:   resourceSourcesecretRead,
-		Schema:        getResourceSchema(),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSourcesecretImporter,
+		},
+		Schema: getResourceSchema(),
 		CustomizeDiff: customdiff.All(
 			spec.ValidateSpec,
 			schema.CustomizeDiffFunc(commonscope.ValidateScope(scope.CredentialTypesAllowed[:])),
@@ -185,7 +188,7 @@ func resourceSourcesecretCreate(ctx context.Context, d *schema.ResourceData, m i
 
 	err := enableContinuousDelivery(&config, scopedFullnameData, meta)
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control source secret entry, name : %s", sourcesecretName))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control source secret entry, name : %s", sourcesecretName))
 	}
 
 	switch scopedFullnameData.Scope {
@@ -201,7 +204,7 @@ func resourceSourcesecretCreate(ctx context.Context, d *schema.ResourceData, m i
 
 			sourcesecretResponse, err := config.TMCConnection.ClusterSourcesecretResourceService.ManageV1alpha1ClusterFluxcdSourcesecretResourceServiceCreate(sourcesecretReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster source secret entry, name : %s", sourcesecretName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster source secret entry, name : %s", sourcesecretName))
 			}
 
 			UID = sourcesecretResponse.SourceSecret.Meta.UID
@@ -218,7 +221,7 @@ func resourceSourcesecretCreate(ctx context.Context, d *schema.ResourceData, m i
 
 			sourcesecretResponse, err := config.TMCConnection.ClusterGroupSourcesecretResourceService.ManageV1alpha1ClustergroupFluxcdSourcesecretResourceServiceCreate(sourcesecretReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group sourcesecret entry, name : %s", sourcesecretName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control cluster group sourcesecret entry, name : %s", sourcesecretName))
 			}
 
 			UID = sourcesecretResponse.SourceSecret.Meta.UID
@@ -279,7 +282,7 @@ func resourceSourcesecretInPlaceUpdate(ctx context.Context, d *schema.ResourceDa
 
 			_, err := config.TMCConnection.ClusterSourcesecretResourceService.ManageV1alpha1ClusterFluxcdSourcesecretResourceServiceUpdate(sourcesecretReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster source secret entry, name : %s", sourcesecretName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster source secret entry, name : %s", sourcesecretName))
 			}
 		}
 	case commonscope.ClusterGroupScope:
@@ -296,7 +299,7 @@ func resourceSourcesecretInPlaceUpdate(ctx context.Context, d *schema.ResourceDa
 
 			_, err := config.TMCConnection.ClusterGroupSourcesecretResourceService.ManageV1alpha1ClustergroupFluxcdSourcesecretResourceServiceUpdate(sourcesecretReq)
 			if err != nil {
-				return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group source secret entry, name : %s", sourcesecretName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control cluster group source secret entry, name : %s", sourcesecretName))
 			}
 		}
 	case commonscope.UnknownScope:
@@ -327,14 +330,14 @@ func resourceSourcesecretDelete(ctx context.Context, d *schema.ResourceData, m i
 		if scopedFullnameData.FullnameCluster != nil {
 			err := config.TMCConnection.ClusterSourcesecretResourceService.ManageV1alpha1ClusterFluxcdSourcesecretResourceServiceDelete(scopedFullnameData.FullnameCluster)
 			if err != nil && !clienterrors.IsNotFoundError(err) {
-				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster source secret entry, name : %s", sourcesecretName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster source secret entry, name : %s", sourcesecretName))
 			}
 		}
 	case commonscope.ClusterGroupScope:
 		if scopedFullnameData.FullnameClusterGroup != nil {
 			err := config.TMCConnection.ClusterGroupSourcesecretResourceService.ManageV1alpha1ClustergroupFluxcdSourcesecretResourceServiceDelete(scopedFullnameData.FullnameClusterGroup)
 			if err != nil && !clienterrors.IsNotFoundError(err) {
-				return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group source secret entry, name : %s", sourcesecretName))
+				return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control cluster group source secret entry, name : %s", sourcesecretName))
 			}
 		}
 	case commonscope.UnknownScope:
@@ -386,3 +389,24 @@ func updateCheckForSpec(d *schema.ResourceData, atomicSpec *sourcesecretclusterm
 
 	return true
 }
+
+// resourceSourcesecretImporter imports a source secret using an ID of the form cluster/<management_cluster_name>/<provisioner_name>/<cluster_name>/<name>
+// or cluster_group/<cluster_group_name>/<name>. Secret data is never returned by Tanzu Mission Control and has to be supplied in the configuration.
+func resourceSourcesecretImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	scopedFullnameData, err := scope.ConstructScopeFromImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	scopeData, name := scope.FlattenScope(scopedFullnameData)
+
+	if err = d.Set(nameKey, name); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the source secret %s", name)
+	}
+
+	if err = d.Set(commonscope.ScopeKey, scopeData); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set scope for the source secret %s", name)
+	}
+
+	return helper.ReadImportedState(ctx, d, m, resourceSourcesecretRead)
+}
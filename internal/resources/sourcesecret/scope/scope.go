@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"golang.org/x/exp/slices"
 
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	sourcesecretlustermodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/cluster"
 	sourcesecretlustergroupmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/sourcesecret/clustergroup"
 	commonscope "github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common/scope"
@@ -95,3 +96,35 @@ func FlattenScope(scopedFullname *ScopedFullname) (data []interface{}, name stri
 
 	return []interface{}{flattenScopeData}, name
 }
+
+// ConstructScopeFromImportID parses a source secret import ID of the form cluster/<management_cluster_name>/<provisioner_name>/<cluster_name>/<name>
+// or cluster_group/<cluster_group_name>/<name>.
+func ConstructScopeFromImportID(id string) (scopedFullnameData *ScopedFullname, err error) {
+	scopeKey, parts, err := helper.ParseScopedImportID(id, commonscope.ImportIDFormats(CredentialTypesAllowed[:], commonscope.NameKey))
+	if err != nil {
+		return nil, err
+	}
+
+	switch scopeKey {
+	case commonscope.ClusterKey:
+		scopedFullnameData = &ScopedFullname{
+			Scope: commonscope.ClusterScope,
+			FullnameCluster: &sourcesecretlustermodel.VmwareTanzuManageV1alpha1ClusterFluxcdSourcesecretFullName{
+				ManagementClusterName: parts[0],
+				ProvisionerName:       parts[1],
+				ClusterName:           parts[2],
+				Name:                  parts[3],
+			},
+		}
+	case commonscope.ClusterGroupKey:
+		scopedFullnameData = &ScopedFullname{
+			Scope: commonscope.ClusterGroupScope,
+			FullnameClusterGroup: &sourcesecretlustergroupmodel.VmwareTanzuManageV1alpha1ClustergroupFluxcdSourcesecretFullName{
+				ClusterGroupName: parts[0],
+				Name:             parts[1],
+			},
+		}
+	}
+
+	return scopedFullnameData, nil
+}
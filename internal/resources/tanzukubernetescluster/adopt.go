@@ -0,0 +1,77 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzukubernetescluster
+
+import (
+	"context"
+	"log"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	tkcmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+// adoptExistingCluster takes over the cluster which made the create request fail with a conflict. The existing
+// cluster is updated when its meta data or spec differ from the configuration, then waited for to be ready.
+func adoptExistingCluster(ctx context.Context, config authctx.TanzuContext, d *schema.ResourceData, desired *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTanzuKubernetesCluster) diag.Diagnostics {
+	fn := desired.FullName
+	diags := diag.Diagnostics{common.AdoptedWarning("Tanzu Kubernetes cluster", fn.Name)}
+
+	getResp, err := config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterResourceServiceGet(fn)
+	if err != nil {
+		return append(diags, clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get existing Tanzu Mission Control Tanzu Kubernetes cluster entry, name : %s", fn.Name))...)
+	}
+
+	cluster := getResp.TanzuKubernetesCluster
+	d.SetId(cluster.Meta.UID)
+
+	if reconcileExistingCluster(cluster, desired) {
+		// there is some translation error, which results
+		// in mismatch on the server.
+		cluster.Meta.CreationTime = strfmt.DateTime{}
+
+		_, err = config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterResourceServiceUpdate(
+			&tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterRequest{
+				TanzuKubernetesCluster: &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTanzuKubernetesCluster{
+					FullName: cluster.FullName,
+					Meta:     cluster.Meta,
+					Spec:     cluster.Spec,
+				},
+			},
+		)
+		if err != nil {
+			return append(diags, clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update existing Tanzu Mission Control Tanzu Kubernetes cluster entry, name : %s", fn.Name))...)
+		}
+	} else {
+		log.Printf("[INFO] existing Tanzu Kubernetes cluster %s matches the configuration", fn.Name)
+	}
+
+	if err := waitForReady(ctx, config, fn, helper.GetWaitTimeout(d, waitKey, schema.TimeoutCreate)); err != nil {
+		return append(diags, clienterrors.ToDiagnostics(err)...)
+	}
+
+	return append(diags, resourceTanzuKubernetesClusterRead(ctx, d, config)...)
+}
+
+// reconcileExistingCluster applies the meta data and spec of the desired cluster to the existing one and returns
+// whether any of them changed. Spec attributes left unset in the configuration are not compared.
+func reconcileExistingCluster(existing, desired *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTanzuKubernetesCluster) bool {
+	updated := common.ReconcileExistingMeta(existing.Meta, desired.Meta)
+
+	if desired.Spec != nil && (existing.Spec == nil || !helper.SetValuesMatch(desired.Spec, existing.Spec)) {
+		existing.Spec = desired.Spec
+		updated = true
+	}
+
+	return updated
+}
@@ -0,0 +1,79 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzukubernetescluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	tkcmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+func TestReconcileExistingCluster(t *testing.T) {
+	t.Parallel()
+
+	aCluster := func(labels map[string]string, clusterGroup, version string) *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTanzuKubernetesCluster {
+		return &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTanzuKubernetesCluster{
+			Meta: &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{
+				Description: "test cluster",
+				Labels:      labels,
+			},
+			Spec: &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterSpec{
+				ClusterGroupName: clusterGroup,
+				Topology: &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTopology{
+					ClusterClass: "tanzukubernetescluster",
+					Version:      version,
+				},
+			},
+		}
+	}
+
+	creatorLabel := map[string]string{common.CreatorLabelKey: "admin"}
+
+	cases := []struct {
+		description     string
+		existing        *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTanzuKubernetesCluster
+		desired         *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTanzuKubernetesCluster
+		expectedUpdated bool
+		expected        *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTanzuKubernetesCluster
+	}{
+		{
+			description:     "existing cluster matching the configuration",
+			existing:        aCluster(creatorLabel, "default", "v1.26.5+vmware.2-fips.1"),
+			desired:         aCluster(map[string]string{}, "default", "v1.26.5+vmware.2-fips.1"),
+			expectedUpdated: false,
+			expected:        aCluster(creatorLabel, "default", "v1.26.5+vmware.2-fips.1"),
+		},
+		{
+			description:     "existing cluster with different labels and version",
+			existing:        aCluster(creatorLabel, "default", "v1.25.7+vmware.3-fips.1"),
+			desired:         aCluster(map[string]string{"env": "test"}, "default", "v1.26.5+vmware.2-fips.1"),
+			expectedUpdated: true,
+			expected:        aCluster(map[string]string{"env": "test", common.CreatorLabelKey: "admin"}, "default", "v1.26.5+vmware.2-fips.1"),
+		},
+		{
+			description:     "attributes left to the defaults of Tanzu Mission Control",
+			existing:        aCluster(nil, "default", "v1.26.5+vmware.2-fips.1"),
+			desired:         aCluster(nil, "", "v1.26.5+vmware.2-fips.1"),
+			expectedUpdated: false,
+			expected:        aCluster(nil, "default", "v1.26.5+vmware.2-fips.1"),
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			t.Parallel()
+
+			updated := reconcileExistingCluster(test.existing, test.desired)
+			require.Equal(t, test.expectedUpdated, updated)
+			require.Equal(t, test.expected, test.existing)
+		})
+	}
+}
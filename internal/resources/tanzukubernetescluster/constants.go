@@ -0,0 +1,44 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzukubernetescluster
+
+const (
+	ResourceName = "tanzu-mission-control_tanzu_kubernetes_cluster"
+
+	ManagementClusterNameKey = "management_cluster_name"
+	ProvisionerNameKey       = "provisioner_name"
+	NameKey                  = "name"
+	SpecKey                  = "spec"
+	StatusKey                = "status"
+	waitKey                  = "ready_wait_timeout"
+	clusterGroupKey          = "cluster_group"
+	clusterGroupDefaultValue = "default"
+	proxyNameKey             = "proxy_name"
+	imageRegistryKey         = "image_registry"
+	topologyKey              = "topology"
+	clusterClassKey          = "cluster_class"
+	versionKey               = "version"
+	clusterVariablesKey      = "cluster_variables"
+	controlPlaneKey          = "control_plane"
+	replicasKey              = "replicas"
+	osImageKey               = "os_image"
+	osImageNameKey           = "name"
+	osImageVersionKey        = "version"
+	osImageArchKey           = "arch"
+	nodePoolKey              = "nodepool"
+	nodePoolNameKey          = "name"
+	nodePoolDescriptionKey   = "description"
+	workerClassKey           = "worker_class"
+	failureDomainKey         = "failure_domain"
+	overrideVariablesKey     = "override_variables"
+	networkKey               = "network"
+	podCidrBlocksKey         = "pod_cidr_blocks"
+	serviceCidrBlocksKey     = "service_cidr_blocks"
+	serviceDomainKey         = "service_domain"
+	phaseKey                 = "phase"
+	healthKey                = "health"
+	messageKey               = "message"
+)
@@ -0,0 +1,258 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzukubernetescluster
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	tkcmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+const pollInterval = 10 * time.Second
+
+func ResourceTanzuKubernetesCluster() *schema.Resource {
+	return &schema.Resource{
+		Schema:        tanzuKubernetesClusterSchema,
+		CreateContext: resourceTanzuKubernetesClusterCreate,
+		ReadContext:   resourceTanzuKubernetesClusterRead,
+		UpdateContext: resourceTanzuKubernetesClusterInPlaceUpdate,
+		DeleteContext: resourceTanzuKubernetesClusterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTanzuKubernetesClusterImporter,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Description: "Tanzu Mission Control ClusterClass based Tanzu Kubernetes Cluster Resource",
+	}
+}
+
+func resourceTanzuKubernetesClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+
+	fn := constructFullname(d)
+
+	spec, err := constructSpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterRequest{
+		TanzuKubernetesCluster: &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTanzuKubernetesCluster{
+			FullName: fn,
+			Meta:     common.ConstructMeta(d),
+			Spec:     spec,
+		},
+	}
+
+	response, err := config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterResourceServiceCreate(request)
+	if clienterrors.IsAlreadyExistsError(err) {
+		if !common.ShouldAdoptExisting(d) {
+			return clienterrors.ToDiagnostics(common.AlreadyExistsError(err, "Tanzu Kubernetes cluster", fn.Name))
+		}
+
+		return adoptExistingCluster(ctx, config, d, request.TanzuKubernetesCluster)
+	}
+
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control Tanzu Kubernetes cluster entry, name : %s", fn.Name))
+	}
+
+	d.SetId(response.TanzuKubernetesCluster.Meta.UID)
+
+	if err := waitForReady(ctx, config, fn, helper.GetWaitTimeout(d, waitKey, schema.TimeoutCreate)); err != nil {
+		return clienterrors.ToDiagnostics(err)
+	}
+
+	return resourceTanzuKubernetesClusterRead(ctx, d, m)
+}
+
+// waitForReady waits for the cluster to reach the READY phase, failing as soon as it reaches the ERROR phase.
+func waitForReady(ctx context.Context, config authctx.TanzuContext, fn *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterFullName, timeout time.Duration) error {
+	getClusterRetryableFn := func() (retry bool, err error) {
+		resp, err := config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterResourceServiceGetWithContext(ctx, fn)
+		if err != nil {
+			return true, errors.Wrapf(err, "Unable to get Tanzu Mission Control Tanzu Kubernetes cluster entry, name : %s", fn.Name)
+		}
+
+		status := resp.TanzuKubernetesCluster.Status
+		if status == nil || status.Phase == nil {
+			return true, errors.Errorf("cluster %s has no phase yet", fn.Name)
+		}
+
+		switch *status.Phase {
+		case tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhaseREADY:
+			return false, nil
+		case tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterPhaseERROR:
+			return false, errors.Errorf("cluster %s reached the ERROR phase: %s", fn.Name, status.Message)
+		}
+
+		log.Printf("[DEBUG] waiting for cluster(%s) to be in READY phase, current phase: %s", fn.ToString(), *status.Phase)
+
+		return true, errors.Errorf("cluster %s did not reach the READY phase, current phase: %s", fn.Name, *status.Phase)
+	}
+
+	_, err := helper.RetryUntilTimeoutWithContext(ctx, getClusterRetryableFn, pollInterval, timeout)
+
+	return err
+}
+
+func resourceTanzuKubernetesClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	fn := constructFullname(d)
+
+	resp, err := config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterResourceServiceGet(fn)
+	if err != nil {
+		if clienterrors.IsNotFoundError(err) {
+			_ = schema.RemoveFromState(d, m)
+			return diags
+		}
+
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control Tanzu Kubernetes cluster entry, name : %s", fn.Name))
+	}
+
+	cluster := resp.TanzuKubernetesCluster
+
+	d.SetId(cluster.Meta.UID)
+
+	if err := d.Set(common.MetaKey, common.FlattenMeta(cluster.Meta)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	spec, err := flattenSpec(cluster.Spec, readConfiguredVariables(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(SpecKey, spec); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Failed to set the spec for cluster %s", fn.Name))
+	}
+
+	if err := d.Set(StatusKey, flattenStatus(cluster.Status)); err != nil {
+		return diag.FromErr(errors.Wrapf(err, "Failed to set status for the cluster %s", fn.Name))
+	}
+
+	return diags
+}
+
+func resourceTanzuKubernetesClusterInPlaceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+
+	if !common.HasMetaChanged(d) && !d.HasChange(SpecKey) {
+		return resourceTanzuKubernetesClusterRead(ctx, d, m)
+	}
+
+	fn := constructFullname(d)
+
+	getResp, err := config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterResourceServiceGet(fn)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control Tanzu Kubernetes cluster entry, name : %s", fn.Name))
+	}
+
+	spec, err := constructSpec(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cluster := getResp.TanzuKubernetesCluster
+	meta := common.ConstructMeta(d)
+
+	if value, ok := cluster.Meta.Labels[common.CreatorLabelKey]; ok {
+		meta.Labels[common.CreatorLabelKey] = value
+	}
+
+	cluster.Meta.Labels = meta.Labels
+	cluster.Meta.Description = meta.Description
+	// there is some translation error, which results
+	// in mismatch on the server.
+	cluster.Meta.CreationTime = strfmt.DateTime{}
+	cluster.Spec = spec
+
+	_, err = config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterResourceServiceUpdate(
+		&tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterRequest{
+			TanzuKubernetesCluster: &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTanzuKubernetesCluster{
+				FullName: cluster.FullName,
+				Meta:     cluster.Meta,
+				Spec:     cluster.Spec,
+			},
+		},
+	)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control Tanzu Kubernetes cluster entry, name : %s", fn.Name))
+	}
+
+	if d.HasChange(SpecKey) {
+		if err := waitForReady(ctx, config, fn, helper.GetWaitTimeout(d, waitKey, schema.TimeoutUpdate)); err != nil {
+			return clienterrors.ToDiagnostics(err)
+		}
+	}
+
+	return resourceTanzuKubernetesClusterRead(ctx, d, m)
+}
+
+func resourceTanzuKubernetesClusterDelete(ctx context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	fn := constructFullname(d)
+
+	err := config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterResourceServiceDelete(fn)
+	if err != nil && !clienterrors.IsNotFoundError(err) {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control Tanzu Kubernetes cluster entry, name : %s", fn.Name))
+	}
+
+	getClusterRetryableFn := func() (retry bool, err error) {
+		_, err = config.TMCConnection.TanzuKubernetesClusterResourceService.TanzuKubernetesClusterResourceServiceGetWithContext(ctx, fn)
+		if err == nil {
+			log.Printf("[DEBUG] cluster(%s) deletion in progress", fn.ToString())
+			return true, errors.New("cluster deletion in progress")
+		}
+
+		if !clienterrors.IsNotFoundError(err) {
+			return true, err
+		}
+
+		return false, nil
+	}
+
+	_, err = helper.RetryUntilTimeoutWithContext(ctx, getClusterRetryableFn, pollInterval, helper.GetWaitTimeout(d, waitKey, schema.TimeoutDelete))
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "verify %s Tanzu Kubernetes cluster resource clean up", fn.Name))
+	}
+
+	_ = schema.RemoveFromState(d, m)
+
+	return diags
+}
+
+// resourceTanzuKubernetesClusterImporter imports a cluster using an ID of the form <management_cluster_name>/<provisioner_name>/<name>.
+func resourceTanzuKubernetesClusterImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts, err := helper.ParseImportID(d.Id(), ManagementClusterNameKey, ProvisionerNameKey, NameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, key := range []string{ManagementClusterNameKey, ProvisionerNameKey, NameKey} {
+		if err = d.Set(key, parts[i]); err != nil {
+			return nil, errors.Wrapf(err, "Failed to set %s for the cluster %s", key, d.Id())
+		}
+	}
+
+	return helper.ReadImportedState(ctx, d, m, resourceTanzuKubernetesClusterRead)
+}
@@ -0,0 +1,241 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzukubernetescluster
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
+)
+
+var tanzuKubernetesClusterSchema = map[string]*schema.Schema{
+	ManagementClusterNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the management cluster",
+		Required:    true,
+		ForceNew:    true,
+	},
+	ProvisionerNameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of the provisioner",
+		Required:    true,
+		ForceNew:    true,
+	},
+	NameKey: {
+		Type:        schema.TypeString,
+		Description: "Name of this cluster",
+		Required:    true,
+		ForceNew:    true,
+	},
+	common.MetaKey: common.Meta,
+	SpecKey:        specSchema,
+	StatusKey: {
+		Type:        schema.TypeMap,
+		Description: "Status of the cluster: phase, health and message",
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	waitKey: {
+		Type:        schema.TypeString,
+		Description: "Wait timeout duration until cluster resource reaches READY state. Accepted timeout duration values like 5s, 45m, or 3h, higher than zero. When not set, the timeouts of the resource apply.",
+		Default:     helper.DefaultWaitTimeoutValue,
+		Optional:    true,
+		DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+			return true
+		},
+	},
+	common.AdoptExistingKey: common.AdoptExisting,
+}
+
+var specSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "Spec for the cluster",
+	Required:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			clusterGroupKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the cluster group to which this cluster belongs",
+				Default:     clusterGroupDefaultValue,
+				Optional:    true,
+			},
+			proxyNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the proxy configuration to use for the cluster",
+				Optional:    true,
+			},
+			imageRegistryKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the image registry configuration to use for the cluster",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			topologyKey: topologySchema,
+		},
+	},
+}
+
+var topologySchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "The ClusterClass topology of the cluster",
+	Required:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			clusterClassKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the ClusterClass of the cluster, for example tanzukubernetescluster",
+				Required:    true,
+				ForceNew:    true,
+			},
+			versionKey: {
+				Type:        schema.TypeString,
+				Description: "Kubernetes version of the cluster, for example v1.26.5+vmware.2-fips.1",
+				Required:    true,
+			},
+			clusterVariablesKey: {
+				Type: schema.TypeString,
+				Description: "Variables of the ClusterClass as a JSON object of variable names to values, for example jsonencode({ vmClass = \"best-effort-large\" }). " +
+					"Only the configured variables are tracked: the variables defaulted by the ClusterClass are ignored on read. All the variables are read when not set.",
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSON,
+			},
+			controlPlaneKey: {
+				Type:        schema.TypeList,
+				Description: "Control plane of the cluster",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						replicasKey: {
+							Type:         schema.TypeInt,
+							Description:  "Number of control plane nodes, 1 or 3 for a highly available control plane",
+							Required:     true,
+							ValidateFunc: validation.IntInSlice([]int{1, 3}),
+						},
+						osImageKey: osImageSchema,
+					},
+				},
+			},
+			nodePoolKey: {
+				Type:        schema.TypeList,
+				Description: "Node pools of the cluster",
+				Required:    true,
+				MinItems:    1,
+				Elem:        nodePoolSchema,
+			},
+			networkKey: {
+				Type:        schema.TypeList,
+				Description: "Network of the cluster",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						podCidrBlocksKey: {
+							Type:        schema.TypeList,
+							Description: "Pod CIDR blocks of the cluster",
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						serviceCidrBlocksKey: {
+							Type:        schema.TypeList,
+							Description: "Service CIDR blocks of the cluster",
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						serviceDomainKey: {
+							Type:        schema.TypeString,
+							Description: "Domain name for the services of the cluster",
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+var nodePoolSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		nodePoolNameKey: {
+			Type:        schema.TypeString,
+			Description: "Name of the node pool",
+			Required:    true,
+		},
+		nodePoolDescriptionKey: {
+			Type:        schema.TypeString,
+			Description: "Description of the node pool",
+			Optional:    true,
+		},
+		workerClassKey: {
+			Type:        schema.TypeString,
+			Description: "Worker class of the ClusterClass backing the node pool, for example node-pool",
+			Required:    true,
+		},
+		replicasKey: {
+			Type:         schema.TypeInt,
+			Description:  "Number of nodes of the node pool",
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		failureDomainKey: {
+			Type:        schema.TypeString,
+			Description: "Failure domain of the node pool, for example a vSphere zone",
+			Optional:    true,
+		},
+		overrideVariablesKey: {
+			Type:             schema.TypeString,
+			Description:      "Variables overriding the cluster variables for the node pool, as a JSON object of variable names to values",
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: suppressEquivalentJSON,
+		},
+		osImageKey: osImageSchema,
+	},
+}
+
+var osImageSchema = &schema.Schema{
+	Type:        schema.TypeList,
+	Description: "OS image of the nodes, defaulted from the Kubernetes release when not set",
+	Optional:    true,
+	Computed:    true,
+	MaxItems:    1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			osImageNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the OS image, for example ubuntu or photon",
+				Required:    true,
+			},
+			osImageVersionKey: {
+				Type:        schema.TypeString,
+				Description: "Version of the OS image",
+				Optional:    true,
+				Computed:    true,
+			},
+			osImageArchKey: {
+				Type:        schema.TypeString,
+				Description: "Architecture of the OS image",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	},
+}
@@ -0,0 +1,438 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzukubernetescluster
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	tkcmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster"
+)
+
+// configuredVariables holds the variables known from the configuration, along with the order of the node pools,
+// which are needed to flatten the cluster without spurious diffs.
+type configuredVariables struct {
+	cluster   string
+	nodePools []configuredNodePool
+}
+
+type configuredNodePool struct {
+	name      string
+	overrides string
+}
+
+func constructFullname(d *schema.ResourceData) (fullname *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterFullName) {
+	fullname = &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterFullName{}
+
+	fullname.ManagementClusterName, _ = d.Get(ManagementClusterNameKey).(string)
+	fullname.ProvisionerName, _ = d.Get(ProvisionerNameKey).(string)
+	fullname.Name, _ = d.Get(NameKey).(string)
+
+	return fullname
+}
+
+func constructSpec(d *schema.ResourceData) (*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterSpec, error) {
+	spec := &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterSpec{
+		TmcManaged: true,
+	}
+
+	data, _ := d.Get(SpecKey).([]interface{})
+	if len(data) == 0 || data[0] == nil {
+		return spec, nil
+	}
+
+	specData, _ := data[0].(map[string]interface{})
+
+	if v, ok := specData[clusterGroupKey]; ok {
+		helper.SetPrimitiveValue(v, &spec.ClusterGroupName, clusterGroupKey)
+	}
+
+	if v, ok := specData[proxyNameKey]; ok {
+		helper.SetPrimitiveValue(v, &spec.ProxyName, proxyNameKey)
+	}
+
+	if v, ok := specData[imageRegistryKey]; ok {
+		helper.SetPrimitiveValue(v, &spec.ImageRegistry, imageRegistryKey)
+	}
+
+	if v, ok := specData[topologyKey]; ok {
+		topologyData, _ := v.([]interface{})
+
+		topology, err := constructTopology(topologyData)
+		if err != nil {
+			return nil, err
+		}
+
+		spec.Topology = topology
+	}
+
+	return spec, nil
+}
+
+func constructTopology(data []interface{}) (*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTopology, error) {
+	topology := &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTopology{}
+
+	if len(data) == 0 || data[0] == nil {
+		return topology, nil
+	}
+
+	topologyData, _ := data[0].(map[string]interface{})
+
+	if v, ok := topologyData[clusterClassKey]; ok {
+		helper.SetPrimitiveValue(v, &topology.ClusterClass, clusterClassKey)
+	}
+
+	if v, ok := topologyData[versionKey]; ok {
+		helper.SetPrimitiveValue(v, &topology.Version, versionKey)
+	}
+
+	variablesJSON, _ := topologyData[clusterVariablesKey].(string)
+
+	variables, err := constructVariables(variablesJSON)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", clusterVariablesKey)
+	}
+
+	topology.ClusterVariables = variables
+
+	if v, ok := topologyData[controlPlaneKey]; ok {
+		controlPlaneData, _ := v.([]interface{})
+		topology.ControlPlane = constructControlPlane(controlPlaneData)
+	}
+
+	if v, ok := topologyData[nodePoolKey]; ok {
+		nodePoolsData, _ := v.([]interface{})
+
+		for _, nodePoolData := range nodePoolsData {
+			nodePool, err := constructNodePool(nodePoolData)
+			if err != nil {
+				return nil, err
+			}
+
+			topology.NodePools = append(topology.NodePools, nodePool)
+		}
+	}
+
+	if v, ok := topologyData[networkKey]; ok {
+		networkData, _ := v.([]interface{})
+		topology.Network = constructNetwork(networkData)
+	}
+
+	return topology, nil
+}
+
+func constructControlPlane(data []interface{}) *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterControlPlane {
+	controlPlane := &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterControlPlane{}
+
+	if len(data) == 0 || data[0] == nil {
+		return controlPlane
+	}
+
+	controlPlaneData, _ := data[0].(map[string]interface{})
+
+	if v, ok := controlPlaneData[replicasKey]; ok {
+		replicas, _ := v.(int)
+		controlPlane.Replicas = int32(replicas)
+	}
+
+	if v, ok := controlPlaneData[osImageKey]; ok {
+		osImageData, _ := v.([]interface{})
+		controlPlane.OsImage = constructOsImage(osImageData)
+	}
+
+	return controlPlane
+}
+
+func constructNodePool(data interface{}) (*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePool, error) {
+	nodePool := &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePool{
+		Info: &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePoolInfo{},
+		Spec: &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePoolSpec{},
+	}
+
+	nodePoolData, _ := data.(map[string]interface{})
+	if nodePoolData == nil {
+		return nodePool, nil
+	}
+
+	if v, ok := nodePoolData[nodePoolNameKey]; ok {
+		helper.SetPrimitiveValue(v, &nodePool.Info.Name, nodePoolNameKey)
+	}
+
+	if v, ok := nodePoolData[nodePoolDescriptionKey]; ok {
+		helper.SetPrimitiveValue(v, &nodePool.Info.Description, nodePoolDescriptionKey)
+	}
+
+	if v, ok := nodePoolData[workerClassKey]; ok {
+		helper.SetPrimitiveValue(v, &nodePool.Spec.WorkerClass, workerClassKey)
+	}
+
+	if v, ok := nodePoolData[replicasKey]; ok {
+		replicas, _ := v.(int)
+		nodePool.Spec.Replicas = int32(replicas)
+	}
+
+	if v, ok := nodePoolData[failureDomainKey]; ok {
+		helper.SetPrimitiveValue(v, &nodePool.Spec.FailureDomain, failureDomainKey)
+	}
+
+	overridesJSON, _ := nodePoolData[overrideVariablesKey].(string)
+
+	overrides, err := constructVariables(overridesJSON)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s of node pool %s", overrideVariablesKey, nodePool.Info.Name)
+	}
+
+	nodePool.Spec.OverrideVariables = overrides
+
+	if v, ok := nodePoolData[osImageKey]; ok {
+		osImageData, _ := v.([]interface{})
+		nodePool.Spec.OsImage = constructOsImage(osImageData)
+	}
+
+	return nodePool, nil
+}
+
+func constructOsImage(data []interface{}) *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterOsImage {
+	if len(data) == 0 || data[0] == nil {
+		return nil
+	}
+
+	osImage := &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterOsImage{}
+	osImageData, _ := data[0].(map[string]interface{})
+
+	if v, ok := osImageData[osImageNameKey]; ok {
+		helper.SetPrimitiveValue(v, &osImage.Name, osImageNameKey)
+	}
+
+	if v, ok := osImageData[osImageVersionKey]; ok {
+		helper.SetPrimitiveValue(v, &osImage.Version, osImageVersionKey)
+	}
+
+	if v, ok := osImageData[osImageArchKey]; ok {
+		helper.SetPrimitiveValue(v, &osImage.Arch, osImageArchKey)
+	}
+
+	return osImage
+}
+
+func constructNetwork(data []interface{}) *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNetwork {
+	if len(data) == 0 || data[0] == nil {
+		return nil
+	}
+
+	network := &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNetwork{}
+	networkData, _ := data[0].(map[string]interface{})
+
+	if v, ok := networkData[podCidrBlocksKey]; ok {
+		network.PodCidrBlocks = constructStringList(v)
+	}
+
+	if v, ok := networkData[serviceCidrBlocksKey]; ok {
+		network.ServiceCidrBlocks = constructStringList(v)
+	}
+
+	if v, ok := networkData[serviceDomainKey]; ok {
+		helper.SetPrimitiveValue(v, &network.ServiceDomain, serviceDomainKey)
+	}
+
+	return network
+}
+
+func constructStringList(data interface{}) []string {
+	values, _ := data.([]interface{})
+	out := make([]string, 0, len(values))
+
+	for _, v := range values {
+		var value string
+
+		helper.SetPrimitiveValue(v, &value, "")
+
+		out = append(out, value)
+	}
+
+	return out
+}
+
+// readConfiguredVariables reads the variables and the node pool order known from the configuration or the state.
+func readConfiguredVariables(d *schema.ResourceData) *configuredVariables {
+	configured := &configuredVariables{}
+
+	configured.cluster, _ = d.Get(helper.GetFirstElementOf(SpecKey, topologyKey, clusterVariablesKey)).(string)
+
+	nodePoolsData, _ := d.Get(helper.GetFirstElementOf(SpecKey, topologyKey, nodePoolKey)).([]interface{})
+
+	for _, data := range nodePoolsData {
+		nodePoolData, _ := data.(map[string]interface{})
+		if nodePoolData == nil {
+			continue
+		}
+
+		name, _ := nodePoolData[nodePoolNameKey].(string)
+		overrides, _ := nodePoolData[overrideVariablesKey].(string)
+
+		configured.nodePools = append(configured.nodePools, configuredNodePool{name: name, overrides: overrides})
+	}
+
+	return configured
+}
+
+func flattenSpec(spec *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterSpec, configured *configuredVariables) ([]interface{}, error) {
+	if spec == nil {
+		return []interface{}{}, nil
+	}
+
+	data := map[string]interface{}{
+		clusterGroupKey:  spec.ClusterGroupName,
+		proxyNameKey:     spec.ProxyName,
+		imageRegistryKey: spec.ImageRegistry,
+	}
+
+	if spec.Topology != nil {
+		topology, err := flattenTopology(spec.Topology, configured)
+		if err != nil {
+			return nil, err
+		}
+
+		data[topologyKey] = topology
+	}
+
+	return []interface{}{data}, nil
+}
+
+func flattenTopology(topology *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterTopology, configured *configuredVariables) ([]interface{}, error) {
+	data := map[string]interface{}{
+		clusterClassKey: topology.ClusterClass,
+		versionKey:      topology.Version,
+	}
+
+	variables, err := flattenVariables(topology.ClusterVariables, configured.cluster)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", clusterVariablesKey)
+	}
+
+	data[clusterVariablesKey] = variables
+
+	if topology.ControlPlane != nil {
+		data[controlPlaneKey] = []interface{}{
+			map[string]interface{}{
+				replicasKey: int(topology.ControlPlane.Replicas),
+				osImageKey:  flattenOsImage(topology.ControlPlane.OsImage),
+			},
+		}
+	}
+
+	nodePools, err := flattenNodePools(topology.NodePools, configured.nodePools)
+	if err != nil {
+		return nil, err
+	}
+
+	data[nodePoolKey] = nodePools
+
+	if topology.Network != nil {
+		data[networkKey] = []interface{}{
+			map[string]interface{}{
+				podCidrBlocksKey:     topology.Network.PodCidrBlocks,
+				serviceCidrBlocksKey: topology.Network.ServiceCidrBlocks,
+				serviceDomainKey:     topology.Network.ServiceDomain,
+			},
+		}
+	}
+
+	return []interface{}{data}, nil
+}
+
+// flattenNodePools flattens the node pools in the order of the configuration, the node pools unknown to the
+// configuration being appended in the order of the server, so that terraform computes the diff per node pool.
+func flattenNodePools(nodePools []*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePool, configured []configuredNodePool) ([]interface{}, error) {
+	byName := make(map[string]*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePool, len(nodePools))
+	ordered := make([]*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePool, 0, len(nodePools))
+	overrides := make(map[string]string, len(configured))
+
+	for _, nodePool := range nodePools {
+		if nodePool == nil || nodePool.Info == nil {
+			continue
+		}
+
+		byName[nodePool.Info.Name] = nodePool
+	}
+
+	for _, each := range configured {
+		overrides[each.name] = each.overrides
+
+		if nodePool, ok := byName[each.name]; ok {
+			ordered = append(ordered, nodePool)
+			delete(byName, each.name)
+		}
+	}
+
+	for _, nodePool := range nodePools {
+		if nodePool == nil || nodePool.Info == nil {
+			continue
+		}
+
+		if _, ok := byName[nodePool.Info.Name]; ok {
+			ordered = append(ordered, nodePool)
+		}
+	}
+
+	data := make([]interface{}, 0, len(ordered))
+
+	for _, nodePool := range ordered {
+		nodePoolData := map[string]interface{}{
+			nodePoolNameKey:        nodePool.Info.Name,
+			nodePoolDescriptionKey: nodePool.Info.Description,
+		}
+
+		if nodePool.Spec != nil {
+			overrideVariables, err := flattenVariables(nodePool.Spec.OverrideVariables, overrides[nodePool.Info.Name])
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid %s of node pool %s", overrideVariablesKey, nodePool.Info.Name)
+			}
+
+			nodePoolData[workerClassKey] = nodePool.Spec.WorkerClass
+			nodePoolData[replicasKey] = int(nodePool.Spec.Replicas)
+			nodePoolData[failureDomainKey] = nodePool.Spec.FailureDomain
+			nodePoolData[overrideVariablesKey] = overrideVariables
+			nodePoolData[osImageKey] = flattenOsImage(nodePool.Spec.OsImage)
+		}
+
+		data = append(data, nodePoolData)
+	}
+
+	return data, nil
+}
+
+func flattenOsImage(osImage *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterOsImage) []interface{} {
+	if osImage == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			osImageNameKey:    osImage.Name,
+			osImageVersionKey: osImage.Version,
+			osImageArchKey:    osImage.Arch,
+		},
+	}
+}
+
+func flattenStatus(status *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterStatus) map[string]interface{} {
+	if status == nil {
+		return map[string]interface{}{}
+	}
+
+	data := map[string]interface{}{
+		healthKey:  status.Health,
+		messageKey: status.Message,
+	}
+
+	if status.Phase != nil {
+		data[phaseKey] = string(*status.Phase)
+	}
+
+	return data
+}
@@ -0,0 +1,117 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzukubernetescluster
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	tkcmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster"
+)
+
+func testNodePool(name string, replicas int32, overrides ...*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable) *tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePool {
+	return &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePool{
+		Info: &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePoolInfo{Name: name},
+		Spec: &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePoolSpec{
+			WorkerClass:       "node-pool",
+			Replicas:          replicas,
+			OverrideVariables: overrides,
+		},
+	}
+}
+
+func TestFlattenNodePools(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       []*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePool
+		configured  []configuredNodePool
+		expected    []string
+	}{
+		{
+			description: "node pools follow the order of the configuration",
+			input:       []*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePool{testNodePool("a", 1), testNodePool("b", 1), testNodePool("c", 1)},
+			configured:  []configuredNodePool{{name: "c"}, {name: "a"}},
+			expected:    []string{"c", "a", "b"},
+		},
+		{
+			description: "node pools missing on the server are dropped",
+			input:       []*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterNodePool{testNodePool("b", 1), nil},
+			configured:  []configuredNodePool{{name: "a"}, {name: "b"}},
+			expected:    []string{"b"},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual, err := flattenNodePools(test.input, test.configured)
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(actual))
+			for _, nodePool := range actual {
+				names = append(names, nodePool.(map[string]interface{})[nodePoolNameKey].(string))
+			}
+
+			require.Equal(t, test.expected, names)
+		})
+	}
+}
+
+func TestConstructAndFlattenSpec(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, tanzuKubernetesClusterSchema, map[string]interface{}{
+		ManagementClusterNameKey: "supervisor",
+		ProvisionerNameKey:       "namespace-1",
+		NameKey:                  "tkc",
+		SpecKey: []interface{}{
+			map[string]interface{}{
+				topologyKey: []interface{}{
+					map[string]interface{}{
+						clusterClassKey:     "tanzukubernetescluster",
+						versionKey:          "v1.26.5+vmware.2-fips.1",
+						clusterVariablesKey: `{"vmClass": "best-effort-large", "storageClass": "vsan"}`,
+						controlPlaneKey: []interface{}{
+							map[string]interface{}{replicasKey: 3},
+						},
+						nodePoolKey: []interface{}{
+							map[string]interface{}{
+								nodePoolNameKey:      "md-0",
+								workerClassKey:       "node-pool",
+								replicasKey:          2,
+								overrideVariablesKey: `{"vmClass": "guaranteed-large"}`,
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	spec, err := constructSpec(d)
+	require.NoError(t, err)
+	require.Equal(t, clusterGroupDefaultValue, spec.ClusterGroupName)
+	require.Equal(t, "tanzukubernetescluster", spec.Topology.ClusterClass)
+	require.Equal(t, int32(3), spec.Topology.ControlPlane.Replicas)
+	require.Len(t, spec.Topology.ClusterVariables, 2)
+	require.Equal(t, []*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable{{Name: "vmClass", Value: "guaranteed-large"}}, spec.Topology.NodePools[0].Spec.OverrideVariables)
+
+	// The server defaults the variables which are not set.
+	spec.Topology.ClusterVariables = append(spec.Topology.ClusterVariables, &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable{Name: "defaultStorageClass", Value: "vsan"})
+	spec.Topology.NodePools[0].Spec.OverrideVariables = append(spec.Topology.NodePools[0].Spec.OverrideVariables, &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable{Name: "storageClass", Value: "vsan"})
+
+	flattened, err := flattenSpec(spec, readConfiguredVariables(d))
+	require.NoError(t, err)
+	require.NoError(t, d.Set(SpecKey, flattened))
+
+	topology := flattened[0].(map[string]interface{})[topologyKey].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, `{"storageClass":"vsan","vmClass":"best-effort-large"}`, topology[clusterVariablesKey])
+	require.Equal(t, `{"vmClass":"guaranteed-large"}`, topology[nodePoolKey].([]interface{})[0].(map[string]interface{})[overrideVariablesKey])
+}
@@ -0,0 +1,107 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzukubernetescluster
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	tkcmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster"
+)
+
+// constructVariables builds the ClusterClass variables from a JSON object of variable names to values.
+// The variables are sorted by name so that the requests are stable.
+func constructVariables(variablesJSON string) ([]*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable, error) {
+	variables := make([]*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable, 0)
+
+	if variablesJSON == "" {
+		return variables, nil
+	}
+
+	values := make(map[string]interface{})
+
+	if err := json.Unmarshal([]byte(variablesJSON), &values); err != nil {
+		return nil, errors.Wrap(err, "variables must be a JSON object of variable names to values")
+	}
+
+	for name, value := range values {
+		variables = append(variables, &tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable{
+			Name:  name,
+			Value: value,
+		})
+	}
+
+	sort.SliceStable(variables, func(i, j int) bool {
+		return variables[i].Name < variables[j].Name
+	})
+
+	return variables, nil
+}
+
+// flattenVariables renders the ClusterClass variables as a JSON object of variable names to values.
+// When the variables are known from the configuration, only those variables are kept: the ClusterClass defaults the
+// variables which are not set, and keeping them would show a diff on every plan.
+// All the variables are kept when none are known from the configuration, as on import.
+func flattenVariables(variables []*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable, configuredJSON string) (string, error) {
+	var configured map[string]interface{}
+
+	if configuredJSON != "" {
+		if err := json.Unmarshal([]byte(configuredJSON), &configured); err != nil {
+			return "", errors.Wrap(err, "variables must be a JSON object of variable names to values")
+		}
+	}
+
+	values := make(map[string]interface{})
+
+	for _, variable := range variables {
+		if variable == nil {
+			continue
+		}
+
+		if configured != nil {
+			if _, ok := configured[variable.Name]; !ok {
+				continue
+			}
+		}
+
+		values[variable.Name] = variable.Value
+	}
+
+	if len(values) == 0 && configured == nil {
+		return "", nil
+	}
+
+	// encoding/json sorts the keys of maps, rendering a stable document.
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// suppressEquivalentJSON suppresses the diff between JSON documents which only differ in formatting or key order.
+func suppressEquivalentJSON(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	if oldValue == newValue {
+		return true
+	}
+
+	var oldJSON, newJSON interface{}
+
+	if err := json.Unmarshal([]byte(oldValue), &oldJSON); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(newValue), &newJSON); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldJSON, newJSON)
+}
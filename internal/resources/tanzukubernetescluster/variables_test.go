@@ -0,0 +1,131 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzukubernetescluster
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tkcmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzukubernetescluster"
+)
+
+func TestConstructVariables(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		description string
+		input       string
+		expected    []*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable
+		expectErr   bool
+	}{
+		{
+			description: "no variables",
+			input:       "",
+			expected:    []*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable{},
+		},
+		{
+			description: "variables are sorted by name",
+			input:       `{"vmClass": "best-effort-large", "storageClass": "vsan", "nodePoolVolumes": [{"name": "etcd", "capacity": {"storage": "4G"}}]}`,
+			expected: []*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable{
+				{
+					Name: "nodePoolVolumes",
+					Value: []interface{}{
+						map[string]interface{}{"name": "etcd", "capacity": map[string]interface{}{"storage": "4G"}},
+					},
+				},
+				{Name: "storageClass", Value: "vsan"},
+				{Name: "vmClass", Value: "best-effort-large"},
+			},
+		},
+		{
+			description: "variables must be a JSON object",
+			input:       `["vmClass"]`,
+			expectErr:   true,
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual, err := constructVariables(test.input)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestFlattenVariables(t *testing.T) {
+	t.Parallel()
+
+	serverVariables := []*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable{
+		{Name: "vmClass", Value: "best-effort-large"},
+		{Name: "storageClass", Value: "vsan"},
+		{Name: "defaultStorageClass", Value: "vsan"},
+		{Name: "ntp", Value: "time.vmware.com"},
+		nil,
+	}
+
+	cases := []struct {
+		description string
+		input       []*tkcmodel.VmwareTanzuManageV1alpha1ManagementclusterProvisionerTanzukubernetesclusterVariable
+		configured  string
+		expected    string
+	}{
+		{
+			description: "server defaulted variables are ignored",
+			input:       serverVariables,
+			configured:  `{ "vmClass" : "best-effort-large", "storageClass": "vsan" }`,
+			expected:    `{"storageClass":"vsan","vmClass":"best-effort-large"}`,
+		},
+		{
+			description: "configured variables changed on the server are reported",
+			input:       serverVariables,
+			configured:  `{"vmClass": "best-effort-small"}`,
+			expected:    `{"vmClass":"best-effort-large"}`,
+		},
+		{
+			description: "configured variables removed on the server are reported",
+			input:       nil,
+			configured:  `{"vmClass": "best-effort-small"}`,
+			expected:    `{}`,
+		},
+		{
+			description: "all variables are kept when none are configured",
+			input:       serverVariables,
+			configured:  "",
+			expected:    `{"defaultStorageClass":"vsan","ntp":"time.vmware.com","storageClass":"vsan","vmClass":"best-effort-large"}`,
+		},
+		{
+			description: "no variables",
+			input:       nil,
+			configured:  "",
+			expected:    "",
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			actual, err := flattenVariables(test.input, test.configured)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestSuppressEquivalentJSON(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, suppressEquivalentJSON("", `{"a":1,"b":[1,2]}`, "{ \"b\": [1, 2],\n \"a\": 1 }", nil))
+	require.False(t, suppressEquivalentJSON("", `{"a":1,"b":[1,2]}`, `{"a":1,"b":[2,1]}`, nil))
+	require.False(t, suppressEquivalentJSON("", "", `{"a":1}`, nil))
+}
@@ -0,0 +1,27 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzupackage
+
+const (
+	VersionsResourceName = "tanzu-mission-control_package_versions"
+
+	ClusterNameKey           = "cluster_name"
+	ManagementClusterNameKey = "management_cluster_name"
+	ProvisionerNameKey       = "provisioner_name"
+	namespaceNameKey         = "namespace_name"
+	packageMetadataNameKey   = "package_metadata_name"
+	versionsKey              = "versions"
+	packagesKey              = "packages"
+	nameKey                  = "name"
+	versionKey               = "version"
+	repositoryNameKey        = "repository_name"
+	releasedAtKey            = "released_at"
+
+	attachedValue = "attached"
+
+	// globalPackageNamespace is the namespace holding the packages available in all the namespaces of the cluster.
+	globalPackageNamespace = "tanzu-package-repo-global"
+)
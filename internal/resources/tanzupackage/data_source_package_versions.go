@@ -0,0 +1,163 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzupackage
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	tanzupackagemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackage"
+)
+
+func DataSourcePackageVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePackageVersionsRead,
+		Schema: map[string]*schema.Schema{
+			ClusterNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the cluster.",
+				Required:    true,
+			},
+			ManagementClusterNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the management cluster.",
+				Default:     attachedValue,
+				Optional:    true,
+			},
+			ProvisionerNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the provisioner.",
+				Default:     attachedValue,
+				Optional:    true,
+			},
+			namespaceNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the namespace the package is available in. The packages of the repositories managed by Tanzu Mission Control are available in the `" + globalPackageNamespace + "` namespace.",
+				Default:     globalPackageNamespace,
+				Optional:    true,
+			},
+			packageMetadataNameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the package metadata, for example `cert-manager.tanzu.vmware.com`.",
+				Required:    true,
+			},
+			versionsKey: {
+				Type:        schema.TypeList,
+				Description: "Versions of the package available on the cluster.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			packagesKey: {
+				Type:        schema.TypeList,
+				Description: "Packages available on the cluster, one for each version.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						nameKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the package.",
+							Computed:    true,
+						},
+						versionKey: {
+							Type:        schema.TypeString,
+							Description: "Version of the package.",
+							Computed:    true,
+						},
+						repositoryNameKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the package repository providing the package.",
+							Computed:    true,
+						},
+						releasedAtKey: {
+							Type:        schema.TypeString,
+							Description: "Date on which the version of the package was released.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePackageVersionsRead(_ context.Context, d *schema.ResourceData, m interface{}) (diags diag.Diagnostics) {
+	config := m.(authctx.TanzuContext)
+
+	fn := &tanzupackagemodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageFullName{
+		ClusterName:           d.Get(ClusterNameKey).(string),
+		ManagementClusterName: d.Get(ManagementClusterNameKey).(string),
+		ProvisionerName:       d.Get(ProvisionerNameKey).(string),
+		NamespaceName:         d.Get(namespaceNameKey).(string),
+		MetadataName:          d.Get(packageMetadataNameKey).(string),
+	}
+
+	resp, err := config.TMCConnection.TanzupackageResourceService.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageResourceServiceList(fn)
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to list Tanzu Mission Control packages, name : %s", fn.MetadataName))
+	}
+
+	d.SetId(strings.Join([]string{fn.ManagementClusterName, fn.ProvisionerName, fn.ClusterName, fn.NamespaceName, fn.MetadataName}, "/"))
+
+	if err := d.Set(versionsKey, flattenVersions(resp.Packages)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(packagesKey, flattenPackages(resp.Packages)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func flattenVersions(packages []*tanzupackagemodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackagePackage) []interface{} {
+	versions := make([]interface{}, 0, len(packages))
+
+	for _, pkg := range packages {
+		if pkg == nil || pkg.Spec == nil {
+			continue
+		}
+
+		versions = append(versions, pkg.Spec.Version)
+	}
+
+	return versions
+}
+
+func flattenPackages(packages []*tanzupackagemodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackagePackage) []interface{} {
+	flattenPackagesData := make([]interface{}, 0, len(packages))
+
+	for _, pkg := range packages {
+		if pkg == nil {
+			continue
+		}
+
+		flattenPackageData := make(map[string]interface{})
+
+		if pkg.FullName != nil {
+			flattenPackageData[nameKey] = pkg.FullName.Name
+		}
+
+		if pkg.Spec != nil {
+			flattenPackageData[versionKey] = pkg.Spec.Version
+			flattenPackageData[repositoryNameKey] = pkg.Spec.RepositoryName
+
+			if releasedAt := time.Time(pkg.Spec.ReleasedAt); !releasedAt.IsZero() {
+				flattenPackageData[releasedAtKey] = releasedAt.Format(time.RFC3339)
+			}
+		}
+
+		flattenPackagesData = append(flattenPackagesData, flattenPackageData)
+	}
+
+	return flattenPackagesData
+}
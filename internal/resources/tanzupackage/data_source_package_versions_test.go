@@ -0,0 +1,82 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package tanzupackage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/require"
+
+	tanzupackagemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/tanzupackage"
+)
+
+func TestFlattenPackages(t *testing.T) {
+	t.Parallel()
+
+	releasedAt := time.Date(2023, time.March, 2, 10, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		description      string
+		input            []*tanzupackagemodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackagePackage
+		expectedVersions []interface{}
+		expectedPackages []interface{}
+	}{
+		{
+			description:      "check for no packages",
+			input:            nil,
+			expectedVersions: []interface{}{},
+			expectedPackages: []interface{}{},
+		},
+		{
+			description: "normal scenario with two versions of a package",
+			input: []*tanzupackagemodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackagePackage{
+				{
+					FullName: &tanzupackagemodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageFullName{
+						Name: "cert-manager.tanzu.vmware.com.1.7.2+vmware.3-tkg.1",
+					},
+					Spec: &tanzupackagemodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageSpec{
+						Version:        "1.7.2+vmware.3-tkg.1",
+						RepositoryName: "tanzu-standard",
+					},
+				},
+				{
+					FullName: &tanzupackagemodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageFullName{
+						Name: "cert-manager.tanzu.vmware.com.1.10.2+vmware.1-tkg.1",
+					},
+					Spec: &tanzupackagemodel.VmwareTanzuManageV1alpha1ClusterNamespaceTanzupackageMetadataPackageSpec{
+						Version:        "1.10.2+vmware.1-tkg.1",
+						RepositoryName: "tanzu-standard",
+						ReleasedAt:     strfmt.DateTime(releasedAt),
+					},
+				},
+			},
+			expectedVersions: []interface{}{"1.7.2+vmware.3-tkg.1", "1.10.2+vmware.1-tkg.1"},
+			expectedPackages: []interface{}{
+				map[string]interface{}{
+					nameKey:           "cert-manager.tanzu.vmware.com.1.7.2+vmware.3-tkg.1",
+					versionKey:        "1.7.2+vmware.3-tkg.1",
+					repositoryNameKey: "tanzu-standard",
+				},
+				map[string]interface{}{
+					nameKey:           "cert-manager.tanzu.vmware.com.1.10.2+vmware.1-tkg.1",
+					versionKey:        "1.10.2+vmware.1-tkg.1",
+					repositoryNameKey: "tanzu-standard",
+					releasedAtKey:     "2023-03-02T10:00:00Z",
+				},
+			},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.description, func(t *testing.T) {
+			require.Equal(t, test.expectedVersions, flattenVersions(test.input))
+			require.Equal(t, test.expectedPackages, flattenPackages(test.input))
+		})
+	}
+}
@@ -6,6 +6,11 @@ SPDX-License-Identifier: MPL-2.0
 package workspace
 
 const (
-	ResourceName = "tanzu-mission-control_workspace"
-	NameKey      = "name"
+	ResourceName           = "tanzu-mission-control_workspace"
+	WorkspacesResourceName = "tanzu-mission-control_workspaces"
+	NameKey                = "name"
+	workspacesKey          = "workspaces"
+	uidKey                 = "uid"
+	descriptionKey         = "description"
+	labelsKey              = "labels"
 )
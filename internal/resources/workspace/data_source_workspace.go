@@ -47,7 +47,7 @@ func dataSourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, m inte
 			return
 		}
 
-		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control workspace entry, name : %s", workspaceName))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control workspace entry, name : %s", workspaceName))
 	}
 
 	d.SetId(resp.Workspace.Meta.UID)
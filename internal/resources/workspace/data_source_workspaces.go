@@ -0,0 +1,156 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package workspace
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/pkg/errors"
+
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
+	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/pagination"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
+	optionsmodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/options"
+	workspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/workspace"
+)
+
+func DataSourceWorkspaces() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWorkspacesRead,
+		Schema: map[string]*schema.Schema{
+			NameKey: {
+				Type:        schema.TypeString,
+				Description: "Name of the workspaces to list, supports globbing with *.",
+				Optional:    true,
+			},
+			labelsKey: {
+				Type:        schema.TypeMap,
+				Description: "Only list the workspaces having all these labels.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			workspacesKey: {
+				Type:        schema.TypeList,
+				Description: "Workspaces matching the filters, sorted by name.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						uidKey: {
+							Type:        schema.TypeString,
+							Description: "UID of the workspace.",
+							Computed:    true,
+						},
+						NameKey: {
+							Type:        schema.TypeString,
+							Description: "Name of the workspace.",
+							Computed:    true,
+						},
+						descriptionKey: {
+							Type:        schema.TypeString,
+							Description: "Description of the workspace.",
+							Computed:    true,
+						},
+						labelsKey: {
+							Type:        schema.TypeMap,
+							Description: "Labels of the workspace.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWorkspacesRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	config := m.(authctx.TanzuContext)
+
+	name, _ := d.Get(NameKey).(string)
+	selector := helper.GetStringMap(d.Get(labelsKey))
+
+	request := &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceListWorkspacesRequestParameters{
+		SearchScope: &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceSearchScope{
+			Name: name,
+		},
+		IncludeTotalCount: true,
+	}
+
+	workspaces, err := pagination.ListAll(pagination.DefaultPageSize, func(options *optionsmodel.VmwareTanzuCoreV1alpha1OptionsOffsetPaginationOptions) (*pagination.Page[*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace], error) {
+		request.Pagination = options
+
+		resp, err := config.TMCConnection.WorkspaceResourceService.ManageV1alpha1WorkspaceResourceServiceList(request)
+		if err != nil {
+			return nil, err
+		}
+
+		return &pagination.Page[*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace]{Items: resp.Workspaces, TotalCount: resp.TotalCount}, nil
+	})
+	if err != nil {
+		return clienterrors.ToDiagnostics(errors.Wrap(err, "Unable to list Tanzu Mission Control workspaces"))
+	}
+
+	if err := d.Set(workspacesKey, flattenWorkspaces(filterWorkspaces(workspaces, selector))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(helper.ConstructListDataSourceID(name, helper.LabelSelectorString(selector)))
+
+	return nil
+}
+
+func filterWorkspaces(workspaces []*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace, selector map[string]string) []*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace {
+	filtered := make([]*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace, 0, len(workspaces))
+
+	for _, workspace := range workspaces {
+		if workspace == nil || workspace.FullName == nil {
+			continue
+		}
+
+		var labels map[string]string
+		if workspace.Meta != nil {
+			labels = workspace.Meta.Labels
+		}
+
+		if helper.MatchLabels(labels, selector) {
+			filtered = append(filtered, workspace)
+		}
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].FullName.Name < filtered[j].FullName.Name
+	})
+
+	return filtered
+}
+
+func flattenWorkspaces(workspaces []*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace) []interface{} {
+	data := make([]interface{}, 0, len(workspaces))
+
+	for _, workspace := range workspaces {
+		flattenWorkspace := map[string]interface{}{
+			NameKey: workspace.FullName.Name,
+		}
+
+		if workspace.Meta != nil {
+			flattenWorkspace[uidKey] = workspace.Meta.UID
+			flattenWorkspace[descriptionKey] = workspace.Meta.Description
+			flattenWorkspace[labelsKey] = workspace.Meta.Labels
+		}
+
+		data = append(data, flattenWorkspace)
+	}
+
+	return data
+}
@@ -0,0 +1,68 @@
+/*
+Copyright © 2023 VMware, Inc. All Rights Reserved.
+SPDX-License-Identifier: MPL-2.0
+*/
+
+package workspace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	objectmetamodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/objectmeta"
+	workspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/workspace"
+)
+
+func TestFilterWorkspaces(t *testing.T) {
+	t.Parallel()
+
+	workspace := func(name string, labels map[string]string) *workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace {
+		return &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace{
+			FullName: &workspacemodel.VmwareTanzuManageV1alpha1WorkspaceFullName{Name: name},
+			Meta:     &objectmetamodel.VmwareTanzuCoreV1alpha1ObjectMeta{Labels: labels},
+		}
+	}
+
+	workspaces := []*workspacemodel.VmwareTanzuManageV1alpha1WorkspaceWorkspace{
+		workspace("ws-b", map[string]string{"env": "prod"}),
+		workspace("ws-a", map[string]string{"env": "prod", "team": "a"}),
+		workspace("ws-c", nil),
+		{},
+	}
+
+	cases := []struct {
+		name     string
+		selector map[string]string
+		expected []string
+	}{
+		{
+			name:     "case for no labels",
+			selector: nil,
+			expected: []string{"ws-a", "ws-b", "ws-c"},
+		},
+		{
+			name:     "case for labels",
+			selector: map[string]string{"env": "prod"},
+			expected: []string{"ws-a", "ws-b"},
+		},
+		{
+			name:     "case for labels no workspace has",
+			selector: map[string]string{"env": "dev"},
+			expected: []string{},
+		},
+	}
+
+	for _, each := range cases {
+		test := each
+		t.Run(test.name, func(t *testing.T) {
+			actual := make([]string, 0)
+
+			for _, workspace := range flattenWorkspaces(filterWorkspaces(workspaces, test.selector)) {
+				actual = append(actual, workspace.(map[string]interface{})[NameKey].(string))
+			}
+
+			require.Equal(t, test.expected, actual)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/authctx"
 	clienterrors "github.com/vmware/terraform-provider-tanzu-mission-control/internal/client/errors"
+	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/helper"
 	workspacemodel "github.com/vmware/terraform-provider-tanzu-mission-control/internal/models/workspace"
 	"github.com/vmware/terraform-provider-tanzu-mission-control/internal/resources/common"
 )
@@ -24,7 +25,10 @@ func ResourceWorkspace() *schema.Resource {
 		ReadContext:   dataSourceWorkspaceRead,
 		UpdateContext: resourceWorkspaceInPlaceUpdate,
 		DeleteContext: resourceWorkspaceDelete,
-		Schema:        workspaceSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWorkspaceImporter,
+		},
+		Schema: workspaceSchema,
 	}
 }
 
@@ -48,7 +52,7 @@ func resourceWorkspaceDelete(ctx context.Context, d *schema.ResourceData, m inte
 
 	err := config.TMCConnection.WorkspaceResourceService.ManageV1alpha1WorkspaceResourceServiceDelete(fn)
 	if err != nil && !clienterrors.IsNotFoundError(err) {
-		return diag.FromErr(errors.Wrapf(err, "Unable to delete Tanzu Mission Control workspace entry, name : %s", workspaceName))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to delete Tanzu Mission Control workspace entry, name : %s", workspaceName))
 	}
 
 	// d.SetId("") is automatically called assuming delete returns no errors, but
@@ -75,7 +79,7 @@ func resourceWorkspaceCreate(ctx context.Context, d *schema.ResourceData, m inte
 	workspaceResponse, err := config.TMCConnection.WorkspaceResourceService.ManageV1alpha1WorkspaceResourceServiceCreate(workspaceRequest)
 
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to create Tanzu Mission Control workspace entry, name : %s", workspaceName))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to create Tanzu Mission Control workspace entry, name : %s", workspaceName))
 	}
 
 	d.SetId(workspaceResponse.Workspace.Meta.UID)
@@ -103,7 +107,7 @@ func resourceWorkspaceInPlaceUpdate(ctx context.Context, d *schema.ResourceData,
 
 	getResp, err := config.TMCConnection.WorkspaceResourceService.ManageV1alpha1WorkspaceResourceServiceGet(fn)
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to get Tanzu Mission Control wrokspace entry, name : %s", workspaceName))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to get Tanzu Mission Control wrokspace entry, name : %s", workspaceName))
 	}
 
 	if updateRequired {
@@ -123,8 +127,22 @@ func resourceWorkspaceInPlaceUpdate(ctx context.Context, d *schema.ResourceData,
 		},
 	)
 	if err != nil {
-		return diag.FromErr(errors.Wrapf(err, "Unable to update Tanzu Mission Control workspace entry, name : %s", workspaceName))
+		return clienterrors.ToDiagnostics(errors.Wrapf(err, "Unable to update Tanzu Mission Control workspace entry, name : %s", workspaceName))
 	}
 
 	return dataSourceWorkspaceRead(ctx, d, m)
 }
+
+// resourceWorkspaceImporter imports a workspace using an ID of the form <name>.
+func resourceWorkspaceImporter(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts, err := helper.ParseImportID(d.Id(), NameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.Set(NameKey, parts[0]); err != nil {
+		return nil, errors.Wrapf(err, "Failed to set name for the workspace %s", parts[0])
+	}
+
+	return helper.ReadImportedState(ctx, d, m, dataSourceWorkspaceRead)
+}